@@ -0,0 +1,194 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/stats/endpoints"
+	"github.com/n-ae/nba-api-go/pkg/stats/parameters"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
+)
+
+// paramValidators check parameters that take a fixed set of values, by
+// field name, whatever the field's Go type.
+var paramValidators = map[string]func(string) error{
+	"LeagueID":     func(v string) error { return parameters.LeagueID(v).Validate() },
+	"Season":       func(v string) error { return parameters.Season(v).Validate() },
+	"SeasonYear":   func(v string) error { return parameters.Season(v).Validate() },
+	"SeasonType":   func(v string) error { return parameters.SeasonType(v).Validate() },
+	"PerMode":      func(v string) error { return parameters.PerMode(v).Validate() },
+	"MeasureType":  func(v string) error { return parameters.MeasureType(v).Validate() },
+	"PlayerOrTeam": func(v string) error { return parameters.PlayerOrTeam(v).Validate() },
+	"StatCategory": func(v string) error { return parameters.StatCategory(v).Validate() },
+}
+
+// requestValidators replace paramValidators for the requests whose
+// parameter of the same name takes other values.
+var requestValidators = map[reflect.Type]map[string]func(string) error{
+	reflect.TypeFor[endpoints.LeagueGameLogRequest]():    {"PlayerOrTeam": validatePlayerOrTeamLetter},
+	reflect.TypeFor[endpoints.LeagueGameFinderRequest](): {"PlayerOrTeam": validatePlayerOrTeamLetter},
+	reflect.TypeFor[endpoints.SynergyPlayTypesRequest](): {"PlayerOrTeam": validatePlayerOrTeamLetter},
+}
+
+// validatePlayerOrTeamLetter checks the "P" or "T" form of PlayerOrTeam.
+func validatePlayerOrTeamLetter(v string) error {
+	if v != "P" && v != "T" {
+		return fmt.Errorf("invalid PlayerOrTeam: %s", v)
+	}
+	return nil
+}
+
+// countParams are the numeric parameters that are not IDs.
+var countParams = map[string]bool{
+	"LastNGames":    true,
+	"Month":         true,
+	"NumberOfGames": true,
+	"GroupQuantity": true,
+	"TopX":          true,
+	"DayOffset":     true,
+}
+
+type paramError struct {
+	code    string
+	message string
+}
+
+// bindValues sets the fields of the request struct req points to from the
+// query. Parameter names match field names ignoring case, and an empty value
+// counts as absent, so the values already set stay as defaults. required
+// lists the parameters that must be given.
+func bindValues(query url.Values, req any, required ...string) *paramError {
+	v := reflect.ValueOf(req).Elem()
+	t := v.Type()
+
+	fields := make(map[string]int, t.NumField())
+	for _, i := range paramFields(t) {
+		fields[strings.ToLower(t.Field(i).Name)] = i
+	}
+
+	given := make(map[string]string, len(query))
+	keys := make([]string, 0, len(query))
+	for key, values := range query {
+		i, ok := fields[strings.ToLower(key)]
+		if !ok {
+			return &paramError{"unknown_parameter", fmt.Sprintf("Unknown parameter %s; supported: %s", key, supportedParams(t))}
+		}
+		if len(values) > 1 {
+			return &paramError{"invalid_parameter", fmt.Sprintf("%s is given more than once", key)}
+		}
+		if values[0] != "" {
+			given[t.Field(i).Name] = values[0]
+			keys = append(keys, t.Field(i).Name)
+		}
+	}
+
+	for _, name := range required {
+		if _, ok := given[name]; !ok {
+			return &paramError{"missing_parameter", name + " is required"}
+		}
+	}
+
+	sort.Strings(keys)
+	for _, name := range keys {
+		value := given[name]
+		if err := validateParam(t, name, value); err != nil {
+			return &paramError{"invalid_parameter", fmt.Sprintf("Invalid %s %q: %v", name, value, err)}
+		}
+
+		field := v.FieldByName(name)
+		if field.Kind() == reflect.Pointer {
+			p := reflect.New(field.Type().Elem())
+			p.Elem().SetString(value)
+			field.Set(p)
+		} else {
+			field.SetString(value)
+		}
+	}
+	return nil
+}
+
+func validateParam(t reflect.Type, name, value string) error {
+	if validate, ok := requestValidators[t][name]; ok {
+		return validate(value)
+	}
+	if validate, ok := paramValidators[name]; ok {
+		return validate(value)
+	}
+
+	switch {
+	case strings.HasSuffix(name, "IDList"):
+		for _, id := range strings.Split(value, ",") {
+			if !isDigits(id) {
+				return errors.New("want comma-separated numeric IDs")
+			}
+		}
+	case strings.HasSuffix(name, "ID") && name != "GroupID":
+		if !isDigits(value) {
+			return errors.New("want a numeric ID")
+		}
+	case strings.HasSuffix(name, "Period") || countParams[name]:
+		if _, err := strconv.Atoi(value); err != nil {
+			return errors.New("want a number")
+		}
+	case strings.HasPrefix(name, "Date") || strings.HasSuffix(name, "Date"):
+		if _, err := timeutil.ParseGameDate(value); err != nil {
+			return errors.New("want a date such as 2024-01-15")
+		}
+	}
+	return nil
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// paramFields returns the indexes of the fields of t that can be bound: the
+// exported string and *string kinds.
+func paramFields(t reflect.Type) []int {
+	var indexes []int
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		kind := field.Type.Kind()
+		if kind == reflect.Pointer {
+			kind = field.Type.Elem().Kind()
+		}
+		if field.IsExported() && kind == reflect.String {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+func supportedParams(t reflect.Type) string {
+	var names []string
+	for _, i := range paramFields(t) {
+		names = append(names, t.Field(i).Name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// writeAPIError reports an endpoint error: a 400 when the endpoint rejected
+// the request, otherwise a 500.
+func writeAPIError(w http.ResponseWriter, err error) {
+	if errors.Is(err, models.ErrInvalidRequest) {
+		writeError(w, http.StatusBadRequest, "invalid_parameter", err.Error())
+		return
+	}
+	writeError(w, http.StatusInternalServerError, "api_error", err.Error())
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/n-ae/nba-api-go/pkg/stats/endpoints"
+	"github.com/n-ae/nba-api-go/pkg/stats/parameters"
+)
+
+func TestBindValues(t *testing.T) {
+	query, _ := url.ParseQuery("playerid=2544&Season=2022-23&SeasonType=Playoffs&MeasureType=Advanced&LastNGames=10&DateFrom=&Month=3")
+	req := endpoints.PlayerDashboardByGeneralSplitsRequest{
+		Season:      parameters.CurrentSeason(),
+		PerMode:     perModePtr(parameters.PerModePerGame),
+		MeasureType: stringPtr("Base"),
+	}
+
+	if err := bindValues(query, &req, "PlayerID"); err != nil {
+		t.Fatalf("bindValues() = %+v", err)
+	}
+	if req.PlayerID != "2544" || req.Season != "2022-23" || req.SeasonType != parameters.SeasonTypePlayoffs {
+		t.Errorf("bound %+v", req)
+	}
+	if *req.MeasureType != "Advanced" || *req.LastNGames != "10" || *req.Month != "3" {
+		t.Errorf("bound MeasureType %q, LastNGames %q, Month %q", *req.MeasureType, *req.LastNGames, *req.Month)
+	}
+	if *req.PerMode != parameters.PerModePerGame || req.DateFrom != nil {
+		t.Errorf("defaults changed: PerMode %q, DateFrom %v", *req.PerMode, req.DateFrom)
+	}
+}
+
+func TestBindValues_PlayerOrTeamByRequest(t *testing.T) {
+	query, _ := url.ParseQuery("PlayerOrTeam=T")
+
+	var gameLog endpoints.LeagueGameLogRequest
+	if err := bindValues(query, &gameLog); err != nil {
+		t.Fatalf("bindValues(LeagueGameLogRequest) = %+v", err)
+	}
+	if *gameLog.PlayerOrTeam != "T" {
+		t.Errorf("bound PlayerOrTeam %q, want T", *gameLog.PlayerOrTeam)
+	}
+
+	var leaders endpoints.HomepageLeadersRequest
+	if err := bindValues(query, &leaders); err == nil || err.code != "invalid_parameter" {
+		t.Errorf("bindValues(HomepageLeadersRequest) = %+v, want invalid_parameter", err)
+	}
+	query.Set("PlayerOrTeam", "Team")
+	if err := bindValues(query, &leaders); err != nil {
+		t.Errorf("bindValues(HomepageLeadersRequest, Team) = %+v", err)
+	}
+}
+
+func TestBindQuery_Errors(t *testing.T) {
+	handler := NewStatsHandler()
+
+	tests := []struct {
+		name string
+		path string
+		code string
+	}{
+		{"missing required", "playergamelog?Season=2023-24", "missing_parameter"},
+		{"unknown parameter", "playergamelog?PlayerID=2544&Sesaon=2023-24", "unknown_parameter"},
+		{"invalid season", "playergamelog?PlayerID=2544&Season=2023-25", "invalid_parameter"},
+		{"invalid season type", "playergamelog?PlayerID=2544&SeasonType=Regular", "invalid_parameter"},
+		{"invalid ID", "playergamelog?PlayerID=lebron", "invalid_parameter"},
+		{"invalid ID list", "playercompare?PlayerIDList=2544,x", "invalid_parameter"},
+		{"invalid measure type", "teamdashboardbygeneralsplits?TeamID=1610612747&MeasureType=Everything", "invalid_parameter"},
+		{"invalid date", "scoreboardv2?GameDate=tomorrow", "invalid_parameter"},
+		{"invalid period", "boxscoretraditionalv3?GameID=0022300061&StartPeriod=first", "invalid_parameter"},
+		{"invalid player or team", "leaguegamelog?PlayerOrTeam=Player", "invalid_parameter"},
+		{"repeated parameter", "playergamelog?PlayerID=2544&PlayerID=201939", "invalid_parameter"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/stats/"+tt.path, nil)
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("expected status 400, got %d: %s", w.Code, w.Body.String())
+			}
+			var response struct {
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+				t.Fatal(err)
+			}
+			if response.Error.Code != tt.code {
+				t.Errorf("expected error code %s, got %s", tt.code, response.Error.Code)
+			}
+		})
+	}
+}
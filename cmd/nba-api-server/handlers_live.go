@@ -0,0 +1,217 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/client"
+	"github.com/n-ae/nba-api-go/pkg/live"
+	"github.com/n-ae/nba-api-go/pkg/live/endpoints"
+	"github.com/n-ae/nba-api-go/pkg/live/watch"
+)
+
+// streamHeartbeat keeps idle streams open through proxies.
+const streamHeartbeat = 15 * time.Second
+
+// LiveHandler serves the live CDN feeds under /api/v1/live/.
+type LiveHandler struct {
+	client *live.Client
+	hub    *liveHub
+}
+
+func NewLiveHandler(logger *log.Logger, clientOpts ...client.Option) *LiveHandler {
+	return newLiveHandler(live.NewClient(live.Config{}, clientOpts...), watch.DefaultConfig(), logger)
+}
+
+func newLiveHandler(liveClient *live.Client, config watch.Config, logger *log.Logger) *LiveHandler {
+	return &LiveHandler{
+		client: liveClient,
+		hub:    newLiveHub(liveClient, config, logger),
+	}
+}
+
+// Close ends all streams, so their requests return before shutdown.
+func (h *LiveHandler) Close() {
+	h.hub.Close()
+}
+
+func (h *LiveHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET requests are supported")
+		return
+	}
+
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/v1/live/"), "/")
+	endpoint := strings.ToLower(path)
+
+	switch {
+	case endpoint == "scoreboard":
+		h.handleScoreboard(w, r, "")
+	case strings.HasPrefix(endpoint, "scoreboard/"):
+		h.handleScoreboard(w, r, strings.TrimPrefix(endpoint, "scoreboard/"))
+	case endpoint == "stream":
+		h.handleStream(w, r, "")
+	case strings.HasPrefix(endpoint, "stream/"):
+		gameID := strings.TrimPrefix(endpoint, "stream/")
+		if !isGameID(gameID) {
+			writeError(w, http.StatusBadRequest, "invalid_parameter", "Game ID must be 10 digits")
+			return
+		}
+		h.handleStream(w, r, gameID)
+	default:
+		writeError(w, http.StatusNotFound, "endpoint_not_found", "Endpoint not supported: "+endpoint)
+	}
+}
+
+func isGameID(s string) bool {
+	if len(s) != 10 {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// handleScoreboard serves today's live scoreboard, or the scoreboard of a
+// date given as YYYY-MM-DD or YYYYMMDD.
+func (h *LiveHandler) handleScoreboard(w http.ResponseWriter, r *http.Request, date string) {
+	if date == "" {
+		resp, err := endpoints.Scoreboard(r.Context(), h.client)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "api_error", err.Error())
+			return
+		}
+		writeSuccess(w, resp.Data)
+		return
+	}
+
+	day, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		day, err = time.Parse("20060102", date)
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_parameter", "Date must be YYYY-MM-DD or YYYYMMDD")
+		return
+	}
+
+	resp, err := endpoints.ScoreboardByDate(r.Context(), h.client, day.Format("20060102"))
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "api_error", err.Error())
+		return
+	}
+	writeSuccess(w, resp.Data)
+}
+
+// handleStream pushes the events of the scoreboard, or of one game and its
+// play-by-play, over Server-Sent Events or, when the request asks to
+// upgrade, a WebSocket. Clients resume with the Last-Event-ID header or the
+// last_event_id query parameter; otherwise they start with a snapshot.
+func (h *LiveHandler) handleStream(w http.ResponseWriter, r *http.Request, gameID string) {
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("last_event_id")
+	}
+
+	stream, sub, backlog, err := h.hub.subscribe(gameID, lastEventID)
+	if err != nil {
+		writeError(w, http.StatusServiceUnavailable, "unavailable", err.Error())
+		return
+	}
+	defer h.hub.unsubscribe(stream, sub)
+
+	if isWebSocketUpgrade(r) {
+		h.serveWebSocket(w, r, sub, backlog)
+		return
+	}
+	h.serveSSE(w, r, sub, backlog)
+}
+
+func (h *LiveHandler) serveSSE(w http.ResponseWriter, r *http.Request, sub *subscriber, backlog []streamEvent) {
+	rc := http.NewResponseController(w)
+	// Streams outlive the server's WriteTimeout.
+	_ = rc.SetWriteDeadline(time.Time{})
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	fmt.Fprint(w, "retry: 3000\n\n")
+	for _, event := range backlog {
+		writeSSE(w, event)
+	}
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-sub.events:
+			if !ok {
+				return
+			}
+			writeSSE(w, event)
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": ping\n\n")
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func writeSSE(w http.ResponseWriter, event streamEvent) {
+	fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, event.Data)
+}
+
+// serveWebSocket sends each event as a JSON text message; its id field is
+// the event ID to resume from.
+func (h *LiveHandler) serveWebSocket(w http.ResponseWriter, r *http.Request, sub *subscriber, backlog []streamEvent) {
+	conn, err := upgradeWebSocket(w, r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_websocket", err.Error())
+		return
+	}
+	defer conn.Close()
+
+	closed := make(chan struct{})
+	go conn.readLoop(closed)
+
+	for _, event := range backlog {
+		if conn.writeFrame(wsText, event.Data) != nil {
+			return
+		}
+	}
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		var err error
+		select {
+		case <-closed:
+			return
+		case event, ok := <-sub.events:
+			if !ok {
+				conn.writeFrame(wsClose, nil)
+				return
+			}
+			err = conn.writeFrame(wsText, event.Data)
+		case <-heartbeat.C:
+			err = conn.writeFrame(wsPing, nil)
+		}
+		if err != nil {
+			return
+		}
+	}
+}
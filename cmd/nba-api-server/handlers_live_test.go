@@ -0,0 +1,381 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/client"
+	"github.com/n-ae/nba-api-go/pkg/live"
+	"github.com/n-ae/nba-api-go/pkg/live/endpoints"
+	"github.com/n-ae/nba-api-go/pkg/live/watch"
+	"github.com/n-ae/nba-api-go/pkg/transport"
+)
+
+const liveTestGameID = "0022400062"
+
+// fakeLiveCDN serves a one-game scoreboard and its play-by-play.
+type fakeLiveCDN struct {
+	mu              sync.Mutex
+	game            endpoints.Game
+	actions         []endpoints.Action
+	scoreboardPolls int
+	pbpPolls        int
+}
+
+func (f *fakeLiveCDN) playByPlayPolls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.pbpPolls
+}
+
+func (f *fakeLiveCDN) polls() (scoreboard, playByPlay int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.scoreboardPolls, f.pbpPolls
+}
+
+func (f *fakeLiveCDN) setScore(home, away int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.game.HomeTeam.Score, f.game.AwayTeam.Score = home, away
+}
+
+func (f *fakeLiveCDN) addAction(action endpoints.Action) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.actions = append(f.actions, action)
+}
+
+func (f *fakeLiveCDN) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.URL.Path {
+	case "/scoreboard/todaysScoreboard_00.json":
+		f.scoreboardPolls++
+		var resp endpoints.ScoreboardResponse
+		resp.Scoreboard.Games = []endpoints.Game{f.game}
+		json.NewEncoder(w).Encode(resp)
+	case "/scoreboard/scoreboard_20241022.json":
+		var resp endpoints.ScoreboardResponse
+		resp.Scoreboard.GameDate = "2024-10-22"
+		resp.Scoreboard.Games = []endpoints.Game{f.game}
+		json.NewEncoder(w).Encode(resp)
+	case "/playbyplay/playbyplay_" + liveTestGameID + ".json":
+		f.pbpPolls++
+		var resp endpoints.PlayByPlayResponse
+		resp.Game.GameID = liveTestGameID
+		resp.Game.Actions = f.actions
+		json.NewEncoder(w).Encode(resp)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// newLiveTestServer serves the full server routes with the live handler
+// polling a fake CDN.
+func newLiveTestServer(t *testing.T) (*httptest.Server, *fakeLiveCDN, *LiveHandler) {
+	t.Helper()
+
+	cdn := &fakeLiveCDN{game: endpoints.Game{GameID: liveTestGameID, GameStatus: 2, Period: 1, GameClock: "PT10M00.00S"}}
+	cdn.game.HomeTeam.TeamID = 1610612747
+	cdn.game.AwayTeam.TeamID = 1610612750
+	cdnServer := httptest.NewServer(cdn)
+	t.Cleanup(cdnServer.Close)
+
+	logger := log.New(io.Discard, "", 0)
+	liveClient := live.NewClient(live.Config{
+		Middlewares: []transport.Middleware{transport.WithUserAgent("test")},
+	}, client.WithBaseURL(cdnServer.URL))
+
+	server := NewServer(logger)
+	server.liveHandler = newLiveHandler(liveClient, watch.Config{
+		Interval:     5 * time.Millisecond,
+		IdleInterval: 5 * time.Millisecond,
+	}, logger)
+
+	ts := httptest.NewServer(server.Routes())
+	t.Cleanup(ts.Close)
+	t.Cleanup(server.liveHandler.Close)
+	return ts, cdn, server.liveHandler
+}
+
+type sseEvent struct {
+	id, event, data string
+}
+
+func readSSE(t *testing.T, r *bufio.Reader) sseEvent {
+	t.Helper()
+	var event sseEvent
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatalf("read stream: %v", err)
+		}
+		line = strings.TrimRight(line, "\n")
+		switch {
+		case line == "" && event.event != "":
+			return event
+		case strings.HasPrefix(line, "id: "):
+			event.id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "event: "):
+			event.event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			event.data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+}
+
+func openSSE(t *testing.T, ctx context.Context, url, lastEventID string) *bufio.Reader {
+	t.Helper()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("GET %s: %v", url, err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "text/event-stream" {
+		t.Fatalf("GET %s: status %d, content type %q", url, resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	return bufio.NewReader(resp.Body)
+}
+
+func TestLiveStream_SSE(t *testing.T) {
+	ts, cdn, handler := newLiveTestServer(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	first := openSSE(t, ctx, ts.URL+"/api/v1/live/stream", "")
+	snapshot := readSSE(t, first)
+	if snapshot.event != "snapshot" || !strings.Contains(snapshot.data, liveTestGameID) {
+		t.Fatalf("first event = %+v, want a snapshot of the game", snapshot)
+	}
+
+	cdn.setScore(2, 0)
+	scored := readSSE(t, first)
+	if scored.event != string(watch.ScoreChanged) {
+		t.Fatalf("event = %+v, want score_changed", scored)
+	}
+	var payload streamPayload
+	if err := json.Unmarshal([]byte(scored.data), &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.ID != scored.id || payload.Game == nil || payload.Game.HomeTeam.Score != 2 {
+		t.Errorf("payload = %+v", payload)
+	}
+
+	// A second client shares the upstream poller and starts from a snapshot.
+	second := openSSE(t, ctx, ts.URL+"/api/v1/live/stream", "")
+	if event := readSSE(t, second); event.event != "snapshot" {
+		t.Errorf("second client first event = %+v, want snapshot", event)
+	}
+	if n := handler.hub.streamCount(); n != 1 {
+		t.Errorf("hub runs %d streams, want 1", n)
+	}
+
+	// Resuming after the snapshot replays what was missed.
+	resumed := openSSE(t, ctx, ts.URL+"/api/v1/live/stream", snapshot.id)
+	if event := readSSE(t, resumed); event.id != scored.id {
+		t.Errorf("resumed client first event = %+v, want %s", event, scored.id)
+	}
+}
+
+func TestLiveStream_SharedPoller(t *testing.T) {
+	ts, cdn, handler := newLiveTestServer(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	scoreboard := openSSE(t, ctx, ts.URL+"/api/v1/live/stream", "")
+	game := openSSE(t, ctx, ts.URL+"/api/v1/live/stream/"+liveTestGameID, "")
+	for _, r := range []*bufio.Reader{scoreboard, game} {
+		if event := readSSE(t, r); event.event != "snapshot" {
+			t.Fatalf("first event = %+v, want snapshot", event)
+		}
+	}
+	if n := handler.hub.streamCount(); n != 2 {
+		t.Errorf("hub runs %d streams, want 2", n)
+	}
+
+	// Both streams get the game's scoreboard events from the same poll.
+	cdn.setScore(2, 0)
+	for _, r := range []*bufio.Reader{scoreboard, game} {
+		if event := readSSE(t, r); event.event != string(watch.ScoreChanged) {
+			t.Errorf("event = %+v, want score_changed", event)
+		}
+	}
+
+	// Each poll reads the scoreboard once, not once per stream.
+	sb0, pbp0 := cdn.polls()
+	for {
+		sb, pbp := cdn.polls()
+		if pbp-pbp0 < 10 {
+			time.Sleep(time.Millisecond)
+			continue
+		}
+		if sb-sb0 > pbp-pbp0+1 {
+			t.Errorf("%d scoreboard polls for %d play-by-play polls, want one each", sb-sb0, pbp-pbp0)
+		}
+		break
+	}
+
+	// Play-by-play actions only go to the game stream.
+	cdn.addAction(endpoints.Action{ActionNumber: 1, Period: 1, ActionType: "2pt"})
+	if event := readSSE(t, game); event.event != string(watch.NewAction) {
+		t.Errorf("game stream event = %+v, want new_action", event)
+	}
+	cdn.setScore(4, 0)
+	if event := readSSE(t, scoreboard); event.event != string(watch.ScoreChanged) {
+		t.Errorf("scoreboard stream event = %+v, want score_changed", event)
+	}
+}
+
+func TestLiveScoreboard(t *testing.T) {
+	ts, _, _ := newLiveTestServer(t)
+
+	tests := []struct {
+		path     string
+		status   int
+		gameDate string
+	}{
+		{"/api/v1/live/scoreboard", http.StatusOK, ""},
+		{"/api/v1/live/scoreboard/2024-10-22", http.StatusOK, "2024-10-22"},
+		{"/api/v1/live/scoreboard/20241022", http.StatusOK, "2024-10-22"},
+		{"/api/v1/live/scoreboard/yesterday", http.StatusBadRequest, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			resp, err := http.Get(ts.URL + tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != tt.status {
+				t.Fatalf("expected status %d, got %d", tt.status, resp.StatusCode)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+
+			var body struct {
+				Success bool                         `json:"success"`
+				Data    endpoints.ScoreboardResponse `json:"data"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+			if !body.Success || len(body.Data.Scoreboard.Games) != 1 || body.Data.Scoreboard.GameDate != tt.gameDate {
+				t.Errorf("response = %+v", body)
+			}
+		})
+	}
+}
+
+func TestLiveStream_InvalidGameID(t *testing.T) {
+	ts, _, _ := newLiveTestServer(t)
+
+	resp, err := http.Get(ts.URL + "/api/v1/live/stream/latest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected status 400, got %d", resp.StatusCode)
+	}
+}
+
+func readWSMessage(t *testing.T, conn net.Conn, r *bufio.Reader) (byte, []byte) {
+	t.Helper()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	var head [2]byte
+	if _, err := io.ReadFull(r, head[:]); err != nil {
+		t.Fatalf("read frame: %v", err)
+	}
+	n := uint64(head[1] & 0x7F)
+	switch n {
+	case 126:
+		var ext [2]byte
+		io.ReadFull(r, ext[:])
+		n = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		io.ReadFull(r, ext[:])
+		n = binary.BigEndian.Uint64(ext[:])
+	}
+	payload := make([]byte, n)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		t.Fatalf("read frame: %v", err)
+	}
+	return head[0] & 0x0F, payload
+}
+
+func TestLiveStream_GameWebSocket(t *testing.T) {
+	ts, cdn, _ := newLiveTestServer(t)
+
+	conn, err := net.Dial("tcp", strings.TrimPrefix(ts.URL, "http://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	io.WriteString(conn, "GET /api/v1/live/stream/"+liveTestGameID+" HTTP/1.1\r\n"+
+		"Host: localhost\r\n"+
+		"Upgrade: websocket\r\n"+
+		"Connection: Upgrade\r\n"+
+		"Sec-WebSocket-Key: dGhlIHNhbXBsZSBub25jZQ==\r\n"+
+		"Sec-WebSocket-Version: 13\r\n\r\n")
+	r := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(r, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols || resp.Header.Get("Sec-WebSocket-Accept") != "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=" {
+		t.Fatalf("handshake: status %d, accept %q", resp.StatusCode, resp.Header.Get("Sec-WebSocket-Accept"))
+	}
+
+	var payload streamPayload
+	for payload.Type != snapshotEvent {
+		opcode, data := readWSMessage(t, conn, r)
+		if opcode == wsText {
+			json.Unmarshal(data, &payload)
+		}
+	}
+
+	// The first play-by-play read is the baseline; add the action after it.
+	for cdn.playByPlayPolls() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	cdn.addAction(endpoints.Action{ActionNumber: 1, Period: 1, ActionType: "2pt"})
+	for payload.Type != string(watch.NewAction) {
+		opcode, data := readWSMessage(t, conn, r)
+		if opcode == wsText {
+			json.Unmarshal(data, &payload)
+		}
+	}
+	if payload.Action == nil || payload.Action.ActionNumber != 1 || payload.GameID != liveTestGameID {
+		t.Errorf("payload = %+v", payload)
+	}
+
+	// A masked close frame from the client is echoed back.
+	conn.Write([]byte{0x80 | wsClose, 0x80, 0, 0, 0, 0})
+	for {
+		opcode, _ := readWSMessage(t, conn, r)
+		if opcode == wsClose {
+			break
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/n-ae/nba-api-go/pkg/stats/static"
+)
+
+// StaticHandler serves the embedded player and team data of
+// pkg/stats/static under /api/v1/players and /api/v1/teams.
+type StaticHandler struct{}
+
+func NewStaticHandler() *StaticHandler {
+	return &StaticHandler{}
+}
+
+func (h *StaticHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET requests are supported")
+		return
+	}
+
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/v1/"), "/")
+	resource, id, _ := strings.Cut(path, "/")
+
+	switch {
+	case resource == "players" && id == "":
+		h.handleSearchPlayers(w, r)
+	case resource == "players":
+		h.handlePlayer(w, id)
+	case resource == "teams" && id == "":
+		h.handleSearchTeams(w, r)
+	case resource == "teams":
+		h.handleTeam(w, id)
+	default:
+		writeError(w, http.StatusNotFound, "endpoint_not_found", "Endpoint not supported: "+path)
+	}
+}
+
+// handleSearchPlayers matches q against player names, ignoring case and
+// accents; without q it lists every player. active=true or false filters
+// by status.
+func (h *StaticHandler) handleSearchPlayers(w http.ResponseWriter, r *http.Request) {
+	var active *bool
+	if value := r.URL.Query().Get("active"); value != "" {
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid_parameter", "active must be true or false")
+			return
+		}
+		active = &b
+	}
+
+	var players []static.Player
+	var err error
+	if q := r.URL.Query().Get("q"); q != "" {
+		players, err = static.SearchPlayers(q)
+	} else {
+		players, err = static.GetAllPlayers()
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "internal_error", err.Error())
+		return
+	}
+
+	if active != nil {
+		filtered := make([]static.Player, 0, len(players))
+		for _, p := range players {
+			if p.IsActive == *active {
+				filtered = append(filtered, p)
+			}
+		}
+		players = filtered
+	}
+
+	writeSuccess(w, players)
+}
+
+func (h *StaticHandler) handlePlayer(w http.ResponseWriter, id string) {
+	playerID, err := strconv.Atoi(id)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_parameter", "Player ID must be a number")
+		return
+	}
+
+	player, err := static.FindPlayerByID(playerID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "internal_error", err.Error())
+		return
+	}
+	if player == nil {
+		writeError(w, http.StatusNotFound, "not_found", "No player with ID "+id)
+		return
+	}
+
+	writeSuccess(w, player)
+}
+
+// handleSearchTeams matches q against team names, cities and abbreviations;
+// without q it lists every team.
+func (h *StaticHandler) handleSearchTeams(w http.ResponseWriter, r *http.Request) {
+	var teams []static.Team
+	var err error
+	if q := r.URL.Query().Get("q"); q != "" {
+		teams, err = static.SearchTeams(q)
+	} else {
+		teams, err = static.GetAllTeams()
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "internal_error", err.Error())
+		return
+	}
+
+	writeSuccess(w, teams)
+}
+
+// handleTeam looks a team up by ID or by abbreviation such as "LAL".
+func (h *StaticHandler) handleTeam(w http.ResponseWriter, id string) {
+	var team *static.Team
+	var err error
+	if teamID, convErr := strconv.Atoi(id); convErr == nil {
+		team, err = static.FindTeamByID(teamID)
+	} else {
+		team, err = static.FindTeamByAbbreviation(id)
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "internal_error", err.Error())
+		return
+	}
+	if team == nil {
+		writeError(w, http.StatusNotFound, "not_found", "No team with ID or abbreviation "+id)
+		return
+	}
+
+	writeSuccess(w, team)
+}
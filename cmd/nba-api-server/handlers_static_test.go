@@ -0,0 +1,86 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/n-ae/nba-api-go/pkg/stats/static"
+)
+
+func getStatic(t *testing.T, path string, data any) int {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	w := httptest.NewRecorder()
+	NewStaticHandler().ServeHTTP(w, req)
+
+	if w.Code == http.StatusOK {
+		body := struct {
+			Success bool `json:"success"`
+			Data    any  `json:"data"`
+		}{Data: data}
+		if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if !body.Success {
+			t.Errorf("%s: expected success=true", path)
+		}
+	}
+	return w.Code
+}
+
+func TestStaticHandler_Players(t *testing.T) {
+	var players []static.Player
+	if code := getStatic(t, "/api/v1/players?q=lebron", &players); code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", code)
+	}
+	if len(players) != 1 || players[0].ID != 2544 {
+		t.Errorf("players = %+v, want LeBron James", players)
+	}
+
+	var active []static.Player
+	getStatic(t, "/api/v1/players?q=james&active=true", &active)
+	for _, p := range active {
+		if !p.IsActive {
+			t.Errorf("active=true returned inactive player %+v", p)
+		}
+	}
+
+	var player static.Player
+	if code := getStatic(t, "/api/v1/players/2544", &player); code != http.StatusOK || player.FullName != "LeBron James" {
+		t.Errorf("GET /api/v1/players/2544 = %d %+v", code, player)
+	}
+
+	for path, want := range map[string]int{
+		"/api/v1/players/1":            http.StatusNotFound,
+		"/api/v1/players/lebron":       http.StatusBadRequest,
+		"/api/v1/players?active=maybe": http.StatusBadRequest,
+		"/api/v1/players/2544/seasons": http.StatusBadRequest,
+	} {
+		if code := getStatic(t, path, nil); code != want {
+			t.Errorf("GET %s = %d, want %d", path, code, want)
+		}
+	}
+}
+
+func TestStaticHandler_Teams(t *testing.T) {
+	var teams []static.Team
+	if code := getStatic(t, "/api/v1/teams?q=lakers", &teams); code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", code)
+	}
+	if len(teams) != 1 || teams[0].Abbreviation != "LAL" {
+		t.Errorf("teams = %+v, want the Lakers", teams)
+	}
+
+	for _, path := range []string{"/api/v1/teams/1610612747", "/api/v1/teams/lal"} {
+		var team static.Team
+		if code := getStatic(t, path, &team); code != http.StatusOK || team.ID != 1610612747 {
+			t.Errorf("GET %s = %d %+v", path, code, team)
+		}
+	}
+
+	if code := getStatic(t, "/api/v1/teams/XYZ", nil); code != http.StatusNotFound {
+		t.Errorf("GET /api/v1/teams/XYZ = %d, want 404", code)
+	}
+}
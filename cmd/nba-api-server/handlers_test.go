@@ -1,14 +1,39 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"io"
 	"log"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/stats"
+	"github.com/n-ae/nba-api-go/pkg/transport"
 )
 
+// newReplayStatsHandler returns a StatsHandler whose upstream calls are served
+// only from the named cassette in testdata/cassettes.
+func newReplayStatsHandler(t *testing.T, name string) *StatsHandler {
+	t.Helper()
+
+	cassette, err := transport.LoadCassette("testdata/cassettes/" + name + ".json")
+	if err != nil {
+		t.Fatalf("LoadCassette() error = %v", err)
+	}
+
+	return &StatsHandler{
+		client: stats.NewClient(stats.Config{
+			Middlewares: []transport.Middleware{transport.WithCassette(cassette, transport.ModeReplay)},
+		}),
+	}
+}
+
 func TestHealthEndpoint(t *testing.T) {
 	logger := log.New(os.Stdout, "[test] ", log.LstdFlags)
 	server := NewServer(logger)
@@ -49,6 +74,10 @@ func TestHealthEndpoint(t *testing.T) {
 		t.Errorf("expected nba_api_status to be 'operational' or 'degraded', got %s", nbaAPIStatus)
 	}
 
+	if counts, ok := response["endpoints_count"].(map[string]interface{}); !ok || counts["http_exposed"] != float64(len(statsRoutes)) || counts["sdk_total"] == nil {
+		t.Errorf("expected endpoints_count http_exposed %d and sdk_total, got %v", len(statsRoutes), response["endpoints_count"])
+	}
+
 	if timestamp, ok := response["timestamp"].(float64); !ok || timestamp == 0 {
 		t.Error("expected timestamp to be set")
 	}
@@ -111,6 +140,24 @@ func TestServerRoutes(t *testing.T) {
 			path:           "/health",
 			expectedStatus: http.StatusOK,
 		},
+		{
+			name:           "endpoints listing",
+			method:         http.MethodGet,
+			path:           "/api/v1/endpoints",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "player lookup",
+			method:         http.MethodGet,
+			path:           "/api/v1/players/2544",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "team search",
+			method:         http.MethodGet,
+			path:           "/api/v1/teams?q=celtics",
+			expectedStatus: http.StatusOK,
+		},
 		{
 			name:           "unknown endpoint",
 			method:         http.MethodGet,
@@ -384,3 +431,132 @@ func TestInternationalBroadcasterScheduleEndpoint_WithOptionalParams(t *testing.
 		t.Errorf("expected status 200, got %d", w.Code)
 	}
 }
+
+func TestPlayerGameLogEndpoint_Replay(t *testing.T) {
+	handler := newReplayStatsHandler(t, "playergamelog")
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/stats/playergamelog?PlayerID=2544&Season=2023-24", nil)
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var response struct {
+		Success bool `json:"success"`
+		Data    struct {
+			PlayerGameLog []map[string]interface{} `json:"PlayerGameLog"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if !response.Success || len(response.Data.PlayerGameLog) != 2 {
+		t.Errorf("expected 2 game logs, got %+v", response)
+	}
+}
+
+func TestWriteSuccess_Envelope(t *testing.T) {
+	w := httptest.NewRecorder()
+	writeSuccess(w, []int{1}, models.SchemaDrift{Kind: models.DriftMissingColumn, ResultSet: "PlayerGameLog", Column: "PTS"})
+
+	var response map[string]json.RawMessage
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(response) != 3 || string(response["data"]) != "[1]" {
+		t.Errorf("unexpected envelope %v", response)
+	}
+	if want := `[{"kind":"missing_column","result_set":"PlayerGameLog","column":"PTS"}]`; string(response["warnings"]) != want {
+		t.Errorf("warnings = %s, want %s", response["warnings"], want)
+	}
+
+	w = httptest.NewRecorder()
+	writeSuccess(w, []int{1})
+	if body := strings.TrimSpace(w.Body.String()); body != `{"success":true,"data":[1]}` {
+		t.Errorf("body without warnings = %s", body)
+	}
+}
+
+func TestBoxScoreTraditionalV3Endpoint_Replay(t *testing.T) {
+	handler := newReplayStatsHandler(t, "boxscoretraditionalv3")
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/stats/boxscoretraditionalv3?GameID=0022400061", nil)
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var response struct {
+		Success bool `json:"success"`
+		Data    struct {
+			HomeTeam struct {
+				TeamTricode string `json:"teamTricode"`
+				Players     []struct {
+					PersonID   int `json:"personId"`
+					Statistics struct {
+						Points int `json:"points"`
+					} `json:"statistics"`
+				} `json:"players"`
+			} `json:"homeTeam"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	home := response.Data.HomeTeam
+	if !response.Success || home.TeamTricode != "LAL" || len(home.Players) != 2 || home.Players[0].Statistics.Points != 16 {
+		t.Errorf("unexpected box score %+v", response)
+	}
+}
+
+func TestBoxScoreV3Endpoints_MissingGameID(t *testing.T) {
+	handler := NewStatsHandler()
+
+	for _, endpoint := range []string{"boxscoretraditionalv3", "boxscorehustlev3", "boxscoresummaryv3"} {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/stats/"+endpoint, nil)
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status 400, got %d", endpoint, w.Code)
+		}
+	}
+}
+
+func TestHealthEndpoint_CircuitBreakerOpen(t *testing.T) {
+	logger := log.New(io.Discard, "", 0)
+	server := NewServer(logger)
+	server.breaker = transport.NewCircuitBreaker(transport.CircuitBreakerConfig{FailureThreshold: 1})
+
+	failing := server.breaker.Middleware()(transport.RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	}))
+	_, _ = failing.RoundTrip(context.Background(), httptest.NewRequest(http.MethodGet, stats.StatsBaseURL+"/x", nil))
+
+	w := httptest.NewRecorder()
+	server.handleHealth()(w, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	var response struct {
+		NBAAPIStatus    string            `json:"nba_api_status"`
+		CircuitBreakers map[string]string `json:"circuit_breakers"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if response.CircuitBreakers[statsHost] != "open" {
+		t.Errorf("expected %s breaker open, got %v", statsHost, response.CircuitBreakers)
+	}
+	if response.NBAAPIStatus != "degraded" {
+		t.Errorf("expected nba_api_status=degraded, got %s", response.NBAAPIStatus)
+	}
+}
@@ -0,0 +1,392 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/live"
+	"github.com/n-ae/nba-api-go/pkg/live/endpoints"
+	"github.com/n-ae/nba-api-go/pkg/live/watch"
+)
+
+const (
+	// streamHistory is how many events a stream keeps for Last-Event-ID
+	// resume.
+	streamHistory = 256
+
+	// streamLinger keeps a stream polling after its last subscriber leaves,
+	// so clients that reconnect can resume.
+	streamLinger = 30 * time.Second
+
+	// subscriberBuffer is how far a subscriber may fall behind before it is
+	// dropped. It can reconnect and resume from the history.
+	subscriberBuffer = 64
+
+	snapshotEvent = "snapshot"
+)
+
+var errHubClosed = errors.New("live streams are shutting down")
+
+// streamPayload is the JSON data of a stream event. A snapshot carries the
+// current Games; the other events carry the Game after the change.
+type streamPayload struct {
+	ID     string            `json:"id"`
+	Type   string            `json:"type"`
+	GameID string            `json:"game_id,omitempty"`
+	Time   time.Time         `json:"time"`
+	Period int               `json:"period,omitempty"`
+	Game   *endpoints.Game   `json:"game,omitempty"`
+	Games  []endpoints.Game  `json:"games,omitempty"`
+	Action *endpoints.Action `json:"action,omitempty"`
+}
+
+type streamEvent struct {
+	seq  uint64
+	ID   string
+	Type string
+	Data []byte
+}
+
+type subscriber struct {
+	events chan streamEvent
+}
+
+// liveHub runs one upstream watcher for all streams and fans its events out
+// to their subscribers. A stream is the whole scoreboard (key "") or one game
+// and its play-by-play (key: the game ID). The watcher polls the scoreboard
+// once for every stream and the play-by-play only of games with a stream.
+type liveHub struct {
+	client *live.Client
+	config watch.Config
+	logger *log.Logger
+	linger time.Duration
+
+	mu      sync.Mutex
+	streams map[string]*liveStream
+	closed  bool
+
+	// stop cancels the running watcher, which is nil when there are no
+	// streams. gen tells a running watcher from one already stopped, and
+	// games is its last poll, nil until the first.
+	stop  context.CancelFunc
+	gen   uint64
+	games []endpoints.Game
+}
+
+func newLiveHub(client *live.Client, config watch.Config, logger *log.Logger) *liveHub {
+	return &liveHub{
+		client:  client,
+		config:  config,
+		logger:  logger,
+		linger:  streamLinger,
+		streams: make(map[string]*liveStream),
+	}
+}
+
+// subscribe joins the stream, starting it if needed, and returns the events
+// to send first: those after lastEventID when it can be resumed, otherwise a
+// snapshot.
+func (h *liveHub) subscribe(key, lastEventID string) (*liveStream, *subscriber, []streamEvent, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		return nil, nil, nil, errHubClosed
+	}
+
+	if h.stop == nil {
+		h.startWatcher()
+	}
+	stream, ok := h.streams[key]
+	if !ok {
+		stream = newLiveStream(key)
+		h.streams[key] = stream
+		if h.games != nil {
+			stream.setGames(gamesFor(key, h.games))
+		}
+	}
+	sub, backlog := stream.add(lastEventID)
+	return stream, sub, backlog, nil
+}
+
+// unsubscribe leaves the stream and stops it once it has had no subscribers
+// for the linger period. The watcher stops with the last stream.
+func (h *liveHub) unsubscribe(stream *liveStream, sub *subscriber) {
+	if !stream.remove(sub) {
+		return
+	}
+	stream.setLinger(time.AfterFunc(h.linger, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if h.streams[stream.key] == stream && stream.idle() {
+			delete(h.streams, stream.key)
+			stream.close()
+			if len(h.streams) == 0 {
+				h.stopWatcher()
+			}
+		}
+	}))
+}
+
+// Close stops every stream, which ends all subscriptions.
+func (h *liveHub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closed = true
+	for key, stream := range h.streams {
+		stream.close()
+		delete(h.streams, key)
+	}
+	if h.stop != nil {
+		h.stopWatcher()
+	}
+}
+
+func (h *liveHub) streamCount() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.streams)
+}
+
+// startWatcher starts the shared watcher. h.mu must be held.
+func (h *liveHub) startWatcher() {
+	ctx, cancel := context.WithCancel(context.Background())
+	h.stop = cancel
+	h.gen++
+	gen := h.gen
+
+	config := h.config
+	config.PlayByPlay = true
+	config.PlayByPlayFor = func(gameID string) bool {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		_, ok := h.streams[gameID]
+		return ok
+	}
+	config.OnPoll = func(games []endpoints.Game) {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if h.gen != gen {
+			return
+		}
+		if games == nil {
+			games = []endpoints.Game{}
+		}
+		h.games = games
+		for key, stream := range h.streams {
+			stream.setGames(gamesFor(key, games))
+		}
+	}
+	config.OnError = func(err error) {
+		h.logger.Printf("Live streams: %v", err)
+	}
+
+	w := watch.New(h.client, config)
+	go w.Run(ctx)
+	go h.run(gen, w)
+}
+
+// stopWatcher stops the shared watcher. h.mu must be held.
+func (h *liveHub) stopWatcher() {
+	h.stop()
+	h.stop = nil
+	h.games = nil
+}
+
+// run publishes the watcher's events to the stream of their game and, except
+// for play-by-play actions, to the scoreboard stream.
+func (h *liveHub) run(gen uint64, w *watch.Watcher) {
+	for event := range w.Events() {
+		payload := streamPayload{
+			Type:   string(event.Type),
+			GameID: event.GameID,
+			Time:   event.Time,
+			Period: event.Period,
+			Game:   &event.Game,
+			Action: event.Action,
+		}
+
+		h.mu.Lock()
+		if h.gen == gen {
+			if stream, ok := h.streams[event.GameID]; ok {
+				stream.publish(payload)
+			}
+			if stream, ok := h.streams[""]; ok && event.Type != watch.NewAction {
+				stream.publish(payload)
+			}
+		}
+		h.mu.Unlock()
+	}
+}
+
+// gamesFor returns the games a stream shows: all of them for the scoreboard
+// stream, otherwise the stream's game if it is on the scoreboard.
+func gamesFor(key string, games []endpoints.Game) []endpoints.Game {
+	if key == "" {
+		return games
+	}
+	for _, game := range games {
+		if game.GameID == key {
+			return []endpoints.Game{game}
+		}
+	}
+	return []endpoints.Game{}
+}
+
+type liveStream struct {
+	key   string
+	epoch string
+
+	mu      sync.Mutex
+	seq     uint64
+	history []streamEvent
+	games   []endpoints.Game
+	subs    map[*subscriber]struct{}
+	linger  *time.Timer
+	closed  bool
+}
+
+func newLiveStream(key string) *liveStream {
+	return &liveStream{
+		key:   key,
+		epoch: strconv.FormatInt(time.Now().UnixNano(), 36),
+		subs:  make(map[*subscriber]struct{}),
+	}
+}
+
+// close ends every subscription.
+func (s *liveStream) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.linger != nil {
+		s.linger.Stop()
+		s.linger = nil
+	}
+	s.closed = true
+	for sub := range s.subs {
+		delete(s.subs, sub)
+		close(sub.events)
+	}
+}
+
+// setGames records the games of each poll. The first poll is also published
+// as a snapshot for the subscribers that joined before it.
+func (s *liveStream) setGames(games []endpoints.Game) {
+	s.mu.Lock()
+	first := s.games == nil
+	s.games = games
+	s.mu.Unlock()
+
+	if first {
+		s.publish(streamPayload{Type: snapshotEvent, Time: time.Now(), Games: games})
+	}
+}
+
+func (s *liveStream) publish(payload streamPayload) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.seq++
+	payload.ID = s.eventID(s.seq)
+	data, _ := json.Marshal(payload)
+	event := streamEvent{seq: s.seq, ID: payload.ID, Type: payload.Type, Data: data}
+
+	if len(s.history) == streamHistory {
+		copy(s.history, s.history[1:])
+		s.history[len(s.history)-1] = event
+	} else {
+		s.history = append(s.history, event)
+	}
+
+	for sub := range s.subs {
+		select {
+		case sub.events <- event:
+		default:
+			delete(s.subs, sub)
+			close(sub.events)
+		}
+	}
+}
+
+// eventID is "<epoch>-<seq>". The epoch changes each time a stream starts,
+// so IDs from an earlier stream are not mistaken for current ones.
+func (s *liveStream) eventID(seq uint64) string {
+	return s.epoch + "-" + strconv.FormatUint(seq, 10)
+}
+
+func (s *liveStream) add(lastEventID string) (*subscriber, []streamEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub := &subscriber{events: make(chan streamEvent, subscriberBuffer)}
+	if s.closed {
+		close(sub.events)
+		return sub, nil
+	}
+	if s.linger != nil {
+		s.linger.Stop()
+		s.linger = nil
+	}
+	s.subs[sub] = struct{}{}
+
+	if backlog, ok := s.since(lastEventID); ok {
+		return sub, backlog
+	}
+	if s.games == nil {
+		// The first poll has not finished; its snapshot will follow.
+		return sub, nil
+	}
+	data, _ := json.Marshal(streamPayload{ID: s.eventID(s.seq), Type: snapshotEvent, Time: time.Now(), Games: s.games})
+	return sub, []streamEvent{{seq: s.seq, ID: s.eventID(s.seq), Type: snapshotEvent, Data: data}}
+}
+
+// since returns the events after lastEventID, and false when it is from
+// another stream or older than the history.
+func (s *liveStream) since(lastEventID string) ([]streamEvent, bool) {
+	epoch, n, ok := strings.Cut(lastEventID, "-")
+	if !ok || epoch != s.epoch {
+		return nil, false
+	}
+	seq, err := strconv.ParseUint(n, 10, 64)
+	if err != nil || seq > s.seq {
+		return nil, false
+	}
+	if seq == s.seq {
+		return nil, true
+	}
+	if len(s.history) == 0 || seq+1 < s.history[0].seq {
+		return nil, false
+	}
+	return append([]streamEvent(nil), s.history[seq+1-s.history[0].seq:]...), true
+}
+
+// remove drops a subscriber and reports whether the stream is now idle.
+func (s *liveStream) remove(sub *subscriber) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.subs[sub]; ok {
+		delete(s.subs, sub)
+		close(sub.events)
+	}
+	return len(s.subs) == 0 && !s.closed
+}
+
+func (s *liveStream) setLinger(timer *time.Timer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.linger != nil {
+		s.linger.Stop()
+	}
+	s.linger = timer
+}
+
+func (s *liveStream) idle() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.subs) == 0
+}
@@ -8,15 +8,24 @@ import (
 	"os"
 	"os/signal"
 	"runtime"
+	"slices"
+	"strings"
 	"syscall"
 	"time"
 
+	"github.com/n-ae/nba-api-go/pkg/client"
 	"github.com/n-ae/nba-api-go/pkg/stats"
 	"github.com/n-ae/nba-api-go/pkg/stats/endpoints"
+	"github.com/n-ae/nba-api-go/pkg/stats/parameters"
+	"github.com/n-ae/nba-api-go/pkg/transport"
 )
 
 const version = "1.1.1"
 
+// statsHost is the upstream host whose circuit breaker state drives
+// nba_api_status.
+const statsHost = "stats.nba.com"
+
 var (
 	buildTime = "unknown"
 	gitCommit = "unknown"
@@ -30,7 +39,12 @@ func main() {
 	logger.Printf("Starting NBA API Server v%s", version)
 	logger.Printf("Log level: %s", logLevel)
 
-	server := NewServer(logger)
+	clientOpts, err := client.OptionsFromEnv()
+	if err != nil {
+		logger.Fatalf("Invalid client configuration: %v", err)
+	}
+
+	server := NewServer(logger, clientOpts...)
 
 	srv := &http.Server{
 		Addr:         ":" + port,
@@ -39,6 +53,7 @@ func main() {
 		WriteTimeout: 30 * time.Second,
 		IdleTimeout:  60 * time.Second,
 	}
+	srv.RegisterOnShutdown(server.liveHandler.Close)
 
 	go func() {
 		logger.Printf("Server listening on port %s", port)
@@ -71,21 +86,39 @@ func getEnv(key, defaultValue string) string {
 }
 
 type Server struct {
-	logger       *log.Logger
-	statsHandler *StatsHandler
-	metrics      *Metrics
-	rateLimiter  *RateLimiter
+	logger        *log.Logger
+	statsHandler  *StatsHandler
+	liveHandler   *LiveHandler
+	staticHandler *StaticHandler
+	metrics       *Metrics
+	rateLimiter   *RateLimiter
+	breaker       *transport.CircuitBreaker
 }
 
-func NewServer(logger *log.Logger) *Server {
+func NewServer(logger *log.Logger, clientOpts ...client.Option) *Server {
 	rateLimiter := NewRateLimiter(100, 200)
 	rateLimiter.CleanupOldLimiters(5 * time.Minute)
 
+	breakerConfig := transport.DefaultCircuitBreakerConfig()
+	breakerConfig.OnStateChange = func(host string, from, to transport.CircuitState) {
+		logger.Printf("Circuit breaker for %s: %s -> %s", host, from, to)
+	}
+	breaker := transport.NewCircuitBreaker(breakerConfig)
+
+	// The breaker goes right after WithRetry, so each upstream attempt
+	// counts against it and an open circuit ends the retries.
+	statsConfig := stats.Config{
+		Middlewares: slices.Insert(stats.DefaultMiddlewares(), 1, breaker.Middleware()),
+	}
+
 	return &Server{
-		logger:       logger,
-		statsHandler: NewStatsHandler(),
-		metrics:      NewMetrics(),
-		rateLimiter:  rateLimiter,
+		logger:        logger,
+		statsHandler:  newStatsHandler(statsConfig, clientOpts...),
+		liveHandler:   NewLiveHandler(logger, clientOpts...),
+		staticHandler: NewStaticHandler(),
+		metrics:       NewMetrics(),
+		rateLimiter:   rateLimiter,
+		breaker:       breaker,
 	}
 }
 
@@ -94,7 +127,13 @@ func (s *Server) Routes() http.Handler {
 
 	mux.HandleFunc("/health", s.handleHealth())
 	mux.HandleFunc("/metrics", s.handleMetrics())
+	mux.HandleFunc("/api/v1/endpoints", s.handleEndpoints())
 	mux.Handle("/api/v1/stats/", s.statsHandler)
+	mux.Handle("/api/v1/live/", s.liveHandler)
+	mux.Handle("/api/v1/players", s.staticHandler)
+	mux.Handle("/api/v1/players/", s.staticHandler)
+	mux.Handle("/api/v1/teams", s.staticHandler)
+	mux.Handle("/api/v1/teams/", s.staticHandler)
 
 	return s.metricsMiddleware(s.loggingMiddleware(s.rateLimiter.Middleware(s.corsMiddleware(mux))))
 }
@@ -121,6 +160,12 @@ func (rec *responseRecorder) WriteHeader(code int) {
 	rec.ResponseWriter.WriteHeader(code)
 }
 
+// Unwrap lets http.ResponseController reach the Flusher and Hijacker of the
+// live streams.
+func (rec *responseRecorder) Unwrap() http.ResponseWriter {
+	return rec.ResponseWriter
+}
+
 func (s *Server) loggingMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
@@ -146,13 +191,14 @@ func (s *Server) corsMiddleware(next http.Handler) http.Handler {
 
 func (s *Server) handleHealth() http.HandlerFunc {
 	type healthResponse struct {
-		Status         string            `json:"status"`
-		Version        string            `json:"version"`
-		BuildInfo      map[string]string `json:"build_info"`
-		EndpointsCount map[string]int    `json:"endpoints_count"`
-		Dependencies   map[string]string `json:"dependencies"`
-		NBAAPIStatus   string            `json:"nba_api_status"`
-		Timestamp      int64             `json:"timestamp"`
+		Status          string            `json:"status"`
+		Version         string            `json:"version"`
+		BuildInfo       map[string]string `json:"build_info"`
+		EndpointsCount  map[string]int    `json:"endpoints_count"`
+		Dependencies    map[string]string `json:"dependencies"`
+		NBAAPIStatus    string            `json:"nba_api_status"`
+		CircuitBreakers map[string]string `json:"circuit_breakers"`
+		Timestamp       int64             `json:"timestamp"`
 	}
 
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -166,15 +212,13 @@ func (s *Server) handleHealth() http.HandlerFunc {
 				"build_time": buildTime,
 				"git_commit": gitCommit,
 			},
-			EndpointsCount: map[string]int{
-				"sdk_total":    140,
-				"http_exposed": 149,
-			},
+			EndpointsCount: endpointCounts(statsRoutes),
 			Dependencies: map[string]string{
 				"nba_api": "stats.nba.com",
 			},
-			NBAAPIStatus: nbaAPIStatus,
-			Timestamp:    time.Now().Unix(),
+			NBAAPIStatus:    nbaAPIStatus,
+			CircuitBreakers: s.circuitBreakerStates(),
+			Timestamp:       time.Now().Unix(),
 		}
 
 		w.Header().Set("Content-Type", "application/json")
@@ -193,17 +237,74 @@ func (s *Server) handleMetrics() http.HandlerFunc {
 	}
 }
 
+type endpointInfo struct {
+	Name       string       `json:"name"`
+	Category   string       `json:"category"`
+	Path       string       `json:"path"`
+	Parameters []routeParam `json:"parameters"`
+}
+
+type endpointsListing struct {
+	Count      int            `json:"count"`
+	Categories []string       `json:"categories"`
+	Endpoints  []endpointInfo `json:"endpoints"`
+}
+
+// handleEndpoints lists the stats routes with their parameters, optionally
+// only those of one category.
+func (s *Server) handleEndpoints() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET requests are supported")
+			return
+		}
+
+		categories := routeCategories(statsRoutes)
+		category := r.URL.Query().Get("category")
+		if category != "" && !slices.Contains(categories, category) {
+			writeError(w, http.StatusBadRequest, "invalid_parameter", "category must be one of: "+strings.Join(categories, ", "))
+			return
+		}
+
+		resp := endpointsListing{Categories: categories, Endpoints: []endpointInfo{}}
+		for _, route := range statsRoutes {
+			if category != "" && route.Category != category {
+				continue
+			}
+			resp.Endpoints = append(resp.Endpoints, endpointInfo{
+				Name:       route.Name,
+				Category:   route.Category,
+				Path:       "/api/v1/stats/" + route.Name,
+				Parameters: route.params(),
+			})
+		}
+		resp.Count = len(resp.Endpoints)
+
+		writeSuccess(w, resp)
+	}
+}
+
+func (s *Server) circuitBreakerStates() map[string]string {
+	states := make(map[string]string)
+	for host, state := range s.breaker.States() {
+		states[host] = state.String()
+	}
+	return states
+}
+
 func (s *Server) checkNBAAPI() string {
+	if s.breaker.State(statsHost) == transport.CircuitOpen {
+		return "degraded"
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
 
-	client := stats.NewDefaultClient()
-
 	req := endpoints.CommonAllPlayersRequest{
-		Season: "2023-24",
+		Season: parameters.CurrentSeason(),
 	}
 
-	_, err := endpoints.GetCommonAllPlayers(ctx, client, req)
+	_, err := endpoints.GetCommonAllPlayers(ctx, s.statsHandler.client, req)
 	if err != nil {
 		return "degraded"
 	}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"runtime"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/stats"
+)
+
+// statsRoutes is every stats endpoint the server exposes. Routing, the
+// /health counts and /api/v1/endpoints are all read from it.
+var statsRoutes = slices.Concat(
+	playerRoutes,
+	teamRoutes,
+	leagueRoutes,
+	commonRoutes,
+	gameRoutes,
+	boxScoreRoutes,
+)
+
+var statsRoutesByName = indexRoutes(statsRoutes)
+
+// excludedEndpoints are the SDK endpoint functions the server deliberately
+// does not expose, with the reason. TestSDKEndpointsRouted checks that every
+// other one has a route.
+var excludedEndpoints = map[string]string{}
+
+// statsRoute serves one endpoint at /api/v1/stats/{Name}: bind builds its
+// request from the query string and invoke calls the SDK function sdkFunc
+// with it, returning the Data and Warnings of its response.
+type statsRoute struct {
+	Name     string
+	Category string
+	sdkFunc  string
+	params   func() []routeParam
+	bind     func(query url.Values) (any, *paramError)
+	invoke   func(ctx context.Context, client *stats.Client, req any) (any, []models.SchemaDrift, error)
+}
+
+type routeParam struct {
+	Name     string `json:"name"`
+	Required bool   `json:"required"`
+	Default  string `json:"default,omitempty"`
+}
+
+// route registers an endpoint that responds with the Data of the SDK
+// response. Its request starts from defaults, or the zero value when
+// defaults is nil, and required lists the parameters the client must give.
+func route[Req, T any](name string, call func(context.Context, *stats.Client, Req) (*models.Response[T], error), defaults func() Req, required ...string) statsRoute {
+	newRequest := func() Req {
+		var req Req
+		if defaults != nil {
+			req = defaults()
+		}
+		return req
+	}
+
+	return statsRoute{
+		Name:    name,
+		sdkFunc: funcName(call),
+		params: func() []routeParam {
+			req := newRequest()
+			return routeParams(reflect.ValueOf(&req).Elem(), required)
+		},
+		bind: func(query url.Values) (any, *paramError) {
+			req := newRequest()
+			if err := bindValues(query, &req, required...); err != nil {
+				return nil, err
+			}
+			return req, nil
+		},
+		invoke: func(ctx context.Context, client *stats.Client, req any) (any, []models.SchemaDrift, error) {
+			resp, err := call(ctx, client, req.(Req))
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.Data, resp.Warnings, nil
+		},
+	}
+}
+
+// funcName returns the unqualified name of the function f, such as
+// "GetLeagueLeaders".
+func funcName(f any) string {
+	name := runtime.FuncForPC(reflect.ValueOf(f).Pointer()).Name()
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+func indexRoutes(routes []statsRoute) map[string]*statsRoute {
+	byName := make(map[string]*statsRoute, len(routes))
+	for i := range routes {
+		byName[routes[i].Name] = &routes[i]
+	}
+	return byName
+}
+
+func inCategory(category string, routes ...statsRoute) []statsRoute {
+	for i := range routes {
+		routes[i].Category = category
+	}
+	return routes
+}
+
+// routeParams describes the bindable fields of a request, with the values
+// it starts from as defaults.
+func routeParams(v reflect.Value, required []string) []routeParam {
+	var params []routeParam
+	for _, i := range paramFields(v.Type()) {
+		param := routeParam{
+			Name:     v.Type().Field(i).Name,
+			Required: slices.Contains(required, v.Type().Field(i).Name),
+		}
+		if field := v.Field(i); field.Kind() != reflect.Pointer {
+			param.Default = field.String()
+		} else if !field.IsNil() {
+			param.Default = field.Elem().String()
+		}
+		params = append(params, param)
+	}
+	return params
+}
+
+// endpointCounts returns the number of SDK endpoint functions, routed or
+// excluded, as sdk_total, the number of routes as http_exposed, and the
+// routes in each category.
+func endpointCounts(routes []statsRoute) map[string]int {
+	counts := map[string]int{"http_exposed": len(routes)}
+	sdkFuncs := make(map[string]bool, len(routes))
+	for _, r := range routes {
+		counts[r.Category]++
+		sdkFuncs[r.sdkFunc] = true
+	}
+	counts["sdk_total"] = len(sdkFuncs) + len(excludedEndpoints)
+	return counts
+}
+
+func routeCategories(routes []statsRoute) []string {
+	var categories []string
+	for _, r := range routes {
+		if !slices.Contains(categories, r.Category) {
+			categories = append(categories, r.Category)
+		}
+	}
+	sort.Strings(categories)
+	return categories
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"io/fs"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestStatsRoutes(t *testing.T) {
+	if len(statsRoutesByName) != len(statsRoutes) {
+		t.Errorf("%d routes but %d distinct names", len(statsRoutes), len(statsRoutesByName))
+	}
+
+	for _, route := range statsRoutes {
+		if route.Name != strings.ToLower(route.Name) || route.Category == "" {
+			t.Errorf("route %q in category %q: want a lowercase name and a category", route.Name, route.Category)
+		}
+
+		// A route needs parameters exactly when one of them is required, so
+		// a required name that is not a field shows up here.
+		required := slices.ContainsFunc(route.params(), func(p routeParam) bool { return p.Required })
+		if _, err := route.bind(nil); (err != nil) != required {
+			t.Errorf("route %s: binding no parameters = %+v, required parameters %v", route.Name, err, required)
+		}
+	}
+}
+
+// TestSDKEndpointsRouted checks that every SDK endpoint function, an
+// exported func of pkg/stats/endpoints taking a context and a stats client,
+// has a route or is listed in excludedEndpoints.
+func TestSDKEndpointsRouted(t *testing.T) {
+	pkgs, err := parser.ParseDir(token.NewFileSet(), "../../pkg/stats/endpoints", func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sdk := make(map[string]bool)
+	for _, file := range pkgs["endpoints"].Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv != nil || !fn.Name.IsExported() || len(fn.Type.Params.List) < 2 {
+				continue
+			}
+			if types.ExprString(fn.Type.Params.List[0].Type) == "context.Context" &&
+				types.ExprString(fn.Type.Params.List[1].Type) == "*stats.Client" {
+				sdk[fn.Name.Name] = true
+			}
+		}
+	}
+	if len(sdk) == 0 {
+		t.Fatal("found no SDK endpoint functions")
+	}
+
+	routed := make(map[string]bool)
+	for _, route := range statsRoutes {
+		if !sdk[route.sdkFunc] {
+			t.Errorf("route %s calls %s, not an SDK endpoint function", route.Name, route.sdkFunc)
+		}
+		routed[route.sdkFunc] = true
+	}
+	for name := range excludedEndpoints {
+		if !sdk[name] || routed[name] {
+			t.Errorf("excluded endpoint %s is not an SDK endpoint function or has a route", name)
+		}
+	}
+	for name := range sdk {
+		if _, excluded := excludedEndpoints[name]; !routed[name] && !excluded {
+			t.Errorf("SDK endpoint %s has no route; register it or add it to excludedEndpoints", name)
+		}
+	}
+	if got := endpointCounts(statsRoutes)["sdk_total"]; got != len(sdk) {
+		t.Errorf("sdk_total = %d, want %d", got, len(sdk))
+	}
+}
+
+func TestStatsRoute_Params(t *testing.T) {
+	params := statsRoutesByName["playergamelog"].params()
+
+	want := map[string]routeParam{
+		"PlayerID":   {Name: "PlayerID", Required: true},
+		"SeasonType": {Name: "SeasonType", Default: "Regular Season"},
+		"LeagueID":   {Name: "LeagueID", Default: "00"},
+	}
+	for _, param := range params {
+		if w, ok := want[param.Name]; ok && param != w {
+			t.Errorf("param = %+v, want %+v", param, w)
+		}
+		delete(want, param.Name)
+	}
+	if len(want) != 0 {
+		t.Errorf("missing params %v", want)
+	}
+}
+
+func TestEndpointsListing(t *testing.T) {
+	server := NewServer(log.New(io.Discard, "", 0))
+	routes := server.Routes()
+
+	get := func(path string) (int, endpointsListing) {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		w := httptest.NewRecorder()
+		routes.ServeHTTP(w, req)
+
+		var response struct {
+			Data endpointsListing `json:"data"`
+		}
+		json.NewDecoder(w.Body).Decode(&response)
+		return w.Code, response.Data
+	}
+
+	status, all := get("/api/v1/endpoints")
+	if status != http.StatusOK || all.Count != len(statsRoutes) || len(all.Endpoints) != len(statsRoutes) {
+		t.Fatalf("status %d, %d endpoints, want %d", status, all.Count, len(statsRoutes))
+	}
+	if all.Endpoints[0].Path != "/api/v1/stats/"+all.Endpoints[0].Name {
+		t.Errorf("endpoint = %+v", all.Endpoints[0])
+	}
+
+	status, boxScores := get("/api/v1/endpoints?category=boxscore")
+	if status != http.StatusOK || boxScores.Count != endpointCounts(statsRoutes)["boxscore"] {
+		t.Errorf("status %d, %d box score endpoints", status, boxScores.Count)
+	}
+	for _, endpoint := range boxScores.Endpoints {
+		if endpoint.Category != "boxscore" {
+			t.Errorf("endpoint %s in category %s", endpoint.Name, endpoint.Category)
+		}
+	}
+
+	if status, _ := get("/api/v1/endpoints?category=unknown"); status != http.StatusBadRequest {
+		t.Errorf("unknown category: status %d, want 400", status)
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import "github.com/n-ae/nba-api-go/pkg/stats/endpoints"
+
+var boxScoreRoutes = inCategory("boxscore",
+	route("boxscoresummaryv2", endpoints.GetBoxScoreSummaryV2, nil, "GameID"),
+	route("boxscoretraditionalv2", endpoints.GetBoxScoreTraditionalV2, func() endpoints.BoxScoreTraditionalV2Request {
+		return endpoints.BoxScoreTraditionalV2Request{
+			StartPeriod: stringPtr("0"),
+			EndPeriod:   stringPtr("10"),
+		}
+	}, "GameID"),
+	route("boxscoreadvancedv2", endpoints.GetBoxScoreAdvancedV2, func() endpoints.BoxScoreAdvancedV2Request {
+		return endpoints.BoxScoreAdvancedV2Request{
+			StartPeriod: stringPtr("0"),
+			EndPeriod:   stringPtr("10"),
+		}
+	}, "GameID"),
+	route("boxscorescoringv2", endpoints.GetBoxScoreScoringV2, func() endpoints.BoxScoreScoringV2Request {
+		return endpoints.BoxScoreScoringV2Request{
+			StartPeriod: stringPtr("0"),
+			EndPeriod:   stringPtr("10"),
+		}
+	}, "GameID"),
+	route("boxscoremiscv2", endpoints.GetBoxScoreMiscV2, func() endpoints.BoxScoreMiscV2Request {
+		return endpoints.BoxScoreMiscV2Request{
+			StartPeriod: stringPtr("0"),
+			EndPeriod:   stringPtr("10"),
+		}
+	}, "GameID"),
+	route("boxscoreusagev2", endpoints.GetBoxScoreUsageV2, func() endpoints.BoxScoreUsageV2Request {
+		return endpoints.BoxScoreUsageV2Request{
+			StartPeriod: stringPtr("0"),
+			EndPeriod:   stringPtr("10"),
+		}
+	}, "GameID"),
+	route("boxscorefourfactorsv2", endpoints.GetBoxScoreFourFactorsV2, func() endpoints.BoxScoreFourFactorsV2Request {
+		return endpoints.BoxScoreFourFactorsV2Request{
+			StartPeriod: stringPtr("0"),
+			EndPeriod:   stringPtr("10"),
+		}
+	}, "GameID"),
+	route("boxscoreplayertrackv2", endpoints.GetBoxScorePlayerTrackV2, nil, "GameID"),
+	route("boxscoredefensivev2", endpoints.GetBoxScoreDefensiveV2, func() endpoints.BoxScoreDefensiveV2Request {
+		return endpoints.BoxScoreDefensiveV2Request{
+			StartPeriod: stringPtr("0"),
+			EndPeriod:   stringPtr("10"),
+		}
+	}, "GameID"),
+	route("boxscorehustlev2", endpoints.GetBoxScoreHustleV2, nil, "GameID"),
+	route("boxscoresummaryv3", endpoints.GetBoxScoreSummaryV3, nil, "GameID"),
+	route("boxscoretraditionalv3", endpoints.GetBoxScoreTraditionalV3, boxScoreV3Defaults, "GameID"),
+	route("boxscoreadvancedv3", endpoints.GetBoxScoreAdvancedV3, boxScoreV3Defaults, "GameID"),
+	route("boxscorescoringv3", endpoints.GetBoxScoreScoringV3, boxScoreV3Defaults, "GameID"),
+	route("boxscoremiscv3", endpoints.GetBoxScoreMiscV3, boxScoreV3Defaults, "GameID"),
+	route("boxscoreusagev3", endpoints.GetBoxScoreUsageV3, boxScoreV3Defaults, "GameID"),
+	route("boxscorefourfactorsv3", endpoints.GetBoxScoreFourFactorsV3, boxScoreV3Defaults, "GameID"),
+	route("boxscoreplayertrackv3", endpoints.GetBoxScorePlayerTrackV3, boxScoreV3Defaults, "GameID"),
+	route("boxscoredefensivev3", endpoints.GetBoxScoreDefensiveV3, boxScoreV3Defaults, "GameID"),
+	route("boxscorehustlev3", endpoints.GetBoxScoreHustleV3, boxScoreV3Defaults, "GameID"),
+	route("boxscorematchupsv3", endpoints.GetBoxScoreMatchupsV3, nil, "GameID"),
+)
+
+// boxScoreV3Defaults covers the whole game, like the V2 routes, unless
+// StartPeriod or EndPeriod is given.
+func boxScoreV3Defaults() endpoints.BoxScoreV3Request {
+	return endpoints.BoxScoreV3Request{
+		StartPeriod: stringPtr("0"),
+		EndPeriod:   stringPtr("10"),
+	}
+}
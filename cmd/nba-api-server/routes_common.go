@@ -0,0 +1,66 @@
+package main
+
+import (
+	"github.com/n-ae/nba-api-go/pkg/stats/endpoints"
+	"github.com/n-ae/nba-api-go/pkg/stats/parameters"
+)
+
+var commonRoutes = inCategory("common",
+	route("commonallplayers", endpoints.GetCommonAllPlayers, func() endpoints.CommonAllPlayersRequest {
+		return endpoints.CommonAllPlayersRequest{
+			Season:              parameters.CurrentSeason(),
+			LeagueID:            leagueIDPtr(parameters.LeagueIDNBA),
+			IsOnlyCurrentSeason: stringPtr("0"),
+		}
+	}),
+	route("commonteamroster", endpoints.GetCommonTeamRoster, func() endpoints.CommonTeamRosterRequest {
+		return endpoints.CommonTeamRosterRequest{
+			Season:   seasonPtr(parameters.CurrentSeason()),
+			LeagueID: leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "TeamID"),
+	route("commonplayerinfo", endpoints.CommonPlayerInfo, func() endpoints.CommonPlayerInfoRequest {
+		return endpoints.CommonPlayerInfoRequest{
+			LeagueID: parameters.LeagueIDNBA,
+		}
+	}, "PlayerID"),
+	route("commonplayerinfov2", endpoints.GetCommonPlayerInfoV2, func() endpoints.CommonPlayerInfoV2Request {
+		return endpoints.CommonPlayerInfoV2Request{
+			LeagueID: leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "PlayerID"),
+	route("commonallplayersv2", endpoints.GetCommonAllPlayersV2, func() endpoints.CommonAllPlayersV2Request {
+		return endpoints.CommonAllPlayersV2Request{
+			Season:   seasonPtr(parameters.CurrentSeason()),
+			LeagueID: leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("commonteamrosterv2", endpoints.GetCommonTeamRosterV2, func() endpoints.CommonTeamRosterV2Request {
+		return endpoints.CommonTeamRosterV2Request{
+			Season:   seasonPtr(parameters.CurrentSeason()),
+			LeagueID: leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "TeamID"),
+	route("commonteamyears", endpoints.GetCommonTeamYears, func() endpoints.CommonTeamYearsRequest {
+		return endpoints.CommonTeamYearsRequest{
+			LeagueID: leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("drafthistory", endpoints.GetDraftHistory, func() endpoints.DraftHistoryRequest {
+		return endpoints.DraftHistoryRequest{
+			LeagueID: leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("draftboard", endpoints.GetDraftBoard, func() endpoints.DraftBoardRequest {
+		return endpoints.DraftBoardRequest{
+			Season:   seasonPtr(parameters.CurrentSeason()),
+			LeagueID: leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("draftcombinestats", endpoints.GetDraftCombineStats, func() endpoints.DraftCombineStatsRequest {
+		return endpoints.DraftCombineStatsRequest{
+			SeasonYear: stringPtr(parameters.CurrentSeason().String()),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+)
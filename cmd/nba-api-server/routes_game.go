@@ -0,0 +1,60 @@
+package main
+
+import (
+	"github.com/n-ae/nba-api-go/pkg/stats/endpoints"
+	"github.com/n-ae/nba-api-go/pkg/stats/parameters"
+)
+
+var gameRoutes = inCategory("game",
+	route("scoreboardv2", endpoints.GetScoreboardV2, func() endpoints.ScoreboardV2Request {
+		return endpoints.ScoreboardV2Request{
+			LeagueID: leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "GameDate"),
+	route("playbyplayv2", endpoints.GetPlayByPlayV2, func() endpoints.PlayByPlayV2Request {
+		return endpoints.PlayByPlayV2Request{
+			StartPeriod: stringPtr("0"),
+			EndPeriod:   stringPtr("10"),
+		}
+	}, "GameID"),
+	route("shotchartdetail", endpoints.GetShotChartDetail, func() endpoints.ShotChartDetailRequest {
+		return endpoints.ShotChartDetailRequest{
+			Season:     parameters.CurrentSeason(),
+			SeasonType: parameters.SeasonTypeRegular,
+			TeamID:     stringPtr("0"),
+			PlayerID:   stringPtr("0"),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("gamerotation", endpoints.GetGameRotation, func() endpoints.GameRotationRequest {
+		return endpoints.GameRotationRequest{
+			LeagueID: leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "GameID"),
+	route("winprobabilitypbp", endpoints.GetWinProbabilityPBP, nil, "GameID"),
+	route("scoreboardv3", endpoints.GetScoreboardV3, func() endpoints.ScoreboardV3Request {
+		return endpoints.ScoreboardV3Request{
+			LeagueID: leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("videoevents", endpoints.GetVideoEvents, nil, "GameID", "GameEventID"),
+	route("playbyplayv3", endpoints.GetPlayByPlayV3, nil, "GameID"),
+	route("shotchartlineupdetail", endpoints.GetShotChartLineupDetail, func() endpoints.ShotChartLineupDetailRequest {
+		return endpoints.ShotChartLineupDetailRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("internationalbroadcasterschedule", endpoints.GetInternationalBroadcasterSchedule, func() endpoints.InternationalBroadcasterScheduleRequest {
+		return endpoints.InternationalBroadcasterScheduleRequest{
+			LeagueID: parameters.LeagueIDNBA,
+		}
+	}, "Season"),
+	route("scheduleleaguev2", endpoints.GetScheduleLeagueV2, func() endpoints.ScheduleLeagueV2Request {
+		return endpoints.ScheduleLeagueV2Request{
+			Season:   seasonPtr(parameters.CurrentSeason()),
+			LeagueID: leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+)
@@ -0,0 +1,339 @@
+package main
+
+import (
+	"github.com/n-ae/nba-api-go/pkg/stats/endpoints"
+	"github.com/n-ae/nba-api-go/pkg/stats/parameters"
+)
+
+var leagueRoutes = inCategory("league",
+	route("leaguestandings", endpoints.GetLeagueStandings, func() endpoints.LeagueStandingsRequest {
+		return endpoints.LeagueStandingsRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leagueleaders", endpoints.LeagueLeaders, func() endpoints.LeagueLeadersRequest {
+		return endpoints.LeagueLeadersRequest{
+			Season:     parameters.CurrentSeason(),
+			SeasonType: parameters.SeasonTypeRegular,
+			PerMode:    parameters.PerModePerGame,
+			LeagueID:   parameters.LeagueIDNBA,
+		}
+	}),
+	route("leaguedashteamstats", endpoints.GetLeagueDashTeamStats, func() endpoints.LeagueDashTeamStatsRequest {
+		return endpoints.LeagueDashTeamStatsRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leaguedashplayerstats", endpoints.GetLeagueDashPlayerStats, func() endpoints.LeagueDashPlayerStatsRequest {
+		return endpoints.LeagueDashPlayerStatsRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leaguegamelog", endpoints.GetLeagueGameLog, func() endpoints.LeagueGameLogRequest {
+		return endpoints.LeagueGameLogRequest{
+			Season:       parameters.CurrentSeason(),
+			SeasonType:   seasonTypePtr(parameters.SeasonTypeRegular),
+			PlayerOrTeam: stringPtr("P"),
+			LeagueID:     leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("playoffpicture", endpoints.GetPlayoffPicture, func() endpoints.PlayoffPictureRequest {
+		return endpoints.PlayoffPictureRequest{
+			SeasonID: parameters.CurrentSeason(),
+			LeagueID: leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leaguedashlineups", endpoints.GetLeagueDashLineups, func() endpoints.LeagueDashLineupsRequest {
+		return endpoints.LeagueDashLineupsRequest{
+			Season:        seasonPtr(parameters.CurrentSeason()),
+			SeasonType:    seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:       perModePtr(parameters.PerModePerGame),
+			MeasureType:   stringPtr("Base"),
+			GroupQuantity: stringPtr("5"),
+			LeagueID:      leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leaguedashplayerclutch", endpoints.GetLeagueDashPlayerClutch, func() endpoints.LeagueDashPlayerClutchRequest {
+		return endpoints.LeagueDashPlayerClutchRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leaguedashteamclutch", endpoints.GetLeagueDashTeamClutch, func() endpoints.LeagueDashTeamClutchRequest {
+		return endpoints.LeagueDashTeamClutchRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leaguedashplayerbiostats", endpoints.GetLeagueDashPlayerBioStats, func() endpoints.LeagueDashPlayerBioStatsRequest {
+		return endpoints.LeagueDashPlayerBioStatsRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leaguedashteambiostats", endpoints.GetLeagueDashTeamBioStats, func() endpoints.LeagueDashTeamBioStatsRequest {
+		return endpoints.LeagueDashTeamBioStatsRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leaguedashptstats", endpoints.GetLeagueDashPtStats, func() endpoints.LeagueDashPtStatsRequest {
+		return endpoints.LeagueDashPtStatsRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leaguehustlestatsplayer", endpoints.GetLeagueHustleStatsPlayer, func() endpoints.LeagueHustleStatsPlayerRequest {
+		return endpoints.LeagueHustleStatsPlayerRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leaguehustlestatsteam", endpoints.GetLeagueHustleStatsTeam, func() endpoints.LeagueHustleStatsTeamRequest {
+		return endpoints.LeagueHustleStatsTeamRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leaguedashptdefend", endpoints.GetLeagueDashPtDefend, func() endpoints.LeagueDashPtDefendRequest {
+		return endpoints.LeagueDashPtDefendRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leaguegamefinder", endpoints.GetLeagueGameFinder, func() endpoints.LeagueGameFinderRequest {
+		return endpoints.LeagueGameFinderRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leaguestandingsv3", endpoints.GetLeagueStandingsV3, func() endpoints.LeagueStandingsV3Request {
+		return endpoints.LeagueStandingsV3Request{
+			Season:   seasonPtr(parameters.CurrentSeason()),
+			LeagueID: leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leaguedashplayershotlocations", endpoints.GetLeagueDashPlayerShotLocations, func() endpoints.LeagueDashPlayerShotLocationsRequest {
+		return endpoints.LeagueDashPlayerShotLocationsRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leaguedashteamshotlocations", endpoints.GetLeagueDashTeamShotLocations, func() endpoints.LeagueDashTeamShotLocationsRequest {
+		return endpoints.LeagueDashTeamShotLocationsRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leagueseasonmatchups", endpoints.GetLeagueSeasonMatchups, func() endpoints.LeagueSeasonMatchupsRequest {
+		return endpoints.LeagueSeasonMatchupsRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leaguedashptteamdefend", endpoints.GetLeagueDashPtTeamDefend, func() endpoints.LeagueDashPtTeamDefendRequest {
+		return endpoints.LeagueDashPtTeamDefendRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leaguedashplayerptshot", endpoints.GetLeagueDashPlayerPtShot, func() endpoints.LeagueDashPlayerPtShotRequest {
+		return endpoints.LeagueDashPlayerPtShotRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leaguedashteamptshot", endpoints.GetLeagueDashTeamPtShot, func() endpoints.LeagueDashTeamPtShotRequest {
+		return endpoints.LeagueDashTeamPtShotRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("commonplayoffseries", endpoints.GetCommonPlayoffSeries, func() endpoints.CommonPlayoffSeriesRequest {
+		return endpoints.CommonPlayoffSeriesRequest{
+			Season:   parameters.CurrentSeason(),
+			LeagueID: leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("franchisehistory", endpoints.GetFranchiseHistory, func() endpoints.FranchiseHistoryRequest {
+		return endpoints.FranchiseHistoryRequest{
+			LeagueID: leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("franchiseleaders", endpoints.GetFranchiseLeaders, func() endpoints.FranchiseLeadersRequest {
+		return endpoints.FranchiseLeadersRequest{
+			LeagueID: leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "TeamID"),
+	route("homepagev2", endpoints.GetHomepageV2, func() endpoints.HomepageV2Request {
+		return endpoints.HomepageV2Request{
+			LeagueID: leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("homepageleaders", endpoints.GetHomepageLeaders, func() endpoints.HomepageLeadersRequest {
+		return endpoints.HomepageLeadersRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("alltimeleadersgrids", endpoints.GetAllTimeLeadersGrids, func() endpoints.AllTimeLeadersGridsRequest {
+		return endpoints.AllTimeLeadersGridsRequest{
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("defensehub", endpoints.GetDefenseHub, func() endpoints.DefenseHubRequest {
+		return endpoints.DefenseHubRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("assisttracker", endpoints.GetAssistTracker, func() endpoints.AssistTrackerRequest {
+		return endpoints.AssistTrackerRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("synergyplaytypes", endpoints.GetSynergyPlayTypes, func() endpoints.SynergyPlayTypesRequest {
+		return endpoints.SynergyPlayTypesRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leaguedashoppptshot", endpoints.GetLeagueDashOppPtShot, func() endpoints.LeagueDashOppPtShotRequest {
+		return endpoints.LeagueDashOppPtShotRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leagueleadersv2", endpoints.GetLeagueLeadersV2, func() endpoints.LeagueLeadersV2Request {
+		return endpoints.LeagueLeadersV2Request{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("opponentshooting", endpoints.GetOpponentShooting, func() endpoints.OpponentShootingRequest {
+		return endpoints.OpponentShootingRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("shootingefficiency", endpoints.GetShootingEfficiency, func() endpoints.ShootingEfficiencyRequest {
+		return endpoints.ShootingEfficiencyRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("matchuprollup", endpoints.GetMatchupRollup, func() endpoints.MatchupRollupRequest {
+		return endpoints.MatchupRollupRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leagueplayerondetails", endpoints.GetLeaguePlayerOnDetails, func() endpoints.LeaguePlayerOnDetailsRequest {
+		return endpoints.LeaguePlayerOnDetailsRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("assistleaders", endpoints.GetAssistLeaders, func() endpoints.AssistLeadersRequest {
+		return endpoints.AssistLeadersRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leaguehustlestatsteamleaders", endpoints.GetLeagueHustleStatsTeamLeaders, func() endpoints.LeagueHustleStatsTeamLeadersRequest {
+		return endpoints.LeagueHustleStatsTeamLeadersRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("commonplayoffseriesv2", endpoints.GetCommonPlayoffSeriesV2, func() endpoints.CommonPlayoffSeriesV2Request {
+		return endpoints.CommonPlayoffSeriesV2Request{
+			Season:   seasonPtr(parameters.CurrentSeason()),
+			LeagueID: leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leaguedashplayerclutchv2", endpoints.GetLeagueDashPlayerClutchV2, func() endpoints.LeagueDashPlayerClutchV2Request {
+		return endpoints.LeagueDashPlayerClutchV2Request{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leaguedashplayershotlocationv2", endpoints.GetLeagueDashPlayerShotLocationV2, func() endpoints.LeagueDashPlayerShotLocationV2Request {
+		return endpoints.LeagueDashPlayerShotLocationV2Request{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("leaguedashteamclutchv2", endpoints.GetLeagueDashTeamClutchV2, func() endpoints.LeagueDashTeamClutchV2Request {
+		return endpoints.LeagueDashTeamClutchV2Request{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+)
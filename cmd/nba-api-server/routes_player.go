@@ -0,0 +1,283 @@
+package main
+
+import (
+	"github.com/n-ae/nba-api-go/pkg/stats/endpoints"
+	"github.com/n-ae/nba-api-go/pkg/stats/parameters"
+)
+
+var playerRoutes = inCategory("player",
+	route("playergamelog", endpoints.PlayerGameLog, func() endpoints.PlayerGameLogRequest {
+		return endpoints.PlayerGameLogRequest{
+			Season:     parameters.CurrentSeason(),
+			SeasonType: parameters.SeasonTypeRegular,
+			LeagueID:   parameters.LeagueIDNBA,
+		}
+	}, "PlayerID"),
+	route("playercareerstats", endpoints.PlayerCareerStats, func() endpoints.PlayerCareerStatsRequest {
+		return endpoints.PlayerCareerStatsRequest{
+			PerMode:  parameters.PerModePerGame,
+			LeagueID: parameters.LeagueIDNBA,
+		}
+	}, "PlayerID"),
+	route("playerprofilev2", endpoints.GetPlayerProfileV2, func() endpoints.PlayerProfileV2Request {
+		return endpoints.PlayerProfileV2Request{
+			LeagueID: leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "PlayerID"),
+	route("playerawards", endpoints.GetPlayerAwards, nil, "PlayerID"),
+	route("playerdashboardbygeneralsplits", endpoints.GetPlayerDashboardByGeneralSplits, func() endpoints.PlayerDashboardByGeneralSplitsRequest {
+		return endpoints.PlayerDashboardByGeneralSplitsRequest{
+			Season:      parameters.CurrentSeason(),
+			SeasonType:  parameters.SeasonTypeRegular,
+			PerMode:     perModePtr(parameters.PerModePerGame),
+			MeasureType: stringPtr("Base"),
+			LeagueID:    leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "PlayerID"),
+	route("playerdashboardbyshootingsplits", endpoints.GetPlayerDashboardByShootingSplits, func() endpoints.PlayerDashboardByShootingSplitsRequest {
+		return endpoints.PlayerDashboardByShootingSplitsRequest{
+			Season:      seasonPtr(parameters.CurrentSeason()),
+			SeasonType:  seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:     perModePtr(parameters.PerModePerGame),
+			MeasureType: stringPtr("Base"),
+			LeagueID:    leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "PlayerID"),
+	route("playerdashboardbyopponent", endpoints.GetPlayerDashboardByOpponent, func() endpoints.PlayerDashboardByOpponentRequest {
+		return endpoints.PlayerDashboardByOpponentRequest{
+			Season:      seasonPtr(parameters.CurrentSeason()),
+			SeasonType:  seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:     perModePtr(parameters.PerModePerGame),
+			MeasureType: stringPtr("Base"),
+			LeagueID:    leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "PlayerID"),
+	route("playerdashboardbyclutch", endpoints.GetPlayerDashboardByClutch, func() endpoints.PlayerDashboardByClutchRequest {
+		return endpoints.PlayerDashboardByClutchRequest{
+			Season:      seasonPtr(parameters.CurrentSeason()),
+			SeasonType:  seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:     perModePtr(parameters.PerModePerGame),
+			MeasureType: stringPtr("Base"),
+			LeagueID:    leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "PlayerID"),
+	route("playergamelogs", endpoints.GetPlayerGameLogs, func() endpoints.PlayerGameLogsRequest {
+		return endpoints.PlayerGameLogsRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("playervsplayer", endpoints.GetPlayerVsPlayer, func() endpoints.PlayerVsPlayerRequest {
+		return endpoints.PlayerVsPlayerRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "PlayerID", "VsPlayerID"),
+	route("playertrackingshotdashboard", endpoints.GetPlayerTrackingShootingEfficiency, func() endpoints.PlayerTrackingShootingEfficiencyRequest {
+		return endpoints.PlayerTrackingShootingEfficiencyRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("playertrackingpasses", endpoints.GetPlayerTrackingPasses, func() endpoints.PlayerTrackingPassesRequest {
+		return endpoints.PlayerTrackingPassesRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("playertrackingdefense", endpoints.GetPlayerTrackingDefense, func() endpoints.PlayerTrackingDefenseRequest {
+		return endpoints.PlayerTrackingDefenseRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("playertrackingrebounding", endpoints.GetPlayerTrackingRebounding, func() endpoints.PlayerTrackingReboundingRequest {
+		return endpoints.PlayerTrackingReboundingRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("playertrackingspeeddistance", endpoints.GetPlayerTrackingSpeedDistance, func() endpoints.PlayerTrackingSpeedDistanceRequest {
+		return endpoints.PlayerTrackingSpeedDistanceRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("playertrackingcatchshoot", endpoints.GetPlayerTrackingCatchShoot, func() endpoints.PlayerTrackingCatchShootRequest {
+		return endpoints.PlayerTrackingCatchShootRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("playertrackingdrives", endpoints.GetPlayerTrackingDrives, func() endpoints.PlayerTrackingDrivesRequest {
+		return endpoints.PlayerTrackingDrivesRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("playertrackingposttouch", endpoints.GetPlayerTrackingPostTouch, func() endpoints.PlayerTrackingPostTouchRequest {
+		return endpoints.PlayerTrackingPostTouchRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("playertrackingpainttouch", endpoints.GetPlayerTrackingPaintTouch, func() endpoints.PlayerTrackingPaintTouchRequest {
+		return endpoints.PlayerTrackingPaintTouchRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("playertrackingelbowtouch", endpoints.GetPlayerTrackingElbowTouch, func() endpoints.PlayerTrackingElbowTouchRequest {
+		return endpoints.PlayerTrackingElbowTouchRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("playertrackingpullupshot", endpoints.GetPlayerTrackingPullUpShot, func() endpoints.PlayerTrackingPullUpShotRequest {
+		return endpoints.PlayerTrackingPullUpShotRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("playerestimatedmetrics", endpoints.GetPlayerEstimatedMetrics, func() endpoints.PlayerEstimatedMetricsRequest {
+		return endpoints.PlayerEstimatedMetricsRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("playerfantasyprofile", endpoints.GetPlayerFantasyProfile, nil, "PlayerID"),
+	route("playerdashptshots", endpoints.GetPlayerDashPtShots, func() endpoints.PlayerDashPtShotsRequest {
+		return endpoints.PlayerDashPtShotsRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "PlayerID"),
+	route("playerdashboardbylastngames", endpoints.GetPlayerDashboardByLastNGames, func() endpoints.PlayerDashboardByLastNGamesRequest {
+		return endpoints.PlayerDashboardByLastNGamesRequest{
+			Season:      seasonPtr(parameters.CurrentSeason()),
+			SeasonType:  seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:     perModePtr(parameters.PerModePerGame),
+			MeasureType: stringPtr("Base"),
+			LeagueID:    leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "PlayerID"),
+	route("playerdashboardbyteamperformance", endpoints.GetPlayerDashboardByTeamPerformance, func() endpoints.PlayerDashboardByTeamPerformanceRequest {
+		return endpoints.PlayerDashboardByTeamPerformanceRequest{
+			Season:      seasonPtr(parameters.CurrentSeason()),
+			SeasonType:  seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:     perModePtr(parameters.PerModePerGame),
+			MeasureType: stringPtr("Base"),
+			LeagueID:    leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "PlayerID"),
+	route("playerdashboardbygamesplits", endpoints.GetPlayerDashboardByGameSplits, func() endpoints.PlayerDashboardByGameSplitsRequest {
+		return endpoints.PlayerDashboardByGameSplitsRequest{
+			Season:      seasonPtr(parameters.CurrentSeason()),
+			SeasonType:  seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:     perModePtr(parameters.PerModePerGame),
+			MeasureType: stringPtr("Base"),
+			LeagueID:    leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "PlayerID"),
+	route("playerdashboardbyyearoveryear", endpoints.GetPlayerDashboardByYearOverYear, func() endpoints.PlayerDashboardByYearOverYearRequest {
+		return endpoints.PlayerDashboardByYearOverYearRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "PlayerID"),
+	route("playercompare", endpoints.GetPlayerCompare, func() endpoints.PlayerCompareRequest {
+		return endpoints.PlayerCompareRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "PlayerIDList"),
+	route("playeryearbyyearstats", endpoints.GetPlayerYearByYearStats, func() endpoints.PlayerYearByYearStatsRequest {
+		return endpoints.PlayerYearByYearStatsRequest{
+			LeagueID: leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "PlayerID"),
+	route("infographicfanduelplayer", endpoints.GetInfographicFanDuelPlayer, nil, "PlayerID"),
+	route("playerindex", endpoints.GetPlayerIndex, func() endpoints.PlayerIndexRequest {
+		return endpoints.PlayerIndexRequest{
+			Season:   seasonPtr(parameters.CurrentSeason()),
+			LeagueID: leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("playercareerbycollege", endpoints.GetPlayerCareerByCollege, func() endpoints.PlayerCareerByCollegeRequest {
+		return endpoints.PlayerCareerByCollegeRequest{
+			LeagueID: leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("cumestatsplayer", endpoints.GetCumeStatsPlayer, func() endpoints.CumeStatsPlayerRequest {
+		return endpoints.CumeStatsPlayerRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "PlayerID"),
+	route("playergamestreakfinder", endpoints.GetPlayerGameStreakFinder, func() endpoints.PlayerGameStreakFinderRequest {
+		return endpoints.PlayerGameStreakFinderRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("playerestimatedadvancedstats", endpoints.GetPlayerEstimatedAdvancedStats, func() endpoints.PlayerEstimatedAdvancedStatsRequest {
+		return endpoints.PlayerEstimatedAdvancedStatsRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("playercareerbycollegerollup", endpoints.GetPlayerCareerByCollegeRollup, func() endpoints.PlayerCareerByCollegeRollupRequest {
+		return endpoints.PlayerCareerByCollegeRollupRequest{
+			LeagueID: leagueIDPtr(parameters.LeagueIDNBA),
+			PerMode:  perModePtr(parameters.PerModePerGame),
+		}
+	}),
+	route("playernextngames", endpoints.GetPlayerNextNGames, func() endpoints.PlayerNextNGamesRequest {
+		return endpoints.PlayerNextNGamesRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "PlayerID"),
+	route("playertrackingshootingefficiency", endpoints.GetPlayerTrackingShootingEfficiency, func() endpoints.PlayerTrackingShootingEfficiencyRequest {
+		return endpoints.PlayerTrackingShootingEfficiencyRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+)
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"github.com/n-ae/nba-api-go/pkg/stats/endpoints"
+	"github.com/n-ae/nba-api-go/pkg/stats/parameters"
+)
+
+var teamRoutes = inCategory("team",
+	route("teamgamelog", endpoints.GetTeamGameLog, func() endpoints.TeamGameLogRequest {
+		return endpoints.TeamGameLogRequest{
+			Season:     parameters.CurrentSeason(),
+			SeasonType: parameters.SeasonTypeRegular,
+		}
+	}, "TeamID"),
+	route("teaminfocommon", endpoints.GetTeamInfoCommon, func() endpoints.TeamInfoCommonRequest {
+		return endpoints.TeamInfoCommonRequest{
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "TeamID"),
+	route("teamdashboardbygeneralsplits", endpoints.GetTeamDashboardByGeneralSplits, func() endpoints.TeamDashboardByGeneralSplitsRequest {
+		return endpoints.TeamDashboardByGeneralSplitsRequest{
+			Season:      parameters.CurrentSeason(),
+			SeasonType:  parameters.SeasonTypeRegular,
+			PerMode:     perModePtr(parameters.PerModePerGame),
+			MeasureType: stringPtr("Base"),
+		}
+	}, "TeamID"),
+	route("teamdashboardbyshootingsplits", endpoints.GetTeamDashboardByShootingSplits, func() endpoints.TeamDashboardByShootingSplitsRequest {
+		return endpoints.TeamDashboardByShootingSplitsRequest{
+			Season:      seasonPtr(parameters.CurrentSeason()),
+			SeasonType:  seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:     perModePtr(parameters.PerModePerGame),
+			MeasureType: stringPtr("Base"),
+		}
+	}, "TeamID"),
+	route("teamdashboardbyopponent", endpoints.GetTeamDashboardByOpponent, func() endpoints.TeamDashboardByOpponentRequest {
+		return endpoints.TeamDashboardByOpponentRequest{
+			Season:      seasonPtr(parameters.CurrentSeason()),
+			SeasonType:  seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:     perModePtr(parameters.PerModePerGame),
+			MeasureType: stringPtr("Base"),
+		}
+	}, "TeamID"),
+	route("teamdetails", endpoints.GetTeamDetails, nil, "TeamID"),
+	route("teamplayerdashboard", endpoints.GetTeamPlayerDashboard, func() endpoints.TeamPlayerDashboardRequest {
+		return endpoints.TeamPlayerDashboardRequest{
+			Season:      seasonPtr(parameters.CurrentSeason()),
+			SeasonType:  seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:     perModePtr(parameters.PerModePerGame),
+			MeasureType: stringPtr("Base"),
+		}
+	}, "TeamID"),
+	route("teamlineups", endpoints.GetTeamLineups, func() endpoints.TeamLineupsRequest {
+		return endpoints.TeamLineupsRequest{
+			Season:        seasonPtr(parameters.CurrentSeason()),
+			SeasonType:    seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:       perModePtr(parameters.PerModePerGame),
+			MeasureType:   stringPtr("Base"),
+			GroupQuantity: stringPtr("5"),
+		}
+	}, "TeamID"),
+	route("teamgamelogs", endpoints.GetTeamGameLogs, func() endpoints.TeamGameLogsRequest {
+		return endpoints.TeamGameLogsRequest{
+			Season:     parameters.CurrentSeason(),
+			SeasonType: parameters.SeasonTypeRegular,
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("teamyearbyyearstats", endpoints.GetTeamYearByYearStats, func() endpoints.TeamYearByYearStatsRequest {
+		return endpoints.TeamYearByYearStatsRequest{
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "TeamID"),
+	route("teamvsteam", endpoints.GetTeamVsTeam, func() endpoints.TeamVsTeamRequest {
+		return endpoints.TeamVsTeamRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "TeamID", "VsTeamID"),
+	route("teamhistoricalleaders", endpoints.GetTeamHistoricalLeaders, func() endpoints.TeamHistoricalLeadersRequest {
+		return endpoints.TeamHistoricalLeadersRequest{
+			LeagueID: leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "TeamID"),
+	route("teamestimatedmetrics", endpoints.GetTeamEstimatedMetrics, func() endpoints.TeamEstimatedMetricsRequest {
+		return endpoints.TeamEstimatedMetricsRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("teamdashptshots", endpoints.GetTeamDashPtShots, func() endpoints.TeamDashPtShotsRequest {
+		return endpoints.TeamDashPtShotsRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "TeamID"),
+	route("teamdashboardbyclutch", endpoints.GetTeamDashboardByClutch, func() endpoints.TeamDashboardByClutchRequest {
+		return endpoints.TeamDashboardByClutchRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "TeamID"),
+	route("teamdashboardbylastngames", endpoints.GetTeamDashboardByLastNGames, func() endpoints.TeamDashboardByLastNGamesRequest {
+		return endpoints.TeamDashboardByLastNGamesRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "TeamID"),
+	route("teamdashboardbyyearoveryear", endpoints.GetTeamDashboardByYearOverYear, func() endpoints.TeamDashboardByYearOverYearRequest {
+		return endpoints.TeamDashboardByYearOverYearRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "TeamID"),
+	route("teamvsplayer", endpoints.GetTeamVsPlayer, func() endpoints.TeamVsPlayerRequest {
+		return endpoints.TeamVsPlayerRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "TeamID", "VsPlayerID"),
+	route("teamdashboardbygamesplits", endpoints.GetTeamDashboardByGameSplits, func() endpoints.TeamDashboardByGameSplitsRequest {
+		return endpoints.TeamDashboardByGameSplitsRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "TeamID"),
+	route("teamdashboardbyteamperformance", endpoints.GetTeamDashboardByTeamPerformance, func() endpoints.TeamDashboardByTeamPerformanceRequest {
+		return endpoints.TeamDashboardByTeamPerformanceRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "TeamID"),
+	route("teamplayeronoffsummary", endpoints.GetTeamPlayerOnOffSummary, func() endpoints.TeamPlayerOnOffSummaryRequest {
+		return endpoints.TeamPlayerOnOffSummaryRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "TeamID"),
+	route("cumestatsteam", endpoints.GetCumeStatsTeam, func() endpoints.CumeStatsTeamRequest {
+		return endpoints.CumeStatsTeamRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "TeamID"),
+	route("teamgamestreakfinder", endpoints.GetTeamGameStreakFinder, func() endpoints.TeamGameStreakFinderRequest {
+		return endpoints.TeamGameStreakFinderRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}),
+	route("teamplayeronoffdetails", endpoints.GetTeamPlayerOnOffDetails, func() endpoints.TeamPlayerOnOffDetailsRequest {
+		return endpoints.TeamPlayerOnOffDetailsRequest{
+			PerMode: perModePtr(parameters.PerModePerGame),
+		}
+	}, "TeamID"),
+	route("teamandplayersvsplayers", endpoints.GetTeamAndPlayersVsPlayers, func() endpoints.TeamAndPlayersVsPlayersRequest {
+		return endpoints.TeamAndPlayersVsPlayersRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "TeamID", "VsPlayerID"),
+	route("teaminfocommonv2", endpoints.GetTeamInfoCommonV2, func() endpoints.TeamInfoCommonV2Request {
+		return endpoints.TeamInfoCommonV2Request{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "TeamID"),
+	route("teamnextngames", endpoints.GetTeamNextNGames, func() endpoints.TeamNextNGamesRequest {
+		return endpoints.TeamNextNGamesRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "TeamID"),
+	route("teamyearoveryearsplits", endpoints.GetTeamYearOverYearSplits, func() endpoints.TeamYearOverYearSplitsRequest {
+		return endpoints.TeamYearOverYearSplitsRequest{
+			Season:     seasonPtr(parameters.CurrentSeason()),
+			SeasonType: seasonTypePtr(parameters.SeasonTypeRegular),
+			PerMode:    perModePtr(parameters.PerModePerGame),
+			LeagueID:   leagueIDPtr(parameters.LeagueIDNBA),
+		}
+	}, "TeamID"),
+)
@@ -0,0 +1,158 @@
+package main
+
+import "github.com/n-ae/nba-api-go/pkg/stats/endpoints"
+
+// checks maps the stats.nba.com endpoint path to a drift check for it.
+var checks = map[string]check{
+	"alltimeleadersgrids":               run(endpoints.GetAllTimeLeadersGrids),
+	"assistleaders":                     run(endpoints.GetAssistLeaders),
+	"assisttracker":                     run(endpoints.GetAssistTracker),
+	"boxscoreadvancedv2":                run(endpoints.GetBoxScoreAdvancedV2),
+	"boxscoreadvancedv3":                run(endpoints.GetBoxScoreAdvancedV3),
+	"boxscoredefensivev2":               run(endpoints.GetBoxScoreDefensiveV2),
+	"boxscoredefensivev3":               run(endpoints.GetBoxScoreDefensiveV3),
+	"boxscorefourfactorsv2":             run(endpoints.GetBoxScoreFourFactorsV2),
+	"boxscorefourfactorsv3":             run(endpoints.GetBoxScoreFourFactorsV3),
+	"boxscorehustlev2":                  run(endpoints.GetBoxScoreHustleV2),
+	"boxscorehustlev3":                  run(endpoints.GetBoxScoreHustleV3),
+	"boxscorematchupsv3":                run(endpoints.GetBoxScoreMatchupsV3),
+	"boxscoremiscv2":                    run(endpoints.GetBoxScoreMiscV2),
+	"boxscoremiscv3":                    run(endpoints.GetBoxScoreMiscV3),
+	"boxscoreplayertrackv2":             run(endpoints.GetBoxScorePlayerTrackV2),
+	"boxscoreplayertrackv3":             run(endpoints.GetBoxScorePlayerTrackV3),
+	"boxscorescoringv2":                 run(endpoints.GetBoxScoreScoringV2),
+	"boxscorescoringv3":                 run(endpoints.GetBoxScoreScoringV3),
+	"boxscoresummaryv2":                 run(endpoints.GetBoxScoreSummaryV2),
+	"boxscoresummaryv3":                 run(endpoints.GetBoxScoreSummaryV3),
+	"boxscoretraditionalv2":             run(endpoints.GetBoxScoreTraditionalV2),
+	"boxscoretraditionalv3":             run(endpoints.GetBoxScoreTraditionalV3),
+	"boxscoreusagev2":                   run(endpoints.GetBoxScoreUsageV2),
+	"boxscoreusagev3":                   run(endpoints.GetBoxScoreUsageV3),
+	"commonallplayers":                  run(endpoints.GetCommonAllPlayers),
+	"commonallplayersv2":                run(endpoints.GetCommonAllPlayersV2),
+	"commonplayerinfo":                  run(endpoints.CommonPlayerInfo),
+	"commonplayerinfoV2":                run(endpoints.GetCommonPlayerInfoV2),
+	"commonplayoffseries":               run(endpoints.GetCommonPlayoffSeries),
+	"commonplayoffseriesv2":             run(endpoints.GetCommonPlayoffSeriesV2),
+	"commonteamroster":                  run(endpoints.GetCommonTeamRoster),
+	"commonteamrosterv2":                run(endpoints.GetCommonTeamRosterV2),
+	"commonteamyears":                   run(endpoints.GetCommonTeamYears),
+	"cumestatsplayer":                   run(endpoints.GetCumeStatsPlayer),
+	"cumestatsteam":                     run(endpoints.GetCumeStatsTeam),
+	"defensehub":                        run(endpoints.GetDefenseHub),
+	"draftboard":                        run(endpoints.GetDraftBoard),
+	"draftcombinestats":                 run(endpoints.GetDraftCombineStats),
+	"drafthistory":                      run(endpoints.GetDraftHistory),
+	"franchisehistory":                  run(endpoints.GetFranchiseHistory),
+	"franchiseleaders":                  run(endpoints.GetFranchiseLeaders),
+	"gamerotation":                      run(endpoints.GetGameRotation),
+	"homepageleaders":                   run(endpoints.GetHomepageLeaders),
+	"homepagev2":                        run(endpoints.GetHomepageV2),
+	"infographicfanduelplayer":          run(endpoints.GetInfographicFanDuelPlayer),
+	"leaguedashlineups":                 run(endpoints.GetLeagueDashLineups),
+	"leaguedashoppptshot":               run(endpoints.GetLeagueDashOppPtShot),
+	"leaguedashplayerbiostats":          run(endpoints.GetLeagueDashPlayerBioStats),
+	"leaguedashplayerclutch":            run(endpoints.GetLeagueDashPlayerClutch),
+	"leaguedashplayerclutchv2":          run(endpoints.GetLeagueDashPlayerClutchV2),
+	"leaguedashplayerptshot":            run(endpoints.GetLeagueDashPlayerPtShot),
+	"leaguedashplayershotlocations":     run(endpoints.GetLeagueDashPlayerShotLocations),
+	"leaguedashplayershotlocationv2":    run(endpoints.GetLeagueDashPlayerShotLocationV2),
+	"leaguedashplayerstats":             run(endpoints.GetLeagueDashPlayerStats),
+	"leaguedashptdefend":                run(endpoints.GetLeagueDashPtDefend),
+	"leaguedashptstats":                 run(endpoints.GetLeagueDashPtStats),
+	"leaguedashptteamdefend":            run(endpoints.GetLeagueDashPtTeamDefend),
+	"leaguedashteambiostats":            run(endpoints.GetLeagueDashTeamBioStats),
+	"leaguedashteamclutch":              run(endpoints.GetLeagueDashTeamClutch),
+	"leaguedashteamclutchv2":            run(endpoints.GetLeagueDashTeamClutchV2),
+	"leaguedashteamptshot":              run(endpoints.GetLeagueDashTeamPtShot),
+	"leaguedashteamshotlocations":       run(endpoints.GetLeagueDashTeamShotLocations),
+	"leaguedashteamstats":               run(endpoints.GetLeagueDashTeamStats),
+	"leaguegamefinder":                  run(endpoints.GetLeagueGameFinder),
+	"leaguegamelog":                     run(endpoints.GetLeagueGameLog),
+	"leaguehustlestats team":            run(endpoints.GetLeagueHustleStatsTeam),
+	"leaguehustlestatsTeamleaders":      run(endpoints.GetLeagueHustleStatsTeamLeaders),
+	"leaguehustlestatsp layer":          run(endpoints.GetLeagueHustleStatsPlayer),
+	"leagueleaders":                     run(endpoints.LeagueLeaders),
+	"leagueleadersv2":                   run(endpoints.GetLeagueLeadersV2),
+	"leagueplayerondetails":             run(endpoints.GetLeaguePlayerOnDetails),
+	"leagueseasonmatchups":              run(endpoints.GetLeagueSeasonMatchups),
+	"leaguestandings":                   run(endpoints.GetLeagueStandings),
+	"leaguestandingsv3":                 run(endpoints.GetLeagueStandingsV3),
+	"matchuprollup":                     run(endpoints.GetMatchupRollup),
+	"opponentshooting":                  run(endpoints.GetOpponentShooting),
+	"playbyplayv2":                      run(endpoints.GetPlayByPlayV2),
+	"playbyplayv3":                      run(endpoints.GetPlayByPlayV3),
+	"playerawards":                      run(endpoints.GetPlayerAwards),
+	"playercareerbycollege":             run(endpoints.GetPlayerCareerByCollege),
+	"playercareerbyrollegerollup":       run(endpoints.GetPlayerCareerByCollegeRollup),
+	"playercareerstats":                 run(endpoints.PlayerCareerStats),
+	"playercompare":                     run(endpoints.GetPlayerCompare),
+	"playerdashboardbyclutch":           run(endpoints.GetPlayerDashboardByClutch),
+	"playerdashboardbygamesplits":       run(endpoints.GetPlayerDashboardByGameSplits),
+	"playerdashboardbygeneralsplits":    run(endpoints.GetPlayerDashboardByGeneralSplits),
+	"playerdashboardbylastnGames":       run(endpoints.GetPlayerDashboardByLastNGames),
+	"playerdashboardbyopponent":         run(endpoints.GetPlayerDashboardByOpponent),
+	"playerdashboardbyshootingsplits":   run(endpoints.GetPlayerDashboardByShootingSplits),
+	"playerdashboardbyteamperformance":  run(endpoints.GetPlayerDashboardByTeamPerformance),
+	"playerdashboardbyyearoveryear":     run(endpoints.GetPlayerDashboardByYearOverYear),
+	"playerdashptshots":                 run(endpoints.GetPlayerDashPtShots),
+	"playerestimatedadvancedstats":      run(endpoints.GetPlayerEstimatedAdvancedStats),
+	"playerestimatedmetrics":            run(endpoints.GetPlayerEstimatedMetrics),
+	"playerfantasyprofile":              run(endpoints.GetPlayerFantasyProfile),
+	"playergamelog":                     run(endpoints.PlayerGameLog),
+	"playergamelogs":                    run(endpoints.GetPlayerGameLogs),
+	"playergamestreakfinder":            run(endpoints.GetPlayerGameStreakFinder),
+	"playerindex":                       run(endpoints.GetPlayerIndex),
+	"playernextnGames":                  run(endpoints.GetPlayerNextNGames),
+	"playerprofilev2":                   run(endpoints.GetPlayerProfileV2),
+	"playertrackingcatchshoot":          run(endpoints.GetPlayerTrackingCatchShoot),
+	"playertrackingdefense":             run(endpoints.GetPlayerTrackingDefense),
+	"playertrackingdrives":              run(endpoints.GetPlayerTrackingDrives),
+	"playertrackingebounding":           run(endpoints.GetPlayerTrackingRebounding),
+	"playertrackingelbowtouch":          run(endpoints.GetPlayerTrackingElbowTouch),
+	"playertrackingpainttouch":          run(endpoints.GetPlayerTrackingPaintTouch),
+	"playertrackingpasses":              run(endpoints.GetPlayerTrackingPasses),
+	"playertrackingposttouch":           run(endpoints.GetPlayerTrackingPostTouch),
+	"playertrackingpullupshot":          run(endpoints.GetPlayerTrackingPullUpShot),
+	"playertrackingshootingefficiency":  run(endpoints.GetPlayerTrackingShootingEfficiency),
+	"playertrackingspeeddistance":       run(endpoints.GetPlayerTrackingSpeedDistance),
+	"playervsplayer":                    run(endpoints.GetPlayerVsPlayer),
+	"playeryearbyyearstats":             run(endpoints.GetPlayerYearByYearStats),
+	"playoffpicture":                    run(endpoints.GetPlayoffPicture),
+	"scheduleleaguev2":                  run(endpoints.GetScheduleLeagueV2),
+	"scoreboardv2":                      run(endpoints.GetScoreboardV2),
+	"scoreboardv3":                      run(endpoints.GetScoreboardV3),
+	"shootingefficiency":                run(endpoints.GetShootingEfficiency),
+	"shotchartdetail":                   run(endpoints.GetShotChartDetail),
+	"shotchartlineupdetail":             run(endpoints.GetShotChartLineupDetail),
+	"synergyplaytypes":                  run(endpoints.GetSynergyPlayTypes),
+	"teamandplayersvsplayers":           run(endpoints.GetTeamAndPlayersVsPlayers),
+	"teamdashboardbyclutch":             run(endpoints.GetTeamDashboardByClutch),
+	"teamdashboardbygamesplits":         run(endpoints.GetTeamDashboardByGameSplits),
+	"teamdashboardbygeneralsplits":      run(endpoints.GetTeamDashboardByGeneralSplits),
+	"teamdashboardbylastnGames":         run(endpoints.GetTeamDashboardByLastNGames),
+	"teamdashboardbyopponent":           run(endpoints.GetTeamDashboardByOpponent),
+	"teamdashboardbyshootingsplits":     run(endpoints.GetTeamDashboardByShootingSplits),
+	"teamdashboardbyteamperformance":    run(endpoints.GetTeamDashboardByTeamPerformance),
+	"teamdashboardbyyearoveryear":       run(endpoints.GetTeamDashboardByYearOverYear),
+	"teamdashboardbyyearoveryearsplits": run(endpoints.GetTeamYearOverYearSplits),
+	"teamdashptshots":                   run(endpoints.GetTeamDashPtShots),
+	"teamdetails":                       run(endpoints.GetTeamDetails),
+	"teamestimatedmetrics":              run(endpoints.GetTeamEstimatedMetrics),
+	"teamgamelog":                       run(endpoints.GetTeamGameLog),
+	"teamgamelogs":                      run(endpoints.GetTeamGameLogs),
+	"teamgamestreakfinder":              run(endpoints.GetTeamGameStreakFinder),
+	"teamhistoricalleaders":             run(endpoints.GetTeamHistoricalLeaders),
+	"teaminfocommon":                    run(endpoints.GetTeamInfoCommon),
+	"teaminfocommonv2":                  run(endpoints.GetTeamInfoCommonV2),
+	"teamlineups":                       run(endpoints.GetTeamLineups),
+	"teamnextnGames":                    run(endpoints.GetTeamNextNGames),
+	"teamplayerdashboard":               run(endpoints.GetTeamPlayerDashboard),
+	"teamplayeronoffdetails":            run(endpoints.GetTeamPlayerOnOffDetails),
+	"teamplayeronoffsummary":            run(endpoints.GetTeamPlayerOnOffSummary),
+	"teamvsplayer":                      run(endpoints.GetTeamVsPlayer),
+	"teamvsteam":                        run(endpoints.GetTeamVsTeam),
+	"teamyearbyyearstats":               run(endpoints.GetTeamYearByYearStats),
+	"videoevents":                       run(endpoints.GetVideoEvents),
+	"winprobabilitypbp":                 run(endpoints.GetWinProbabilityPBP),
+}
@@ -0,0 +1,170 @@
+// Command nba-drift-report replays recorded stats.nba.com responses through
+// the endpoints in pkg/stats/endpoints and reports where the responses no
+// longer match the Go types.
+//
+// Usage:
+//
+//	nba-drift-report [-fixtures dir] [-json] [-fail]
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/stats"
+	"github.com/n-ae/nba-api-go/pkg/transport"
+)
+
+type check func(ctx context.Context, client *stats.Client, query url.Values) ([]models.SchemaDrift, error)
+
+// run adapts an endpoint function to a check. The request is built from the
+// recorded query so the replayed URL matches the fixture.
+func run[Req, T any](fn func(context.Context, *stats.Client, Req) (*models.Response[T], error)) check {
+	return func(ctx context.Context, client *stats.Client, query url.Values) ([]models.SchemaDrift, error) {
+		var req Req
+		fillRequest(reflect.ValueOf(&req).Elem(), query)
+
+		resp, err := fn(ctx, client, req)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Warnings, nil
+	}
+}
+
+// fillRequest sets each string or *string field of req to the query
+// parameter of the same name.
+func fillRequest(req reflect.Value, query url.Values) {
+	if req.Kind() != reflect.Struct {
+		return
+	}
+	for i := 0; i < req.NumField(); i++ {
+		field := req.Field(i)
+		name := req.Type().Field(i).Name
+		if !query.Has(name) || !field.CanSet() {
+			continue
+		}
+		value := query.Get(name)
+
+		switch {
+		case field.Kind() == reflect.String:
+			field.SetString(value)
+		case field.Kind() == reflect.Pointer && field.Type().Elem().Kind() == reflect.String:
+			ptr := reflect.New(field.Type().Elem())
+			ptr.Elem().SetString(value)
+			field.Set(ptr)
+		}
+	}
+}
+
+type result struct {
+	Endpoint string               `json:"endpoint"`
+	Fixture  string               `json:"fixture"`
+	URL      string               `json:"url"`
+	Drift    []models.SchemaDrift `json:"drift,omitempty"`
+	Error    string               `json:"error,omitempty"`
+}
+
+func main() {
+	fixtures := flag.String("fixtures", "pkg/stats/endpoints/testdata/cassettes", "directory of recorded cassettes")
+	asJSON := flag.Bool("json", false, "print the report as JSON")
+	failOnDrift := flag.Bool("fail", false, "exit with status 1 when any drift is found")
+	flag.Parse()
+
+	files, err := filepath.Glob(filepath.Join(*fixtures, "*.json"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(files) == 0 {
+		log.Fatalf("no cassettes found in %s", *fixtures)
+	}
+	sort.Strings(files)
+
+	var results []result
+	for _, file := range files {
+		fileResults, err := checkCassette(context.Background(), file)
+		if err != nil {
+			log.Fatal(err)
+		}
+		results = append(results, fileResults...)
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(results); err != nil {
+			log.Fatal(err)
+		}
+	} else {
+		printReport(results)
+	}
+
+	if *failOnDrift {
+		for _, r := range results {
+			if len(r.Drift) > 0 || r.Error != "" {
+				os.Exit(1)
+			}
+		}
+	}
+}
+
+func checkCassette(ctx context.Context, file string) ([]result, error) {
+	cassette, err := transport.LoadCassette(file)
+	if err != nil {
+		return nil, err
+	}
+
+	client := stats.NewClient(stats.Config{
+		Middlewares: []transport.Middleware{transport.WithCassette(cassette, transport.ModeReplay)},
+		SchemaMode:  stats.SchemaLenient,
+	})
+
+	var results []result
+	for _, interaction := range cassette.Interactions {
+		u, err := url.Parse(interaction.Request.URL)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+
+		r := result{Endpoint: path.Base(u.Path), Fixture: filepath.Base(file), URL: interaction.Request.URL}
+		check, ok := checks[r.Endpoint]
+		if !ok {
+			r.Error = "no endpoint in pkg/stats/endpoints"
+		} else if drift, err := check(ctx, client, u.Query()); err != nil {
+			r.Error = err.Error()
+		} else {
+			r.Drift = drift
+		}
+		results = append(results, r)
+	}
+	return results, nil
+}
+
+func printReport(results []result) {
+	var drifted int
+	for _, r := range results {
+		switch {
+		case r.Error != "":
+			fmt.Printf("ERROR  %s (%s): %s\n", r.Endpoint, r.Fixture, r.Error)
+		case len(r.Drift) > 0:
+			drifted++
+			fmt.Printf("DRIFT  %s (%s)\n", r.Endpoint, r.Fixture)
+			for _, d := range r.Drift {
+				fmt.Printf("       %s\n", d)
+			}
+		default:
+			fmt.Printf("OK     %s (%s)\n", r.Endpoint, r.Fixture)
+		}
+	}
+	fmt.Printf("\n%d fixture(s), %d with drift\n", len(results), drifted)
+}
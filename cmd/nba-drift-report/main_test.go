@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/n-ae/nba-api-go/pkg/stats/endpoints"
+	"github.com/n-ae/nba-api-go/pkg/stats/parameters"
+)
+
+func TestFillRequest(t *testing.T) {
+	var req endpoints.AllTimeLeadersGridsRequest
+	fillRequest(reflect.ValueOf(&req).Elem(), url.Values{"PerMode": {"PerGame"}, "TopX": {"10"}, "Unknown": {"x"}})
+
+	if req.PerMode == nil || *req.PerMode != parameters.PerModePerGame || req.TopX == nil || *req.TopX != "10" || req.LeagueID != nil {
+		t.Errorf("fillRequest() = %+v", req)
+	}
+}
+
+func TestCheckCassette(t *testing.T) {
+	results, err := checkCassette(context.Background(), "../../pkg/stats/endpoints/testdata/cassettes/playergamelog.json")
+	if err != nil {
+		t.Fatalf("checkCassette() error = %v", err)
+	}
+	if len(results) != 1 || results[0].Endpoint != "playergamelog" || results[0].Error != "" || len(results[0].Drift) != 0 {
+		t.Errorf("checkCassette() = %+v", results)
+	}
+}
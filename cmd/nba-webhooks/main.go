@@ -0,0 +1,50 @@
+// Command nba-webhooks watches today's live games and delivers their events
+// to the webhooks of a config file.
+//
+// Usage:
+//
+//	nba-webhooks -config webhooks.json
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/n-ae/nba-api-go/pkg/client"
+	"github.com/n-ae/nba-api-go/pkg/live"
+	"github.com/n-ae/nba-api-go/pkg/live/webhook"
+)
+
+func main() {
+	configPath := flag.String("config", "webhooks.json", "webhook config file")
+	flag.Parse()
+
+	logger := log.New(os.Stdout, "[nba-webhooks] ", log.LstdFlags)
+
+	config, err := webhook.LoadConfig(*configPath)
+	if err != nil {
+		logger.Fatalf("Invalid webhook configuration: %v", err)
+	}
+	config.OnError = func(err error) { logger.Print(err) }
+
+	clientOpts, err := client.OptionsFromEnv()
+	if err != nil {
+		logger.Fatalf("Invalid client configuration: %v", err)
+	}
+
+	dispatcher, err := webhook.New(live.NewClient(live.Config{}, clientOpts...), config)
+	if err != nil {
+		logger.Fatalf("Failed to start: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	logger.Printf("Delivering to %d subscriptions", len(config.Subscriptions))
+	dispatcher.Run(ctx)
+	logger.Println("Stopped")
+}
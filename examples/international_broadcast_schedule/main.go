@@ -25,22 +25,22 @@ func main() {
 		log.Fatalf("Failed to get international broadcast schedule: %v", err)
 	}
 
-	if len(resp.Games) == 0 {
+	if len(resp.Data.Games) == 0 {
 		fmt.Println("No games found in the international broadcast schedule.")
 		return
 	}
 
-	fmt.Printf("Found %d scheduled games with international broadcasts\n", len(resp.Games))
+	fmt.Printf("Found %d scheduled games with international broadcasts\n", len(resp.Data.Games))
 	fmt.Println()
 
 	gamesByDate := make(map[string][]endpoints.ScheduledGame)
-	for _, game := range resp.Games {
+	for _, game := range resp.Data.Games {
 		gamesByDate[game.Date] = append(gamesByDate[game.Date], game)
 	}
 
 	uniqueDates := make([]string, 0, len(gamesByDate))
 	seenDates := make(map[string]bool)
-	for _, game := range resp.Games {
+	for _, game := range resp.Data.Games {
 		if !seenDates[game.Date] {
 			uniqueDates = append(uniqueDates, game.Date)
 			seenDates[game.Date] = true
@@ -105,7 +105,7 @@ func main() {
 	}
 
 	totalBroadcasters := make(map[string]int)
-	for _, game := range resp.Games {
+	for _, game := range resp.Data.Games {
 		for _, broadcaster := range game.Broadcasters {
 			totalBroadcasters[broadcaster.BroadcasterName]++
 		}
@@ -62,15 +62,21 @@ func demoBoxScore(ctx context.Context, client *stats.Client) {
 
 		// Direct field access - compiler enforces types!
 		// player.PLAYER_NAME is string
-		// player.PTS is int
-		// player.MIN is float64
-		// player.FG_PCT is float64
+		// player.PTS is models.Opt[int] - absent when the player did not play
+		// player.MIN is models.Opt[string], such as "34:12"
+		// player.FG_PCT is models.Opt[float64]
+		pts, played := player.PTS.Get()
+		if !played {
+			fmt.Printf("%-20s | DNP\n", player.PLAYER_NAME)
+			continue
+		}
 
+		minutes, _ := player.Minutes()
 		fmt.Printf("%-20s | %2d pts | %.1f min | %.1f%% FG\n",
-			player.PLAYER_NAME, // string - no assertion!
-			player.PTS,         // int - no assertion!
-			player.MIN,         // float64 - no assertion!
-			player.FG_PCT*100,  // float64 - math works directly!
+			player.PLAYER_NAME,      // string - no assertion!
+			pts,                     // int - no assertion!
+			minutes.Minutes(),       // parsed from "MM:SS"
+			player.FG_PCT.Or(0)*100, // float64 - math works directly!
 		)
 
 		count++
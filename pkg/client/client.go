@@ -2,7 +2,9 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -11,8 +13,8 @@ import (
 	"sort"
 	"time"
 
-	"github.com/n-ae/nba-api-go/internal/middleware"
 	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/transport"
 )
 
 const (
@@ -29,24 +31,54 @@ type Client struct {
 	httpClient HTTPClient
 	headers    http.Header
 	timeout    time.Duration
-	transport  middleware.RoundTripper
+	transport  transport.RoundTripper
+	configErr  error
 }
 
 type Config struct {
-	BaseURL     string
-	HTTPClient  HTTPClient
-	Headers     http.Header
-	Timeout     time.Duration
-	Middlewares []middleware.Middleware
+	BaseURL    string
+	HTTPClient HTTPClient
+	Headers    http.Header
+
+	// Timeout bounds each attempt. It is applied to the default HTTP client,
+	// and to a custom HTTPClient through the request context.
+	Timeout time.Duration
+
+	// ProxyURL and TLSConfig configure the default HTTP client and are
+	// ignored when HTTPClient is set. Without ProxyURL the standard
+	// HTTP_PROXY/HTTPS_PROXY/NO_PROXY environment variables are used.
+	ProxyURL  string
+	TLSConfig *tls.Config
+
+	Middlewares []transport.Middleware
 }
 
-func NewClient(config Config) *Client {
-	if config.Timeout == 0 {
-		config.Timeout = DefaultTimeout
+func NewClient(config Config, opts ...Option) *Client {
+	for _, opt := range opts {
+		opt(&config)
 	}
 
+	var configErr error
+	var attemptTimeout time.Duration
+
 	if config.HTTPClient == nil {
-		transport := &http.Transport{
+		if config.Timeout == 0 {
+			config.Timeout = DefaultTimeout
+		}
+
+		proxy := http.ProxyFromEnvironment
+		if config.ProxyURL != "" {
+			proxyURL, err := url.Parse(config.ProxyURL)
+			if err != nil {
+				configErr = fmt.Errorf("invalid proxy URL: %w", err)
+			} else {
+				proxy = http.ProxyURL(proxyURL)
+			}
+		}
+
+		httpTransport := &http.Transport{
+			Proxy:                 proxy,
+			TLSClientConfig:       config.TLSConfig,
 			DisableKeepAlives:     true,
 			MaxIdleConns:          1,
 			IdleConnTimeout:       30 * time.Second,
@@ -56,8 +88,10 @@ func NewClient(config Config) *Client {
 
 		config.HTTPClient = &http.Client{
 			Timeout:   config.Timeout,
-			Transport: transport,
+			Transport: httpTransport,
 		}
+	} else {
+		attemptTimeout = config.Timeout
 	}
 
 	if config.Headers == nil {
@@ -68,12 +102,12 @@ func NewClient(config Config) *Client {
 		config.Headers.Set("User-Agent", DefaultUserAgent)
 	}
 
-	baseTransport := &baseRoundTripper{client: config.HTTPClient}
+	baseTransport := &baseRoundTripper{client: config.HTTPClient, timeout: attemptTimeout}
 
-	var transport middleware.RoundTripper = baseTransport
+	var rt transport.RoundTripper = baseTransport
 	if len(config.Middlewares) > 0 {
-		chained := middleware.Chain(config.Middlewares...)
-		transport = chained(baseTransport)
+		chained := transport.Chain(config.Middlewares...)
+		rt = chained(baseTransport)
 	}
 
 	return &Client{
@@ -81,20 +115,48 @@ func NewClient(config Config) *Client {
 		httpClient: config.HTTPClient,
 		headers:    config.Headers,
 		timeout:    config.Timeout,
-		transport:  transport,
+		transport:  rt,
+		configErr:  configErr,
 	}
 }
 
 type baseRoundTripper struct {
-	client HTTPClient
+	client  HTTPClient
+	timeout time.Duration
 }
 
 func (b *baseRoundTripper) RoundTrip(ctx context.Context, req *http.Request) (*http.Response, error) {
-	req = req.WithContext(ctx)
-	return b.client.Do(req)
+	if b.timeout <= 0 {
+		return b.client.Do(req.WithContext(ctx))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, b.timeout)
+	resp, err := b.client.Do(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelOnClose releases the per-attempt context once the body is consumed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
 }
 
 func (c *Client) Get(ctx context.Context, endpoint string, params url.Values) (*models.RawResponse, error) {
+	if c.configErr != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidRequest, c.configErr)
+	}
+
 	reqURL, err := c.buildURL(endpoint, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build URL: %w", err)
@@ -111,8 +173,14 @@ func (c *Client) Get(ctx context.Context, endpoint string, params url.Values) (*
 		}
 	}
 
+	ctx, attempts := transport.TrackAttempts(ctx)
+
+	start := time.Now()
 	resp, err := c.transport.RoundTrip(ctx, req)
 	if err != nil {
+		if n := attempts(); n > 1 {
+			return nil, fmt.Errorf("request failed after %d attempts: %w", n, err)
+		}
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
@@ -123,25 +191,40 @@ func (c *Client) Get(ctx context.Context, endpoint string, params url.Values) (*
 	}
 
 	if resp.StatusCode >= 400 {
-		if apiErr := models.HTTPStatusToError(resp.StatusCode, reqURL); apiErr != nil {
-			return nil, apiErr
+		if err := models.HTTPStatusToError(resp.StatusCode, reqURL); err != nil {
+			var apiErr *models.APIError
+			if errors.As(err, &apiErr) {
+				apiErr.Attempts = attempts()
+			}
+			return nil, err
 		}
 	}
 
-	return models.NewRawResponse(body, resp.StatusCode, reqURL, resp.Header), nil
+	rawResp := models.NewRawResponse(body, resp.StatusCode, reqURL, resp.Header)
+	rawResp.FetchedAt = time.Now()
+	rawResp.Latency = rawResp.FetchedAt.Sub(start)
+	rawResp.CacheHit = resp.Header.Get(transport.CacheStatusHeader) == transport.CacheHit
+	return rawResp, nil
 }
 
 func (c *Client) GetJSON(ctx context.Context, endpoint string, params url.Values, v interface{}) error {
+	_, err := c.GetJSONRaw(ctx, endpoint, params, v)
+	return err
+}
+
+// GetJSONRaw decodes the body into v like GetJSON and also returns the raw
+// response, whose metadata endpoints copy into models.Response.
+func (c *Client) GetJSONRaw(ctx context.Context, endpoint string, params url.Values, v interface{}) (*models.RawResponse, error) {
 	rawResp, err := c.Get(ctx, endpoint, params)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := json.Unmarshal(rawResp.Body, v); err != nil {
-		return fmt.Errorf("%w: %v", models.ErrInvalidResponse, err)
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidResponse, err)
 	}
 
-	return nil
+	return rawResp, nil
 }
 
 func (c *Client) buildURL(endpoint string, params url.Values) (string, error) {
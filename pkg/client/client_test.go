@@ -2,10 +2,15 @@ package client
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/transport"
 )
 
 func TestClient_Get(t *testing.T) {
@@ -132,3 +137,65 @@ func TestClient_buildURL(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_Get_AttemptsOnAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	retry := transport.DefaultRetryConfig()
+	retry.MaxRetries = 2
+	retry.InitialBackoff = time.Millisecond
+	retry.MaxBackoff = time.Millisecond
+
+	client := NewClient(Config{
+		BaseURL:     server.URL,
+		Middlewares: []transport.Middleware{transport.WithRetry(retry)},
+	})
+
+	_, err := client.Get(context.Background(), "/test", nil)
+
+	var apiErr *models.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Client.Get() error = %v, want *models.APIError", err)
+	}
+	if apiErr.Attempts != 3 {
+		t.Errorf("APIError.Attempts = %d, want 3", apiErr.Attempts)
+	}
+}
+
+func TestClient_GetJSONRaw_Metadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{
+		BaseURL: server.URL,
+		Middlewares: []transport.Middleware{
+			transport.WithCache(transport.DefaultCacheConfig(transport.NewMemoryCache(10))),
+		},
+	})
+
+	var v struct{ OK bool }
+	first, err := client.GetJSONRaw(context.Background(), "/test", url.Values{"b": {"2"}, "a": {"1"}}, &v)
+	if err != nil {
+		t.Fatalf("GetJSONRaw() error = %v", err)
+	}
+	if !v.OK || first.StatusCode != http.StatusOK || first.URL != server.URL+"/test?a=1&b=2" {
+		t.Errorf("unexpected response: %+v", first)
+	}
+	if first.CacheHit || first.FetchedAt.IsZero() || first.Latency <= 0 {
+		t.Errorf("first response metadata: cacheHit=%v fetchedAt=%v latency=%v", first.CacheHit, first.FetchedAt, first.Latency)
+	}
+
+	second, err := client.GetJSONRaw(context.Background(), "/test", url.Values{"a": {"1"}, "b": {"2"}}, &v)
+	if err != nil {
+		t.Fatalf("GetJSONRaw() error = %v", err)
+	}
+	if !second.CacheHit {
+		t.Error("second response should be a cache hit")
+	}
+}
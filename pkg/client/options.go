@@ -0,0 +1,77 @@
+package client
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+// Environment variables read by OptionsFromEnv.
+const (
+	EnvTimeout = "NBA_API_TIMEOUT"
+	EnvProxy   = "NBA_API_PROXY"
+)
+
+// Option modifies a Config before the client is built.
+type Option func(config *Config)
+
+func WithTimeout(timeout time.Duration) Option {
+	return func(config *Config) {
+		config.Timeout = timeout
+	}
+}
+
+func WithHTTPClient(httpClient HTTPClient) Option {
+	return func(config *Config) {
+		config.HTTPClient = httpClient
+	}
+}
+
+func WithProxy(proxyURL string) Option {
+	return func(config *Config) {
+		config.ProxyURL = proxyURL
+	}
+}
+
+func WithTLSConfig(tlsConfig *tls.Config) Option {
+	return func(config *Config) {
+		config.TLSConfig = tlsConfig
+	}
+}
+
+func WithBaseURL(baseURL string) Option {
+	return func(config *Config) {
+		config.BaseURL = baseURL
+	}
+}
+
+func WithHeader(key, value string) Option {
+	return func(config *Config) {
+		if config.Headers == nil {
+			config.Headers = make(http.Header)
+		}
+		config.Headers.Set(key, value)
+	}
+}
+
+// OptionsFromEnv builds options from NBA_API_TIMEOUT (a Go duration such as
+// "30s") and NBA_API_PROXY. Unset variables are skipped.
+func OptionsFromEnv() ([]Option, error) {
+	var opts []Option
+
+	if value := os.Getenv(EnvTimeout); value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", EnvTimeout, err)
+		}
+		opts = append(opts, WithTimeout(timeout))
+	}
+
+	if value := os.Getenv(EnvProxy); value != "" {
+		opts = append(opts, WithProxy(value))
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+)
+
+func TestWithProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		w.Write([]byte(`{}`))
+	}))
+	defer proxy.Close()
+
+	client := NewClient(Config{BaseURL: "http://upstream.invalid"}, WithProxy(proxy.URL))
+
+	if _, err := client.Get(context.Background(), "/test", nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if proxied != "http://upstream.invalid/test" {
+		t.Errorf("proxy received %q, want absolute upstream URL", proxied)
+	}
+}
+
+func TestWithProxy_Invalid(t *testing.T) {
+	client := NewClient(Config{BaseURL: "http://upstream.invalid"}, WithProxy("://bad"))
+
+	_, err := client.Get(context.Background(), "/test", nil)
+	if !errors.Is(err, models.ErrInvalidRequest) {
+		t.Errorf("Get() error = %v, want ErrInvalidRequest", err)
+	}
+}
+
+func TestWithTimeout_CustomHTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(2 * time.Second):
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{BaseURL: server.URL},
+		WithHTTPClient(&http.Client{}),
+		WithTimeout(50*time.Millisecond),
+	)
+
+	start := time.Now()
+	if _, err := client.Get(context.Background(), "/slow", nil); err == nil {
+		t.Fatal("Get() expected timeout error")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Get() took %v, timeout not applied", elapsed)
+	}
+}
+
+func TestWithHeader(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("X-Api-Key")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{}, WithBaseURL(server.URL), WithHeader("X-Api-Key", "secret"))
+	if _, err := client.Get(context.Background(), "/test", nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got != "secret" {
+		t.Errorf("X-Api-Key = %q, want %q", got, "secret")
+	}
+}
+
+func TestOptionsFromEnv(t *testing.T) {
+	t.Setenv(EnvTimeout, "5s")
+	t.Setenv(EnvProxy, "http://proxy.local:3128")
+
+	opts, err := OptionsFromEnv()
+	if err != nil {
+		t.Fatalf("OptionsFromEnv() error = %v", err)
+	}
+
+	var config Config
+	for _, opt := range opts {
+		opt(&config)
+	}
+	if config.Timeout != 5*time.Second {
+		t.Errorf("Timeout = %v, want 5s", config.Timeout)
+	}
+	if config.ProxyURL != "http://proxy.local:3128" {
+		t.Errorf("ProxyURL = %q", config.ProxyURL)
+	}
+
+	t.Setenv(EnvTimeout, "thirty")
+	if _, err := OptionsFromEnv(); err == nil {
+		t.Error("OptionsFromEnv() expected error for invalid timeout")
+	}
+}
@@ -11,6 +11,10 @@ func (c *Client) GetJSON(ctx context.Context, endpoint string, params url.Values
 	return c.client.GetJSON(ctx, endpoint, params, v)
 }
 
+func (c *Client) GetJSONRaw(ctx context.Context, endpoint string, params url.Values, v interface{}) (*models.RawResponse, error) {
+	return c.client.GetJSONRaw(ctx, endpoint, params, v)
+}
+
 func (c *Client) Get(ctx context.Context, endpoint string, params url.Values) (*models.RawResponse, error) {
 	return c.client.Get(ctx, endpoint, params)
 }
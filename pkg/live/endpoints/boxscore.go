@@ -0,0 +1,234 @@
+package endpoints
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/live"
+	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
+)
+
+type Arena struct {
+	ArenaID       int    `json:"arenaId"`
+	ArenaName     string `json:"arenaName"`
+	ArenaCity     string `json:"arenaCity"`
+	ArenaState    string `json:"arenaState"`
+	ArenaCountry  string `json:"arenaCountry"`
+	ArenaTimezone string `json:"arenaTimezone"`
+}
+
+type Official struct {
+	PersonID   int    `json:"personId"`
+	Name       string `json:"name"`
+	NameI      string `json:"nameI"`
+	FirstName  string `json:"firstName"`
+	FamilyName string `json:"familyName"`
+	JerseyNum  string `json:"jerseyNum"`
+	Assignment string `json:"assignment"`
+}
+
+// PlayerStatistics is a player's line. Minutes is an ISO 8601 duration
+// ("PT35M12.00S").
+type PlayerStatistics struct {
+	Assists                 int     `json:"assists"`
+	Blocks                  int     `json:"blocks"`
+	BlocksReceived          int     `json:"blocksReceived"`
+	FieldGoalsAttempted     int     `json:"fieldGoalsAttempted"`
+	FieldGoalsMade          int     `json:"fieldGoalsMade"`
+	FieldGoalsPercentage    float64 `json:"fieldGoalsPercentage"`
+	FoulsOffensive          int     `json:"foulsOffensive"`
+	FoulsDrawn              int     `json:"foulsDrawn"`
+	FoulsPersonal           int     `json:"foulsPersonal"`
+	FoulsTechnical          int     `json:"foulsTechnical"`
+	FreeThrowsAttempted     int     `json:"freeThrowsAttempted"`
+	FreeThrowsMade          int     `json:"freeThrowsMade"`
+	FreeThrowsPercentage    float64 `json:"freeThrowsPercentage"`
+	Minus                   float64 `json:"minus"`
+	Minutes                 string  `json:"minutes"`
+	MinutesCalculated       string  `json:"minutesCalculated"`
+	Plus                    float64 `json:"plus"`
+	PlusMinusPoints         float64 `json:"plusMinusPoints"`
+	Points                  int     `json:"points"`
+	PointsFastBreak         int     `json:"pointsFastBreak"`
+	PointsInThePaint        int     `json:"pointsInThePaint"`
+	PointsSecondChance      int     `json:"pointsSecondChance"`
+	ReboundsDefensive       int     `json:"reboundsDefensive"`
+	ReboundsOffensive       int     `json:"reboundsOffensive"`
+	ReboundsTotal           int     `json:"reboundsTotal"`
+	Steals                  int     `json:"steals"`
+	ThreePointersAttempted  int     `json:"threePointersAttempted"`
+	ThreePointersMade       int     `json:"threePointersMade"`
+	ThreePointersPercentage float64 `json:"threePointersPercentage"`
+	Turnovers               int     `json:"turnovers"`
+	TwoPointersAttempted    int     `json:"twoPointersAttempted"`
+	TwoPointersMade         int     `json:"twoPointersMade"`
+	TwoPointersPercentage   float64 `json:"twoPointersPercentage"`
+}
+
+// MinutesPlayed parses Minutes.
+func (s PlayerStatistics) MinutesPlayed() (time.Duration, error) {
+	return timeutil.ParseClock(s.Minutes)
+}
+
+// Player is a player in the live box score. Starter, OnCourt and Played are
+// "1" or "0"; NotPlayingReason is set for inactive players.
+type Player struct {
+	Status                string           `json:"status"`
+	Order                 int              `json:"order"`
+	PersonID              int              `json:"personId"`
+	JerseyNum             string           `json:"jerseyNum"`
+	Position              string           `json:"position"`
+	Starter               string           `json:"starter"`
+	OnCourt               string           `json:"oncourt"`
+	Played                string           `json:"played"`
+	Name                  string           `json:"name"`
+	NameI                 string           `json:"nameI"`
+	FirstName             string           `json:"firstName"`
+	FamilyName            string           `json:"familyName"`
+	NotPlayingReason      string           `json:"notPlayingReason"`
+	NotPlayingDescription string           `json:"notPlayingDescription"`
+	Statistics            PlayerStatistics `json:"statistics"`
+}
+
+func (p Player) IsStarter() bool {
+	return p.Starter == "1"
+}
+
+func (p Player) IsOnCourt() bool {
+	return p.OnCourt == "1"
+}
+
+func (p Player) HasPlayed() bool {
+	return p.Played == "1"
+}
+
+type TeamStatistics struct {
+	Assists                      int     `json:"assists"`
+	AssistsTurnoverRatio         float64 `json:"assistsTurnoverRatio"`
+	BenchPoints                  int     `json:"benchPoints"`
+	BiggestLead                  int     `json:"biggestLead"`
+	BiggestLeadScore             string  `json:"biggestLeadScore"`
+	BiggestScoringRun            int     `json:"biggestScoringRun"`
+	BiggestScoringRunScore       string  `json:"biggestScoringRunScore"`
+	Blocks                       int     `json:"blocks"`
+	BlocksReceived               int     `json:"blocksReceived"`
+	FastBreakPointsAttempted     int     `json:"fastBreakPointsAttempted"`
+	FastBreakPointsMade          int     `json:"fastBreakPointsMade"`
+	FastBreakPointsPercentage    float64 `json:"fastBreakPointsPercentage"`
+	FieldGoalsAttempted          int     `json:"fieldGoalsAttempted"`
+	FieldGoalsEffectiveAdjusted  float64 `json:"fieldGoalsEffectiveAdjusted"`
+	FieldGoalsMade               int     `json:"fieldGoalsMade"`
+	FieldGoalsPercentage         float64 `json:"fieldGoalsPercentage"`
+	FoulsOffensive               int     `json:"foulsOffensive"`
+	FoulsDrawn                   int     `json:"foulsDrawn"`
+	FoulsPersonal                int     `json:"foulsPersonal"`
+	FoulsTeam                    int     `json:"foulsTeam"`
+	FoulsTechnical               int     `json:"foulsTechnical"`
+	FoulsTeamTechnical           int     `json:"foulsTeamTechnical"`
+	FreeThrowsAttempted          int     `json:"freeThrowsAttempted"`
+	FreeThrowsMade               int     `json:"freeThrowsMade"`
+	FreeThrowsPercentage         float64 `json:"freeThrowsPercentage"`
+	LeadChanges                  int     `json:"leadChanges"`
+	Minutes                      string  `json:"minutes"`
+	Points                       int     `json:"points"`
+	PointsAgainst                int     `json:"pointsAgainst"`
+	PointsFastBreak              int     `json:"pointsFastBreak"`
+	PointsFromTurnovers          int     `json:"pointsFromTurnovers"`
+	PointsInThePaint             int     `json:"pointsInThePaint"`
+	PointsSecondChance           int     `json:"pointsSecondChance"`
+	ReboundsDefensive            int     `json:"reboundsDefensive"`
+	ReboundsOffensive            int     `json:"reboundsOffensive"`
+	ReboundsPersonal             int     `json:"reboundsPersonal"`
+	ReboundsTeam                 int     `json:"reboundsTeam"`
+	ReboundsTotal                int     `json:"reboundsTotal"`
+	SecondChancePointsAttempted  int     `json:"secondChancePointsAttempted"`
+	SecondChancePointsMade       int     `json:"secondChancePointsMade"`
+	SecondChancePointsPercentage float64 `json:"secondChancePointsPercentage"`
+	Steals                       int     `json:"steals"`
+	ThreePointersAttempted       int     `json:"threePointersAttempted"`
+	ThreePointersMade            int     `json:"threePointersMade"`
+	ThreePointersPercentage      float64 `json:"threePointersPercentage"`
+	TimeLeading                  string  `json:"timeLeading"`
+	TimesTied                    int     `json:"timesTied"`
+	TrueShootingAttempts         float64 `json:"trueShootingAttempts"`
+	TrueShootingPercentage       float64 `json:"trueShootingPercentage"`
+	Turnovers                    int     `json:"turnovers"`
+	TurnoversTeam                int     `json:"turnoversTeam"`
+	TurnoversTotal               int     `json:"turnoversTotal"`
+	TwoPointersAttempted         int     `json:"twoPointersAttempted"`
+	TwoPointersMade              int     `json:"twoPointersMade"`
+	TwoPointersPercentage        float64 `json:"twoPointersPercentage"`
+}
+
+// BoxScoreTeam is a team's totals and players. InBonus is "1" or "0".
+type BoxScoreTeam struct {
+	TeamID            int    `json:"teamId"`
+	TeamName          string `json:"teamName"`
+	TeamCity          string `json:"teamCity"`
+	TeamTricode       string `json:"teamTricode"`
+	Score             int    `json:"score"`
+	InBonus           string `json:"inBonus"`
+	TimeoutsRemaining int    `json:"timeoutsRemaining"`
+	Periods           []struct {
+		Period     int    `json:"period"`
+		PeriodType string `json:"periodType"`
+		Score      int    `json:"score"`
+	} `json:"periods"`
+	Players    []Player       `json:"players"`
+	Statistics TeamStatistics `json:"statistics"`
+}
+
+type BoxScoreGame struct {
+	GameID            string       `json:"gameId"`
+	GameTimeLocal     string       `json:"gameTimeLocal"`
+	GameTimeUTC       string       `json:"gameTimeUTC"`
+	GameTimeHome      string       `json:"gameTimeHome"`
+	GameTimeAway      string       `json:"gameTimeAway"`
+	GameEt            string       `json:"gameEt"`
+	Duration          int          `json:"duration"`
+	GameCode          string       `json:"gameCode"`
+	GameStatusText    string       `json:"gameStatusText"`
+	GameStatus        int          `json:"gameStatus"`
+	RegulationPeriods int          `json:"regulationPeriods"`
+	Period            int          `json:"period"`
+	GameClock         string       `json:"gameClock"`
+	Attendance        int          `json:"attendance"`
+	Sellout           string       `json:"sellout"`
+	Arena             Arena        `json:"arena"`
+	Officials         []Official   `json:"officials"`
+	HomeTeam          BoxScoreTeam `json:"homeTeam"`
+	AwayTeam          BoxScoreTeam `json:"awayTeam"`
+}
+
+// Clock parses GameClock, the time left in the period.
+func (g BoxScoreGame) Clock() (time.Duration, error) {
+	return timeutil.ParseClock(g.GameClock)
+}
+
+// StartTime parses GameTimeUTC.
+func (g BoxScoreGame) StartTime() (time.Time, error) {
+	return timeutil.ParseGameTime(g.GameTimeUTC)
+}
+
+type BoxScoreResponse struct {
+	Meta Meta         `json:"meta"`
+	Game BoxScoreGame `json:"game"`
+}
+
+// BoxScore fetches the live box score of a game. It is published once the
+// game starts; before that the CDN answers 403.
+func BoxScore(ctx context.Context, client *live.Client, gameID string) (*models.Response[*BoxScoreResponse], error) {
+	if gameID == "" {
+		return nil, fmt.Errorf("%w: gameID is required", models.ErrInvalidRequest)
+	}
+
+	var resp BoxScoreResponse
+	raw, err := client.GetJSONRaw(ctx, "/boxscore/boxscore_"+gameID+".json", nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewResponseFromRaw(&resp, raw), nil
+}
@@ -0,0 +1,79 @@
+package endpoints
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+)
+
+func TestBoxScore_Replay(t *testing.T) {
+	resp, err := BoxScore(context.Background(), replayClient(t, "boxscore"), "0022400062")
+	if err != nil {
+		t.Fatalf("BoxScore() error = %v", err)
+	}
+
+	game := resp.Data.Game
+	if game.GameID != "0022400062" || game.Arena.ArenaName != "Crypto.com Arena" || len(game.Officials) != 1 {
+		t.Fatalf("game = %+v", game)
+	}
+	if game.HomeTeam.Statistics.Points != 110 || game.AwayTeam.Statistics.PointsAgainst != 110 || len(game.HomeTeam.Periods) != 4 {
+		t.Errorf("team stats = %+v / %+v", game.HomeTeam.Statistics, game.AwayTeam.Statistics)
+	}
+
+	lebron := game.HomeTeam.Players[0]
+	if !lebron.IsStarter() || !lebron.HasPlayed() || lebron.Statistics.Points != 16 || lebron.Statistics.Assists != 8 {
+		t.Errorf("player = %+v", lebron)
+	}
+	if played, err := lebron.Statistics.MinutesPlayed(); err != nil || played != 35*time.Minute+12*time.Second {
+		t.Errorf("MinutesPlayed() = %v, %v", played, err)
+	}
+	if inactive := game.HomeTeam.Players[1]; inactive.HasPlayed() || inactive.NotPlayingReason != "INACTIVE_INJURY" {
+		t.Errorf("inactive player = %+v", inactive)
+	}
+}
+
+func TestPlayByPlay_Replay(t *testing.T) {
+	resp, err := PlayByPlay(context.Background(), replayClient(t, "playbyplay"), "0022400062")
+	if err != nil {
+		t.Fatalf("PlayByPlay() error = %v", err)
+	}
+
+	game := resp.Data.Game
+	if len(game.Actions) != 4 || game.LastActionNumber() != 4 {
+		t.Fatalf("actions = %+v", game.Actions)
+	}
+
+	shot := game.Actions[3]
+	if shot.X == nil || *shot.X != 31.2 || shot.XLegacy == nil || *shot.XLegacy != -177 || shot.ShotResult != "Made" {
+		t.Errorf("shot = %+v", shot)
+	}
+	if game.Actions[0].X != nil {
+		t.Errorf("period start has coordinates: %+v", game.Actions[0])
+	}
+	if left, err := shot.Remaining(); err != nil || left != 11*time.Minute+42*time.Second {
+		t.Errorf("Remaining() = %v, %v", left, err)
+	}
+	if at, err := shot.Time(); err != nil || at.Second() != 14 {
+		t.Errorf("Time() = %v, %v", at, err)
+	}
+
+	since := game.ActionsSince(2)
+	if len(since) != 2 || since[0].ActionNumber != 3 || since[1].ActionNumber != 4 {
+		t.Errorf("ActionsSince(2) = %+v", since)
+	}
+	if since := game.ActionsSince(game.LastActionNumber()); len(since) != 0 {
+		t.Errorf("ActionsSince(last) = %+v, want none", since)
+	}
+}
+
+func TestLiveGame_MissingGameID(t *testing.T) {
+	if _, err := BoxScore(context.Background(), replayClient(t, "boxscore"), ""); !errors.Is(err, models.ErrInvalidRequest) {
+		t.Errorf("BoxScore() error = %v, want ErrInvalidRequest", err)
+	}
+	if _, err := PlayByPlay(context.Background(), replayClient(t, "playbyplay"), ""); !errors.Is(err, models.ErrInvalidRequest) {
+		t.Errorf("PlayByPlay() error = %v, want ErrInvalidRequest", err)
+	}
+}
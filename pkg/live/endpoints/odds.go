@@ -0,0 +1,142 @@
+package endpoints
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/n-ae/nba-api-go/pkg/live"
+	"github.com/n-ae/nba-api-go/pkg/models"
+)
+
+// Market names in the odds feed.
+const (
+	MarketMoneyline = "2way"
+	MarketSpread    = "spread"
+	MarketTotal     = "total"
+)
+
+// Decimal is a number that the odds feed sends either as a JSON number or as
+// a quoted string ("1.800").
+type Decimal float64
+
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	data = bytes.Trim(bytes.TrimSpace(data), `"`)
+	if len(data) == 0 || string(data) == "null" {
+		*d = 0
+		return nil
+	}
+	f, err := strconv.ParseFloat(string(data), 64)
+	if err != nil {
+		return fmt.Errorf("invalid decimal %q", data)
+	}
+	*d = Decimal(f)
+	return nil
+}
+
+// OddsOutcome is one side of a market at one book. Odds are decimal odds.
+// Type is "home" or "away", or "over" or "under" for totals. Spread and Total
+// are the line and are nil for markets without one.
+type OddsOutcome struct {
+	OddsFieldID   int      `json:"odds_field_id"`
+	Type          string   `json:"type"`
+	Odds          Decimal  `json:"odds"`
+	OpeningOdds   Decimal  `json:"opening_odds"`
+	OddsTrend     string   `json:"odds_trend"`
+	Spread        *Decimal `json:"spread"`
+	OpeningSpread *Decimal `json:"opening_spread"`
+	Total         *Decimal `json:"total"`
+	OpeningTotal  *Decimal `json:"opening_total"`
+}
+
+// ImpliedProbability converts Odds to the implied win probability, including
+// the book's margin. It is 0 when there are no odds.
+func (o OddsOutcome) ImpliedProbability() float64 {
+	if o.Odds <= 0 {
+		return 0
+	}
+	return 1 / float64(o.Odds)
+}
+
+type OddsBook struct {
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	Outcomes    []OddsOutcome `json:"outcomes"`
+	URL         string        `json:"url"`
+	CountryCode string        `json:"countryCode"`
+}
+
+// Outcome returns the outcome of the given type, such as "home".
+func (b OddsBook) Outcome(outcomeType string) (OddsOutcome, bool) {
+	for _, outcome := range b.Outcomes {
+		if outcome.Type == outcomeType {
+			return outcome, true
+		}
+	}
+	return OddsOutcome{}, false
+}
+
+type OddsMarket struct {
+	Name       string     `json:"name"`
+	OddsTypeID int        `json:"odds_type_id"`
+	GroupName  string     `json:"group_name"`
+	Books      []OddsBook `json:"books"`
+}
+
+// Book returns the named book, such as "DraftKings".
+func (m OddsMarket) Book(name string) (OddsBook, bool) {
+	for _, book := range m.Books {
+		if book.Name == name {
+			return book, true
+		}
+	}
+	return OddsBook{}, false
+}
+
+// OddsGame holds the markets of one game. GameID matches Game.GameID of the
+// live scoreboard.
+type OddsGame struct {
+	GameID     string       `json:"gameId"`
+	SrID       string       `json:"sr_id"`
+	SrMatchID  string       `json:"srMatchId"`
+	HomeTeamID string       `json:"homeTeamId"`
+	AwayTeamID string       `json:"awayTeamId"`
+	Markets    []OddsMarket `json:"markets"`
+}
+
+// Market returns the named market, one of MarketMoneyline, MarketSpread and
+// MarketTotal.
+func (g OddsGame) Market(name string) (OddsMarket, bool) {
+	for _, market := range g.Markets {
+		if market.Name == name {
+			return market, true
+		}
+	}
+	return OddsMarket{}, false
+}
+
+type OddsResponse struct {
+	Games []OddsGame `json:"games"`
+}
+
+// Game returns the odds of a game by its ID.
+func (r *OddsResponse) Game(gameID string) (OddsGame, bool) {
+	for _, game := range r.Games {
+		if game.GameID == gameID {
+			return game, true
+		}
+	}
+	return OddsGame{}, false
+}
+
+// Odds fetches the betting markets of today's games.
+func Odds(ctx context.Context, client *live.Client) (*models.Response[*OddsResponse], error) {
+	var resp OddsResponse
+	raw, err := client.GetJSONRaw(ctx, "/odds/odds_todaysGames.json", nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewResponseFromRaw(&resp, raw), nil
+}
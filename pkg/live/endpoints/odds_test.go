@@ -0,0 +1,65 @@
+package endpoints
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestOdds_Replay(t *testing.T) {
+	resp, err := Odds(context.Background(), replayClient(t, "odds"))
+	if err != nil {
+		t.Fatalf("Odds() error = %v", err)
+	}
+
+	game, ok := resp.Data.Game("0022400062")
+	if !ok || game.HomeTeamID != "1610612747" || len(game.Markets) != 3 {
+		t.Fatalf("Game() = %+v, %v", game, ok)
+	}
+
+	moneyline, _ := game.Market(MarketMoneyline)
+	fanduel, ok := moneyline.Book("FanDuel")
+	if !ok {
+		t.Fatalf("Book(FanDuel) not found in %+v", moneyline)
+	}
+	home, _ := fanduel.Outcome("home")
+	if home.Odds != 1.82 || home.OpeningOdds != 1.76 || home.Spread != nil {
+		t.Errorf("moneyline home = %+v", home)
+	}
+	if p := home.ImpliedProbability(); math.Abs(p-1/1.82) > 1e-9 {
+		t.Errorf("ImpliedProbability() = %v", p)
+	}
+
+	spread, _ := game.Market(MarketSpread)
+	draftkings, _ := spread.Book("DraftKings")
+	home, _ = draftkings.Outcome("home")
+	if home.Spread == nil || *home.Spread != -2.5 || home.OpeningSpread == nil || *home.OpeningSpread != -3.5 {
+		t.Errorf("spread home = %+v", home)
+	}
+
+	total, _ := game.Market(MarketTotal)
+	draftkings, _ = total.Book("DraftKings")
+	over, _ := draftkings.Outcome("over")
+	if over.Total == nil || *over.Total != 221.5 || over.OpeningTotal == nil || *over.OpeningTotal != 220.5 {
+		t.Errorf("total over = %+v", over)
+	}
+
+	if _, ok := resp.Data.Game("0000000000"); ok {
+		t.Error("Game() found an unknown game")
+	}
+}
+
+func TestDecimal_UnmarshalJSON(t *testing.T) {
+	tests := map[string]Decimal{`"1.800"`: 1.8, `-3.5`: -3.5, `""`: 0, `null`: 0}
+	for in, want := range tests {
+		var d Decimal
+		if err := json.Unmarshal([]byte(in), &d); err != nil || d != want {
+			t.Errorf("Unmarshal(%s) = %v, %v, want %v", in, d, err, want)
+		}
+	}
+	var d Decimal
+	if err := json.Unmarshal([]byte(`"abc"`), &d); err == nil {
+		t.Error("Unmarshal(abc) succeeded")
+	}
+}
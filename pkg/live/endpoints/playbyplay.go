@@ -0,0 +1,112 @@
+package endpoints
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/live"
+	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
+)
+
+// Action is one play-by-play event. X and Y are the shot location on a
+// 0-100 court grid and are nil for actions without a location; XLegacy and
+// YLegacy use the older stats.nba.com shot chart coordinates.
+type Action struct {
+	ActionNumber            int      `json:"actionNumber"`
+	Clock                   string   `json:"clock"`
+	TimeActual              string   `json:"timeActual"`
+	Period                  int      `json:"period"`
+	PeriodType              string   `json:"periodType"`
+	TeamID                  int      `json:"teamId"`
+	TeamTricode             string   `json:"teamTricode"`
+	ActionType              string   `json:"actionType"`
+	SubType                 string   `json:"subType"`
+	Descriptor              string   `json:"descriptor"`
+	Qualifiers              []string `json:"qualifiers"`
+	PersonID                int      `json:"personId"`
+	X                       *float64 `json:"x"`
+	Y                       *float64 `json:"y"`
+	XLegacy                 *int     `json:"xLegacy"`
+	YLegacy                 *int     `json:"yLegacy"`
+	Area                    string   `json:"area"`
+	AreaDetail              string   `json:"areaDetail"`
+	Side                    string   `json:"side"`
+	ShotDistance            float64  `json:"shotDistance"`
+	ShotResult              string   `json:"shotResult"`
+	IsFieldGoal             int      `json:"isFieldGoal"`
+	Possession              int      `json:"possession"`
+	ScoreHome               string   `json:"scoreHome"`
+	ScoreAway               string   `json:"scoreAway"`
+	Edited                  string   `json:"edited"`
+	OrderNumber             int      `json:"orderNumber"`
+	Description             string   `json:"description"`
+	PlayerName              string   `json:"playerName"`
+	PlayerNameI             string   `json:"playerNameI"`
+	PersonIDsFilter         []int    `json:"personIdsFilter"`
+	PointsTotal             int      `json:"pointsTotal"`
+	AssistPersonID          int      `json:"assistPersonId"`
+	AssistPlayerNameInitial string   `json:"assistPlayerNameInitial"`
+	AssistTotal             int      `json:"assistTotal"`
+}
+
+// Remaining parses Clock, the time left in the period.
+func (a Action) Remaining() (time.Duration, error) {
+	return timeutil.ParseClock(a.Clock)
+}
+
+// Time parses TimeActual, the wall-clock time the action was logged.
+func (a Action) Time() (time.Time, error) {
+	return timeutil.ParseGameTime(a.TimeActual)
+}
+
+type PlayByPlayGame struct {
+	GameID  string   `json:"gameId"`
+	Actions []Action `json:"actions"`
+}
+
+// ActionsSince returns the actions numbered after actionNumber, in feed
+// order. Pollers pass the LastActionNumber of the previous poll to process
+// each action once.
+func (g PlayByPlayGame) ActionsSince(actionNumber int) []Action {
+	var actions []Action
+	for _, action := range g.Actions {
+		if action.ActionNumber > actionNumber {
+			actions = append(actions, action)
+		}
+	}
+	return actions
+}
+
+// LastActionNumber returns the highest action number in the feed, or 0 when
+// it is empty.
+func (g PlayByPlayGame) LastActionNumber() int {
+	last := 0
+	for _, action := range g.Actions {
+		if action.ActionNumber > last {
+			last = action.ActionNumber
+		}
+	}
+	return last
+}
+
+type PlayByPlayResponse struct {
+	Meta Meta           `json:"meta"`
+	Game PlayByPlayGame `json:"game"`
+}
+
+// PlayByPlay fetches the live play-by-play of a game.
+func PlayByPlay(ctx context.Context, client *live.Client, gameID string) (*models.Response[*PlayByPlayResponse], error) {
+	if gameID == "" {
+		return nil, fmt.Errorf("%w: gameID is required", models.ErrInvalidRequest)
+	}
+
+	var resp PlayByPlayResponse
+	raw, err := client.GetJSONRaw(ctx, "/playbyplay/playbyplay_"+gameID+".json", nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewResponseFromRaw(&resp, raw), nil
+}
@@ -0,0 +1,32 @@
+package endpoints
+
+import (
+	"context"
+
+	"github.com/n-ae/nba-api-go/pkg/live"
+	"github.com/n-ae/nba-api-go/pkg/models"
+	statsendpoints "github.com/n-ae/nba-api-go/pkg/stats/endpoints"
+)
+
+// scheduleEndpoint is the season schedule on the CDN. It is published under
+// staticData next to liveData, so it is addressed relative to LiveBaseURL.
+const scheduleEndpoint = "../staticData/scheduleLeagueV2.json"
+
+// ScheduleResponse is the CDN season schedule. LeagueSchedule has the same
+// shape as the stats scheduleleaguev2 endpoint.
+type ScheduleResponse struct {
+	Meta           Meta                                    `json:"meta"`
+	LeagueSchedule statsendpoints.ScheduleLeagueV2Response `json:"leagueSchedule"`
+}
+
+// Schedule fetches the full schedule of the current season, including
+// preseason and postseason games.
+func Schedule(ctx context.Context, client *live.Client) (*models.Response[*ScheduleResponse], error) {
+	var resp ScheduleResponse
+	raw, err := client.GetJSONRaw(ctx, scheduleEndpoint, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewResponseFromRaw(&resp, raw), nil
+}
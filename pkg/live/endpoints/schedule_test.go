@@ -0,0 +1,37 @@
+package endpoints
+
+import (
+	"context"
+	"testing"
+
+	"github.com/n-ae/nba-api-go/pkg/live"
+	"github.com/n-ae/nba-api-go/pkg/transport"
+)
+
+func replayClient(t *testing.T, name string) *live.Client {
+	t.Helper()
+
+	cassette, err := transport.LoadCassette("testdata/cassettes/" + name + ".json")
+	if err != nil {
+		t.Fatalf("LoadCassette() error = %v", err)
+	}
+
+	return live.NewClient(live.Config{
+		Middlewares: []transport.Middleware{transport.WithCassette(cassette, transport.ModeReplay)},
+	})
+}
+
+func TestSchedule_Replay(t *testing.T) {
+	resp, err := Schedule(context.Background(), replayClient(t, "schedule"))
+	if err != nil {
+		t.Fatalf("Schedule() error = %v", err)
+	}
+
+	schedule := resp.Data.LeagueSchedule
+	if schedule.SeasonYear != "2024-25" || len(schedule.Games()) != 3 || len(schedule.Weeks) != 2 {
+		t.Errorf("schedule = %+v", schedule)
+	}
+	if resp.URL != "https://cdn.nba.com/static/json/staticData/scheduleLeagueV2.json" {
+		t.Errorf("URL = %q", resp.URL)
+	}
+}
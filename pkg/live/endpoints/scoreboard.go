@@ -2,9 +2,11 @@ package endpoints
 
 import (
 	"context"
+	"time"
 
 	"github.com/n-ae/nba-api-go/pkg/live"
 	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
 )
 
 type TeamScore struct {
@@ -61,13 +63,32 @@ type Game struct {
 	} `json:"pbOdds"`
 }
 
+// Clock parses GameClock ("PT05M23.00S"), the time left in the period. It is
+// zero when the game is not in progress.
+func (g Game) Clock() (time.Duration, error) {
+	return timeutil.ParseClock(g.GameClock)
+}
+
+// StartTime parses GameTimeUTC.
+func (g Game) StartTime() (time.Time, error) {
+	return timeutil.ParseGameTime(g.GameTimeUTC)
+}
+
+// StartTimeET parses GameEt as an Eastern time.
+func (g Game) StartTimeET() (time.Time, error) {
+	return timeutil.ParseGameET(g.GameEt)
+}
+
+// Meta describes a CDN document.
+type Meta struct {
+	Version int    `json:"version"`
+	Request string `json:"request"`
+	Time    string `json:"time"`
+	Code    int    `json:"code"`
+}
+
 type ScoreboardResponse struct {
-	Meta struct {
-		Version int    `json:"version"`
-		Request string `json:"request"`
-		Time    string `json:"time"`
-		Code    int    `json:"code"`
-	} `json:"meta"`
+	Meta       Meta `json:"meta"`
 	Scoreboard struct {
 		GameDate   string `json:"gameDate"`
 		LeagueID   string `json:"leagueId"`
@@ -78,20 +99,22 @@ type ScoreboardResponse struct {
 
 func Scoreboard(ctx context.Context, client *live.Client) (*models.Response[*ScoreboardResponse], error) {
 	var resp ScoreboardResponse
-	if err := client.GetJSON(ctx, "/scoreboard/todaysScoreboard_00.json", nil, &resp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "/scoreboard/todaysScoreboard_00.json", nil, &resp)
+	if err != nil {
 		return nil, err
 	}
 
-	return models.NewResponse(&resp, 200, "", nil), nil
+	return models.NewResponseFromRaw(&resp, raw), nil
 }
 
 func ScoreboardByDate(ctx context.Context, client *live.Client, date string) (*models.Response[*ScoreboardResponse], error) {
 	endpoint := "/scoreboard/scoreboard_" + date + ".json"
 
 	var resp ScoreboardResponse
-	if err := client.GetJSON(ctx, endpoint, nil, &resp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, endpoint, nil, &resp)
+	if err != nil {
 		return nil, err
 	}
 
-	return models.NewResponse(&resp, 200, "", nil), nil
+	return models.NewResponseFromRaw(&resp, raw), nil
 }
@@ -0,0 +1,119 @@
+package watch
+
+import (
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/live/endpoints"
+)
+
+// gameState is what the watcher remembers about a game between polls.
+type gameState struct {
+	game endpoints.Game
+
+	// leader is the team that last held the lead; ties keep it.
+	leader int
+
+	// periodEnded is the last period reported as ended.
+	periodEnded int
+
+	lastAction int
+
+	// pbpBaseline skips the actions of the first play-by-play fetch of a
+	// game that was already in progress when first seen, or whose
+	// play-by-play PlayByPlayFor left out.
+	pbpBaseline bool
+
+	// pbpDone is set once the play-by-play has been read after the final.
+	pbpDone bool
+}
+
+func newGameState(game endpoints.Game) *gameState {
+	s := &gameState{
+		game:        game,
+		leader:      leader(game),
+		pbpBaseline: game.GameStatus != statusScheduled,
+		pbpDone:     game.GameStatus == statusFinal,
+	}
+	switch game.GameStatus {
+	case statusInProgress:
+		s.periodEnded = game.Period - 1
+		if periodOver(game) {
+			s.periodEnded = game.Period
+		}
+	case statusFinal:
+		s.periodEnded = game.Period
+	}
+	return s
+}
+
+func (s *gameState) wantsPlayByPlay() bool {
+	return s.game.GameStatus == statusInProgress || (s.game.GameStatus == statusFinal && !s.pbpDone)
+}
+
+// update records the new snapshot of the game and returns its events.
+func (s *gameState) update(game endpoints.Game, now time.Time) []Event {
+	prev := s.game
+	s.game = game
+
+	var events []Event
+	add := func(t EventType, period int) {
+		events = append(events, Event{Type: t, GameID: game.GameID, Time: now, Game: game, Previous: prev, Period: period})
+	}
+
+	if prev.GameStatus == statusScheduled && game.GameStatus >= statusInProgress {
+		add(GameStarted, 0)
+	}
+
+	if game.HomeTeam.Score != prev.HomeTeam.Score || game.AwayTeam.Score != prev.AwayTeam.Score {
+		add(ScoreChanged, game.Period)
+	}
+
+	if l := leader(game); l != 0 {
+		if s.leader != 0 && l != s.leader {
+			add(LeadChange, game.Period)
+		}
+		s.leader = l
+	}
+
+	ended := 0
+	switch {
+	case game.GameStatus == statusFinal:
+		ended = game.Period
+	case game.GameStatus == statusInProgress && periodOver(game):
+		ended = game.Period
+	case game.GameStatus == statusInProgress:
+		ended = game.Period - 1
+	}
+	for period := s.periodEnded + 1; period <= ended; period++ {
+		add(PeriodEnded, period)
+	}
+	if ended > s.periodEnded {
+		s.periodEnded = ended
+	}
+
+	if prev.GameStatus != statusFinal && game.GameStatus == statusFinal {
+		add(GameFinal, game.Period)
+	}
+
+	return events
+}
+
+// leader returns the team ID of the team ahead, or 0 when the score is tied.
+func leader(game endpoints.Game) int {
+	switch {
+	case game.HomeTeam.Score > game.AwayTeam.Score:
+		return game.HomeTeam.TeamID
+	case game.AwayTeam.Score > game.HomeTeam.Score:
+		return game.AwayTeam.TeamID
+	}
+	return 0
+}
+
+// periodOver reports whether the clock of the current period has run out.
+func periodOver(game endpoints.Game) bool {
+	if game.GameClock == "" {
+		return false
+	}
+	clock, err := game.Clock()
+	return err == nil && clock == 0
+}
@@ -0,0 +1,85 @@
+package watch
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/live/endpoints"
+)
+
+func testGame(status, period int, clock string, home, away int) endpoints.Game {
+	game := endpoints.Game{GameID: "0022400062", GameStatus: status, Period: period, GameClock: clock}
+	game.HomeTeam.TeamID, game.HomeTeam.Score = 1610612747, home
+	game.AwayTeam.TeamID, game.AwayTeam.Score = 1610612750, away
+	return game
+}
+
+type eventKey struct {
+	Type   EventType
+	Period int
+}
+
+func keys(events []Event) []eventKey {
+	out := []eventKey{}
+	for _, e := range events {
+		out = append(out, eventKey{e.Type, e.Period})
+	}
+	return out
+}
+
+func TestGameState_Update(t *testing.T) {
+	state := newGameState(testGame(statusScheduled, 0, "", 0, 0))
+	now := time.Now()
+
+	steps := []struct {
+		game endpoints.Game
+		want []eventKey
+	}{
+		{testGame(statusInProgress, 1, "PT11M00.00S", 2, 0), []eventKey{{GameStarted, 0}, {ScoreChanged, 1}}},
+		{testGame(statusInProgress, 1, "PT05M00.00S", 2, 5), []eventKey{{ScoreChanged, 1}, {LeadChange, 1}}},
+		{testGame(statusInProgress, 1, "PT00M00.00S", 7, 5), []eventKey{{ScoreChanged, 1}, {LeadChange, 1}, {PeriodEnded, 1}}},
+		{testGame(statusInProgress, 2, "PT12M00.00S", 7, 5), []eventKey{}},
+		{testGame(statusInProgress, 2, "PT08M00.00S", 7, 7), []eventKey{{ScoreChanged, 2}}},
+		// Polls missed between the 2nd and the 4th period.
+		{testGame(statusInProgress, 4, "PT03M00.00S", 9, 7), []eventKey{{ScoreChanged, 4}, {PeriodEnded, 2}, {PeriodEnded, 3}}},
+		{testGame(statusFinal, 4, "PT00M00.00S", 9, 7), []eventKey{{PeriodEnded, 4}, {GameFinal, 4}}},
+		{testGame(statusFinal, 4, "PT00M00.00S", 9, 7), []eventKey{}},
+	}
+	for i, step := range steps {
+		events := state.update(step.game, now)
+		if got := keys(events); !reflect.DeepEqual(got, step.want) {
+			t.Errorf("step %d: events = %v, want %v", i, got, step.want)
+		}
+		for _, e := range events {
+			if e.GameID != "0022400062" || e.Game.HomeTeam.Score != step.game.HomeTeam.Score || !e.Time.Equal(now) {
+				t.Errorf("step %d: event = %+v", i, e)
+			}
+		}
+	}
+}
+
+func TestGameState_BaselineInProgress(t *testing.T) {
+	state := newGameState(testGame(statusInProgress, 3, "PT04M00.00S", 80, 70))
+	if state.periodEnded != 2 || !state.pbpBaseline {
+		t.Fatalf("state = %+v", state)
+	}
+
+	events := state.update(testGame(statusInProgress, 3, "PT03M40.00S", 80, 70), time.Now())
+	if len(events) != 0 {
+		t.Errorf("events = %v, want none", keys(events))
+	}
+}
+
+func TestNextBackoff(t *testing.T) {
+	var got []time.Duration
+	backoff := time.Duration(0)
+	for i := 0; i < 5; i++ {
+		backoff = nextBackoff(backoff, time.Second, 5*time.Second)
+		got = append(got, backoff)
+	}
+	want := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("backoff = %v, want %v", got, want)
+	}
+}
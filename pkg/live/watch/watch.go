@@ -0,0 +1,336 @@
+// Package watch polls the live scoreboard and, optionally, the play-by-play of
+// games in progress, and emits an event for each change between successive
+// snapshots.
+package watch
+
+import (
+	"context"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/live"
+	"github.com/n-ae/nba-api-go/pkg/live/endpoints"
+)
+
+type EventType string
+
+const (
+	GameStarted  EventType = "game_started"
+	ScoreChanged EventType = "score_changed"
+	LeadChange   EventType = "lead_change"
+	PeriodEnded  EventType = "period_ended"
+	GameFinal    EventType = "game_final"
+	NewAction    EventType = "new_action"
+)
+
+// Game status values of the live feeds.
+const (
+	statusScheduled  = 1
+	statusInProgress = 2
+	statusFinal      = 3
+)
+
+// Event is a change in a game. Game is the scoreboard entry after the change
+// and, except for NewAction, Previous is the one before it. Period is the
+// period that ended for PeriodEnded, and Action is set for NewAction.
+type Event struct {
+	Type     EventType
+	GameID   string
+	Time     time.Time
+	Game     endpoints.Game
+	Previous endpoints.Game
+	Period   int
+	Action   *endpoints.Action
+}
+
+type Config struct {
+	// Interval is the poll interval while a game is in progress.
+	Interval time.Duration
+
+	// IdleInterval is the poll interval while no game is in progress. It is
+	// shortened when a game is scheduled to start sooner.
+	IdleInterval time.Duration
+
+	// MaxBackoff caps the delay between retries after errors, which starts
+	// at Interval and doubles on each consecutive failure.
+	MaxBackoff time.Duration
+
+	// PlayByPlay also polls the play-by-play of games in progress and emits
+	// NewAction events.
+	PlayByPlay bool
+
+	// PlayByPlayFor, when set, limits PlayByPlay to the games it accepts. It
+	// is called on each poll, so the set can change while the watcher runs;
+	// a game that is accepted again starts from a new baseline.
+	PlayByPlayFor func(gameID string) bool
+
+	// GameIDs restricts the watcher to these games. Empty watches all games
+	// on the scoreboard.
+	GameIDs []string
+
+	// Buffer is the capacity of the events channel.
+	Buffer int
+
+	// OnError is called with each polling error before the watcher backs off
+	// and retries.
+	OnError func(error)
+
+	// OnPoll is called with the watched games of each successful scoreboard
+	// poll, after their events have been sent.
+	OnPoll func(games []endpoints.Game)
+
+	// OnPlayByPlay is called with the number of the last action of each
+	// successful play-by-play poll of a game, after its NewAction events
+	// have been sent. SeedPlayByPlay resumes from it.
+	OnPlayByPlay func(gameID string, lastAction int)
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Interval:     10 * time.Second,
+		IdleInterval: 5 * time.Minute,
+		MaxBackoff:   5 * time.Minute,
+		Buffer:       64,
+	}
+}
+
+// Watcher turns successive live snapshots into events. The first snapshot of
+// a game is its baseline: a game already in progress when the watcher starts
+// emits no events for what happened before, and neither do its earlier
+// play-by-play actions.
+type Watcher struct {
+	client *live.Client
+	config Config
+	events chan Event
+	games  map[string]*gameState
+	only   map[string]bool
+	now    func() time.Time
+}
+
+// New returns a watcher for client. Zero Config fields take their
+// DefaultConfig values.
+func New(client *live.Client, config Config) *Watcher {
+	defaults := DefaultConfig()
+	if config.Interval <= 0 {
+		config.Interval = defaults.Interval
+	}
+	if config.IdleInterval <= 0 {
+		config.IdleInterval = defaults.IdleInterval
+	}
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = defaults.MaxBackoff
+	}
+	if config.Buffer <= 0 {
+		config.Buffer = defaults.Buffer
+	}
+
+	var only map[string]bool
+	if len(config.GameIDs) > 0 {
+		only = make(map[string]bool, len(config.GameIDs))
+		for _, id := range config.GameIDs {
+			only[id] = true
+		}
+	}
+
+	return &Watcher{
+		client: client,
+		config: config,
+		events: make(chan Event, config.Buffer),
+		games:  make(map[string]*gameState),
+		only:   only,
+		now:    time.Now,
+	}
+}
+
+// Seed sets earlier snapshots of games, such as ones saved before a restart,
+// as their baseline. Changes since then are emitted on the first poll. Seed
+// must be called before Run.
+func (w *Watcher) Seed(games ...endpoints.Game) {
+	for _, game := range games {
+		if w.only == nil || w.only[game.GameID] {
+			w.games[game.GameID] = newGameState(game)
+		}
+	}
+}
+
+// SeedPlayByPlay sets the last play-by-play action already seen of a seeded
+// game. The actions after it are emitted on the first play-by-play poll
+// instead of being taken as its baseline. It must be called after Seed and
+// before Run.
+func (w *Watcher) SeedPlayByPlay(gameID string, lastAction int) {
+	if state, ok := w.games[gameID]; ok {
+		state.lastAction = lastAction
+		state.pbpBaseline = false
+	}
+}
+
+// Events returns the channel events are delivered on. It is closed when Run
+// returns.
+func (w *Watcher) Events() <-chan Event {
+	return w.events
+}
+
+// Run polls until ctx is done, then closes Events and returns ctx.Err().
+// Errors are reported to Config.OnError and retried with backoff; state is
+// kept across errors, so changes that happened meanwhile are emitted once on
+// the next successful poll.
+func (w *Watcher) Run(ctx context.Context) error {
+	defer close(w.events)
+
+	var backoff time.Duration
+	for {
+		delay, err := w.poll(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if w.config.OnError != nil {
+				w.config.OnError(err)
+			}
+			backoff = nextBackoff(backoff, w.config.Interval, w.config.MaxBackoff)
+			delay = backoff
+		} else {
+			backoff = 0
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func nextBackoff(current, initial, max time.Duration) time.Duration {
+	if current == 0 {
+		return initial
+	}
+	if current *= 2; current > max {
+		return max
+	}
+	return current
+}
+
+// poll takes one snapshot, emits its events and returns the delay before the
+// next poll.
+func (w *Watcher) poll(ctx context.Context) (time.Duration, error) {
+	resp, err := endpoints.Scoreboard(ctx, w.client)
+	if err != nil {
+		return 0, err
+	}
+	now := w.now()
+
+	seen := make(map[string]bool, len(resp.Data.Scoreboard.Games))
+	var games []endpoints.Game
+	for _, game := range resp.Data.Scoreboard.Games {
+		if w.only != nil && !w.only[game.GameID] {
+			continue
+		}
+		seen[game.GameID] = true
+		games = append(games, game)
+
+		state, ok := w.games[game.GameID]
+		if !ok {
+			w.games[game.GameID] = newGameState(game)
+			continue
+		}
+		for _, event := range state.update(game, now) {
+			if err := w.emit(ctx, event); err != nil {
+				return 0, err
+			}
+		}
+	}
+	for id := range w.games {
+		if !seen[id] {
+			delete(w.games, id)
+		}
+	}
+	if w.config.OnPoll != nil {
+		w.config.OnPoll(games)
+	}
+
+	if w.config.PlayByPlay {
+		for id, state := range w.games {
+			if !w.pollsPlayByPlay(id, state) {
+				continue
+			}
+			if err := w.pollPlayByPlay(ctx, id, state); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return w.delay(now), nil
+}
+
+// pollsPlayByPlay reports whether the play-by-play of a game is due. A game
+// that PlayByPlayFor leaves out while it is under way has its actions
+// skipped until it is accepted again.
+func (w *Watcher) pollsPlayByPlay(gameID string, state *gameState) bool {
+	if !w.config.PlayByPlay || !state.wantsPlayByPlay() {
+		return false
+	}
+	if w.config.PlayByPlayFor != nil && !w.config.PlayByPlayFor(gameID) {
+		state.pbpBaseline = true
+		return false
+	}
+	return true
+}
+
+func (w *Watcher) pollPlayByPlay(ctx context.Context, gameID string, state *gameState) error {
+	resp, err := endpoints.PlayByPlay(ctx, w.client, gameID)
+	if err != nil {
+		return err
+	}
+	now := w.now()
+
+	if !state.pbpBaseline {
+		for _, action := range resp.Data.Game.ActionsSince(state.lastAction) {
+			event := Event{Type: NewAction, GameID: gameID, Time: now, Game: state.game, Period: action.Period, Action: &action}
+			if err := w.emit(ctx, event); err != nil {
+				return err
+			}
+		}
+	}
+
+	if last := resp.Data.Game.LastActionNumber(); last > state.lastAction {
+		state.lastAction = last
+	}
+	state.pbpBaseline = false
+	state.pbpDone = state.game.GameStatus == statusFinal
+	if w.config.OnPlayByPlay != nil {
+		w.config.OnPlayByPlay(gameID, state.lastAction)
+	}
+	return nil
+}
+
+func (w *Watcher) emit(ctx context.Context, event Event) error {
+	select {
+	case w.events <- event:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// delay is Interval while a game is in progress and IdleInterval otherwise,
+// cut short when a scheduled game starts before then.
+func (w *Watcher) delay(now time.Time) time.Duration {
+	delay := w.config.IdleInterval
+	for id, state := range w.games {
+		if state.game.GameStatus == statusInProgress || w.pollsPlayByPlay(id, state) {
+			return w.config.Interval
+		}
+		if state.game.GameStatus != statusScheduled {
+			continue
+		}
+		if start, err := state.game.StartTime(); err == nil && start.Sub(now) < delay {
+			delay = start.Sub(now)
+		}
+	}
+	if delay < w.config.Interval {
+		delay = w.config.Interval
+	}
+	return delay
+}
@@ -0,0 +1,295 @@
+package watch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/client"
+	"github.com/n-ae/nba-api-go/pkg/live"
+	"github.com/n-ae/nba-api-go/pkg/live/endpoints"
+	"github.com/n-ae/nba-api-go/pkg/transport"
+)
+
+// fakeCDN serves a scripted sequence of scoreboards, one per request, and
+// repeats the last one. A nil scoreboard answers 500.
+type fakeCDN struct {
+	mu          sync.Mutex
+	scoreboards []*endpoints.ScoreboardResponse
+	polls       int
+	actions     func(polls int) []endpoints.Action
+}
+
+func (f *fakeCDN) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.URL.Path {
+	case "/scoreboard/todaysScoreboard_00.json":
+		i := f.polls
+		if i >= len(f.scoreboards) {
+			i = len(f.scoreboards) - 1
+		}
+		f.polls++
+		if f.scoreboards[i] == nil {
+			http.Error(w, "unavailable", http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(f.scoreboards[i])
+	case "/playbyplay/playbyplay_0022400062.json":
+		var resp endpoints.PlayByPlayResponse
+		resp.Game.GameID = "0022400062"
+		resp.Game.Actions = f.actions(f.polls)
+		json.NewEncoder(w).Encode(resp)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func scoreboard(games ...endpoints.Game) *endpoints.ScoreboardResponse {
+	resp := &endpoints.ScoreboardResponse{}
+	resp.Scoreboard.Games = games
+	return resp
+}
+
+func TestWatcher_Run(t *testing.T) {
+	cdn := &fakeCDN{
+		scoreboards: []*endpoints.ScoreboardResponse{
+			scoreboard(testGame(statusScheduled, 0, "", 0, 0)),
+			nil,
+			scoreboard(testGame(statusInProgress, 1, "PT10M00.00S", 3, 0)),
+			scoreboard(testGame(statusFinal, 4, "PT00M00.00S", 3, 0)),
+		},
+		actions: func(polls int) []endpoints.Action {
+			actions := []endpoints.Action{{ActionNumber: 1, Period: 1}, {ActionNumber: 2, Period: 1}}
+			if polls > 3 {
+				actions = append(actions, endpoints.Action{ActionNumber: 3, Period: 4})
+			}
+			return actions
+		},
+	}
+	server := httptest.NewServer(cdn)
+	defer server.Close()
+
+	liveClient := live.NewClient(live.Config{
+		Middlewares: []transport.Middleware{transport.WithUserAgent("test")},
+	}, client.WithBaseURL(server.URL))
+
+	var errCount int
+	watcher := New(liveClient, Config{
+		Interval:     time.Millisecond,
+		IdleInterval: time.Millisecond,
+		MaxBackoff:   5 * time.Millisecond,
+		PlayByPlay:   true,
+		OnError:      func(error) { errCount++ },
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() { done <- watcher.Run(ctx) }()
+
+	want := []eventKey{
+		{GameStarted, 0}, {ScoreChanged, 1}, {NewAction, 1}, {NewAction, 1},
+		{PeriodEnded, 1}, {PeriodEnded, 2}, {PeriodEnded, 3}, {PeriodEnded, 4}, {GameFinal, 4}, {NewAction, 4},
+	}
+	var got []eventKey
+	for len(got) < len(want) {
+		select {
+		case event := <-watcher.Events():
+			got = append(got, eventKey{event.Type, event.Period})
+		case <-ctx.Done():
+			t.Fatalf("timed out after events %v", got)
+		}
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("events = %v, want %v", got, want)
+		}
+	}
+
+	// Nothing is left to report once the final play-by-play has been read.
+	select {
+	case event := <-watcher.Events():
+		t.Errorf("unexpected event %+v", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Run() = %v, want context.Canceled", err)
+	}
+	if _, open := <-watcher.Events(); open {
+		t.Error("Events() not closed after Run returned")
+	}
+	if errCount != 1 {
+		t.Errorf("OnError called %d times, want 1", errCount)
+	}
+}
+
+func TestWatcher_Delay(t *testing.T) {
+	now := time.Date(2024, time.October, 22, 22, 0, 0, 0, time.UTC)
+	w := New(nil, Config{Interval: 10 * time.Second, IdleInterval: 5 * time.Minute})
+
+	soon := testGame(statusScheduled, 0, "", 0, 0)
+	soon.GameTimeUTC = now.Add(time.Minute).Format(time.RFC3339)
+	w.games[soon.GameID] = newGameState(soon)
+	if d := w.delay(now); d != time.Minute {
+		t.Errorf("delay before tip-off = %v, want 1m", d)
+	}
+
+	w.games[soon.GameID] = newGameState(testGame(statusFinal, 4, "", 100, 90))
+	if d := w.delay(now); d != 5*time.Minute {
+		t.Errorf("idle delay = %v, want 5m", d)
+	}
+
+	w.games[soon.GameID] = newGameState(testGame(statusInProgress, 2, "PT05M00.00S", 50, 48))
+	if d := w.delay(now); d != 10*time.Second {
+		t.Errorf("live delay = %v, want 10s", d)
+	}
+}
+
+func TestWatcher_Seed(t *testing.T) {
+	cdn := &fakeCDN{
+		scoreboards: []*endpoints.ScoreboardResponse{scoreboard(testGame(statusFinal, 4, "PT00M00.00S", 110, 103))},
+	}
+	server := httptest.NewServer(cdn)
+	defer server.Close()
+
+	liveClient := live.NewClient(live.Config{
+		Middlewares: []transport.Middleware{transport.WithUserAgent("test")},
+	}, client.WithBaseURL(server.URL))
+
+	var polled []endpoints.Game
+	watcher := New(liveClient, Config{OnPoll: func(games []endpoints.Game) { polled = games }})
+	// Last seen in the 4th period before a restart.
+	watcher.Seed(testGame(statusInProgress, 4, "PT01M00.00S", 105, 103))
+
+	if _, err := watcher.poll(context.Background()); err != nil {
+		t.Fatalf("poll() error = %v", err)
+	}
+	var got []eventKey
+	for len(watcher.events) > 0 {
+		event := <-watcher.events
+		got = append(got, eventKey{event.Type, event.Period})
+	}
+	want := []eventKey{{ScoreChanged, 4}, {PeriodEnded, 4}, {GameFinal, 4}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("events = %v, want %v", got, want)
+	}
+	if len(polled) != 1 || polled[0].GameStatus != statusFinal {
+		t.Errorf("OnPoll games = %+v", polled)
+	}
+}
+
+func TestWatcher_SeedPlayByPlay(t *testing.T) {
+	cdn := &fakeCDN{
+		scoreboards: []*endpoints.ScoreboardResponse{scoreboard(testGame(statusInProgress, 2, "PT05M00.00S", 50, 48))},
+		actions: func(int) []endpoints.Action {
+			return []endpoints.Action{{ActionNumber: 1, Period: 2}, {ActionNumber: 2, Period: 2}, {ActionNumber: 3, Period: 2}}
+		},
+	}
+	server := httptest.NewServer(cdn)
+	defer server.Close()
+
+	liveClient := live.NewClient(live.Config{
+		Middlewares: []transport.Middleware{transport.WithUserAgent("test")},
+	}, client.WithBaseURL(server.URL))
+
+	lastAction := 0
+	watcher := New(liveClient, Config{
+		PlayByPlay:   true,
+		OnPlayByPlay: func(gameID string, last int) { lastAction = last },
+	})
+	// Action 1 was handled before a restart.
+	watcher.Seed(testGame(statusInProgress, 2, "PT06M00.00S", 50, 48))
+	watcher.SeedPlayByPlay("0022400062", 1)
+
+	if _, err := watcher.poll(context.Background()); err != nil {
+		t.Fatalf("poll() error = %v", err)
+	}
+	var got []int
+	for len(watcher.events) > 0 {
+		if event := <-watcher.events; event.Type == NewAction {
+			got = append(got, event.Action.ActionNumber)
+		}
+	}
+	if !reflect.DeepEqual(got, []int{2, 3}) {
+		t.Errorf("new actions = %v, want [2 3]", got)
+	}
+	if lastAction != 3 {
+		t.Errorf("OnPlayByPlay last action = %d, want 3", lastAction)
+	}
+}
+
+func TestWatcher_PlayByPlayFor(t *testing.T) {
+	var mu sync.Mutex
+	actions := []endpoints.Action{{ActionNumber: 1, Period: 2}, {ActionNumber: 2, Period: 2}}
+	cdn := &fakeCDN{
+		scoreboards: []*endpoints.ScoreboardResponse{scoreboard(testGame(statusInProgress, 2, "PT05M00.00S", 50, 48))},
+		actions: func(int) []endpoints.Action {
+			mu.Lock()
+			defer mu.Unlock()
+			return append([]endpoints.Action(nil), actions...)
+		},
+	}
+	server := httptest.NewServer(cdn)
+	defer server.Close()
+
+	liveClient := live.NewClient(live.Config{
+		Middlewares: []transport.Middleware{transport.WithUserAgent("test")},
+	}, client.WithBaseURL(server.URL))
+
+	wanted := false
+	watcher := New(liveClient, Config{
+		PlayByPlay:    true,
+		PlayByPlayFor: func(string) bool { return wanted },
+	})
+	poll := func() []int {
+		t.Helper()
+		if _, err := watcher.poll(context.Background()); err != nil {
+			t.Fatalf("poll() error = %v", err)
+		}
+		var numbers []int
+		for len(watcher.events) > 0 {
+			if event := <-watcher.events; event.Type == NewAction {
+				numbers = append(numbers, event.Action.ActionNumber)
+			}
+		}
+		return numbers
+	}
+	addAction := func(n int) {
+		mu.Lock()
+		defer mu.Unlock()
+		actions = append(actions, endpoints.Action{ActionNumber: n, Period: 2})
+	}
+
+	poll()
+	wanted = true
+	if got := poll(); got != nil {
+		t.Errorf("first accepted poll = %v, want the baseline only", got)
+	}
+	addAction(3)
+	if got := poll(); !reflect.DeepEqual(got, []int{3}) {
+		t.Errorf("actions = %v, want [3]", got)
+	}
+
+	// Actions while the game was left out are not reported afterwards.
+	wanted = false
+	poll()
+	addAction(4)
+	wanted = true
+	if got := poll(); got != nil {
+		t.Errorf("poll after being left out = %v, want a new baseline", got)
+	}
+	addAction(5)
+	if got := poll(); !reflect.DeepEqual(got, []int{5}) {
+		t.Errorf("actions = %v, want [5]", got)
+	}
+}
@@ -0,0 +1,202 @@
+// Package webhook delivers live game events to HTTP webhooks. Subscriptions
+// are read from a JSON config file; deliveries are signed with HMAC-SHA256,
+// retried with backoff and persisted, so a restart neither drops nor repeats
+// notifications.
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/live/endpoints"
+	"github.com/n-ae/nba-api-go/pkg/live/watch"
+)
+
+var ErrInvalidConfig = errors.New("invalid webhook config")
+
+// PlayerThreshold is sent once when a player reaches a Threshold.
+const PlayerThreshold watch.EventType = "player_threshold"
+
+// eventTypes are the event types a subscription can list.
+var eventTypes = map[watch.EventType]bool{
+	watch.GameStarted:  true,
+	watch.ScoreChanged: true,
+	watch.LeadChange:   true,
+	watch.PeriodEnded:  true,
+	watch.GameFinal:    true,
+	watch.NewAction:    true,
+}
+
+// stats are the player stats a Threshold can watch.
+var stats = map[string]func(endpoints.PlayerStatistics) int{
+	"points":   func(s endpoints.PlayerStatistics) int { return s.Points },
+	"rebounds": func(s endpoints.PlayerStatistics) int { return s.ReboundsTotal },
+	"assists":  func(s endpoints.PlayerStatistics) int { return s.Assists },
+	"steals":   func(s endpoints.PlayerStatistics) int { return s.Steals },
+	"blocks":   func(s endpoints.PlayerStatistics) int { return s.Blocks },
+	"threes":   func(s endpoints.PlayerStatistics) int { return s.ThreePointersMade },
+}
+
+// Threshold fires when a player's Stat reaches Value in a game, such as 40
+// points. Stat is one of points, rebounds, assists, steals, blocks and
+// threes.
+type Threshold struct {
+	PersonID int    `json:"person_id"`
+	Stat     string `json:"stat"`
+	Value    int    `json:"value"`
+}
+
+// Subscription sends the listed events, and its player thresholds, to URL.
+// GameIDs and Teams (tricodes such as "LAL") restrict it to some games;
+// empty matches every game. Secret overrides Config.Secret.
+type Subscription struct {
+	ID         string            `json:"id"`
+	URL        string            `json:"url"`
+	Secret     string            `json:"secret,omitempty"`
+	Events     []watch.EventType `json:"events"`
+	GameIDs    []string          `json:"game_ids,omitempty"`
+	Teams      []string          `json:"teams,omitempty"`
+	Thresholds []Threshold       `json:"thresholds,omitempty"`
+}
+
+func (s Subscription) wants(eventType watch.EventType) bool {
+	for _, t := range s.Events {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func (s Subscription) matches(game endpoints.Game) bool {
+	if len(s.GameIDs) > 0 && !contains(s.GameIDs, game.GameID) {
+		return false
+	}
+	if len(s.Teams) > 0 && !contains(s.Teams, game.HomeTeam.TeamTricode) && !contains(s.Teams, game.AwayTeam.TeamTricode) {
+		return false
+	}
+	return true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Duration is a time.Duration written in JSON as a string such as "30s".
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+type Config struct {
+	// Secret signs deliveries of subscriptions without their own secret.
+	Secret string `json:"secret,omitempty"`
+
+	// StateFile persists pending and sent deliveries and the last seen games.
+	// Empty keeps them in memory only.
+	StateFile string `json:"state_file,omitempty"`
+
+	Subscriptions []Subscription `json:"subscriptions"`
+
+	// PollInterval is the live feed poll interval while games are in
+	// progress.
+	PollInterval Duration `json:"poll_interval,omitempty"`
+
+	MaxAttempts    int      `json:"max_attempts,omitempty"`
+	InitialBackoff Duration `json:"initial_backoff,omitempty"`
+	MaxBackoff     Duration `json:"max_backoff,omitempty"`
+
+	// Timeout is the per-delivery timeout.
+	Timeout Duration `json:"timeout,omitempty"`
+
+	HTTPClient *http.Client `json:"-"`
+
+	// OnError is called with delivery, polling and state errors.
+	OnError func(error) `json:"-"`
+}
+
+func DefaultConfig() Config {
+	return Config{
+		PollInterval:   Duration(10 * time.Second),
+		MaxAttempts:    8,
+		InitialBackoff: Duration(time.Second),
+		MaxBackoff:     Duration(5 * time.Minute),
+		Timeout:        Duration(10 * time.Second),
+	}
+}
+
+// LoadConfig reads and validates a JSON config file. Fields it leaves out
+// take their DefaultConfig values.
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+
+	config := DefaultConfig()
+	if err := json.Unmarshal(data, &config); err != nil {
+		return Config{}, fmt.Errorf("%w: %s: %v", ErrInvalidConfig, path, err)
+	}
+	if err := config.Validate(); err != nil {
+		return Config{}, err
+	}
+	return config, nil
+}
+
+// Validate checks that every subscription has a unique ID, an HTTP(S) URL, a
+// secret, and known event types and stats.
+func (c Config) Validate() error {
+	ids := make(map[string]bool)
+	for i, sub := range c.Subscriptions {
+		switch {
+		case sub.ID == "":
+			return fmt.Errorf("%w: subscription %d has no id", ErrInvalidConfig, i)
+		case ids[sub.ID]:
+			return fmt.Errorf("%w: duplicate subscription id %q", ErrInvalidConfig, sub.ID)
+		case sub.Secret == "" && c.Secret == "":
+			return fmt.Errorf("%w: subscription %q has no secret", ErrInvalidConfig, sub.ID)
+		case len(sub.Events) == 0 && len(sub.Thresholds) == 0:
+			return fmt.Errorf("%w: subscription %q has no events or thresholds", ErrInvalidConfig, sub.ID)
+		}
+		ids[sub.ID] = true
+
+		if u, err := url.Parse(sub.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%w: subscription %q has invalid url %q", ErrInvalidConfig, sub.ID, sub.URL)
+		}
+		for _, t := range sub.Events {
+			if !eventTypes[t] {
+				return fmt.Errorf("%w: subscription %q has unknown event %q", ErrInvalidConfig, sub.ID, t)
+			}
+		}
+		for _, t := range sub.Thresholds {
+			if stats[t.Stat] == nil || t.PersonID == 0 || t.Value <= 0 {
+				return fmt.Errorf("%w: subscription %q has invalid threshold %+v", ErrInvalidConfig, sub.ID, t)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package webhook
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/live/watch"
+)
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "webhooks.json")
+	data := `{
+  "secret": "s3cret",
+  "state_file": "webhooks.state.json",
+  "max_attempts": 3,
+  "initial_backoff": "2s",
+  "subscriptions": [
+    {
+      "id": "slack",
+      "url": "https://hooks.example.com/nba",
+      "events": ["score_changed", "game_final"],
+      "teams": ["LAL"],
+      "thresholds": [{"person_id": 2544, "stat": "points", "value": 40}]
+    }
+  ]
+}`
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	if config.MaxAttempts != 3 || time.Duration(config.InitialBackoff) != 2*time.Second {
+		t.Errorf("MaxAttempts, InitialBackoff = %d, %v, want 3, 2s", config.MaxAttempts, time.Duration(config.InitialBackoff))
+	}
+	if time.Duration(config.MaxBackoff) != 5*time.Minute {
+		t.Errorf("MaxBackoff = %v, want the default 5m", time.Duration(config.MaxBackoff))
+	}
+	sub := config.Subscriptions[0]
+	if !sub.wants(watch.GameFinal) || sub.wants(watch.LeadChange) || sub.Thresholds[0].Value != 40 {
+		t.Errorf("subscription = %+v", sub)
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	valid := func() Subscription {
+		return Subscription{ID: "slack", URL: "https://hooks.example.com/nba", Events: []watch.EventType{watch.GameFinal}}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*Config)
+	}{
+		{"missing id", func(c *Config) { c.Subscriptions[0].ID = "" }},
+		{"duplicate id", func(c *Config) { c.Subscriptions = append(c.Subscriptions, valid()) }},
+		{"missing secret", func(c *Config) { c.Secret = "" }},
+		{"bad url", func(c *Config) { c.Subscriptions[0].URL = "hooks.example.com" }},
+		{"unknown event", func(c *Config) { c.Subscriptions[0].Events = []watch.EventType{"overtime"} }},
+		{"nothing to send", func(c *Config) { c.Subscriptions[0].Events = nil }},
+		{"unknown stat", func(c *Config) {
+			c.Subscriptions[0].Thresholds = []Threshold{{PersonID: 2544, Stat: "dunks", Value: 5}}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := Config{Secret: "s3cret", Subscriptions: []Subscription{valid()}}
+			if err := config.Validate(); err != nil {
+				t.Fatalf("valid config: Validate() = %v", err)
+			}
+			tt.modify(&config)
+			if err := config.Validate(); !errors.Is(err, ErrInvalidConfig) {
+				t.Errorf("Validate() = %v, want ErrInvalidConfig", err)
+			}
+		})
+	}
+}
+
+func TestLoadConfig_Example(t *testing.T) {
+	if _, err := LoadConfig("../../../cmd/nba-webhooks/webhooks.example.json"); err != nil {
+		t.Errorf("LoadConfig() error = %v", err)
+	}
+}
@@ -0,0 +1,484 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/live"
+	"github.com/n-ae/nba-api-go/pkg/live/endpoints"
+	"github.com/n-ae/nba-api-go/pkg/live/watch"
+)
+
+// idleDelay is how long the dispatcher waits for events when no delivery is
+// due.
+const idleDelay = time.Minute
+
+// Payload is the JSON body of a delivery. ID identifies the notification
+// and is stable across retries and restarts. Period is the ended period for
+// PeriodEnded, Action is set for NewAction and Player for PlayerThreshold.
+type Payload struct {
+	ID           string            `json:"id"`
+	Type         watch.EventType   `json:"type"`
+	Subscription string            `json:"subscription"`
+	GameID       string            `json:"game_id"`
+	Time         time.Time         `json:"time"`
+	Period       int               `json:"period,omitempty"`
+	Game         endpoints.Game    `json:"game"`
+	Action       *endpoints.Action `json:"action,omitempty"`
+	Player       *PlayerStat       `json:"player,omitempty"`
+}
+
+// PlayerStat is the player line that reached a Threshold.
+type PlayerStat struct {
+	PersonID    int    `json:"person_id"`
+	Name        string `json:"name"`
+	TeamTricode string `json:"team_tricode"`
+	Stat        string `json:"stat"`
+	Threshold   int    `json:"threshold"`
+	Value       int    `json:"value"`
+}
+
+// Dispatcher watches the live feeds and delivers the events of its
+// subscriptions. Deliveries are at least once: a notification is retried
+// until the receiver answers 2xx, so receivers should drop repeated
+// HeaderID values.
+type Dispatcher struct {
+	client *live.Client
+	config Config
+	http   *http.Client
+	subs   map[string]Subscription
+	now    func() time.Time
+
+	// mu guards state, which the watcher's OnPoll also reaches.
+	mu    sync.Mutex
+	state *state
+}
+
+// New validates config and loads its state file. Zero Config fields take
+// their DefaultConfig values.
+func New(client *live.Client, config Config) (*Dispatcher, error) {
+	defaults := DefaultConfig()
+	if config.PollInterval <= 0 {
+		config.PollInterval = defaults.PollInterval
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaults.MaxAttempts
+	}
+	if config.InitialBackoff <= 0 {
+		config.InitialBackoff = defaults.InitialBackoff
+	}
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = defaults.MaxBackoff
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = defaults.Timeout
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	st, err := loadState(config.StateFile)
+	if err != nil {
+		return nil, fmt.Errorf("load webhook state: %w", err)
+	}
+
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: time.Duration(config.Timeout)}
+	}
+
+	subs := make(map[string]Subscription, len(config.Subscriptions))
+	for _, sub := range config.Subscriptions {
+		if sub.Secret == "" {
+			sub.Secret = config.Secret
+		}
+		subs[sub.ID] = sub
+	}
+
+	return &Dispatcher{
+		client: client,
+		config: config,
+		http:   httpClient,
+		subs:   subs,
+		now:    time.Now,
+		state:  st,
+	}, nil
+}
+
+// Run watches the live feeds and delivers notifications until ctx is done,
+// then returns ctx.Err(). It resumes from the games and play-by-play actions
+// saved in the state file, so changes made while it was stopped are still
+// notified.
+func (d *Dispatcher) Run(ctx context.Context) error {
+	type playByPlayPoll struct {
+		gameID     string
+		lastAction int
+	}
+	polls := make(chan []endpoints.Game)
+	pbpPolls := make(chan playByPlayPoll)
+	w := watch.New(d.client, watch.Config{
+		Interval:   time.Duration(d.config.PollInterval),
+		PlayByPlay: d.wantsActions(),
+		OnError:    d.report,
+		OnPoll: func(games []endpoints.Game) {
+			select {
+			case polls <- games:
+			case <-ctx.Done():
+			}
+		},
+		OnPlayByPlay: func(gameID string, lastAction int) {
+			select {
+			case pbpPolls <- playByPlayPoll{gameID, lastAction}:
+			case <-ctx.Done():
+			}
+		},
+	})
+
+	d.mu.Lock()
+	for id, record := range d.state.Games {
+		w.Seed(record.Game)
+		if record.LastAction != nil {
+			w.SeedPlayByPlay(id, *record.LastAction)
+		}
+	}
+	d.mu.Unlock()
+
+	done := make(chan error, 1)
+	go func() { done <- w.Run(ctx) }()
+
+	events := w.Events()
+	// handleSent handles the events the watcher sent before calling OnPoll
+	// or OnPlayByPlay, so what they report can be saved as the point to
+	// resume from.
+	handleSent := func() {
+		for n := len(events); n > 0; n-- {
+			if event, ok := <-events; ok {
+				d.handle(ctx, event)
+			}
+		}
+	}
+	for {
+		timer := time.NewTimer(d.deliver(ctx))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return <-done
+		case event, ok := <-events:
+			timer.Stop()
+			if !ok {
+				return <-done
+			}
+			d.handle(ctx, event)
+		case games := <-polls:
+			timer.Stop()
+			handleSent()
+			d.saveGames(games)
+		case poll := <-pbpPolls:
+			timer.Stop()
+			handleSent()
+			d.saveLastAction(poll.gameID, poll.lastAction)
+		case <-timer.C:
+		}
+	}
+}
+
+// wantsActions reports whether a subscription lists NewAction, which needs
+// the watcher to poll the play-by-play.
+func (d *Dispatcher) wantsActions() bool {
+	for _, sub := range d.config.Subscriptions {
+		if sub.wants(watch.NewAction) {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *Dispatcher) report(err error) {
+	if d.config.OnError != nil {
+		d.config.OnError(err)
+	}
+}
+
+// handle enqueues the notifications of an event.
+func (d *Dispatcher) handle(ctx context.Context, event watch.Event) {
+	var payloads []Payload
+	for _, sub := range d.config.Subscriptions {
+		if !sub.wants(event.Type) || !sub.matches(event.Game) {
+			continue
+		}
+		payloads = append(payloads, Payload{
+			ID:           notificationID(sub.ID, event),
+			Type:         event.Type,
+			Subscription: sub.ID,
+			GameID:       event.GameID,
+			Time:         event.Time,
+			Period:       event.Period,
+			Game:         event.Game,
+			Action:       event.Action,
+		})
+	}
+	if event.Type == watch.ScoreChanged || event.Type == watch.GameFinal {
+		payloads = append(payloads, d.thresholds(ctx, event)...)
+	}
+	if len(payloads) == 0 {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	added := false
+	for _, payload := range payloads {
+		if d.state.known(payload.ID) {
+			continue
+		}
+		d.state.Pending = append(d.state.Pending, &delivery{Subscription: payload.Subscription, Payload: payload})
+		added = true
+	}
+	if added {
+		d.saveLocked()
+	}
+}
+
+// notificationID is the same each time the watcher reports the same change,
+// such as after a restart.
+func notificationID(subID string, event watch.Event) string {
+	id := fmt.Sprintf("%s/%s/%s", subID, event.GameID, event.Type)
+	switch event.Type {
+	case watch.ScoreChanged, watch.LeadChange:
+		id += fmt.Sprintf("/%d-%d", event.Game.HomeTeam.Score, event.Game.AwayTeam.Score)
+	case watch.PeriodEnded:
+		id += fmt.Sprintf("/%d", event.Period)
+	case watch.NewAction:
+		id += fmt.Sprintf("/%d", event.Action.ActionNumber)
+	}
+	return id
+}
+
+// thresholds reads the box score of the event's game and returns the player
+// thresholds it has reached.
+func (d *Dispatcher) thresholds(ctx context.Context, event watch.Event) []Payload {
+	var subs []Subscription
+	for _, sub := range d.config.Subscriptions {
+		if len(sub.Thresholds) > 0 && sub.matches(event.Game) {
+			subs = append(subs, sub)
+		}
+	}
+	if len(subs) == 0 {
+		return nil
+	}
+
+	resp, err := endpoints.BoxScore(ctx, d.client, event.GameID)
+	if err != nil {
+		if ctx.Err() == nil {
+			d.report(fmt.Errorf("webhook: box score %s: %w", event.GameID, err))
+		}
+		return nil
+	}
+
+	players := make(map[int]endpoints.Player)
+	tricodes := make(map[int]string)
+	for _, team := range []endpoints.BoxScoreTeam{resp.Data.Game.HomeTeam, resp.Data.Game.AwayTeam} {
+		for _, player := range team.Players {
+			players[player.PersonID] = player
+			tricodes[player.PersonID] = team.TeamTricode
+		}
+	}
+
+	var payloads []Payload
+	for _, sub := range subs {
+		for _, t := range sub.Thresholds {
+			player, ok := players[t.PersonID]
+			if !ok {
+				continue
+			}
+			value := stats[t.Stat](player.Statistics)
+			if value < t.Value {
+				continue
+			}
+			payloads = append(payloads, Payload{
+				ID:           fmt.Sprintf("%s/%s/%s/%d/%s/%d", sub.ID, event.GameID, PlayerThreshold, t.PersonID, t.Stat, t.Value),
+				Type:         PlayerThreshold,
+				Subscription: sub.ID,
+				GameID:       event.GameID,
+				Time:         event.Time,
+				Game:         event.Game,
+				Player: &PlayerStat{
+					PersonID:    t.PersonID,
+					Name:        player.Name,
+					TeamTricode: tricodes[t.PersonID],
+					Stat:        t.Stat,
+					Threshold:   t.Value,
+					Value:       value,
+				},
+			})
+		}
+	}
+	return payloads
+}
+
+func (d *Dispatcher) saveGames(games []endpoints.Game) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := d.now()
+	for _, game := range games {
+		record := d.state.Games[game.GameID]
+		record.Game, record.SeenAt = game, now
+		d.state.Games[game.GameID] = record
+	}
+	d.saveLocked()
+}
+
+// saveLastAction records the last play-by-play action of a game whose
+// actions up to it have been handled. The state is only written when it
+// changes.
+func (d *Dispatcher) saveLastAction(gameID string, lastAction int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	record, ok := d.state.Games[gameID]
+	if !ok || (record.LastAction != nil && *record.LastAction == lastAction) {
+		return
+	}
+	record.LastAction = &lastAction
+	d.state.Games[gameID] = record
+	d.saveLocked()
+}
+
+func (d *Dispatcher) saveLocked() {
+	d.state.prune(d.now())
+	if err := d.state.save(d.config.StateFile); err != nil {
+		d.report(fmt.Errorf("webhook: save state: %w", err))
+	}
+}
+
+// deliver sends the pending deliveries that are due and returns the delay
+// until the next one. Deliveries of a subscription go out in order: one
+// that is waiting to be retried holds back those after it.
+func (d *Dispatcher) deliver(ctx context.Context) time.Duration {
+	d.mu.Lock()
+	pending := append([]*delivery(nil), d.state.Pending...)
+	d.mu.Unlock()
+
+	next := idleDelay
+	blocked := make(map[string]bool)
+	for _, del := range pending {
+		if ctx.Err() != nil {
+			return next
+		}
+		if blocked[del.Subscription] {
+			continue
+		}
+		if wait := del.NextAttempt.Sub(d.now()); wait > 0 {
+			blocked[del.Subscription] = true
+			next = min(next, wait)
+			continue
+		}
+
+		err := d.send(ctx, del)
+		if err != nil && ctx.Err() != nil {
+			return next
+		}
+		if d.finish(del, err) {
+			blocked[del.Subscription] = true
+			next = min(next, max(del.NextAttempt.Sub(d.now()), 0))
+		}
+	}
+	return next
+}
+
+// finish records an attempt and reports whether the delivery will be
+// retried.
+func (d *Dispatcher) finish(del *delivery, err error) bool {
+	d.mu.Lock()
+	now := d.now()
+	del.Attempts++
+	retry := false
+	if err != nil {
+		del.LastError = err.Error()
+		var permanent *permanentError
+		retry = !errors.As(err, &permanent) && del.Attempts < d.config.MaxAttempts
+	}
+	if retry {
+		del.NextAttempt = now.Add(d.backoff(del.Attempts))
+	} else {
+		for i, p := range d.state.Pending {
+			if p == del {
+				d.state.Pending = append(d.state.Pending[:i], d.state.Pending[i+1:]...)
+				break
+			}
+		}
+		d.state.Sent[del.Payload.ID] = now
+	}
+	d.saveLocked()
+	d.mu.Unlock()
+
+	switch {
+	case err != nil && retry:
+		d.report(fmt.Errorf("webhook %s: delivery %s failed, attempt %d of %d: %w", del.Subscription, del.Payload.ID, del.Attempts, d.config.MaxAttempts, err))
+	case err != nil:
+		d.report(fmt.Errorf("webhook %s: giving up on delivery %s after %d attempts: %w", del.Subscription, del.Payload.ID, del.Attempts, err))
+	}
+	return retry
+}
+
+// backoff is InitialBackoff doubled for each attempt after the first, capped
+// at MaxBackoff.
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	backoff, limit := time.Duration(d.config.InitialBackoff), time.Duration(d.config.MaxBackoff)
+	for i := 1; i < attempts && backoff < limit; i++ {
+		backoff *= 2
+	}
+	return min(backoff, limit)
+}
+
+// permanentError is a delivery failure that retrying will not fix.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+func (d *Dispatcher) send(ctx context.Context, del *delivery) error {
+	sub, ok := d.subs[del.Subscription]
+	if !ok {
+		return &permanentError{fmt.Errorf("subscription %q no longer configured", del.Subscription)}
+	}
+
+	body, err := json.Marshal(del.Payload)
+	if err != nil {
+		return &permanentError{err}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return &permanentError{err}
+	}
+	timestamp := d.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderID, del.Payload.ID)
+	req.Header.Set(HeaderTimestamp, fmt.Sprint(timestamp))
+	req.Header.Set(HeaderSignature, Sign(sub.Secret, timestamp, body))
+
+	resp, err := d.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	err = fmt.Errorf("%s responded %s", sub.URL, resp.Status)
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests {
+		return &permanentError{err}
+	}
+	return err
+}
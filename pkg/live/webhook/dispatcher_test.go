@@ -0,0 +1,352 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/client"
+	"github.com/n-ae/nba-api-go/pkg/live"
+	"github.com/n-ae/nba-api-go/pkg/live/endpoints"
+	"github.com/n-ae/nba-api-go/pkg/live/watch"
+	"github.com/n-ae/nba-api-go/pkg/transport"
+)
+
+const (
+	testSecret = "s3cret"
+	lebron     = 2544
+)
+
+func testGame(status, home, away int) endpoints.Game {
+	game := endpoints.Game{GameID: "0022400062", GameStatus: status, Period: 2, GameClock: "PT05M00.00S"}
+	game.HomeTeam.TeamID, game.HomeTeam.TeamTricode, game.HomeTeam.Score = 1610612747, "LAL", home
+	game.AwayTeam.TeamID, game.AwayTeam.TeamTricode, game.AwayTeam.Score = 1610612750, "MIN", away
+	if status == 3 {
+		game.Period, game.GameClock = 4, "PT00M00.00S"
+	}
+	return game
+}
+
+// fakeCDN serves the scoreboard from game, a box score in which LeBron
+// James has points and a play-by-play of actions.
+type fakeCDN struct {
+	mu      sync.Mutex
+	game    endpoints.Game
+	points  int
+	actions []endpoints.Action
+	polls   int
+}
+
+func (f *fakeCDN) set(game endpoints.Game, points int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.game, f.points = game, points
+}
+
+func (f *fakeCDN) addAction(action endpoints.Action) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.actions = append(f.actions, action)
+}
+
+func (f *fakeCDN) pollCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.polls
+}
+
+func (f *fakeCDN) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.URL.Path {
+	case "/scoreboard/todaysScoreboard_00.json":
+		f.polls++
+		var resp endpoints.ScoreboardResponse
+		resp.Scoreboard.Games = []endpoints.Game{f.game}
+		json.NewEncoder(w).Encode(resp)
+	case "/boxscore/boxscore_0022400062.json":
+		var resp endpoints.BoxScoreResponse
+		resp.Game.GameID = f.game.GameID
+		resp.Game.HomeTeam.TeamTricode = "LAL"
+		resp.Game.HomeTeam.Players = []endpoints.Player{{PersonID: lebron, Name: "LeBron James", Statistics: endpoints.PlayerStatistics{Points: f.points}}}
+		json.NewEncoder(w).Encode(resp)
+	case "/playbyplay/playbyplay_0022400062.json":
+		var resp endpoints.PlayByPlayResponse
+		resp.Game.GameID = f.game.GameID
+		resp.Game.Actions = f.actions
+		json.NewEncoder(w).Encode(resp)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// receiver is a webhook endpoint that checks signatures and answers 500 to
+// its first fail requests.
+type receiver struct {
+	t         *testing.T
+	mu        sync.Mutex
+	fail      int
+	attempts  int
+	delivered []Payload
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	body, _ := io.ReadAll(r.Body)
+	if err := Verify(testSecret, r.Header, body, time.Minute); err != nil {
+		rc.t.Errorf("Verify() = %v", err)
+		http.Error(w, "bad signature", http.StatusUnauthorized)
+		return
+	}
+	rc.attempts++
+	if rc.fail > 0 {
+		rc.fail--
+		http.Error(w, "unavailable", http.StatusInternalServerError)
+		return
+	}
+
+	var payload Payload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		rc.t.Errorf("decode payload: %v", err)
+	}
+	if id := r.Header.Get(HeaderID); id != payload.ID {
+		rc.t.Errorf("%s = %q, payload id %q", HeaderID, id, payload.ID)
+	}
+	rc.delivered = append(rc.delivered, payload)
+}
+
+func (rc *receiver) ids() []string {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	ids := []string{}
+	for _, p := range rc.delivered {
+		ids = append(ids, p.ID)
+	}
+	return ids
+}
+
+func (rc *receiver) setFail(n int) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.fail = n
+}
+
+func (rc *receiver) attemptCount() int {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return rc.attempts
+}
+
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+type harness struct {
+	cdn      *fakeCDN
+	receiver *receiver
+	client   *live.Client
+	config   Config
+}
+
+func newHarness(t *testing.T) *harness {
+	cdn := &fakeCDN{}
+	cdnServer := httptest.NewServer(cdn)
+	t.Cleanup(cdnServer.Close)
+
+	rc := &receiver{t: t}
+	receiverServer := httptest.NewServer(rc)
+	t.Cleanup(receiverServer.Close)
+
+	return &harness{
+		cdn:      cdn,
+		receiver: rc,
+		client: live.NewClient(live.Config{
+			Middlewares: []transport.Middleware{transport.WithUserAgent("test")},
+		}, client.WithBaseURL(cdnServer.URL)),
+		config: Config{
+			Secret:    testSecret,
+			StateFile: filepath.Join(t.TempDir(), "state.json"),
+			Subscriptions: []Subscription{{
+				ID:         "slack",
+				URL:        receiverServer.URL,
+				Events:     []watch.EventType{watch.ScoreChanged, watch.GameFinal},
+				Teams:      []string{"LAL"},
+				Thresholds: []Threshold{{PersonID: lebron, Stat: "points", Value: 40}},
+			}},
+			PollInterval:   Duration(time.Millisecond),
+			InitialBackoff: Duration(time.Millisecond),
+			MaxBackoff:     Duration(5 * time.Millisecond),
+			OnError:        func(error) {},
+		},
+	}
+}
+
+// start runs a dispatcher and returns a func that stops it.
+func (h *harness) start(t *testing.T) func() {
+	t.Helper()
+	d, err := New(h.client, h.config)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- d.Run(ctx) }()
+	return func() {
+		cancel()
+		if err := <-done; !errors.Is(err, context.Canceled) {
+			t.Errorf("Run() = %v, want context.Canceled", err)
+		}
+	}
+}
+
+func TestDispatcher_Run(t *testing.T) {
+	h := newHarness(t)
+	h.receiver.setFail(1)
+	h.cdn.set(testGame(2, 2, 0), 38)
+
+	stop := h.start(t)
+	waitFor(t, "baseline poll", func() bool { return h.cdn.pollCount() >= 2 })
+	h.cdn.set(testGame(2, 5, 0), 41)
+	waitFor(t, "score and threshold", func() bool { return len(h.receiver.ids()) >= 2 })
+	h.cdn.set(testGame(3, 5, 0), 41)
+	waitFor(t, "final", func() bool { return len(h.receiver.ids()) >= 3 })
+	waitFor(t, "state saved", func() bool {
+		st, err := loadState(h.config.StateFile)
+		return err == nil && len(st.Sent) == 3
+	})
+	stop()
+
+	want := []string{
+		"slack/0022400062/score_changed/5-0",
+		"slack/0022400062/player_threshold/2544/points/40",
+		"slack/0022400062/game_final",
+	}
+	if got := h.receiver.ids(); !reflect.DeepEqual(got, want) {
+		t.Errorf("delivered %v, want %v", got, want)
+	}
+	if n := h.receiver.attemptCount(); n != 4 {
+		t.Errorf("receiver got %d requests, want 4 with one retry", n)
+	}
+	if p := h.receiver.delivered[1].Player; p == nil || p.Name != "LeBron James" || p.Value != 41 {
+		t.Errorf("threshold player = %+v", p)
+	}
+
+	st, err := loadState(h.config.StateFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(st.Pending) != 0 || len(st.Sent) != 3 {
+		t.Errorf("state has %d pending and %d sent, want 0 and 3", len(st.Pending), len(st.Sent))
+	}
+}
+
+func TestDispatcher_Restart(t *testing.T) {
+	h := newHarness(t)
+	h.config.MaxAttempts = 100
+	h.cdn.set(testGame(2, 2, 0), 10)
+
+	// The receiver is down when the score changes.
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+	up := h.config.Subscriptions[0].URL
+	h.config.Subscriptions[0].URL = down.URL
+
+	stop := h.start(t)
+	waitFor(t, "baseline poll", func() bool { return h.cdn.pollCount() >= 2 })
+	h.cdn.set(testGame(2, 5, 0), 10)
+	waitFor(t, "failed attempt", func() bool {
+		st, err := loadState(h.config.StateFile)
+		return err == nil && len(st.Pending) == 1 && st.Pending[0].Attempts > 0
+	})
+	stop()
+
+	// After the restart the pending delivery goes out once and the unchanged
+	// scoreboard is not notified again.
+	h.config.Subscriptions[0].URL = up
+	polls := h.cdn.pollCount()
+	stop = h.start(t)
+	waitFor(t, "pending delivery", func() bool { return len(h.receiver.ids()) >= 1 })
+	waitFor(t, "later polls", func() bool { return h.cdn.pollCount() >= polls+3 })
+	h.cdn.set(testGame(2, 7, 0), 10)
+	waitFor(t, "new score", func() bool { return len(h.receiver.ids()) >= 2 })
+	stop()
+
+	want := []string{"slack/0022400062/score_changed/5-0", "slack/0022400062/score_changed/7-0"}
+	if got := h.receiver.ids(); !reflect.DeepEqual(got, want) {
+		t.Errorf("delivered %v, want %v", got, want)
+	}
+}
+
+func TestDispatcher_NewAction(t *testing.T) {
+	h := newHarness(t)
+	h.config.Subscriptions[0].Events = []watch.EventType{watch.NewAction}
+	h.config.Subscriptions[0].Thresholds = nil
+	h.cdn.set(testGame(2, 2, 0), 0)
+	h.cdn.addAction(endpoints.Action{ActionNumber: 1, Period: 2})
+
+	stop := h.start(t)
+	waitFor(t, "baseline poll", func() bool { return h.cdn.pollCount() >= 2 })
+	h.cdn.addAction(endpoints.Action{ActionNumber: 2, Period: 2, Description: "James 26' 3PT"})
+	waitFor(t, "new action", func() bool { return len(h.receiver.ids()) >= 1 })
+	stop()
+
+	want := []string{"slack/0022400062/new_action/2"}
+	if got := h.receiver.ids(); !reflect.DeepEqual(got, want) {
+		t.Errorf("delivered %v, want %v", got, want)
+	}
+	if a := h.receiver.delivered[0].Action; a == nil || a.Description != "James 26' 3PT" {
+		t.Errorf("delivered action = %+v", a)
+	}
+}
+
+func TestDispatcher_RestartNewActions(t *testing.T) {
+	h := newHarness(t)
+	h.config.Subscriptions[0].Events = []watch.EventType{watch.NewAction}
+	h.config.Subscriptions[0].Thresholds = nil
+	h.cdn.set(testGame(2, 2, 0), 0)
+	h.cdn.addAction(endpoints.Action{ActionNumber: 1, Period: 2})
+
+	lastAction := func() int {
+		st, err := loadState(h.config.StateFile)
+		if err != nil || st.Games["0022400062"].LastAction == nil {
+			return -1
+		}
+		return *st.Games["0022400062"].LastAction
+	}
+
+	stop := h.start(t)
+	waitFor(t, "baseline action saved", func() bool { return lastAction() == 1 })
+	stop()
+
+	// The actions made while the dispatcher is stopped are delivered after
+	// the restart.
+	h.cdn.addAction(endpoints.Action{ActionNumber: 2, Period: 2})
+	h.cdn.addAction(endpoints.Action{ActionNumber: 3, Period: 2})
+	stop = h.start(t)
+	waitFor(t, "missed actions", func() bool { return len(h.receiver.ids()) >= 2 })
+	waitFor(t, "last action saved", func() bool { return lastAction() == 3 })
+	stop()
+
+	want := []string{"slack/0022400062/new_action/2", "slack/0022400062/new_action/3"}
+	if got := h.receiver.ids(); !reflect.DeepEqual(got, want) {
+		t.Errorf("delivered %v, want %v", got, want)
+	}
+}
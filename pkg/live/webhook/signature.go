@@ -0,0 +1,54 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Delivery headers. HeaderID is the notification ID; it is the same on every
+// retry, so receivers can drop duplicates.
+const (
+	HeaderID        = "X-NBA-Webhook-ID"
+	HeaderTimestamp = "X-NBA-Webhook-Timestamp"
+	HeaderSignature = "X-NBA-Webhook-Signature"
+)
+
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// Sign returns the HeaderSignature value for a delivery: "sha256=" and the
+// hex HMAC-SHA256 of the Unix timestamp, a ".", and the body.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature headers of a received delivery. Deliveries
+// signed more than tolerance from now are rejected to limit replays; zero
+// skips that check.
+func Verify(secret string, header http.Header, body []byte, tolerance time.Duration) error {
+	timestamp, err := strconv.ParseInt(header.Get(HeaderTimestamp), 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: bad timestamp", ErrInvalidSignature)
+	}
+	if tolerance > 0 {
+		if age := time.Since(time.Unix(timestamp, 0)); age > tolerance || age < -tolerance {
+			return fmt.Errorf("%w: timestamp outside tolerance", ErrInvalidSignature)
+		}
+	}
+
+	got := header.Get(HeaderSignature)
+	if !strings.HasPrefix(got, "sha256=") || !hmac.Equal([]byte(got), []byte(Sign(secret, timestamp, body))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
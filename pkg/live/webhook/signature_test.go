@@ -0,0 +1,43 @@
+package webhook
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"id":"slack/0022400062/game_final"}`)
+	signed := func(secret string, at time.Time, body []byte) http.Header {
+		header := http.Header{}
+		header.Set(HeaderTimestamp, strconv.FormatInt(at.Unix(), 10))
+		header.Set(HeaderSignature, Sign(secret, at.Unix(), body))
+		return header
+	}
+
+	tests := []struct {
+		name   string
+		header http.Header
+		body   []byte
+		ok     bool
+	}{
+		{"valid", signed("s3cret", time.Now(), body), body, true},
+		{"wrong secret", signed("other", time.Now(), body), body, false},
+		{"tampered body", signed("s3cret", time.Now(), body), []byte(`{"id":"x"}`), false},
+		{"expired", signed("s3cret", time.Now().Add(-time.Hour), body), body, false},
+		{"unsigned", http.Header{}, body, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Verify("s3cret", tt.header, tt.body, 5*time.Minute)
+			if tt.ok && err != nil {
+				t.Errorf("Verify() = %v, want nil", err)
+			}
+			if !tt.ok && !errors.Is(err, ErrInvalidSignature) {
+				t.Errorf("Verify() = %v, want ErrInvalidSignature", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/live/endpoints"
+)
+
+// Records older than these are dropped from the state file. A game leaves
+// the live scoreboard the day after it is played, so its notifications
+// cannot recur later than that.
+const (
+	sentRetention = 7 * 24 * time.Hour
+	gameRetention = 3 * 24 * time.Hour
+)
+
+// delivery is a notification waiting to be sent.
+type delivery struct {
+	Subscription string    `json:"subscription"`
+	Payload      Payload   `json:"payload"`
+	Attempts     int       `json:"attempts"`
+	NextAttempt  time.Time `json:"next_attempt"`
+	LastError    string    `json:"last_error,omitempty"`
+}
+
+// gameRecord is the last snapshot of a game and, once its play-by-play has
+// been read, the number of its last action handled.
+type gameRecord struct {
+	Game       endpoints.Game `json:"game"`
+	SeenAt     time.Time      `json:"seen_at"`
+	LastAction *int           `json:"last_action,omitempty"`
+}
+
+// state is what the dispatcher persists. Sent holds the IDs of finished
+// notifications, delivered or given up, so they are never enqueued again;
+// Games holds the last snapshot and play-by-play action of each game the
+// watcher resumes from.
+type state struct {
+	Pending []*delivery           `json:"pending"`
+	Sent    map[string]time.Time  `json:"sent"`
+	Games   map[string]gameRecord `json:"games"`
+}
+
+func newState() *state {
+	return &state{
+		Sent:  make(map[string]time.Time),
+		Games: make(map[string]gameRecord),
+	}
+}
+
+// loadState reads the state file. A missing file is an empty state.
+func loadState(path string) (*state, error) {
+	s := newState()
+	if path == "" {
+		return s, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, err
+	}
+	if s.Sent == nil {
+		s.Sent = make(map[string]time.Time)
+	}
+	if s.Games == nil {
+		s.Games = make(map[string]gameRecord)
+	}
+	return s, nil
+}
+
+// save writes the state file through a temporary file and a rename, so a
+// crash leaves either the old or the new state.
+func (s *state) save(path string) error {
+	if path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// known reports whether a notification is pending or already sent.
+func (s *state) known(id string) bool {
+	if _, ok := s.Sent[id]; ok {
+		return true
+	}
+	for _, d := range s.Pending {
+		if d.Payload.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *state) prune(now time.Time) {
+	for id, at := range s.Sent {
+		if now.Sub(at) > sentRetention {
+			delete(s.Sent, id)
+		}
+	}
+	for id, record := range s.Games {
+		if now.Sub(record.SeenAt) > gameRetention {
+			delete(s.Games, id)
+		}
+	}
+}
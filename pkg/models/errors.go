@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 var (
@@ -13,6 +14,7 @@ var (
 	ErrInvalidRequest  = errors.New("invalid request parameters")
 	ErrTimeout         = errors.New("request timeout")
 	ErrUnauthorized    = errors.New("unauthorized")
+	ErrCircuitOpen     = errors.New("circuit breaker open")
 )
 
 type APIError struct {
@@ -20,19 +22,41 @@ type APIError struct {
 	Message    string
 	URL        string
 	Err        error
+
+	// Attempts is the number of requests made, including retries.
+	Attempts int
 }
 
 func (e *APIError) Error() string {
+	location := fmt.Sprintf("status %d, url %s", e.StatusCode, e.URL)
+	if e.Attempts > 1 {
+		location += fmt.Sprintf(", attempts %d", e.Attempts)
+	}
 	if e.Err != nil {
-		return fmt.Sprintf("API error (status %d, url %s): %s: %v", e.StatusCode, e.URL, e.Message, e.Err)
+		return fmt.Sprintf("API error (%s): %s: %v", location, e.Message, e.Err)
 	}
-	return fmt.Sprintf("API error (status %d, url %s): %s", e.StatusCode, e.URL, e.Message)
+	return fmt.Sprintf("API error (%s): %s", location, e.Message)
 }
 
 func (e *APIError) Unwrap() error {
 	return e.Err
 }
 
+// CircuitOpenError is returned without contacting upstream while the circuit
+// breaker for Host is open.
+type CircuitOpenError struct {
+	Host       string
+	RetryAfter time.Duration
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("%v for %s (retry after %v)", ErrCircuitOpen, e.Host, e.RetryAfter)
+}
+
+func (e *CircuitOpenError) Unwrap() error {
+	return ErrCircuitOpen
+}
+
 func NewAPIError(statusCode int, url, message string, err error) *APIError {
 	return &APIError{
 		StatusCode: statusCode,
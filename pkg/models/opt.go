@@ -0,0 +1,83 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Opt is a value that may be absent. The NBA API sends null for stats that
+// were not recorded, such as the box score line of a player who did not play,
+// and Opt keeps that apart from a real zero. It marshals to null when absent.
+type Opt[T any] struct {
+	Value T
+	Valid bool
+}
+
+func Some[T any](v T) Opt[T] {
+	return Opt[T]{Value: v, Valid: true}
+}
+
+func None[T any]() Opt[T] {
+	return Opt[T]{}
+}
+
+func (o Opt[T]) Get() (T, bool) {
+	return o.Value, o.Valid
+}
+
+// Or returns the value, or def when it is absent.
+func (o Opt[T]) Or(def T) T {
+	if !o.Valid {
+		return def
+	}
+	return o.Value
+}
+
+func (o Opt[T]) String() string {
+	if !o.Valid {
+		return "null"
+	}
+	return fmt.Sprint(o.Value)
+}
+
+func (o Opt[T]) MarshalJSON() ([]byte, error) {
+	if !o.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.Value)
+}
+
+func (o *Opt[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = Opt[T]{}
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
+
+type number interface {
+	~int | ~int64 | ~float64
+}
+
+// Mean averages the present values and ignores absent ones, so players who
+// did not play do not drag the average down. It is absent when no value is.
+func Mean[T number](values []Opt[T]) Opt[float64] {
+	var sum float64
+	var n int
+	for _, v := range values {
+		if v.Valid {
+			sum += float64(v.Value)
+			n++
+		}
+	}
+	if n == 0 {
+		return None[float64]()
+	}
+	return Some(sum / float64(n))
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOpt_JSON(t *testing.T) {
+	type line struct {
+		PTS Opt[int]     `json:"pts"`
+		MIN Opt[float64] `json:"min"`
+	}
+
+	var got line
+	if err := json.Unmarshal([]byte(`{"pts":0,"min":null}`), &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if v, ok := got.PTS.Get(); !ok || v != 0 {
+		t.Errorf("PTS = %v, want present 0", got.PTS)
+	}
+	if got.MIN.Valid || got.MIN.Or(-1) != -1 {
+		t.Errorf("MIN = %v, want absent", got.MIN)
+	}
+
+	data, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if string(data) != `{"pts":0,"min":null}` {
+		t.Errorf("Marshal() = %s", data)
+	}
+}
+
+func TestMean_SkipsAbsent(t *testing.T) {
+	mean := Mean([]Opt[int]{Some(10), None[int](), Some(0), None[int]()})
+	if v, ok := mean.Get(); !ok || v != 5 {
+		t.Errorf("Mean() = %v, want 5", mean)
+	}
+	if Mean([]Opt[float64]{None[float64]()}).Valid {
+		t.Error("Mean() of absent values should be absent")
+	}
+}
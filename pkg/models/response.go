@@ -3,13 +3,26 @@ package models
 import (
 	"encoding/json"
 	"net/http"
+	"time"
 )
 
+// Response is the decoded data of an endpoint with the metadata of its
+// request. The request URL and the upstream headers are left out of its JSON.
 type Response[T any] struct {
-	Data       T
-	StatusCode int
-	URL        string
-	Headers    http.Header
+	Data       T           `json:"data"`
+	StatusCode int         `json:"status_code"`
+	URL        string      `json:"-"`
+	Headers    http.Header `json:"-"`
+
+	// FetchedAt is when the response was received and Latency how long the
+	// request took, including retries. CacheHit reports whether the body was
+	// served by transport.WithCache.
+	FetchedAt time.Time     `json:"fetched_at"`
+	Latency   time.Duration `json:"latency"`
+	CacheHit  bool          `json:"cache_hit"`
+
+	// Warnings lists schema drift found while decoding in lenient mode.
+	Warnings []SchemaDrift `json:"warnings,omitempty"`
 }
 
 func NewResponse[T any](data T, statusCode int, url string, headers http.Header) *Response[T] {
@@ -21,6 +34,20 @@ func NewResponse[T any](data T, statusCode int, url string, headers http.Header)
 	}
 }
 
+// NewResponseFromRaw wraps data with the metadata of the raw response it was
+// decoded from.
+func NewResponseFromRaw[T any](data T, raw *RawResponse) *Response[T] {
+	return &Response[T]{
+		Data:       data,
+		StatusCode: raw.StatusCode,
+		URL:        raw.URL,
+		Headers:    raw.Headers,
+		FetchedAt:  raw.FetchedAt,
+		Latency:    raw.Latency,
+		CacheHit:   raw.CacheHit,
+	}
+}
+
 func (r *Response[T]) JSON() ([]byte, error) {
 	return json.Marshal(r.Data)
 }
@@ -30,6 +57,10 @@ type RawResponse struct {
 	StatusCode int
 	URL        string
 	Headers    http.Header
+
+	FetchedAt time.Time
+	Latency   time.Duration
+	CacheHit  bool
 }
 
 func NewRawResponse(body []byte, statusCode int, url string, headers http.Header) *RawResponse {
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestResponse_JSONOmitsRequestDetails(t *testing.T) {
+	resp := NewResponseFromRaw([]int{1}, &RawResponse{
+		StatusCode: http.StatusOK,
+		URL:        "https://stats.nba.com/stats/playergamelog?PlayerID=2544",
+		Headers:    http.Header{"Set-Cookie": []string{"session=secret"}},
+	})
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	for _, leaked := range []string{"stats.nba.com", "session=secret"} {
+		if strings.Contains(string(data), leaked) {
+			t.Errorf("Marshal() = %s, contains %q", data, leaked)
+		}
+	}
+
+	var fields map[string]json.RawMessage
+	json.Unmarshal(data, &fields)
+	if string(fields["data"]) != "[1]" || string(fields["status_code"]) != "200" {
+		t.Errorf("Marshal() = %s", data)
+	}
+}
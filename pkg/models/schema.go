@@ -0,0 +1,60 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrSchemaDrift = errors.New("schema drift")
+
+type DriftKind string
+
+const (
+	DriftMissingResultSet    DriftKind = "missing_result_set"
+	DriftUnexpectedResultSet DriftKind = "unexpected_result_set"
+	DriftRenamedResultSet    DriftKind = "renamed_result_set"
+	DriftMissingColumn       DriftKind = "missing_column"
+	DriftUnexpectedColumn    DriftKind = "unexpected_column"
+	DriftRenamedColumn       DriftKind = "renamed_column"
+	DriftInvalidValue        DriftKind = "invalid_value"
+)
+
+// SchemaDrift is one difference between a response and the Go types it is
+// decoded into. Column is empty for result-set level drift.
+type SchemaDrift struct {
+	Kind      DriftKind `json:"kind"`
+	ResultSet string    `json:"result_set"`
+	Column    string    `json:"column,omitempty"`
+	Detail    string    `json:"detail,omitempty"`
+}
+
+func (d SchemaDrift) String() string {
+	location := d.ResultSet
+	if d.Column != "" {
+		location += "." + d.Column
+	}
+	if d.Detail != "" {
+		return fmt.Sprintf("%s %s: %s", d.Kind, location, d.Detail)
+	}
+	return fmt.Sprintf("%s %s", d.Kind, location)
+}
+
+// SchemaDriftError is returned by endpoints in strict schema mode when the
+// response does not match the expected result sets and columns.
+type SchemaDriftError struct {
+	Endpoint string
+	Drift    []SchemaDrift
+}
+
+func (e *SchemaDriftError) Error() string {
+	parts := make([]string, len(e.Drift))
+	for i, d := range e.Drift {
+		parts[i] = d.String()
+	}
+	return fmt.Sprintf("%v in %s: %s", ErrSchemaDrift, e.Endpoint, strings.Join(parts, "; "))
+}
+
+func (e *SchemaDriftError) Unwrap() error {
+	return ErrSchemaDrift
+}
@@ -83,81 +83,62 @@ func GetAllTimeLeadersGrids(ctx context.Context, client *stats.Client, req AllTi
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "alltimeleadersgrids", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "alltimeleadersgrids", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &AllTimeLeadersGridsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.AllTimeLeadersPTS = make([]AllTimeLeadersGridsAllTimeLeadersPTS, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 4 {
-				item := AllTimeLeadersGridsAllTimeLeadersPTS{
-					PLAYER_ID:   toInt(row[0]),
-					PLAYER_NAME: toString(row[1]),
-					PTS:         toFloat(row[2]),
-					PTS_RANK:    toFloat(row[3]),
-				}
-				response.AllTimeLeadersPTS = append(response.AllTimeLeadersPTS, item)
+	if rs := rawResp.resultSet("AllTimeLeadersPTS"); rs != nil {
+		response.AllTimeLeadersPTS = decodeRows(rs, func(row *rowReader) AllTimeLeadersGridsAllTimeLeadersPTS {
+			return AllTimeLeadersGridsAllTimeLeadersPTS{
+				PLAYER_ID:   row.Int("PLAYER_ID"),
+				PLAYER_NAME: row.String("PLAYER_NAME"),
+				PTS:         row.Float("PTS"),
+				PTS_RANK:    row.Float("PTS_RANK"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.AllTimeLeadersAST = make([]AllTimeLeadersGridsAllTimeLeadersAST, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 4 {
-				item := AllTimeLeadersGridsAllTimeLeadersAST{
-					PLAYER_ID:   toInt(row[0]),
-					PLAYER_NAME: toString(row[1]),
-					AST:         toFloat(row[2]),
-					AST_RANK:    toFloat(row[3]),
-				}
-				response.AllTimeLeadersAST = append(response.AllTimeLeadersAST, item)
+	if rs := rawResp.resultSet("AllTimeLeadersAST"); rs != nil {
+		response.AllTimeLeadersAST = decodeRows(rs, func(row *rowReader) AllTimeLeadersGridsAllTimeLeadersAST {
+			return AllTimeLeadersGridsAllTimeLeadersAST{
+				PLAYER_ID:   row.Int("PLAYER_ID"),
+				PLAYER_NAME: row.String("PLAYER_NAME"),
+				AST:         row.Float("AST"),
+				AST_RANK:    row.Float("AST_RANK"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 2 {
-		response.AllTimeLeadersREB = make([]AllTimeLeadersGridsAllTimeLeadersREB, 0, len(rawResp.ResultSets[2].RowSet))
-		for _, row := range rawResp.ResultSets[2].RowSet {
-			if len(row) >= 4 {
-				item := AllTimeLeadersGridsAllTimeLeadersREB{
-					PLAYER_ID:   toInt(row[0]),
-					PLAYER_NAME: toString(row[1]),
-					REB:         toFloat(row[2]),
-					REB_RANK:    toFloat(row[3]),
-				}
-				response.AllTimeLeadersREB = append(response.AllTimeLeadersREB, item)
+	if rs := rawResp.resultSet("AllTimeLeadersREB"); rs != nil {
+		response.AllTimeLeadersREB = decodeRows(rs, func(row *rowReader) AllTimeLeadersGridsAllTimeLeadersREB {
+			return AllTimeLeadersGridsAllTimeLeadersREB{
+				PLAYER_ID:   row.Int("PLAYER_ID"),
+				PLAYER_NAME: row.String("PLAYER_NAME"),
+				REB:         row.Float("REB"),
+				REB_RANK:    row.Float("REB_RANK"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 3 {
-		response.AllTimeLeadersBLK = make([]AllTimeLeadersGridsAllTimeLeadersBLK, 0, len(rawResp.ResultSets[3].RowSet))
-		for _, row := range rawResp.ResultSets[3].RowSet {
-			if len(row) >= 4 {
-				item := AllTimeLeadersGridsAllTimeLeadersBLK{
-					PLAYER_ID:   toInt(row[0]),
-					PLAYER_NAME: toString(row[1]),
-					BLK:         toFloat(row[2]),
-					BLK_RANK:    toFloat(row[3]),
-				}
-				response.AllTimeLeadersBLK = append(response.AllTimeLeadersBLK, item)
+	if rs := rawResp.resultSet("AllTimeLeadersBLK"); rs != nil {
+		response.AllTimeLeadersBLK = decodeRows(rs, func(row *rowReader) AllTimeLeadersGridsAllTimeLeadersBLK {
+			return AllTimeLeadersGridsAllTimeLeadersBLK{
+				PLAYER_ID:   row.Int("PLAYER_ID"),
+				PLAYER_NAME: row.String("PLAYER_NAME"),
+				BLK:         row.Float("BLK"),
+				BLK_RANK:    row.Float("BLK_RANK"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 4 {
-		response.AllTimeLeadersSTL = make([]AllTimeLeadersGridsAllTimeLeadersSTL, 0, len(rawResp.ResultSets[4].RowSet))
-		for _, row := range rawResp.ResultSets[4].RowSet {
-			if len(row) >= 4 {
-				item := AllTimeLeadersGridsAllTimeLeadersSTL{
-					PLAYER_ID:   toInt(row[0]),
-					PLAYER_NAME: toString(row[1]),
-					STL:         toFloat(row[2]),
-					STL_RANK:    toFloat(row[3]),
-				}
-				response.AllTimeLeadersSTL = append(response.AllTimeLeadersSTL, item)
+	if rs := rawResp.resultSet("AllTimeLeadersSTL"); rs != nil {
+		response.AllTimeLeadersSTL = decodeRows(rs, func(row *rowReader) AllTimeLeadersGridsAllTimeLeadersSTL {
+			return AllTimeLeadersGridsAllTimeLeadersSTL{
+				PLAYER_ID:   row.Int("PLAYER_ID"),
+				PLAYER_NAME: row.String("PLAYER_NAME"),
+				STL:         row.Float("STL"),
+				STL_RANK:    row.Float("STL_RANK"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -50,28 +50,25 @@ func GetAssistLeaders(ctx context.Context, client *stats.Client, req AssistLeade
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "assistleaders", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "assistleaders", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &AssistLeadersResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.AssistLeaders = make([]AssistLeadersAssistLeaders, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 7 {
-				item := AssistLeadersAssistLeaders{
-					PLAYER_ID:         toInt(row[0]),
-					PLAYER_NAME:       toString(row[1]),
-					TEAM_ID:           toInt(row[2]),
-					TEAM_ABBREVIATION: toString(row[3]),
-					GP:                toInt(row[4]),
-					MIN:               toFloat(row[5]),
-					AST:               toFloat(row[6]),
-				}
-				response.AssistLeaders = append(response.AssistLeaders, item)
+	if rs := rawResp.resultSet("AssistLeaders"); rs != nil {
+		response.AssistLeaders = decodeRows(rs, func(row *rowReader) AssistLeadersAssistLeaders {
+			return AssistLeadersAssistLeaders{
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				GP:                row.Int("GP"),
+				MIN:               row.Float("MIN"),
+				AST:               row.Float("AST"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -58,36 +58,33 @@ func GetAssistTracker(ctx context.Context, client *stats.Client, req AssistTrack
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "assisttracker", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "assisttracker", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &AssistTrackerResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.AssistTracker = make([]AssistTrackerAssistTracker, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 15 {
-				item := AssistTrackerAssistTracker{
-					PLAYER_ID:                toInt(row[0]),
-					PLAYER_NAME:              toString(row[1]),
-					TEAM_ID:                  toInt(row[2]),
-					TEAM_ABBREVIATION:        toString(row[3]),
-					GP:                       toInt(row[4]),
-					W:                        toString(row[5]),
-					L:                        toString(row[6]),
-					W_PCT:                    toFloat(row[7]),
-					MIN:                      toFloat(row[8]),
-					AST:                      toFloat(row[9]),
-					PASS_TO:                  toString(row[10]),
-					AST_PTS_CREATED:          toFloat(row[11]),
-					AST_PTS_CREATED_PER_PASS: toFloat(row[12]),
-					AST_PCT:                  toFloat(row[13]),
-					AST_ADJ:                  toFloat(row[14]),
-				}
-				response.AssistTracker = append(response.AssistTracker, item)
+	if rs := rawResp.resultSet("AssistTracker"); rs != nil {
+		response.AssistTracker = decodeRows(rs, func(row *rowReader) AssistTrackerAssistTracker {
+			return AssistTrackerAssistTracker{
+				PLAYER_ID:                row.Int("PLAYER_ID"),
+				PLAYER_NAME:              row.String("PLAYER_NAME"),
+				TEAM_ID:                  row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION:        row.String("TEAM_ABBREVIATION"),
+				GP:                       row.Int("GP"),
+				W:                        row.String("W"),
+				L:                        row.String("L"),
+				W_PCT:                    row.Float("W_PCT"),
+				MIN:                      row.Float("MIN"),
+				AST:                      row.Float("AST"),
+				PASS_TO:                  row.String("PASS_TO"),
+				AST_PTS_CREATED:          row.Float("AST_PTS_CREATED"),
+				AST_PTS_CREATED_PER_PASS: row.Float("AST_PTS_CREATED_PER_PASS"),
+				AST_PCT:                  row.Float("AST_PCT"),
+				AST_ADJ:                  row.Float("AST_ADJ"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
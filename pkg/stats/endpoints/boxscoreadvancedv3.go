@@ -0,0 +1,54 @@
+package endpoints
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/stats"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
+)
+
+// BoxScoreAdvancedV3Statistics is an advanced box score line of a player or team.
+type BoxScoreAdvancedV3Statistics struct {
+	Minutes                      string  `json:"minutes"`
+	EstimatedOffensiveRating     float64 `json:"estimatedOffensiveRating"`
+	OffensiveRating              float64 `json:"offensiveRating"`
+	EstimatedDefensiveRating     float64 `json:"estimatedDefensiveRating"`
+	DefensiveRating              float64 `json:"defensiveRating"`
+	EstimatedNetRating           float64 `json:"estimatedNetRating"`
+	NetRating                    float64 `json:"netRating"`
+	AssistPercentage             float64 `json:"assistPercentage"`
+	AssistToTurnover             float64 `json:"assistToTurnover"`
+	AssistRatio                  float64 `json:"assistRatio"`
+	OffensiveReboundPercentage   float64 `json:"offensiveReboundPercentage"`
+	DefensiveReboundPercentage   float64 `json:"defensiveReboundPercentage"`
+	ReboundPercentage            float64 `json:"reboundPercentage"`
+	TurnoverRatio                float64 `json:"turnoverRatio"`
+	EffectiveFieldGoalPercentage float64 `json:"effectiveFieldGoalPercentage"`
+	TrueShootingPercentage       float64 `json:"trueShootingPercentage"`
+	UsagePercentage              float64 `json:"usagePercentage"`
+	EstimatedUsagePercentage     float64 `json:"estimatedUsagePercentage"`
+	EstimatedPace                float64 `json:"estimatedPace"`
+	Pace                         float64 `json:"pace"`
+	PacePer40                    float64 `json:"pacePer40"`
+	Possessions                  float64 `json:"possessions"`
+	PIE                          float64 `json:"PIE"`
+}
+
+// MinutesPlayed parses Minutes ("35:12").
+func (s BoxScoreAdvancedV3Statistics) MinutesPlayed() (time.Duration, error) {
+	return timeutil.ParseMinutes(s.Minutes)
+}
+
+type BoxScoreAdvancedV3Response = BoxScoreV3[BoxScoreAdvancedV3Statistics]
+
+// GetBoxScoreAdvancedV3 retrieves the boxscoreadvancedv3 endpoint.
+func GetBoxScoreAdvancedV3(ctx context.Context, client *stats.Client, req BoxScoreV3Request) (*models.Response[*BoxScoreAdvancedV3Response], error) {
+	var doc struct {
+		Meta     json.RawMessage             `json:"meta"`
+		BoxScore *BoxScoreAdvancedV3Response `json:"boxScoreAdvanced"`
+	}
+	return getBoxScoreV3(ctx, client, "boxscoreadvancedv3", req, &doc, &doc.BoxScore)
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/n-ae/nba-api-go/pkg/models"
 	"github.com/n-ae/nba-api-go/pkg/stats"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
 )
 
 // BoxScoreDefensiveV2Request contains parameters for the BoxScoreDefensiveV2 endpoint
@@ -21,19 +23,24 @@ type BoxScoreDefensiveV2Request struct {
 
 // BoxScoreDefensiveV2PlayerStats represents the PlayerStats result set for BoxScoreDefensiveV2
 type BoxScoreDefensiveV2PlayerStats struct {
-	GAME_ID           string  `json:"GAME_ID"`
-	TEAM_ID           int     `json:"TEAM_ID"`
-	TEAM_ABBREVIATION string  `json:"TEAM_ABBREVIATION"`
-	TEAM_CITY         string  `json:"TEAM_CITY"`
-	PLAYER_ID         int     `json:"PLAYER_ID"`
-	PLAYER_NAME       string  `json:"PLAYER_NAME"`
-	NICKNAME          string  `json:"NICKNAME"`
-	START_POSITION    string  `json:"START_POSITION"`
-	COMMENT           string  `json:"COMMENT"`
-	MIN               float64 `json:"MIN"`
-	DEF_RIM_FGM       int     `json:"DEF_RIM_FGM"`
-	DEF_RIM_FGA       int     `json:"DEF_RIM_FGA"`
-	DEF_RIM_FG_PCT    float64 `json:"DEF_RIM_FG_PCT"`
+	GAME_ID           string              `json:"GAME_ID"`
+	TEAM_ID           int                 `json:"TEAM_ID"`
+	TEAM_ABBREVIATION string              `json:"TEAM_ABBREVIATION"`
+	TEAM_CITY         string              `json:"TEAM_CITY"`
+	PLAYER_ID         int                 `json:"PLAYER_ID"`
+	PLAYER_NAME       string              `json:"PLAYER_NAME"`
+	NICKNAME          string              `json:"NICKNAME"`
+	START_POSITION    string              `json:"START_POSITION"`
+	COMMENT           string              `json:"COMMENT"`
+	MIN               models.Opt[string]  `json:"MIN"`
+	DEF_RIM_FGM       models.Opt[int]     `json:"DEF_RIM_FGM"`
+	DEF_RIM_FGA       models.Opt[int]     `json:"DEF_RIM_FGA"`
+	DEF_RIM_FG_PCT    models.Opt[float64] `json:"DEF_RIM_FG_PCT"`
+}
+
+// Minutes parses MIN ("35:12"). It is zero for a player who did not play.
+func (s BoxScoreDefensiveV2PlayerStats) Minutes() (time.Duration, error) {
+	return timeutil.ParseMinutes(s.MIN.Or(""))
 }
 
 // BoxScoreDefensiveV2TeamStats represents the TeamStats result set for BoxScoreDefensiveV2
@@ -43,12 +50,17 @@ type BoxScoreDefensiveV2TeamStats struct {
 	TEAM_NAME         string  `json:"TEAM_NAME"`
 	TEAM_ABBREVIATION string  `json:"TEAM_ABBREVIATION"`
 	TEAM_CITY         string  `json:"TEAM_CITY"`
-	MIN               float64 `json:"MIN"`
+	MIN               string  `json:"MIN"`
 	DEF_RIM_FGM       int     `json:"DEF_RIM_FGM"`
 	DEF_RIM_FGA       int     `json:"DEF_RIM_FGA"`
 	DEF_RIM_FG_PCT    float64 `json:"DEF_RIM_FG_PCT"`
 }
 
+// Minutes parses MIN ("240:00").
+func (s BoxScoreDefensiveV2TeamStats) Minutes() (time.Duration, error) {
+	return timeutil.ParseMinutes(s.MIN)
+}
+
 // BoxScoreDefensiveV2Response contains the response data from the BoxScoreDefensiveV2 endpoint
 type BoxScoreDefensiveV2Response struct {
 	PlayerStats []BoxScoreDefensiveV2PlayerStats
@@ -79,53 +91,46 @@ func GetBoxScoreDefensiveV2(ctx context.Context, client *stats.Client, req BoxSc
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "boxscoredefensivev2", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "boxscoredefensivev2", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &BoxScoreDefensiveV2Response{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerStats = make([]BoxScoreDefensiveV2PlayerStats, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 13 {
-				item := BoxScoreDefensiveV2PlayerStats{
-					GAME_ID:           toString(row[0]),
-					TEAM_ID:           toInt(row[1]),
-					TEAM_ABBREVIATION: toString(row[2]),
-					TEAM_CITY:         toString(row[3]),
-					PLAYER_ID:         toInt(row[4]),
-					PLAYER_NAME:       toString(row[5]),
-					NICKNAME:          toString(row[6]),
-					START_POSITION:    toString(row[7]),
-					COMMENT:           toString(row[8]),
-					MIN:               toFloat(row[9]),
-					DEF_RIM_FGM:       toInt(row[10]),
-					DEF_RIM_FGA:       toInt(row[11]),
-					DEF_RIM_FG_PCT:    toFloat(row[12]),
-				}
-				response.PlayerStats = append(response.PlayerStats, item)
+	if rs := rawResp.resultSet("PlayerStats"); rs != nil {
+		response.PlayerStats = decodeRows(rs, func(row *rowReader) BoxScoreDefensiveV2PlayerStats {
+			return BoxScoreDefensiveV2PlayerStats{
+				GAME_ID:           row.String("GAME_ID"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				TEAM_CITY:         row.String("TEAM_CITY"),
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				NICKNAME:          row.String("NICKNAME"),
+				START_POSITION:    row.String("START_POSITION"),
+				COMMENT:           row.String("COMMENT"),
+				MIN:               row.OptString("MIN"),
+				DEF_RIM_FGM:       row.OptInt("DEF_RIM_FGM"),
+				DEF_RIM_FGA:       row.OptInt("DEF_RIM_FGA"),
+				DEF_RIM_FG_PCT:    row.OptFloat("DEF_RIM_FG_PCT"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.TeamStats = make([]BoxScoreDefensiveV2TeamStats, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 9 {
-				item := BoxScoreDefensiveV2TeamStats{
-					GAME_ID:           toString(row[0]),
-					TEAM_ID:           toInt(row[1]),
-					TEAM_NAME:         toString(row[2]),
-					TEAM_ABBREVIATION: toString(row[3]),
-					TEAM_CITY:         toString(row[4]),
-					MIN:               toFloat(row[5]),
-					DEF_RIM_FGM:       toInt(row[6]),
-					DEF_RIM_FGA:       toInt(row[7]),
-					DEF_RIM_FG_PCT:    toFloat(row[8]),
-				}
-				response.TeamStats = append(response.TeamStats, item)
+	if rs := rawResp.resultSet("TeamStats"); rs != nil {
+		response.TeamStats = decodeRows(rs, func(row *rowReader) BoxScoreDefensiveV2TeamStats {
+			return BoxScoreDefensiveV2TeamStats{
+				GAME_ID:           row.String("GAME_ID"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_NAME:         row.String("TEAM_NAME"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				TEAM_CITY:         row.String("TEAM_CITY"),
+				MIN:               row.String("MIN"),
+				DEF_RIM_FGM:       row.Int("DEF_RIM_FGM"),
+				DEF_RIM_FGA:       row.Int("DEF_RIM_FGA"),
+				DEF_RIM_FG_PCT:    row.Float("DEF_RIM_FG_PCT"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -0,0 +1,46 @@
+package endpoints
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/stats"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
+)
+
+// BoxScoreDefensiveV3Statistics is a defensive box score line of a player or team.
+type BoxScoreDefensiveV3Statistics struct {
+	MatchupMinutes                string  `json:"matchupMinutes"`
+	PartialPossessions            float64 `json:"partialPossessions"`
+	SwitchesOn                    int     `json:"switchesOn"`
+	PlayerPoints                  int     `json:"playerPoints"`
+	DefensiveRebounds             int     `json:"defensiveRebounds"`
+	MatchupAssists                int     `json:"matchupAssists"`
+	MatchupTurnovers              int     `json:"matchupTurnovers"`
+	Steals                        int     `json:"steals"`
+	Blocks                        int     `json:"blocks"`
+	MatchupFieldGoalsMade         int     `json:"matchupFieldGoalsMade"`
+	MatchupFieldGoalsAttempted    int     `json:"matchupFieldGoalsAttempted"`
+	MatchupFieldGoalPercentage    float64 `json:"matchupFieldGoalPercentage"`
+	MatchupThreePointersMade      int     `json:"matchupThreePointersMade"`
+	MatchupThreePointersAttempted int     `json:"matchupThreePointersAttempted"`
+	MatchupThreePointerPercentage float64 `json:"matchupThreePointerPercentage"`
+}
+
+// MinutesPlayed parses MatchupMinutes ("35:12").
+func (s BoxScoreDefensiveV3Statistics) MinutesPlayed() (time.Duration, error) {
+	return timeutil.ParseMinutes(s.MatchupMinutes)
+}
+
+type BoxScoreDefensiveV3Response = BoxScoreV3[BoxScoreDefensiveV3Statistics]
+
+// GetBoxScoreDefensiveV3 retrieves the boxscoredefensivev3 endpoint.
+func GetBoxScoreDefensiveV3(ctx context.Context, client *stats.Client, req BoxScoreV3Request) (*models.Response[*BoxScoreDefensiveV3Response], error) {
+	var doc struct {
+		Meta     json.RawMessage              `json:"meta"`
+		BoxScore *BoxScoreDefensiveV3Response `json:"boxScoreDefensive"`
+	}
+	return getBoxScoreV3(ctx, client, "boxscoredefensivev3", req, &doc, &doc.BoxScore)
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/n-ae/nba-api-go/pkg/models"
 	"github.com/n-ae/nba-api-go/pkg/stats"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
 )
 
 // BoxScoreFourFactorsV2Request contains parameters for the BoxScoreFourFactorsV2 endpoint
@@ -21,24 +23,29 @@ type BoxScoreFourFactorsV2Request struct {
 
 // BoxScoreFourFactorsV2PlayerStats represents the PlayerStats result set for BoxScoreFourFactorsV2
 type BoxScoreFourFactorsV2PlayerStats struct {
-	GAME_ID           string  `json:"GAME_ID"`
-	TEAM_ID           int     `json:"TEAM_ID"`
-	TEAM_ABBREVIATION string  `json:"TEAM_ABBREVIATION"`
-	TEAM_CITY         string  `json:"TEAM_CITY"`
-	PLAYER_ID         int     `json:"PLAYER_ID"`
-	PLAYER_NAME       string  `json:"PLAYER_NAME"`
-	NICKNAME          string  `json:"NICKNAME"`
-	START_POSITION    string  `json:"START_POSITION"`
-	COMMENT           string  `json:"COMMENT"`
-	MIN               float64 `json:"MIN"`
-	EFG_PCT           float64 `json:"EFG_PCT"`
-	FTA_RATE          float64 `json:"FTA_RATE"`
-	TM_TOV_PCT        float64 `json:"TM_TOV_PCT"`
-	OREB_PCT          float64 `json:"OREB_PCT"`
-	OPP_EFG_PCT       float64 `json:"OPP_EFG_PCT"`
-	OPP_FTA_RATE      float64 `json:"OPP_FTA_RATE"`
-	OPP_TOV_PCT       float64 `json:"OPP_TOV_PCT"`
-	OPP_OREB_PCT      float64 `json:"OPP_OREB_PCT"`
+	GAME_ID           string              `json:"GAME_ID"`
+	TEAM_ID           int                 `json:"TEAM_ID"`
+	TEAM_ABBREVIATION string              `json:"TEAM_ABBREVIATION"`
+	TEAM_CITY         string              `json:"TEAM_CITY"`
+	PLAYER_ID         int                 `json:"PLAYER_ID"`
+	PLAYER_NAME       string              `json:"PLAYER_NAME"`
+	NICKNAME          string              `json:"NICKNAME"`
+	START_POSITION    string              `json:"START_POSITION"`
+	COMMENT           string              `json:"COMMENT"`
+	MIN               models.Opt[string]  `json:"MIN"`
+	EFG_PCT           models.Opt[float64] `json:"EFG_PCT"`
+	FTA_RATE          models.Opt[float64] `json:"FTA_RATE"`
+	TM_TOV_PCT        models.Opt[float64] `json:"TM_TOV_PCT"`
+	OREB_PCT          models.Opt[float64] `json:"OREB_PCT"`
+	OPP_EFG_PCT       models.Opt[float64] `json:"OPP_EFG_PCT"`
+	OPP_FTA_RATE      models.Opt[float64] `json:"OPP_FTA_RATE"`
+	OPP_TOV_PCT       models.Opt[float64] `json:"OPP_TOV_PCT"`
+	OPP_OREB_PCT      models.Opt[float64] `json:"OPP_OREB_PCT"`
+}
+
+// Minutes parses MIN ("35:12"). It is zero for a player who did not play.
+func (s BoxScoreFourFactorsV2PlayerStats) Minutes() (time.Duration, error) {
+	return timeutil.ParseMinutes(s.MIN.Or(""))
 }
 
 // BoxScoreFourFactorsV2TeamStats represents the TeamStats result set for BoxScoreFourFactorsV2
@@ -48,7 +55,7 @@ type BoxScoreFourFactorsV2TeamStats struct {
 	TEAM_NAME         string  `json:"TEAM_NAME"`
 	TEAM_ABBREVIATION string  `json:"TEAM_ABBREVIATION"`
 	TEAM_CITY         string  `json:"TEAM_CITY"`
-	MIN               float64 `json:"MIN"`
+	MIN               string  `json:"MIN"`
 	EFG_PCT           float64 `json:"EFG_PCT"`
 	FTA_RATE          float64 `json:"FTA_RATE"`
 	TM_TOV_PCT        float64 `json:"TM_TOV_PCT"`
@@ -59,6 +66,11 @@ type BoxScoreFourFactorsV2TeamStats struct {
 	OPP_OREB_PCT      float64 `json:"OPP_OREB_PCT"`
 }
 
+// Minutes parses MIN ("240:00").
+func (s BoxScoreFourFactorsV2TeamStats) Minutes() (time.Duration, error) {
+	return timeutil.ParseMinutes(s.MIN)
+}
+
 // BoxScoreFourFactorsV2Response contains the response data from the BoxScoreFourFactorsV2 endpoint
 type BoxScoreFourFactorsV2Response struct {
 	PlayerStats []BoxScoreFourFactorsV2PlayerStats
@@ -89,63 +101,56 @@ func GetBoxScoreFourFactorsV2(ctx context.Context, client *stats.Client, req Box
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "boxscorefourfactorsv2", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "boxscorefourfactorsv2", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &BoxScoreFourFactorsV2Response{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerStats = make([]BoxScoreFourFactorsV2PlayerStats, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 18 {
-				item := BoxScoreFourFactorsV2PlayerStats{
-					GAME_ID:           toString(row[0]),
-					TEAM_ID:           toInt(row[1]),
-					TEAM_ABBREVIATION: toString(row[2]),
-					TEAM_CITY:         toString(row[3]),
-					PLAYER_ID:         toInt(row[4]),
-					PLAYER_NAME:       toString(row[5]),
-					NICKNAME:          toString(row[6]),
-					START_POSITION:    toString(row[7]),
-					COMMENT:           toString(row[8]),
-					MIN:               toFloat(row[9]),
-					EFG_PCT:           toFloat(row[10]),
-					FTA_RATE:          toFloat(row[11]),
-					TM_TOV_PCT:        toFloat(row[12]),
-					OREB_PCT:          toFloat(row[13]),
-					OPP_EFG_PCT:       toFloat(row[14]),
-					OPP_FTA_RATE:      toFloat(row[15]),
-					OPP_TOV_PCT:       toFloat(row[16]),
-					OPP_OREB_PCT:      toFloat(row[17]),
-				}
-				response.PlayerStats = append(response.PlayerStats, item)
+	if rs := rawResp.resultSet("PlayerStats"); rs != nil {
+		response.PlayerStats = decodeRows(rs, func(row *rowReader) BoxScoreFourFactorsV2PlayerStats {
+			return BoxScoreFourFactorsV2PlayerStats{
+				GAME_ID:           row.String("GAME_ID"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				TEAM_CITY:         row.String("TEAM_CITY"),
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				NICKNAME:          row.String("NICKNAME"),
+				START_POSITION:    row.String("START_POSITION"),
+				COMMENT:           row.String("COMMENT"),
+				MIN:               row.OptString("MIN"),
+				EFG_PCT:           row.OptFloat("EFG_PCT"),
+				FTA_RATE:          row.OptFloat("FTA_RATE"),
+				TM_TOV_PCT:        row.OptFloat("TM_TOV_PCT"),
+				OREB_PCT:          row.OptFloat("OREB_PCT"),
+				OPP_EFG_PCT:       row.OptFloat("OPP_EFG_PCT"),
+				OPP_FTA_RATE:      row.OptFloat("OPP_FTA_RATE"),
+				OPP_TOV_PCT:       row.OptFloat("OPP_TOV_PCT"),
+				OPP_OREB_PCT:      row.OptFloat("OPP_OREB_PCT"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.TeamStats = make([]BoxScoreFourFactorsV2TeamStats, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 14 {
-				item := BoxScoreFourFactorsV2TeamStats{
-					GAME_ID:           toString(row[0]),
-					TEAM_ID:           toInt(row[1]),
-					TEAM_NAME:         toString(row[2]),
-					TEAM_ABBREVIATION: toString(row[3]),
-					TEAM_CITY:         toString(row[4]),
-					MIN:               toFloat(row[5]),
-					EFG_PCT:           toFloat(row[6]),
-					FTA_RATE:          toFloat(row[7]),
-					TM_TOV_PCT:        toFloat(row[8]),
-					OREB_PCT:          toFloat(row[9]),
-					OPP_EFG_PCT:       toFloat(row[10]),
-					OPP_FTA_RATE:      toFloat(row[11]),
-					OPP_TOV_PCT:       toFloat(row[12]),
-					OPP_OREB_PCT:      toFloat(row[13]),
-				}
-				response.TeamStats = append(response.TeamStats, item)
+	if rs := rawResp.resultSet("TeamStats"); rs != nil {
+		response.TeamStats = decodeRows(rs, func(row *rowReader) BoxScoreFourFactorsV2TeamStats {
+			return BoxScoreFourFactorsV2TeamStats{
+				GAME_ID:           row.String("GAME_ID"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_NAME:         row.String("TEAM_NAME"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				TEAM_CITY:         row.String("TEAM_CITY"),
+				MIN:               row.String("MIN"),
+				EFG_PCT:           row.Float("EFG_PCT"),
+				FTA_RATE:          row.Float("FTA_RATE"),
+				TM_TOV_PCT:        row.Float("TM_TOV_PCT"),
+				OREB_PCT:          row.Float("OREB_PCT"),
+				OPP_EFG_PCT:       row.Float("OPP_EFG_PCT"),
+				OPP_FTA_RATE:      row.Float("OPP_FTA_RATE"),
+				OPP_TOV_PCT:       row.Float("OPP_TOV_PCT"),
+				OPP_OREB_PCT:      row.Float("OPP_OREB_PCT"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
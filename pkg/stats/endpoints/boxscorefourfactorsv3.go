@@ -0,0 +1,40 @@
+package endpoints
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/stats"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
+)
+
+// BoxScoreFourFactorsV3Statistics is a four factors box score line of a player or team.
+type BoxScoreFourFactorsV3Statistics struct {
+	Minutes                         string  `json:"minutes"`
+	EffectiveFieldGoalPercentage    float64 `json:"effectiveFieldGoalPercentage"`
+	FreeThrowAttemptRate            float64 `json:"freeThrowAttemptRate"`
+	TeamTurnoverPercentage          float64 `json:"teamTurnoverPercentage"`
+	OffensiveReboundPercentage      float64 `json:"offensiveReboundPercentage"`
+	OppEffectiveFieldGoalPercentage float64 `json:"oppEffectiveFieldGoalPercentage"`
+	OppFreeThrowAttemptRate         float64 `json:"oppFreeThrowAttemptRate"`
+	OppTeamTurnoverPercentage       float64 `json:"oppTeamTurnoverPercentage"`
+	OppOffensiveReboundPercentage   float64 `json:"oppOffensiveReboundPercentage"`
+}
+
+// MinutesPlayed parses Minutes ("35:12").
+func (s BoxScoreFourFactorsV3Statistics) MinutesPlayed() (time.Duration, error) {
+	return timeutil.ParseMinutes(s.Minutes)
+}
+
+type BoxScoreFourFactorsV3Response = BoxScoreV3[BoxScoreFourFactorsV3Statistics]
+
+// GetBoxScoreFourFactorsV3 retrieves the boxscorefourfactorsv3 endpoint.
+func GetBoxScoreFourFactorsV3(ctx context.Context, client *stats.Client, req BoxScoreV3Request) (*models.Response[*BoxScoreFourFactorsV3Response], error) {
+	var doc struct {
+		Meta     json.RawMessage                `json:"meta"`
+		BoxScore *BoxScoreFourFactorsV3Response `json:"boxScoreFourFactors"`
+	}
+	return getBoxScoreV3(ctx, client, "boxscorefourfactorsv3", req, &doc, &doc.BoxScore)
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/n-ae/nba-api-go/pkg/models"
 	"github.com/n-ae/nba-api-go/pkg/stats"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
 )
 
 // BoxScoreHustleV2Request contains parameters for the BoxScoreHustleV2 endpoint
@@ -21,28 +23,33 @@ type BoxScoreHustleV2Request struct {
 
 // BoxScoreHustleV2PlayerStats represents the PlayerStats result set for BoxScoreHustleV2
 type BoxScoreHustleV2PlayerStats struct {
-	GAME_ID                   string  `json:"GAME_ID"`
-	TEAM_ID                   int     `json:"TEAM_ID"`
-	TEAM_ABBREVIATION         string  `json:"TEAM_ABBREVIATION"`
-	TEAM_CITY                 string  `json:"TEAM_CITY"`
-	PLAYER_ID                 int     `json:"PLAYER_ID"`
-	PLAYER_NAME               string  `json:"PLAYER_NAME"`
-	START_POSITION            string  `json:"START_POSITION"`
-	COMMENT                   string  `json:"COMMENT"`
-	MIN                       float64 `json:"MIN"`
-	CONTESTED_SHOTS           string  `json:"CONTESTED_SHOTS"`
-	CONTESTED_SHOTS_2PT       string  `json:"CONTESTED_SHOTS_2PT"`
-	CONTESTED_SHOTS_3PT       string  `json:"CONTESTED_SHOTS_3PT"`
-	DEFLECTIONS               string  `json:"DEFLECTIONS"`
-	CHARGES_DRAWN             string  `json:"CHARGES_DRAWN"`
-	SCREEN_ASSISTS            string  `json:"SCREEN_ASSISTS"`
-	SCREEN_AST_PTS            float64 `json:"SCREEN_AST_PTS"`
-	OFF_LOOSE_BALLS_RECOVERED string  `json:"OFF_LOOSE_BALLS_RECOVERED"`
-	DEF_LOOSE_BALLS_RECOVERED string  `json:"DEF_LOOSE_BALLS_RECOVERED"`
-	LOOSE_BALLS_RECOVERED     string  `json:"LOOSE_BALLS_RECOVERED"`
-	OFF_BOXOUTS               string  `json:"OFF_BOXOUTS"`
-	DEF_BOXOUTS               string  `json:"DEF_BOXOUTS"`
-	BOX_OUTS                  string  `json:"BOX_OUTS"`
+	GAME_ID                   string              `json:"GAME_ID"`
+	TEAM_ID                   int                 `json:"TEAM_ID"`
+	TEAM_ABBREVIATION         string              `json:"TEAM_ABBREVIATION"`
+	TEAM_CITY                 string              `json:"TEAM_CITY"`
+	PLAYER_ID                 int                 `json:"PLAYER_ID"`
+	PLAYER_NAME               string              `json:"PLAYER_NAME"`
+	START_POSITION            string              `json:"START_POSITION"`
+	COMMENT                   string              `json:"COMMENT"`
+	MIN                       models.Opt[string]  `json:"MIN"`
+	CONTESTED_SHOTS           string              `json:"CONTESTED_SHOTS"`
+	CONTESTED_SHOTS_2PT       string              `json:"CONTESTED_SHOTS_2PT"`
+	CONTESTED_SHOTS_3PT       string              `json:"CONTESTED_SHOTS_3PT"`
+	DEFLECTIONS               string              `json:"DEFLECTIONS"`
+	CHARGES_DRAWN             string              `json:"CHARGES_DRAWN"`
+	SCREEN_ASSISTS            string              `json:"SCREEN_ASSISTS"`
+	SCREEN_AST_PTS            models.Opt[float64] `json:"SCREEN_AST_PTS"`
+	OFF_LOOSE_BALLS_RECOVERED string              `json:"OFF_LOOSE_BALLS_RECOVERED"`
+	DEF_LOOSE_BALLS_RECOVERED string              `json:"DEF_LOOSE_BALLS_RECOVERED"`
+	LOOSE_BALLS_RECOVERED     string              `json:"LOOSE_BALLS_RECOVERED"`
+	OFF_BOXOUTS               string              `json:"OFF_BOXOUTS"`
+	DEF_BOXOUTS               string              `json:"DEF_BOXOUTS"`
+	BOX_OUTS                  string              `json:"BOX_OUTS"`
+}
+
+// Minutes parses MIN ("35:12"). It is zero for a player who did not play.
+func (s BoxScoreHustleV2PlayerStats) Minutes() (time.Duration, error) {
+	return timeutil.ParseMinutes(s.MIN.Or(""))
 }
 
 // BoxScoreHustleV2TeamStats represents the TeamStats result set for BoxScoreHustleV2
@@ -52,7 +59,7 @@ type BoxScoreHustleV2TeamStats struct {
 	TEAM_NAME                 string  `json:"TEAM_NAME"`
 	TEAM_ABBREVIATION         string  `json:"TEAM_ABBREVIATION"`
 	TEAM_CITY                 string  `json:"TEAM_CITY"`
-	MIN                       float64 `json:"MIN"`
+	MIN                       string  `json:"MIN"`
 	CONTESTED_SHOTS           string  `json:"CONTESTED_SHOTS"`
 	CONTESTED_SHOTS_2PT       string  `json:"CONTESTED_SHOTS_2PT"`
 	CONTESTED_SHOTS_3PT       string  `json:"CONTESTED_SHOTS_3PT"`
@@ -68,6 +75,11 @@ type BoxScoreHustleV2TeamStats struct {
 	BOX_OUTS                  string  `json:"BOX_OUTS"`
 }
 
+// Minutes parses MIN ("240:00").
+func (s BoxScoreHustleV2TeamStats) Minutes() (time.Duration, error) {
+	return timeutil.ParseMinutes(s.MIN)
+}
+
 // BoxScoreHustleV2Response contains the response data from the BoxScoreHustleV2 endpoint
 type BoxScoreHustleV2Response struct {
 	PlayerStats []BoxScoreHustleV2PlayerStats
@@ -98,72 +110,65 @@ func GetBoxScoreHustleV2(ctx context.Context, client *stats.Client, req BoxScore
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "boxscorehustlev2", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "boxscorehustlev2", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &BoxScoreHustleV2Response{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerStats = make([]BoxScoreHustleV2PlayerStats, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 22 {
-				item := BoxScoreHustleV2PlayerStats{
-					GAME_ID:                   toString(row[0]),
-					TEAM_ID:                   toInt(row[1]),
-					TEAM_ABBREVIATION:         toString(row[2]),
-					TEAM_CITY:                 toString(row[3]),
-					PLAYER_ID:                 toInt(row[4]),
-					PLAYER_NAME:               toString(row[5]),
-					START_POSITION:            toString(row[6]),
-					COMMENT:                   toString(row[7]),
-					MIN:                       toFloat(row[8]),
-					CONTESTED_SHOTS:           toString(row[9]),
-					CONTESTED_SHOTS_2PT:       toString(row[10]),
-					CONTESTED_SHOTS_3PT:       toString(row[11]),
-					DEFLECTIONS:               toString(row[12]),
-					CHARGES_DRAWN:             toString(row[13]),
-					SCREEN_ASSISTS:            toString(row[14]),
-					SCREEN_AST_PTS:            toFloat(row[15]),
-					OFF_LOOSE_BALLS_RECOVERED: toString(row[16]),
-					DEF_LOOSE_BALLS_RECOVERED: toString(row[17]),
-					LOOSE_BALLS_RECOVERED:     toString(row[18]),
-					OFF_BOXOUTS:               toString(row[19]),
-					DEF_BOXOUTS:               toString(row[20]),
-					BOX_OUTS:                  toString(row[21]),
-				}
-				response.PlayerStats = append(response.PlayerStats, item)
+	if rs := rawResp.resultSet("PlayerStats"); rs != nil {
+		response.PlayerStats = decodeRows(rs, func(row *rowReader) BoxScoreHustleV2PlayerStats {
+			return BoxScoreHustleV2PlayerStats{
+				GAME_ID:                   row.String("GAME_ID"),
+				TEAM_ID:                   row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION:         row.String("TEAM_ABBREVIATION"),
+				TEAM_CITY:                 row.String("TEAM_CITY"),
+				PLAYER_ID:                 row.Int("PLAYER_ID"),
+				PLAYER_NAME:               row.String("PLAYER_NAME"),
+				START_POSITION:            row.String("START_POSITION"),
+				COMMENT:                   row.String("COMMENT"),
+				MIN:                       row.OptString("MIN"),
+				CONTESTED_SHOTS:           row.String("CONTESTED_SHOTS"),
+				CONTESTED_SHOTS_2PT:       row.String("CONTESTED_SHOTS_2PT"),
+				CONTESTED_SHOTS_3PT:       row.String("CONTESTED_SHOTS_3PT"),
+				DEFLECTIONS:               row.String("DEFLECTIONS"),
+				CHARGES_DRAWN:             row.String("CHARGES_DRAWN"),
+				SCREEN_ASSISTS:            row.String("SCREEN_ASSISTS"),
+				SCREEN_AST_PTS:            row.OptFloat("SCREEN_AST_PTS"),
+				OFF_LOOSE_BALLS_RECOVERED: row.String("OFF_LOOSE_BALLS_RECOVERED"),
+				DEF_LOOSE_BALLS_RECOVERED: row.String("DEF_LOOSE_BALLS_RECOVERED"),
+				LOOSE_BALLS_RECOVERED:     row.String("LOOSE_BALLS_RECOVERED"),
+				OFF_BOXOUTS:               row.String("OFF_BOXOUTS"),
+				DEF_BOXOUTS:               row.String("DEF_BOXOUTS"),
+				BOX_OUTS:                  row.String("BOX_OUTS"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.TeamStats = make([]BoxScoreHustleV2TeamStats, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 19 {
-				item := BoxScoreHustleV2TeamStats{
-					GAME_ID:                   toString(row[0]),
-					TEAM_ID:                   toInt(row[1]),
-					TEAM_NAME:                 toString(row[2]),
-					TEAM_ABBREVIATION:         toString(row[3]),
-					TEAM_CITY:                 toString(row[4]),
-					MIN:                       toFloat(row[5]),
-					CONTESTED_SHOTS:           toString(row[6]),
-					CONTESTED_SHOTS_2PT:       toString(row[7]),
-					CONTESTED_SHOTS_3PT:       toString(row[8]),
-					DEFLECTIONS:               toString(row[9]),
-					CHARGES_DRAWN:             toString(row[10]),
-					SCREEN_ASSISTS:            toString(row[11]),
-					SCREEN_AST_PTS:            toFloat(row[12]),
-					OFF_LOOSE_BALLS_RECOVERED: toString(row[13]),
-					DEF_LOOSE_BALLS_RECOVERED: toString(row[14]),
-					LOOSE_BALLS_RECOVERED:     toString(row[15]),
-					OFF_BOXOUTS:               toString(row[16]),
-					DEF_BOXOUTS:               toString(row[17]),
-					BOX_OUTS:                  toString(row[18]),
-				}
-				response.TeamStats = append(response.TeamStats, item)
+	if rs := rawResp.resultSet("TeamStats"); rs != nil {
+		response.TeamStats = decodeRows(rs, func(row *rowReader) BoxScoreHustleV2TeamStats {
+			return BoxScoreHustleV2TeamStats{
+				GAME_ID:                   row.String("GAME_ID"),
+				TEAM_ID:                   row.Int("TEAM_ID"),
+				TEAM_NAME:                 row.String("TEAM_NAME"),
+				TEAM_ABBREVIATION:         row.String("TEAM_ABBREVIATION"),
+				TEAM_CITY:                 row.String("TEAM_CITY"),
+				MIN:                       row.String("MIN"),
+				CONTESTED_SHOTS:           row.String("CONTESTED_SHOTS"),
+				CONTESTED_SHOTS_2PT:       row.String("CONTESTED_SHOTS_2PT"),
+				CONTESTED_SHOTS_3PT:       row.String("CONTESTED_SHOTS_3PT"),
+				DEFLECTIONS:               row.String("DEFLECTIONS"),
+				CHARGES_DRAWN:             row.String("CHARGES_DRAWN"),
+				SCREEN_ASSISTS:            row.String("SCREEN_ASSISTS"),
+				SCREEN_AST_PTS:            row.Float("SCREEN_AST_PTS"),
+				OFF_LOOSE_BALLS_RECOVERED: row.String("OFF_LOOSE_BALLS_RECOVERED"),
+				DEF_LOOSE_BALLS_RECOVERED: row.String("DEF_LOOSE_BALLS_RECOVERED"),
+				LOOSE_BALLS_RECOVERED:     row.String("LOOSE_BALLS_RECOVERED"),
+				OFF_BOXOUTS:               row.String("OFF_BOXOUTS"),
+				DEF_BOXOUTS:               row.String("DEF_BOXOUTS"),
+				BOX_OUTS:                  row.String("BOX_OUTS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -0,0 +1,48 @@
+package endpoints
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/stats"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
+)
+
+// BoxScoreHustleV3Statistics is a hustle box score line of a player or team.
+type BoxScoreHustleV3Statistics struct {
+	Minutes                      string `json:"minutes"`
+	Points                       int    `json:"points"`
+	ContestedShots               int    `json:"contestedShots"`
+	ContestedShots2pt            int    `json:"contestedShots2pt"`
+	ContestedShots3pt            int    `json:"contestedShots3pt"`
+	Deflections                  int    `json:"deflections"`
+	ChargesDrawn                 int    `json:"chargesDrawn"`
+	ScreenAssists                int    `json:"screenAssists"`
+	ScreenAssistPoints           int    `json:"screenAssistPoints"`
+	LooseBallsRecoveredOffensive int    `json:"looseBallsRecoveredOffensive"`
+	LooseBallsRecoveredDefensive int    `json:"looseBallsRecoveredDefensive"`
+	LooseBallsRecoveredTotal     int    `json:"looseBallsRecoveredTotal"`
+	OffensiveBoxOuts             int    `json:"offensiveBoxOuts"`
+	DefensiveBoxOuts             int    `json:"defensiveBoxOuts"`
+	BoxOutPlayerTeamRebounds     int    `json:"boxOutPlayerTeamRebounds"`
+	BoxOutPlayerRebounds         int    `json:"boxOutPlayerRebounds"`
+	BoxOuts                      int    `json:"boxOuts"`
+}
+
+// MinutesPlayed parses Minutes ("35:12").
+func (s BoxScoreHustleV3Statistics) MinutesPlayed() (time.Duration, error) {
+	return timeutil.ParseMinutes(s.Minutes)
+}
+
+type BoxScoreHustleV3Response = BoxScoreV3[BoxScoreHustleV3Statistics]
+
+// GetBoxScoreHustleV3 retrieves the boxscorehustlev3 endpoint.
+func GetBoxScoreHustleV3(ctx context.Context, client *stats.Client, req BoxScoreV3Request) (*models.Response[*BoxScoreHustleV3Response], error) {
+	var doc struct {
+		Meta     json.RawMessage           `json:"meta"`
+		BoxScore *BoxScoreHustleV3Response `json:"boxScoreHustle"`
+	}
+	return getBoxScoreV3(ctx, client, "boxscorehustlev3", req, &doc, &doc.BoxScore)
+}
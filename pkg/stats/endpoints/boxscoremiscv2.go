@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/n-ae/nba-api-go/pkg/models"
 	"github.com/n-ae/nba-api-go/pkg/stats"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
 )
 
 // BoxScoreMiscV2Request contains parameters for the BoxScoreMiscV2 endpoint
@@ -21,28 +23,33 @@ type BoxScoreMiscV2Request struct {
 
 // BoxScoreMiscV2PlayerStats represents the PlayerStats result set for BoxScoreMiscV2
 type BoxScoreMiscV2PlayerStats struct {
-	GAME_ID            string  `json:"GAME_ID"`
-	TEAM_ID            int     `json:"TEAM_ID"`
-	TEAM_ABBREVIATION  string  `json:"TEAM_ABBREVIATION"`
-	TEAM_CITY          string  `json:"TEAM_CITY"`
-	PLAYER_ID          int     `json:"PLAYER_ID"`
-	PLAYER_NAME        string  `json:"PLAYER_NAME"`
-	NICKNAME           string  `json:"NICKNAME"`
-	START_POSITION     string  `json:"START_POSITION"`
-	COMMENT            string  `json:"COMMENT"`
-	MIN                float64 `json:"MIN"`
-	PTS_OFF_TOV        float64 `json:"PTS_OFF_TOV"`
-	PTS_2ND_CHANCE     float64 `json:"PTS_2ND_CHANCE"`
-	PTS_FB             float64 `json:"PTS_FB"`
-	PTS_PAINT          float64 `json:"PTS_PAINT"`
-	OPP_PTS_OFF_TOV    float64 `json:"OPP_PTS_OFF_TOV"`
-	OPP_PTS_2ND_CHANCE float64 `json:"OPP_PTS_2ND_CHANCE"`
-	OPP_PTS_FB         float64 `json:"OPP_PTS_FB"`
-	OPP_PTS_PAINT      float64 `json:"OPP_PTS_PAINT"`
-	BLK                float64 `json:"BLK"`
-	BLKA               int     `json:"BLKA"`
-	PF                 float64 `json:"PF"`
-	PFD                float64 `json:"PFD"`
+	GAME_ID            string              `json:"GAME_ID"`
+	TEAM_ID            int                 `json:"TEAM_ID"`
+	TEAM_ABBREVIATION  string              `json:"TEAM_ABBREVIATION"`
+	TEAM_CITY          string              `json:"TEAM_CITY"`
+	PLAYER_ID          int                 `json:"PLAYER_ID"`
+	PLAYER_NAME        string              `json:"PLAYER_NAME"`
+	NICKNAME           string              `json:"NICKNAME"`
+	START_POSITION     string              `json:"START_POSITION"`
+	COMMENT            string              `json:"COMMENT"`
+	MIN                models.Opt[string]  `json:"MIN"`
+	PTS_OFF_TOV        models.Opt[float64] `json:"PTS_OFF_TOV"`
+	PTS_2ND_CHANCE     models.Opt[float64] `json:"PTS_2ND_CHANCE"`
+	PTS_FB             models.Opt[float64] `json:"PTS_FB"`
+	PTS_PAINT          models.Opt[float64] `json:"PTS_PAINT"`
+	OPP_PTS_OFF_TOV    models.Opt[float64] `json:"OPP_PTS_OFF_TOV"`
+	OPP_PTS_2ND_CHANCE models.Opt[float64] `json:"OPP_PTS_2ND_CHANCE"`
+	OPP_PTS_FB         models.Opt[float64] `json:"OPP_PTS_FB"`
+	OPP_PTS_PAINT      models.Opt[float64] `json:"OPP_PTS_PAINT"`
+	BLK                models.Opt[float64] `json:"BLK"`
+	BLKA               models.Opt[int]     `json:"BLKA"`
+	PF                 models.Opt[float64] `json:"PF"`
+	PFD                models.Opt[float64] `json:"PFD"`
+}
+
+// Minutes parses MIN ("35:12"). It is zero for a player who did not play.
+func (s BoxScoreMiscV2PlayerStats) Minutes() (time.Duration, error) {
+	return timeutil.ParseMinutes(s.MIN.Or(""))
 }
 
 // BoxScoreMiscV2TeamStats represents the TeamStats result set for BoxScoreMiscV2
@@ -52,7 +59,7 @@ type BoxScoreMiscV2TeamStats struct {
 	TEAM_NAME          string  `json:"TEAM_NAME"`
 	TEAM_ABBREVIATION  string  `json:"TEAM_ABBREVIATION"`
 	TEAM_CITY          string  `json:"TEAM_CITY"`
-	MIN                float64 `json:"MIN"`
+	MIN                string  `json:"MIN"`
 	PTS_OFF_TOV        float64 `json:"PTS_OFF_TOV"`
 	PTS_2ND_CHANCE     float64 `json:"PTS_2ND_CHANCE"`
 	PTS_FB             float64 `json:"PTS_FB"`
@@ -67,6 +74,11 @@ type BoxScoreMiscV2TeamStats struct {
 	PFD                float64 `json:"PFD"`
 }
 
+// Minutes parses MIN ("240:00").
+func (s BoxScoreMiscV2TeamStats) Minutes() (time.Duration, error) {
+	return timeutil.ParseMinutes(s.MIN)
+}
+
 // BoxScoreMiscV2Response contains the response data from the BoxScoreMiscV2 endpoint
 type BoxScoreMiscV2Response struct {
 	PlayerStats []BoxScoreMiscV2PlayerStats
@@ -97,71 +109,64 @@ func GetBoxScoreMiscV2(ctx context.Context, client *stats.Client, req BoxScoreMi
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "boxscoremiscv2", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "boxscoremiscv2", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &BoxScoreMiscV2Response{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerStats = make([]BoxScoreMiscV2PlayerStats, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 22 {
-				item := BoxScoreMiscV2PlayerStats{
-					GAME_ID:            toString(row[0]),
-					TEAM_ID:            toInt(row[1]),
-					TEAM_ABBREVIATION:  toString(row[2]),
-					TEAM_CITY:          toString(row[3]),
-					PLAYER_ID:          toInt(row[4]),
-					PLAYER_NAME:        toString(row[5]),
-					NICKNAME:           toString(row[6]),
-					START_POSITION:     toString(row[7]),
-					COMMENT:            toString(row[8]),
-					MIN:                toFloat(row[9]),
-					PTS_OFF_TOV:        toFloat(row[10]),
-					PTS_2ND_CHANCE:     toFloat(row[11]),
-					PTS_FB:             toFloat(row[12]),
-					PTS_PAINT:          toFloat(row[13]),
-					OPP_PTS_OFF_TOV:    toFloat(row[14]),
-					OPP_PTS_2ND_CHANCE: toFloat(row[15]),
-					OPP_PTS_FB:         toFloat(row[16]),
-					OPP_PTS_PAINT:      toFloat(row[17]),
-					BLK:                toFloat(row[18]),
-					BLKA:               toInt(row[19]),
-					PF:                 toFloat(row[20]),
-					PFD:                toFloat(row[21]),
-				}
-				response.PlayerStats = append(response.PlayerStats, item)
+	if rs := rawResp.resultSet("PlayerStats"); rs != nil {
+		response.PlayerStats = decodeRows(rs, func(row *rowReader) BoxScoreMiscV2PlayerStats {
+			return BoxScoreMiscV2PlayerStats{
+				GAME_ID:            row.String("GAME_ID"),
+				TEAM_ID:            row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION:  row.String("TEAM_ABBREVIATION"),
+				TEAM_CITY:          row.String("TEAM_CITY"),
+				PLAYER_ID:          row.Int("PLAYER_ID"),
+				PLAYER_NAME:        row.String("PLAYER_NAME"),
+				NICKNAME:           row.String("NICKNAME"),
+				START_POSITION:     row.String("START_POSITION"),
+				COMMENT:            row.String("COMMENT"),
+				MIN:                row.OptString("MIN"),
+				PTS_OFF_TOV:        row.OptFloat("PTS_OFF_TOV"),
+				PTS_2ND_CHANCE:     row.OptFloat("PTS_2ND_CHANCE"),
+				PTS_FB:             row.OptFloat("PTS_FB"),
+				PTS_PAINT:          row.OptFloat("PTS_PAINT"),
+				OPP_PTS_OFF_TOV:    row.OptFloat("OPP_PTS_OFF_TOV"),
+				OPP_PTS_2ND_CHANCE: row.OptFloat("OPP_PTS_2ND_CHANCE"),
+				OPP_PTS_FB:         row.OptFloat("OPP_PTS_FB"),
+				OPP_PTS_PAINT:      row.OptFloat("OPP_PTS_PAINT"),
+				BLK:                row.OptFloat("BLK"),
+				BLKA:               row.OptInt("BLKA"),
+				PF:                 row.OptFloat("PF"),
+				PFD:                row.OptFloat("PFD"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.TeamStats = make([]BoxScoreMiscV2TeamStats, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 18 {
-				item := BoxScoreMiscV2TeamStats{
-					GAME_ID:            toString(row[0]),
-					TEAM_ID:            toInt(row[1]),
-					TEAM_NAME:          toString(row[2]),
-					TEAM_ABBREVIATION:  toString(row[3]),
-					TEAM_CITY:          toString(row[4]),
-					MIN:                toFloat(row[5]),
-					PTS_OFF_TOV:        toFloat(row[6]),
-					PTS_2ND_CHANCE:     toFloat(row[7]),
-					PTS_FB:             toFloat(row[8]),
-					PTS_PAINT:          toFloat(row[9]),
-					OPP_PTS_OFF_TOV:    toFloat(row[10]),
-					OPP_PTS_2ND_CHANCE: toFloat(row[11]),
-					OPP_PTS_FB:         toFloat(row[12]),
-					OPP_PTS_PAINT:      toFloat(row[13]),
-					BLK:                toFloat(row[14]),
-					BLKA:               toInt(row[15]),
-					PF:                 toFloat(row[16]),
-					PFD:                toFloat(row[17]),
-				}
-				response.TeamStats = append(response.TeamStats, item)
+	if rs := rawResp.resultSet("TeamStats"); rs != nil {
+		response.TeamStats = decodeRows(rs, func(row *rowReader) BoxScoreMiscV2TeamStats {
+			return BoxScoreMiscV2TeamStats{
+				GAME_ID:            row.String("GAME_ID"),
+				TEAM_ID:            row.Int("TEAM_ID"),
+				TEAM_NAME:          row.String("TEAM_NAME"),
+				TEAM_ABBREVIATION:  row.String("TEAM_ABBREVIATION"),
+				TEAM_CITY:          row.String("TEAM_CITY"),
+				MIN:                row.String("MIN"),
+				PTS_OFF_TOV:        row.Float("PTS_OFF_TOV"),
+				PTS_2ND_CHANCE:     row.Float("PTS_2ND_CHANCE"),
+				PTS_FB:             row.Float("PTS_FB"),
+				PTS_PAINT:          row.Float("PTS_PAINT"),
+				OPP_PTS_OFF_TOV:    row.Float("OPP_PTS_OFF_TOV"),
+				OPP_PTS_2ND_CHANCE: row.Float("OPP_PTS_2ND_CHANCE"),
+				OPP_PTS_FB:         row.Float("OPP_PTS_FB"),
+				OPP_PTS_PAINT:      row.Float("OPP_PTS_PAINT"),
+				BLK:                row.Float("BLK"),
+				BLKA:               row.Int("BLKA"),
+				PF:                 row.Float("PF"),
+				PFD:                row.Float("PFD"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
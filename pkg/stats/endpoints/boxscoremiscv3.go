@@ -0,0 +1,44 @@
+package endpoints
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/stats"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
+)
+
+// BoxScoreMiscV3Statistics is a misc box score line of a player or team.
+type BoxScoreMiscV3Statistics struct {
+	Minutes               string `json:"minutes"`
+	PointsOffTurnovers    int    `json:"pointsOffTurnovers"`
+	PointsSecondChance    int    `json:"pointsSecondChance"`
+	PointsFastBreak       int    `json:"pointsFastBreak"`
+	PointsPaint           int    `json:"pointsPaint"`
+	OppPointsOffTurnovers int    `json:"oppPointsOffTurnovers"`
+	OppPointsSecondChance int    `json:"oppPointsSecondChance"`
+	OppPointsFastBreak    int    `json:"oppPointsFastBreak"`
+	OppPointsPaint        int    `json:"oppPointsPaint"`
+	Blocks                int    `json:"blocks"`
+	BlocksAgainst         int    `json:"blocksAgainst"`
+	FoulsPersonal         int    `json:"foulsPersonal"`
+	FoulsDrawn            int    `json:"foulsDrawn"`
+}
+
+// MinutesPlayed parses Minutes ("35:12").
+func (s BoxScoreMiscV3Statistics) MinutesPlayed() (time.Duration, error) {
+	return timeutil.ParseMinutes(s.Minutes)
+}
+
+type BoxScoreMiscV3Response = BoxScoreV3[BoxScoreMiscV3Statistics]
+
+// GetBoxScoreMiscV3 retrieves the boxscoremiscv3 endpoint.
+func GetBoxScoreMiscV3(ctx context.Context, client *stats.Client, req BoxScoreV3Request) (*models.Response[*BoxScoreMiscV3Response], error) {
+	var doc struct {
+		Meta     json.RawMessage         `json:"meta"`
+		BoxScore *BoxScoreMiscV3Response `json:"boxScoreMisc"`
+	}
+	return getBoxScoreV3(ctx, client, "boxscoremiscv3", req, &doc, &doc.BoxScore)
+}
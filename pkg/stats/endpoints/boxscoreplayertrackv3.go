@@ -0,0 +1,52 @@
+package endpoints
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/stats"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
+)
+
+// BoxScorePlayerTrackV3Statistics is a player tracking box score line of a player or team.
+type BoxScorePlayerTrackV3Statistics struct {
+	Minutes                          string  `json:"minutes"`
+	Speed                            float64 `json:"speed"`
+	Distance                         float64 `json:"distance"`
+	ReboundChancesOffensive          int     `json:"reboundChancesOffensive"`
+	ReboundChancesDefensive          int     `json:"reboundChancesDefensive"`
+	ReboundChancesTotal              int     `json:"reboundChancesTotal"`
+	Touches                          int     `json:"touches"`
+	SecondaryAssists                 int     `json:"secondaryAssists"`
+	FreeThrowAssists                 int     `json:"freeThrowAssists"`
+	Passes                           int     `json:"passes"`
+	Assists                          int     `json:"assists"`
+	ContestedFieldGoalsMade          int     `json:"contestedFieldGoalsMade"`
+	ContestedFieldGoalsAttempted     int     `json:"contestedFieldGoalsAttempted"`
+	ContestedFieldGoalPercentage     float64 `json:"contestedFieldGoalPercentage"`
+	UncontestedFieldGoalsMade        int     `json:"uncontestedFieldGoalsMade"`
+	UncontestedFieldGoalsAttempted   int     `json:"uncontestedFieldGoalsAttempted"`
+	UncontestedFieldGoalsPercentage  float64 `json:"uncontestedFieldGoalsPercentage"`
+	FieldGoalPercentage              float64 `json:"fieldGoalPercentage"`
+	DefendedAtRimFieldGoalsMade      int     `json:"defendedAtRimFieldGoalsMade"`
+	DefendedAtRimFieldGoalsAttempted int     `json:"defendedAtRimFieldGoalsAttempted"`
+	DefendedAtRimFieldGoalPercentage float64 `json:"defendedAtRimFieldGoalPercentage"`
+}
+
+// MinutesPlayed parses Minutes ("35:12").
+func (s BoxScorePlayerTrackV3Statistics) MinutesPlayed() (time.Duration, error) {
+	return timeutil.ParseMinutes(s.Minutes)
+}
+
+type BoxScorePlayerTrackV3Response = BoxScoreV3[BoxScorePlayerTrackV3Statistics]
+
+// GetBoxScorePlayerTrackV3 retrieves the boxscoreplayertrackv3 endpoint.
+func GetBoxScorePlayerTrackV3(ctx context.Context, client *stats.Client, req BoxScoreV3Request) (*models.Response[*BoxScorePlayerTrackV3Response], error) {
+	var doc struct {
+		Meta     json.RawMessage                `json:"meta"`
+		BoxScore *BoxScorePlayerTrackV3Response `json:"boxScorePlayerTrack"`
+	}
+	return getBoxScoreV3(ctx, client, "boxscoreplayertrackv3", req, &doc, &doc.BoxScore)
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/n-ae/nba-api-go/pkg/models"
 	"github.com/n-ae/nba-api-go/pkg/stats"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
 )
 
 // BoxScoreScoringV2Request contains parameters for the BoxScoreScoringV2 endpoint
@@ -21,31 +23,36 @@ type BoxScoreScoringV2Request struct {
 
 // BoxScoreScoringV2PlayerStats represents the PlayerStats result set for BoxScoreScoringV2
 type BoxScoreScoringV2PlayerStats struct {
-	GAME_ID           string  `json:"GAME_ID"`
-	TEAM_ID           int     `json:"TEAM_ID"`
-	TEAM_ABBREVIATION string  `json:"TEAM_ABBREVIATION"`
-	TEAM_CITY         string  `json:"TEAM_CITY"`
-	PLAYER_ID         int     `json:"PLAYER_ID"`
-	PLAYER_NAME       string  `json:"PLAYER_NAME"`
-	NICKNAME          string  `json:"NICKNAME"`
-	START_POSITION    string  `json:"START_POSITION"`
-	COMMENT           string  `json:"COMMENT"`
-	MIN               float64 `json:"MIN"`
-	PCT_FGA_2PT       float64 `json:"PCT_FGA_2PT"`
-	PCT_FGA_3PT       float64 `json:"PCT_FGA_3PT"`
-	PCT_PTS_2PT       float64 `json:"PCT_PTS_2PT"`
-	PCT_PTS_2PT_MR    float64 `json:"PCT_PTS_2PT_MR"`
-	PCT_PTS_3PT       float64 `json:"PCT_PTS_3PT"`
-	PCT_PTS_FB        float64 `json:"PCT_PTS_FB"`
-	PCT_PTS_FT        float64 `json:"PCT_PTS_FT"`
-	PCT_PTS_OFF_TOV   float64 `json:"PCT_PTS_OFF_TOV"`
-	PCT_PTS_PAINT     float64 `json:"PCT_PTS_PAINT"`
-	PCT_AST_2PM       int     `json:"PCT_AST_2PM"`
-	PCT_UAST_2PM      int     `json:"PCT_UAST_2PM"`
-	PCT_AST_3PM       int     `json:"PCT_AST_3PM"`
-	PCT_UAST_3PM      int     `json:"PCT_UAST_3PM"`
-	PCT_AST_FGM       int     `json:"PCT_AST_FGM"`
-	PCT_UAST_FGM      int     `json:"PCT_UAST_FGM"`
+	GAME_ID           string              `json:"GAME_ID"`
+	TEAM_ID           int                 `json:"TEAM_ID"`
+	TEAM_ABBREVIATION string              `json:"TEAM_ABBREVIATION"`
+	TEAM_CITY         string              `json:"TEAM_CITY"`
+	PLAYER_ID         int                 `json:"PLAYER_ID"`
+	PLAYER_NAME       string              `json:"PLAYER_NAME"`
+	NICKNAME          string              `json:"NICKNAME"`
+	START_POSITION    string              `json:"START_POSITION"`
+	COMMENT           string              `json:"COMMENT"`
+	MIN               models.Opt[string]  `json:"MIN"`
+	PCT_FGA_2PT       models.Opt[float64] `json:"PCT_FGA_2PT"`
+	PCT_FGA_3PT       models.Opt[float64] `json:"PCT_FGA_3PT"`
+	PCT_PTS_2PT       models.Opt[float64] `json:"PCT_PTS_2PT"`
+	PCT_PTS_2PT_MR    models.Opt[float64] `json:"PCT_PTS_2PT_MR"`
+	PCT_PTS_3PT       models.Opt[float64] `json:"PCT_PTS_3PT"`
+	PCT_PTS_FB        models.Opt[float64] `json:"PCT_PTS_FB"`
+	PCT_PTS_FT        models.Opt[float64] `json:"PCT_PTS_FT"`
+	PCT_PTS_OFF_TOV   models.Opt[float64] `json:"PCT_PTS_OFF_TOV"`
+	PCT_PTS_PAINT     models.Opt[float64] `json:"PCT_PTS_PAINT"`
+	PCT_AST_2PM       models.Opt[int]     `json:"PCT_AST_2PM"`
+	PCT_UAST_2PM      models.Opt[int]     `json:"PCT_UAST_2PM"`
+	PCT_AST_3PM       models.Opt[int]     `json:"PCT_AST_3PM"`
+	PCT_UAST_3PM      models.Opt[int]     `json:"PCT_UAST_3PM"`
+	PCT_AST_FGM       models.Opt[int]     `json:"PCT_AST_FGM"`
+	PCT_UAST_FGM      models.Opt[int]     `json:"PCT_UAST_FGM"`
+}
+
+// Minutes parses MIN ("35:12"). It is zero for a player who did not play.
+func (s BoxScoreScoringV2PlayerStats) Minutes() (time.Duration, error) {
+	return timeutil.ParseMinutes(s.MIN.Or(""))
 }
 
 // BoxScoreScoringV2TeamStats represents the TeamStats result set for BoxScoreScoringV2
@@ -55,7 +62,7 @@ type BoxScoreScoringV2TeamStats struct {
 	TEAM_NAME         string  `json:"TEAM_NAME"`
 	TEAM_ABBREVIATION string  `json:"TEAM_ABBREVIATION"`
 	TEAM_CITY         string  `json:"TEAM_CITY"`
-	MIN               float64 `json:"MIN"`
+	MIN               string  `json:"MIN"`
 	PCT_FGA_2PT       float64 `json:"PCT_FGA_2PT"`
 	PCT_FGA_3PT       float64 `json:"PCT_FGA_3PT"`
 	PCT_PTS_2PT       float64 `json:"PCT_PTS_2PT"`
@@ -73,6 +80,11 @@ type BoxScoreScoringV2TeamStats struct {
 	PCT_UAST_FGM      int     `json:"PCT_UAST_FGM"`
 }
 
+// Minutes parses MIN ("240:00").
+func (s BoxScoreScoringV2TeamStats) Minutes() (time.Duration, error) {
+	return timeutil.ParseMinutes(s.MIN)
+}
+
 // BoxScoreScoringV2Response contains the response data from the BoxScoreScoringV2 endpoint
 type BoxScoreScoringV2Response struct {
 	PlayerStats []BoxScoreScoringV2PlayerStats
@@ -103,77 +115,70 @@ func GetBoxScoreScoringV2(ctx context.Context, client *stats.Client, req BoxScor
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "boxscorescoringv2", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "boxscorescoringv2", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &BoxScoreScoringV2Response{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerStats = make([]BoxScoreScoringV2PlayerStats, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 25 {
-				item := BoxScoreScoringV2PlayerStats{
-					GAME_ID:           toString(row[0]),
-					TEAM_ID:           toInt(row[1]),
-					TEAM_ABBREVIATION: toString(row[2]),
-					TEAM_CITY:         toString(row[3]),
-					PLAYER_ID:         toInt(row[4]),
-					PLAYER_NAME:       toString(row[5]),
-					NICKNAME:          toString(row[6]),
-					START_POSITION:    toString(row[7]),
-					COMMENT:           toString(row[8]),
-					MIN:               toFloat(row[9]),
-					PCT_FGA_2PT:       toFloat(row[10]),
-					PCT_FGA_3PT:       toFloat(row[11]),
-					PCT_PTS_2PT:       toFloat(row[12]),
-					PCT_PTS_2PT_MR:    toFloat(row[13]),
-					PCT_PTS_3PT:       toFloat(row[14]),
-					PCT_PTS_FB:        toFloat(row[15]),
-					PCT_PTS_FT:        toFloat(row[16]),
-					PCT_PTS_OFF_TOV:   toFloat(row[17]),
-					PCT_PTS_PAINT:     toFloat(row[18]),
-					PCT_AST_2PM:       toInt(row[19]),
-					PCT_UAST_2PM:      toInt(row[20]),
-					PCT_AST_3PM:       toInt(row[21]),
-					PCT_UAST_3PM:      toInt(row[22]),
-					PCT_AST_FGM:       toInt(row[23]),
-					PCT_UAST_FGM:      toInt(row[24]),
-				}
-				response.PlayerStats = append(response.PlayerStats, item)
+	if rs := rawResp.resultSet("PlayerStats"); rs != nil {
+		response.PlayerStats = decodeRows(rs, func(row *rowReader) BoxScoreScoringV2PlayerStats {
+			return BoxScoreScoringV2PlayerStats{
+				GAME_ID:           row.String("GAME_ID"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				TEAM_CITY:         row.String("TEAM_CITY"),
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				NICKNAME:          row.String("NICKNAME"),
+				START_POSITION:    row.String("START_POSITION"),
+				COMMENT:           row.String("COMMENT"),
+				MIN:               row.OptString("MIN"),
+				PCT_FGA_2PT:       row.OptFloat("PCT_FGA_2PT"),
+				PCT_FGA_3PT:       row.OptFloat("PCT_FGA_3PT"),
+				PCT_PTS_2PT:       row.OptFloat("PCT_PTS_2PT"),
+				PCT_PTS_2PT_MR:    row.OptFloat("PCT_PTS_2PT_MR"),
+				PCT_PTS_3PT:       row.OptFloat("PCT_PTS_3PT"),
+				PCT_PTS_FB:        row.OptFloat("PCT_PTS_FB"),
+				PCT_PTS_FT:        row.OptFloat("PCT_PTS_FT"),
+				PCT_PTS_OFF_TOV:   row.OptFloat("PCT_PTS_OFF_TOV"),
+				PCT_PTS_PAINT:     row.OptFloat("PCT_PTS_PAINT"),
+				PCT_AST_2PM:       row.OptInt("PCT_AST_2PM"),
+				PCT_UAST_2PM:      row.OptInt("PCT_UAST_2PM"),
+				PCT_AST_3PM:       row.OptInt("PCT_AST_3PM"),
+				PCT_UAST_3PM:      row.OptInt("PCT_UAST_3PM"),
+				PCT_AST_FGM:       row.OptInt("PCT_AST_FGM"),
+				PCT_UAST_FGM:      row.OptInt("PCT_UAST_FGM"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.TeamStats = make([]BoxScoreScoringV2TeamStats, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 21 {
-				item := BoxScoreScoringV2TeamStats{
-					GAME_ID:           toString(row[0]),
-					TEAM_ID:           toInt(row[1]),
-					TEAM_NAME:         toString(row[2]),
-					TEAM_ABBREVIATION: toString(row[3]),
-					TEAM_CITY:         toString(row[4]),
-					MIN:               toFloat(row[5]),
-					PCT_FGA_2PT:       toFloat(row[6]),
-					PCT_FGA_3PT:       toFloat(row[7]),
-					PCT_PTS_2PT:       toFloat(row[8]),
-					PCT_PTS_2PT_MR:    toFloat(row[9]),
-					PCT_PTS_3PT:       toFloat(row[10]),
-					PCT_PTS_FB:        toFloat(row[11]),
-					PCT_PTS_FT:        toFloat(row[12]),
-					PCT_PTS_OFF_TOV:   toFloat(row[13]),
-					PCT_PTS_PAINT:     toFloat(row[14]),
-					PCT_AST_2PM:       toInt(row[15]),
-					PCT_UAST_2PM:      toInt(row[16]),
-					PCT_AST_3PM:       toInt(row[17]),
-					PCT_UAST_3PM:      toInt(row[18]),
-					PCT_AST_FGM:       toInt(row[19]),
-					PCT_UAST_FGM:      toInt(row[20]),
-				}
-				response.TeamStats = append(response.TeamStats, item)
+	if rs := rawResp.resultSet("TeamStats"); rs != nil {
+		response.TeamStats = decodeRows(rs, func(row *rowReader) BoxScoreScoringV2TeamStats {
+			return BoxScoreScoringV2TeamStats{
+				GAME_ID:           row.String("GAME_ID"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_NAME:         row.String("TEAM_NAME"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				TEAM_CITY:         row.String("TEAM_CITY"),
+				MIN:               row.String("MIN"),
+				PCT_FGA_2PT:       row.Float("PCT_FGA_2PT"),
+				PCT_FGA_3PT:       row.Float("PCT_FGA_3PT"),
+				PCT_PTS_2PT:       row.Float("PCT_PTS_2PT"),
+				PCT_PTS_2PT_MR:    row.Float("PCT_PTS_2PT_MR"),
+				PCT_PTS_3PT:       row.Float("PCT_PTS_3PT"),
+				PCT_PTS_FB:        row.Float("PCT_PTS_FB"),
+				PCT_PTS_FT:        row.Float("PCT_PTS_FT"),
+				PCT_PTS_OFF_TOV:   row.Float("PCT_PTS_OFF_TOV"),
+				PCT_PTS_PAINT:     row.Float("PCT_PTS_PAINT"),
+				PCT_AST_2PM:       row.Int("PCT_AST_2PM"),
+				PCT_UAST_2PM:      row.Int("PCT_UAST_2PM"),
+				PCT_AST_3PM:       row.Int("PCT_AST_3PM"),
+				PCT_UAST_3PM:      row.Int("PCT_UAST_3PM"),
+				PCT_AST_FGM:       row.Int("PCT_AST_FGM"),
+				PCT_UAST_FGM:      row.Int("PCT_UAST_FGM"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -0,0 +1,47 @@
+package endpoints
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/stats"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
+)
+
+// BoxScoreScoringV3Statistics is a scoring box score line of a player or team.
+type BoxScoreScoringV3Statistics struct {
+	Minutes                          string  `json:"minutes"`
+	PercentageFieldGoalsAttempted2pt float64 `json:"percentageFieldGoalsAttempted2pt"`
+	PercentageFieldGoalsAttempted3pt float64 `json:"percentageFieldGoalsAttempted3pt"`
+	PercentagePoints2pt              float64 `json:"percentagePoints2pt"`
+	PercentagePointsMidrange2pt      float64 `json:"percentagePointsMidrange2pt"`
+	PercentagePoints3pt              float64 `json:"percentagePoints3pt"`
+	PercentagePointsFastBreak        float64 `json:"percentagePointsFastBreak"`
+	PercentagePointsFreeThrow        float64 `json:"percentagePointsFreeThrow"`
+	PercentagePointsOffTurnovers     float64 `json:"percentagePointsOffTurnovers"`
+	PercentagePointsPaint            float64 `json:"percentagePointsPaint"`
+	PercentageAssisted2pt            float64 `json:"percentageAssisted2pt"`
+	PercentageUnassisted2pt          float64 `json:"percentageUnassisted2pt"`
+	PercentageAssisted3pt            float64 `json:"percentageAssisted3pt"`
+	PercentageUnassisted3pt          float64 `json:"percentageUnassisted3pt"`
+	PercentageAssistedFGM            float64 `json:"percentageAssistedFGM"`
+	PercentageUnassistedFGM          float64 `json:"percentageUnassistedFGM"`
+}
+
+// MinutesPlayed parses Minutes ("35:12").
+func (s BoxScoreScoringV3Statistics) MinutesPlayed() (time.Duration, error) {
+	return timeutil.ParseMinutes(s.Minutes)
+}
+
+type BoxScoreScoringV3Response = BoxScoreV3[BoxScoreScoringV3Statistics]
+
+// GetBoxScoreScoringV3 retrieves the boxscorescoringv3 endpoint.
+func GetBoxScoreScoringV3(ctx context.Context, client *stats.Client, req BoxScoreV3Request) (*models.Response[*BoxScoreScoringV3Response], error) {
+	var doc struct {
+		Meta     json.RawMessage            `json:"meta"`
+		BoxScore *BoxScoreScoringV3Response `json:"boxScoreScoring"`
+	}
+	return getBoxScoreV3(ctx, client, "boxscorescoringv3", req, &doc, &doc.BoxScore)
+}
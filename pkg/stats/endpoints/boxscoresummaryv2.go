@@ -165,185 +165,159 @@ func GetBoxScoreSummaryV2(ctx context.Context, client *stats.Client, req BoxScor
 	params.Set("GameID", string(req.GameID))
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "boxscoresummaryv2", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "boxscoresummaryv2", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &BoxScoreSummaryV2Response{}
-	if len(rawResp.ResultSets) > 0 {
-		response.GameSummary = make([]BoxScoreSummaryV2GameSummary, len(rawResp.ResultSets[0].RowSet))
-		for i, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 14 {
-				response.GameSummary[i] = BoxScoreSummaryV2GameSummary{
-					GAME_DATE_EST:                    row[0],
-					GAME_SEQUENCE:                    row[1],
-					GAME_ID:                          row[2],
-					GAME_STATUS_ID:                   row[3],
-					GAME_STATUS_TEXT:                 row[4],
-					GAMECODE:                         row[5],
-					HOME_TEAM_ID:                     row[6],
-					VISITOR_TEAM_ID:                  row[7],
-					SEASON:                           row[8],
-					LIVE_PERIOD:                      row[9],
-					LIVE_PC_TIME:                     row[10],
-					NATL_TV_BROADCASTER_ABBREVIATION: row[11],
-					LIVE_PERIOD_TIME_BCAST:           row[12],
-					WH_STATUS:                        row[13],
-				}
+	if rs := rawResp.resultSet("GameSummary"); rs != nil {
+		response.GameSummary = decodeRows(rs, func(row *rowReader) BoxScoreSummaryV2GameSummary {
+			return BoxScoreSummaryV2GameSummary{
+				GAME_DATE_EST:                    row.Value("GAME_DATE_EST"),
+				GAME_SEQUENCE:                    row.Value("GAME_SEQUENCE"),
+				GAME_ID:                          row.Value("GAME_ID"),
+				GAME_STATUS_ID:                   row.Value("GAME_STATUS_ID"),
+				GAME_STATUS_TEXT:                 row.Value("GAME_STATUS_TEXT"),
+				GAMECODE:                         row.Value("GAMECODE"),
+				HOME_TEAM_ID:                     row.Value("HOME_TEAM_ID"),
+				VISITOR_TEAM_ID:                  row.Value("VISITOR_TEAM_ID"),
+				SEASON:                           row.Value("SEASON"),
+				LIVE_PERIOD:                      row.Value("LIVE_PERIOD"),
+				LIVE_PC_TIME:                     row.Value("LIVE_PC_TIME"),
+				NATL_TV_BROADCASTER_ABBREVIATION: row.Value("NATL_TV_BROADCASTER_ABBREVIATION"),
+				LIVE_PERIOD_TIME_BCAST:           row.Value("LIVE_PERIOD_TIME_BCAST"),
+				WH_STATUS:                        row.Value("WH_STATUS"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.OtherStats = make([]BoxScoreSummaryV2OtherStats, len(rawResp.ResultSets[1].RowSet))
-		for i, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 14 {
-				response.OtherStats[i] = BoxScoreSummaryV2OtherStats{
-					LEAGUE_ID:         row[0],
-					TEAM_ID:           row[1],
-					TEAM_ABBREVIATION: row[2],
-					TEAM_CITY:         row[3],
-					PTS_PAINT:         row[4],
-					PTS_2ND_CHANCE:    row[5],
-					PTS_FB:            row[6],
-					LARGEST_LEAD:      row[7],
-					LEAD_CHANGES:      row[8],
-					TIMES_TIED:        row[9],
-					TEAM_TURNOVERS:    row[10],
-					TOTAL_TURNOVERS:   row[11],
-					TEAM_REBOUNDS:     row[12],
-					PTS_OFF_TO:        row[13],
-				}
+	if rs := rawResp.resultSet("OtherStats"); rs != nil {
+		response.OtherStats = decodeRows(rs, func(row *rowReader) BoxScoreSummaryV2OtherStats {
+			return BoxScoreSummaryV2OtherStats{
+				LEAGUE_ID:         row.Value("LEAGUE_ID"),
+				TEAM_ID:           row.Value("TEAM_ID"),
+				TEAM_ABBREVIATION: row.Value("TEAM_ABBREVIATION"),
+				TEAM_CITY:         row.Value("TEAM_CITY"),
+				PTS_PAINT:         row.Value("PTS_PAINT"),
+				PTS_2ND_CHANCE:    row.Value("PTS_2ND_CHANCE"),
+				PTS_FB:            row.Value("PTS_FB"),
+				LARGEST_LEAD:      row.Value("LARGEST_LEAD"),
+				LEAD_CHANGES:      row.Value("LEAD_CHANGES"),
+				TIMES_TIED:        row.Value("TIMES_TIED"),
+				TEAM_TURNOVERS:    row.Value("TEAM_TURNOVERS"),
+				TOTAL_TURNOVERS:   row.Value("TOTAL_TURNOVERS"),
+				TEAM_REBOUNDS:     row.Value("TEAM_REBOUNDS"),
+				PTS_OFF_TO:        row.Value("PTS_OFF_TO"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 2 {
-		response.Officials = make([]BoxScoreSummaryV2Officials, len(rawResp.ResultSets[2].RowSet))
-		for i, row := range rawResp.ResultSets[2].RowSet {
-			if len(row) >= 4 {
-				response.Officials[i] = BoxScoreSummaryV2Officials{
-					OFFICIAL_ID: row[0],
-					FIRST_NAME:  row[1],
-					LAST_NAME:   row[2],
-					JERSEY_NUM:  row[3],
-				}
+	if rs := rawResp.resultSet("Officials"); rs != nil {
+		response.Officials = decodeRows(rs, func(row *rowReader) BoxScoreSummaryV2Officials {
+			return BoxScoreSummaryV2Officials{
+				OFFICIAL_ID: row.Value("OFFICIAL_ID"),
+				FIRST_NAME:  row.Value("FIRST_NAME"),
+				LAST_NAME:   row.Value("LAST_NAME"),
+				JERSEY_NUM:  row.Value("JERSEY_NUM"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 3 {
-		response.InactivePlayers = make([]BoxScoreSummaryV2InactivePlayers, len(rawResp.ResultSets[3].RowSet))
-		for i, row := range rawResp.ResultSets[3].RowSet {
-			if len(row) >= 8 {
-				response.InactivePlayers[i] = BoxScoreSummaryV2InactivePlayers{
-					PLAYER_ID:         row[0],
-					FIRST_NAME:        row[1],
-					LAST_NAME:         row[2],
-					JERSEY_NUM:        row[3],
-					TEAM_ID:           row[4],
-					TEAM_CITY:         row[5],
-					TEAM_NAME:         row[6],
-					TEAM_ABBREVIATION: row[7],
-				}
+	if rs := rawResp.resultSet("InactivePlayers"); rs != nil {
+		response.InactivePlayers = decodeRows(rs, func(row *rowReader) BoxScoreSummaryV2InactivePlayers {
+			return BoxScoreSummaryV2InactivePlayers{
+				PLAYER_ID:         row.Value("PLAYER_ID"),
+				FIRST_NAME:        row.Value("FIRST_NAME"),
+				LAST_NAME:         row.Value("LAST_NAME"),
+				JERSEY_NUM:        row.Value("JERSEY_NUM"),
+				TEAM_ID:           row.Value("TEAM_ID"),
+				TEAM_CITY:         row.Value("TEAM_CITY"),
+				TEAM_NAME:         row.Value("TEAM_NAME"),
+				TEAM_ABBREVIATION: row.Value("TEAM_ABBREVIATION"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 4 {
-		response.GameInfo = make([]BoxScoreSummaryV2GameInfo, len(rawResp.ResultSets[4].RowSet))
-		for i, row := range rawResp.ResultSets[4].RowSet {
-			if len(row) >= 3 {
-				response.GameInfo[i] = BoxScoreSummaryV2GameInfo{
-					GAME_DATE:  row[0],
-					ATTENDANCE: row[1],
-					GAME_TIME:  row[2],
-				}
+	if rs := rawResp.resultSet("GameInfo"); rs != nil {
+		response.GameInfo = decodeRows(rs, func(row *rowReader) BoxScoreSummaryV2GameInfo {
+			return BoxScoreSummaryV2GameInfo{
+				GAME_DATE:  row.Value("GAME_DATE"),
+				ATTENDANCE: row.Value("ATTENDANCE"),
+				GAME_TIME:  row.Value("GAME_TIME"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 5 {
-		response.LineScore = make([]BoxScoreSummaryV2LineScore, len(rawResp.ResultSets[5].RowSet))
-		for i, row := range rawResp.ResultSets[5].RowSet {
-			if len(row) >= 28 {
-				response.LineScore[i] = BoxScoreSummaryV2LineScore{
-					GAME_DATE_EST:     row[0],
-					GAME_SEQUENCE:     row[1],
-					GAME_ID:           row[2],
-					TEAM_ID:           row[3],
-					TEAM_ABBREVIATION: row[4],
-					TEAM_CITY_NAME:    row[5],
-					TEAM_WINS_LOSSES:  row[6],
-					PTS_QTR1:          row[7],
-					PTS_QTR2:          row[8],
-					PTS_QTR3:          row[9],
-					PTS_QTR4:          row[10],
-					PTS_OT1:           row[11],
-					PTS_OT2:           row[12],
-					PTS_OT3:           row[13],
-					PTS_OT4:           row[14],
-					PTS_OT5:           row[15],
-					PTS_OT6:           row[16],
-					PTS_OT7:           row[17],
-					PTS_OT8:           row[18],
-					PTS_OT9:           row[19],
-					PTS_OT10:          row[20],
-					PTS:               row[21],
-					FG_PCT:            row[22],
-					FT_PCT:            row[23],
-					FG3_PCT:           row[24],
-					AST:               row[25],
-					REB:               row[26],
-					TOV:               row[27],
-				}
+	if rs := rawResp.resultSet("LineScore"); rs != nil {
+		response.LineScore = decodeRows(rs, func(row *rowReader) BoxScoreSummaryV2LineScore {
+			return BoxScoreSummaryV2LineScore{
+				GAME_DATE_EST:     row.Value("GAME_DATE_EST"),
+				GAME_SEQUENCE:     row.Value("GAME_SEQUENCE"),
+				GAME_ID:           row.Value("GAME_ID"),
+				TEAM_ID:           row.Value("TEAM_ID"),
+				TEAM_ABBREVIATION: row.Value("TEAM_ABBREVIATION"),
+				TEAM_CITY_NAME:    row.Value("TEAM_CITY_NAME"),
+				TEAM_WINS_LOSSES:  row.Value("TEAM_WINS_LOSSES"),
+				PTS_QTR1:          row.Value("PTS_QTR1"),
+				PTS_QTR2:          row.Value("PTS_QTR2"),
+				PTS_QTR3:          row.Value("PTS_QTR3"),
+				PTS_QTR4:          row.Value("PTS_QTR4"),
+				PTS_OT1:           row.Value("PTS_OT1"),
+				PTS_OT2:           row.Value("PTS_OT2"),
+				PTS_OT3:           row.Value("PTS_OT3"),
+				PTS_OT4:           row.Value("PTS_OT4"),
+				PTS_OT5:           row.Value("PTS_OT5"),
+				PTS_OT6:           row.Value("PTS_OT6"),
+				PTS_OT7:           row.Value("PTS_OT7"),
+				PTS_OT8:           row.Value("PTS_OT8"),
+				PTS_OT9:           row.Value("PTS_OT9"),
+				PTS_OT10:          row.Value("PTS_OT10"),
+				PTS:               row.Value("PTS"),
+				FG_PCT:            row.Value("FG_PCT"),
+				FT_PCT:            row.Value("FT_PCT"),
+				FG3_PCT:           row.Value("FG3_PCT"),
+				AST:               row.Value("AST"),
+				REB:               row.Value("REB"),
+				TOV:               row.Value("TOV"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 6 {
-		response.LastMeeting = make([]BoxScoreSummaryV2LastMeeting, len(rawResp.ResultSets[6].RowSet))
-		for i, row := range rawResp.ResultSets[6].RowSet {
-			if len(row) >= 13 {
-				response.LastMeeting[i] = BoxScoreSummaryV2LastMeeting{
-					GAME_ID:                   row[0],
-					GAME_DATE_EST:             row[1],
-					GAME_DATE_TIME_EST:        row[2],
-					HOME_TEAM_ID:              row[3],
-					HOME_TEAM_CITY:            row[4],
-					HOME_TEAM_NAME:            row[5],
-					HOME_TEAM_ABBREVIATION:    row[6],
-					HOME_TEAM_POINTS:          row[7],
-					VISITOR_TEAM_ID:           row[8],
-					VISITOR_TEAM_CITY:         row[9],
-					VISITOR_TEAM_NAME:         row[10],
-					VISITOR_TEAM_ABBREVIATION: row[11],
-					VISITOR_TEAM_POINTS:       row[12],
-				}
+	if rs := rawResp.resultSet("LastMeeting"); rs != nil {
+		response.LastMeeting = decodeRows(rs, func(row *rowReader) BoxScoreSummaryV2LastMeeting {
+			return BoxScoreSummaryV2LastMeeting{
+				GAME_ID:                   row.Value("GAME_ID"),
+				GAME_DATE_EST:             row.Value("GAME_DATE_EST"),
+				GAME_DATE_TIME_EST:        row.Value("GAME_DATE_TIME_EST"),
+				HOME_TEAM_ID:              row.Value("HOME_TEAM_ID"),
+				HOME_TEAM_CITY:            row.Value("HOME_TEAM_CITY"),
+				HOME_TEAM_NAME:            row.Value("HOME_TEAM_NAME"),
+				HOME_TEAM_ABBREVIATION:    row.Value("HOME_TEAM_ABBREVIATION"),
+				HOME_TEAM_POINTS:          row.Value("HOME_TEAM_POINTS"),
+				VISITOR_TEAM_ID:           row.Value("VISITOR_TEAM_ID"),
+				VISITOR_TEAM_CITY:         row.Value("VISITOR_TEAM_CITY"),
+				VISITOR_TEAM_NAME:         row.Value("VISITOR_TEAM_NAME"),
+				VISITOR_TEAM_ABBREVIATION: row.Value("VISITOR_TEAM_ABBREVIATION"),
+				VISITOR_TEAM_POINTS:       row.Value("VISITOR_TEAM_POINTS"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 7 {
-		response.SeasonSeries = make([]BoxScoreSummaryV2SeasonSeries, len(rawResp.ResultSets[7].RowSet))
-		for i, row := range rawResp.ResultSets[7].RowSet {
-			if len(row) >= 7 {
-				response.SeasonSeries[i] = BoxScoreSummaryV2SeasonSeries{
-					GAME_ID:          row[0],
-					HOME_TEAM_ID:     row[1],
-					VISITOR_TEAM_ID:  row[2],
-					GAME_DATE_EST:    row[3],
-					HOME_TEAM_WINS:   row[4],
-					HOME_TEAM_LOSSES: row[5],
-					SERIES_LEADER:    row[6],
-				}
+	if rs := rawResp.resultSet("SeasonSeries"); rs != nil {
+		response.SeasonSeries = decodeRows(rs, func(row *rowReader) BoxScoreSummaryV2SeasonSeries {
+			return BoxScoreSummaryV2SeasonSeries{
+				GAME_ID:          row.Value("GAME_ID"),
+				HOME_TEAM_ID:     row.Value("HOME_TEAM_ID"),
+				VISITOR_TEAM_ID:  row.Value("VISITOR_TEAM_ID"),
+				GAME_DATE_EST:    row.Value("GAME_DATE_EST"),
+				HOME_TEAM_WINS:   row.Value("HOME_TEAM_WINS"),
+				HOME_TEAM_LOSSES: row.Value("HOME_TEAM_LOSSES"),
+				SERIES_LEADER:    row.Value("SERIES_LEADER"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 8 {
-		response.AvailableVideo = make([]BoxScoreSummaryV2AvailableVideo, len(rawResp.ResultSets[8].RowSet))
-		for i, row := range rawResp.ResultSets[8].RowSet {
-			if len(row) >= 2 {
-				response.AvailableVideo[i] = BoxScoreSummaryV2AvailableVideo{
-					GAME_ID:              row[0],
-					VIDEO_AVAILABLE_FLAG: row[1],
-				}
+	if rs := rawResp.resultSet("AvailableVideo"); rs != nil {
+		response.AvailableVideo = decodeRows(rs, func(row *rowReader) BoxScoreSummaryV2AvailableVideo {
+			return BoxScoreSummaryV2AvailableVideo{
+				GAME_ID:              row.Value("GAME_ID"),
+				VIDEO_AVAILABLE_FLAG: row.Value("VIDEO_AVAILABLE_FLAG"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -0,0 +1,119 @@
+package endpoints
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/stats"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
+)
+
+// BoxScoreSummaryV3Request contains parameters for the BoxScoreSummaryV3 endpoint
+type BoxScoreSummaryV3Request struct {
+	GameID string
+}
+
+type BoxScoreSummaryV3Arena struct {
+	ArenaID            int    `json:"arenaId"`
+	ArenaName          string `json:"arenaName"`
+	ArenaCity          string `json:"arenaCity"`
+	ArenaState         string `json:"arenaState"`
+	ArenaCountry       string `json:"arenaCountry"`
+	ArenaTimezone      string `json:"arenaTimezone"`
+	ArenaStreetAddress string `json:"arenaStreetAddress"`
+	ArenaPostalCode    string `json:"arenaPostalCode"`
+}
+
+type BoxScoreSummaryV3Official struct {
+	PersonID   int    `json:"personId"`
+	Name       string `json:"name"`
+	NameI      string `json:"nameI"`
+	FirstName  string `json:"firstName"`
+	FamilyName string `json:"familyName"`
+	JerseyNum  string `json:"jerseyNum"`
+	Assignment string `json:"assignment"`
+}
+
+type BoxScoreSummaryV3Team struct {
+	TeamID            int                  `json:"teamId"`
+	TeamName          string               `json:"teamName"`
+	TeamCity          string               `json:"teamCity"`
+	TeamTricode       string               `json:"teamTricode"`
+	TeamSlug          string               `json:"teamSlug"`
+	TeamWins          int                  `json:"teamWins"`
+	TeamLosses        int                  `json:"teamLosses"`
+	Score             int                  `json:"score"`
+	InBonus           models.Opt[string]   `json:"inBonus"`
+	TimeoutsRemaining int                  `json:"timeoutsRemaining"`
+	Seed              models.Opt[int]      `json:"seed"`
+	Periods           []ScoreboardV3Period `json:"periods"`
+}
+
+// BoxScoreSummaryV3Response is the boxScoreSummary object of the
+// boxscoresummaryv3 document. Duration is the game length in minutes.
+type BoxScoreSummaryV3Response struct {
+	GameID           string                      `json:"gameId"`
+	GameCode         string                      `json:"gameCode"`
+	GameStatus       int                         `json:"gameStatus"`
+	GameStatusText   string                      `json:"gameStatusText"`
+	Period           int                         `json:"period"`
+	GameClock        string                      `json:"gameClock"`
+	GameTimeUTC      string                      `json:"gameTimeUTC"`
+	GameEt           string                      `json:"gameEt"`
+	AwayTeamID       int                         `json:"awayTeamId"`
+	HomeTeamID       int                         `json:"homeTeamId"`
+	Duration         int                         `json:"duration"`
+	Attendance       int                         `json:"attendance"`
+	Sellout          int                         `json:"sellout"`
+	SeriesGameNumber string                      `json:"seriesGameNumber"`
+	GameLabel        string                      `json:"gameLabel"`
+	GameSubLabel     string                      `json:"gameSubLabel"`
+	SeriesText       string                      `json:"seriesText"`
+	IfNecessary      bool                        `json:"ifNecessary"`
+	IsNeutral        bool                        `json:"isNeutral"`
+	Arena            BoxScoreSummaryV3Arena      `json:"arena"`
+	Officials        []BoxScoreSummaryV3Official `json:"officials"`
+	HomeTeam         BoxScoreSummaryV3Team       `json:"homeTeam"`
+	AwayTeam         BoxScoreSummaryV3Team       `json:"awayTeam"`
+}
+
+// StartTime parses GameTimeUTC.
+func (s BoxScoreSummaryV3Response) StartTime() (time.Time, error) {
+	return timeutil.ParseGameTime(s.GameTimeUTC)
+}
+
+// GameDuration converts Duration to a time.Duration.
+func (s BoxScoreSummaryV3Response) GameDuration() time.Duration {
+	return time.Duration(s.Duration) * time.Minute
+}
+
+type boxScoreSummaryV3Document struct {
+	Meta            json.RawMessage            `json:"meta"`
+	BoxScoreSummary *BoxScoreSummaryV3Response `json:"boxScoreSummary"`
+}
+
+// GetBoxScoreSummaryV3 retrieves data from the boxscoresummaryv3 endpoint
+func GetBoxScoreSummaryV3(ctx context.Context, client *stats.Client, req BoxScoreSummaryV3Request) (*models.Response[*BoxScoreSummaryV3Response], error) {
+	if req.GameID == "" {
+		return nil, fmt.Errorf("%w: GameID is required", models.ErrInvalidRequest)
+	}
+	params := url.Values{}
+	params.Set("GameID", req.GameID)
+
+	var doc boxScoreSummaryV3Document
+	raw, drift, err := getDocument(ctx, client, "boxscoresummaryv3", params, &doc)
+	if err != nil {
+		return nil, err
+	}
+
+	response := doc.BoxScoreSummary
+	if response == nil {
+		response = &BoxScoreSummaryV3Response{}
+	}
+
+	return newDriftResponse(client, response, raw, drift)
+}
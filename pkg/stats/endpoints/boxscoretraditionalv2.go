@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/n-ae/nba-api-go/pkg/models"
 	"github.com/n-ae/nba-api-go/pkg/stats"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
 )
 
 // BoxScoreTraditionalV2Request contains parameters for the BoxScoreTraditionalV2 endpoint
@@ -21,35 +23,40 @@ type BoxScoreTraditionalV2Request struct {
 
 // BoxScoreTraditionalV2PlayerStats represents the PlayerStats result set for BoxScoreTraditionalV2
 type BoxScoreTraditionalV2PlayerStats struct {
-	GAME_ID           string  `json:"GAME_ID"`
-	TEAM_ID           int     `json:"TEAM_ID"`
-	TEAM_ABBREVIATION string  `json:"TEAM_ABBREVIATION"`
-	TEAM_CITY         string  `json:"TEAM_CITY"`
-	PLAYER_ID         int     `json:"PLAYER_ID"`
-	PLAYER_NAME       string  `json:"PLAYER_NAME"`
-	NICKNAME          string  `json:"NICKNAME"`
-	START_POSITION    string  `json:"START_POSITION"`
-	COMMENT           string  `json:"COMMENT"`
-	MIN               float64 `json:"MIN"`
-	FGM               int     `json:"FGM"`
-	FGA               int     `json:"FGA"`
-	FG_PCT            float64 `json:"FG_PCT"`
-	FG3M              int     `json:"FG3M"`
-	FG3A              int     `json:"FG3A"`
-	FG3_PCT           float64 `json:"FG3_PCT"`
-	FTM               int     `json:"FTM"`
-	FTA               int     `json:"FTA"`
-	FT_PCT            float64 `json:"FT_PCT"`
-	OREB              int     `json:"OREB"`
-	DREB              int     `json:"DREB"`
-	REB               int     `json:"REB"`
-	AST               int     `json:"AST"`
-	STL               int     `json:"STL"`
-	BLK               int     `json:"BLK"`
-	TO                int     `json:"TO"`
-	PF                int     `json:"PF"`
-	PTS               int     `json:"PTS"`
-	PLUS_MINUS        float64 `json:"PLUS_MINUS"`
+	GAME_ID           string              `json:"GAME_ID"`
+	TEAM_ID           int                 `json:"TEAM_ID"`
+	TEAM_ABBREVIATION string              `json:"TEAM_ABBREVIATION"`
+	TEAM_CITY         string              `json:"TEAM_CITY"`
+	PLAYER_ID         int                 `json:"PLAYER_ID"`
+	PLAYER_NAME       string              `json:"PLAYER_NAME"`
+	NICKNAME          string              `json:"NICKNAME"`
+	START_POSITION    string              `json:"START_POSITION"`
+	COMMENT           string              `json:"COMMENT"`
+	MIN               models.Opt[string]  `json:"MIN"`
+	FGM               models.Opt[int]     `json:"FGM"`
+	FGA               models.Opt[int]     `json:"FGA"`
+	FG_PCT            models.Opt[float64] `json:"FG_PCT"`
+	FG3M              models.Opt[int]     `json:"FG3M"`
+	FG3A              models.Opt[int]     `json:"FG3A"`
+	FG3_PCT           models.Opt[float64] `json:"FG3_PCT"`
+	FTM               models.Opt[int]     `json:"FTM"`
+	FTA               models.Opt[int]     `json:"FTA"`
+	FT_PCT            models.Opt[float64] `json:"FT_PCT"`
+	OREB              models.Opt[int]     `json:"OREB"`
+	DREB              models.Opt[int]     `json:"DREB"`
+	REB               models.Opt[int]     `json:"REB"`
+	AST               models.Opt[int]     `json:"AST"`
+	STL               models.Opt[int]     `json:"STL"`
+	BLK               models.Opt[int]     `json:"BLK"`
+	TO                models.Opt[int]     `json:"TO"`
+	PF                models.Opt[int]     `json:"PF"`
+	PTS               models.Opt[int]     `json:"PTS"`
+	PLUS_MINUS        models.Opt[float64] `json:"PLUS_MINUS"`
+}
+
+// Minutes parses MIN ("35:12"). It is zero for a player who did not play.
+func (s BoxScoreTraditionalV2PlayerStats) Minutes() (time.Duration, error) {
+	return timeutil.ParseMinutes(s.MIN.Or(""))
 }
 
 // BoxScoreTraditionalV2TeamStats represents the TeamStats result set for BoxScoreTraditionalV2
@@ -59,7 +66,7 @@ type BoxScoreTraditionalV2TeamStats struct {
 	TEAM_NAME         string  `json:"TEAM_NAME"`
 	TEAM_ABBREVIATION string  `json:"TEAM_ABBREVIATION"`
 	TEAM_CITY         string  `json:"TEAM_CITY"`
-	MIN               float64 `json:"MIN"`
+	MIN               string  `json:"MIN"`
 	FGM               int     `json:"FGM"`
 	FGA               int     `json:"FGA"`
 	FG_PCT            float64 `json:"FG_PCT"`
@@ -81,6 +88,11 @@ type BoxScoreTraditionalV2TeamStats struct {
 	PLUS_MINUS        float64 `json:"PLUS_MINUS"`
 }
 
+// Minutes parses MIN ("240:00").
+func (s BoxScoreTraditionalV2TeamStats) Minutes() (time.Duration, error) {
+	return timeutil.ParseMinutes(s.MIN)
+}
+
 // BoxScoreTraditionalV2TeamStarterBenchStats represents the TeamStarterBenchStats result set for BoxScoreTraditionalV2
 type BoxScoreTraditionalV2TeamStarterBenchStats struct {
 	GAME_ID           string  `json:"GAME_ID"`
@@ -89,7 +101,7 @@ type BoxScoreTraditionalV2TeamStarterBenchStats struct {
 	TEAM_ABBREVIATION string  `json:"TEAM_ABBREVIATION"`
 	TEAM_CITY         string  `json:"TEAM_CITY"`
 	STARTERS_BENCH    string  `json:"STARTERS_BENCH"`
-	MIN               float64 `json:"MIN"`
+	MIN               string  `json:"MIN"`
 	FGM               int     `json:"FGM"`
 	FGA               int     `json:"FGA"`
 	FG_PCT            float64 `json:"FG_PCT"`
@@ -111,6 +123,11 @@ type BoxScoreTraditionalV2TeamStarterBenchStats struct {
 	PLUS_MINUS        float64 `json:"PLUS_MINUS"`
 }
 
+// Minutes parses MIN ("240:00").
+func (s BoxScoreTraditionalV2TeamStarterBenchStats) Minutes() (time.Duration, error) {
+	return timeutil.ParseMinutes(s.MIN)
+}
+
 // BoxScoreTraditionalV2Response contains the response data from the BoxScoreTraditionalV2 endpoint
 type BoxScoreTraditionalV2Response struct {
 	PlayerStats           []BoxScoreTraditionalV2PlayerStats
@@ -143,124 +160,113 @@ func GetBoxScoreTraditionalV2(ctx context.Context, client *stats.Client, req Box
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "boxscoretraditionalv2", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "boxscoretraditionalv2", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &BoxScoreTraditionalV2Response{}
 
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerStats = make([]BoxScoreTraditionalV2PlayerStats, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 29 {
-				item := BoxScoreTraditionalV2PlayerStats{
-					GAME_ID:           toString(row[0]),
-					TEAM_ID:           toInt(row[1]),
-					TEAM_ABBREVIATION: toString(row[2]),
-					TEAM_CITY:         toString(row[3]),
-					PLAYER_ID:         toInt(row[4]),
-					PLAYER_NAME:       toString(row[5]),
-					NICKNAME:          toString(row[6]),
-					START_POSITION:    toString(row[7]),
-					COMMENT:           toString(row[8]),
-					MIN:               toFloat(row[9]),
-					FGM:               toInt(row[10]),
-					FGA:               toInt(row[11]),
-					FG_PCT:            toFloat(row[12]),
-					FG3M:              toInt(row[13]),
-					FG3A:              toInt(row[14]),
-					FG3_PCT:           toFloat(row[15]),
-					FTM:               toInt(row[16]),
-					FTA:               toInt(row[17]),
-					FT_PCT:            toFloat(row[18]),
-					OREB:              toInt(row[19]),
-					DREB:              toInt(row[20]),
-					REB:               toInt(row[21]),
-					AST:               toInt(row[22]),
-					STL:               toInt(row[23]),
-					BLK:               toInt(row[24]),
-					TO:                toInt(row[25]),
-					PF:                toInt(row[26]),
-					PTS:               toInt(row[27]),
-					PLUS_MINUS:        toFloat(row[28]),
-				}
-				response.PlayerStats = append(response.PlayerStats, item)
+	if rs := rawResp.resultSet("PlayerStats"); rs != nil {
+		response.PlayerStats = decodeRows(rs, func(row *rowReader) BoxScoreTraditionalV2PlayerStats {
+			return BoxScoreTraditionalV2PlayerStats{
+				GAME_ID:           row.String("GAME_ID"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				TEAM_CITY:         row.String("TEAM_CITY"),
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				NICKNAME:          row.String("NICKNAME"),
+				START_POSITION:    row.String("START_POSITION"),
+				COMMENT:           row.String("COMMENT"),
+				MIN:               row.OptString("MIN"),
+				FGM:               row.OptInt("FGM"),
+				FGA:               row.OptInt("FGA"),
+				FG_PCT:            row.OptFloat("FG_PCT"),
+				FG3M:              row.OptInt("FG3M"),
+				FG3A:              row.OptInt("FG3A"),
+				FG3_PCT:           row.OptFloat("FG3_PCT"),
+				FTM:               row.OptInt("FTM"),
+				FTA:               row.OptInt("FTA"),
+				FT_PCT:            row.OptFloat("FT_PCT"),
+				OREB:              row.OptInt("OREB"),
+				DREB:              row.OptInt("DREB"),
+				REB:               row.OptInt("REB"),
+				AST:               row.OptInt("AST"),
+				STL:               row.OptInt("STL"),
+				BLK:               row.OptInt("BLK"),
+				TO:                row.OptInt("TO"),
+				PF:                row.OptInt("PF"),
+				PTS:               row.OptInt("PTS"),
+				PLUS_MINUS:        row.OptFloat("PLUS_MINUS"),
 			}
-		}
+		})
 	}
 
-	if len(rawResp.ResultSets) > 1 {
-		response.TeamStats = make([]BoxScoreTraditionalV2TeamStats, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 25 {
-				item := BoxScoreTraditionalV2TeamStats{
-					GAME_ID:           toString(row[0]),
-					TEAM_ID:           toInt(row[1]),
-					TEAM_NAME:         toString(row[2]),
-					TEAM_ABBREVIATION: toString(row[3]),
-					TEAM_CITY:         toString(row[4]),
-					MIN:               toFloat(row[5]),
-					FGM:               toInt(row[6]),
-					FGA:               toInt(row[7]),
-					FG_PCT:            toFloat(row[8]),
-					FG3M:              toInt(row[9]),
-					FG3A:              toInt(row[10]),
-					FG3_PCT:           toFloat(row[11]),
-					FTM:               toInt(row[12]),
-					FTA:               toInt(row[13]),
-					FT_PCT:            toFloat(row[14]),
-					OREB:              toInt(row[15]),
-					DREB:              toInt(row[16]),
-					REB:               toInt(row[17]),
-					AST:               toInt(row[18]),
-					STL:               toInt(row[19]),
-					BLK:               toInt(row[20]),
-					TO:                toInt(row[21]),
-					PF:                toInt(row[22]),
-					PTS:               toInt(row[23]),
-					PLUS_MINUS:        toFloat(row[24]),
-				}
-				response.TeamStats = append(response.TeamStats, item)
+	if rs := rawResp.resultSet("TeamStats"); rs != nil {
+		response.TeamStats = decodeRows(rs, func(row *rowReader) BoxScoreTraditionalV2TeamStats {
+			return BoxScoreTraditionalV2TeamStats{
+				GAME_ID:           row.String("GAME_ID"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_NAME:         row.String("TEAM_NAME"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				TEAM_CITY:         row.String("TEAM_CITY"),
+				MIN:               row.String("MIN"),
+				FGM:               row.Int("FGM"),
+				FGA:               row.Int("FGA"),
+				FG_PCT:            row.Float("FG_PCT"),
+				FG3M:              row.Int("FG3M"),
+				FG3A:              row.Int("FG3A"),
+				FG3_PCT:           row.Float("FG3_PCT"),
+				FTM:               row.Int("FTM"),
+				FTA:               row.Int("FTA"),
+				FT_PCT:            row.Float("FT_PCT"),
+				OREB:              row.Int("OREB"),
+				DREB:              row.Int("DREB"),
+				REB:               row.Int("REB"),
+				AST:               row.Int("AST"),
+				STL:               row.Int("STL"),
+				BLK:               row.Int("BLK"),
+				TO:                row.Int("TO"),
+				PF:                row.Int("PF"),
+				PTS:               row.Int("PTS"),
+				PLUS_MINUS:        row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
 
-	if len(rawResp.ResultSets) > 2 {
-		response.TeamStarterBenchStats = make([]BoxScoreTraditionalV2TeamStarterBenchStats, 0, len(rawResp.ResultSets[2].RowSet))
-		for _, row := range rawResp.ResultSets[2].RowSet {
-			if len(row) >= 26 {
-				item := BoxScoreTraditionalV2TeamStarterBenchStats{
-					GAME_ID:           toString(row[0]),
-					TEAM_ID:           toInt(row[1]),
-					TEAM_NAME:         toString(row[2]),
-					TEAM_ABBREVIATION: toString(row[3]),
-					TEAM_CITY:         toString(row[4]),
-					STARTERS_BENCH:    toString(row[5]),
-					MIN:               toFloat(row[6]),
-					FGM:               toInt(row[7]),
-					FGA:               toInt(row[8]),
-					FG_PCT:            toFloat(row[9]),
-					FG3M:              toInt(row[10]),
-					FG3A:              toInt(row[11]),
-					FG3_PCT:           toFloat(row[12]),
-					FTM:               toInt(row[13]),
-					FTA:               toInt(row[14]),
-					FT_PCT:            toFloat(row[15]),
-					OREB:              toInt(row[16]),
-					DREB:              toInt(row[17]),
-					REB:               toInt(row[18]),
-					AST:               toInt(row[19]),
-					STL:               toInt(row[20]),
-					BLK:               toInt(row[21]),
-					TO:                toInt(row[22]),
-					PF:                toInt(row[23]),
-					PTS:               toInt(row[24]),
-					PLUS_MINUS:        toFloat(row[25]),
-				}
-				response.TeamStarterBenchStats = append(response.TeamStarterBenchStats, item)
+	if rs := rawResp.resultSet("TeamStarterBenchStats"); rs != nil {
+		response.TeamStarterBenchStats = decodeRows(rs, func(row *rowReader) BoxScoreTraditionalV2TeamStarterBenchStats {
+			return BoxScoreTraditionalV2TeamStarterBenchStats{
+				GAME_ID:           row.String("GAME_ID"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_NAME:         row.String("TEAM_NAME"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				TEAM_CITY:         row.String("TEAM_CITY"),
+				STARTERS_BENCH:    row.String("STARTERS_BENCH"),
+				MIN:               row.String("MIN"),
+				FGM:               row.Int("FGM"),
+				FGA:               row.Int("FGA"),
+				FG_PCT:            row.Float("FG_PCT"),
+				FG3M:              row.Int("FG3M"),
+				FG3A:              row.Int("FG3A"),
+				FG3_PCT:           row.Float("FG3_PCT"),
+				FTM:               row.Int("FTM"),
+				FTA:               row.Int("FTA"),
+				FT_PCT:            row.Float("FT_PCT"),
+				OREB:              row.Int("OREB"),
+				DREB:              row.Int("DREB"),
+				REB:               row.Int("REB"),
+				AST:               row.Int("AST"),
+				STL:               row.Int("STL"),
+				BLK:               row.Int("BLK"),
+				TO:                row.Int("TO"),
+				PF:                row.Int("PF"),
+				PTS:               row.Int("PTS"),
+				PLUS_MINUS:        row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -0,0 +1,39 @@
+package endpoints
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestBoxScoreTraditionalV2_Replay(t *testing.T) {
+	resp, err := GetBoxScoreTraditionalV2(context.Background(), replayClient(t, "boxscoretraditionalv2"), BoxScoreTraditionalV2Request{GameID: "0022300061"})
+	if err != nil {
+		t.Fatalf("GetBoxScoreTraditionalV2() error = %v", err)
+	}
+	if len(resp.Warnings) != 0 {
+		t.Errorf("Warnings = %v, want none", resp.Warnings)
+	}
+
+	if len(resp.Data.PlayerStats) != 2 || len(resp.Data.TeamStats) != 1 {
+		t.Fatalf("PlayerStats = %+v, TeamStats = %+v", resp.Data.PlayerStats, resp.Data.TeamStats)
+	}
+	if played := resp.Data.PlayerStats[0]; played.MIN.Or("") != "35:12" || played.PTS.Or(0) != 28 {
+		t.Errorf("played line = %+v", played)
+	}
+	if d, err := resp.Data.PlayerStats[0].Minutes(); err != nil || d != 35*time.Minute+12*time.Second {
+		t.Errorf("Minutes() = %v, %v, want 35m12s", d, err)
+	}
+	if dnp := resp.Data.PlayerStats[1]; dnp.MIN.Valid || dnp.PTS.Valid {
+		t.Errorf("DNP line = %+v, want MIN and PTS absent", dnp)
+	}
+	if d, err := resp.Data.PlayerStats[1].Minutes(); err != nil || d != 0 {
+		t.Errorf("DNP Minutes() = %v, %v, want 0", d, err)
+	}
+	if team := resp.Data.TeamStats[0]; team.MIN != "240:00" {
+		t.Errorf("team MIN = %q, want 240:00", team.MIN)
+	}
+	if d, err := resp.Data.TeamStats[0].Minutes(); err != nil || d != 240*time.Minute {
+		t.Errorf("team Minutes() = %v, %v, want 4h0m0s", d, err)
+	}
+}
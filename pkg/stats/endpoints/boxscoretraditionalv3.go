@@ -0,0 +1,51 @@
+package endpoints
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/stats"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
+)
+
+// BoxScoreTraditionalV3Statistics is a traditional box score line of a player or team.
+type BoxScoreTraditionalV3Statistics struct {
+	Minutes                 string  `json:"minutes"`
+	FieldGoalsMade          int     `json:"fieldGoalsMade"`
+	FieldGoalsAttempted     int     `json:"fieldGoalsAttempted"`
+	FieldGoalsPercentage    float64 `json:"fieldGoalsPercentage"`
+	ThreePointersMade       int     `json:"threePointersMade"`
+	ThreePointersAttempted  int     `json:"threePointersAttempted"`
+	ThreePointersPercentage float64 `json:"threePointersPercentage"`
+	FreeThrowsMade          int     `json:"freeThrowsMade"`
+	FreeThrowsAttempted     int     `json:"freeThrowsAttempted"`
+	FreeThrowsPercentage    float64 `json:"freeThrowsPercentage"`
+	ReboundsOffensive       int     `json:"reboundsOffensive"`
+	ReboundsDefensive       int     `json:"reboundsDefensive"`
+	ReboundsTotal           int     `json:"reboundsTotal"`
+	Assists                 int     `json:"assists"`
+	Steals                  int     `json:"steals"`
+	Blocks                  int     `json:"blocks"`
+	Turnovers               int     `json:"turnovers"`
+	FoulsPersonal           int     `json:"foulsPersonal"`
+	Points                  int     `json:"points"`
+	PlusMinusPoints         float64 `json:"plusMinusPoints"`
+}
+
+// MinutesPlayed parses Minutes ("35:12").
+func (s BoxScoreTraditionalV3Statistics) MinutesPlayed() (time.Duration, error) {
+	return timeutil.ParseMinutes(s.Minutes)
+}
+
+type BoxScoreTraditionalV3Response = BoxScoreV3[BoxScoreTraditionalV3Statistics]
+
+// GetBoxScoreTraditionalV3 retrieves the boxscoretraditionalv3 endpoint.
+func GetBoxScoreTraditionalV3(ctx context.Context, client *stats.Client, req BoxScoreV3Request) (*models.Response[*BoxScoreTraditionalV3Response], error) {
+	var doc struct {
+		Meta     json.RawMessage                `json:"meta"`
+		BoxScore *BoxScoreTraditionalV3Response `json:"boxScoreTraditional"`
+	}
+	return getBoxScoreV3(ctx, client, "boxscoretraditionalv3", req, &doc, &doc.BoxScore)
+}
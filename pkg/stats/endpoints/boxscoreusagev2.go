@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/n-ae/nba-api-go/pkg/models"
 	"github.com/n-ae/nba-api-go/pkg/stats"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
 )
 
 // BoxScoreUsageV2Request contains parameters for the BoxScoreUsageV2 endpoint
@@ -21,34 +23,39 @@ type BoxScoreUsageV2Request struct {
 
 // BoxScoreUsageV2PlayerStats represents the PlayerStats result set for BoxScoreUsageV2
 type BoxScoreUsageV2PlayerStats struct {
-	GAME_ID           string  `json:"GAME_ID"`
-	TEAM_ID           int     `json:"TEAM_ID"`
-	TEAM_ABBREVIATION string  `json:"TEAM_ABBREVIATION"`
-	TEAM_CITY         string  `json:"TEAM_CITY"`
-	PLAYER_ID         int     `json:"PLAYER_ID"`
-	PLAYER_NAME       string  `json:"PLAYER_NAME"`
-	NICKNAME          string  `json:"NICKNAME"`
-	START_POSITION    string  `json:"START_POSITION"`
-	COMMENT           string  `json:"COMMENT"`
-	MIN               float64 `json:"MIN"`
-	USG_PCT           float64 `json:"USG_PCT"`
-	PCT_FGM           int     `json:"PCT_FGM"`
-	PCT_FGA           int     `json:"PCT_FGA"`
-	PCT_FG3M          int     `json:"PCT_FG3M"`
-	PCT_FG3A          int     `json:"PCT_FG3A"`
-	PCT_FTM           int     `json:"PCT_FTM"`
-	PCT_FTA           int     `json:"PCT_FTA"`
-	PCT_OREB          float64 `json:"PCT_OREB"`
-	PCT_DREB          float64 `json:"PCT_DREB"`
-	PCT_REB           float64 `json:"PCT_REB"`
-	PCT_AST           float64 `json:"PCT_AST"`
-	PCT_TOV           float64 `json:"PCT_TOV"`
-	PCT_STL           float64 `json:"PCT_STL"`
-	PCT_BLK           float64 `json:"PCT_BLK"`
-	PCT_BLKA          int     `json:"PCT_BLKA"`
-	PCT_PF            float64 `json:"PCT_PF"`
-	PCT_PFD           float64 `json:"PCT_PFD"`
-	PCT_PTS           float64 `json:"PCT_PTS"`
+	GAME_ID           string              `json:"GAME_ID"`
+	TEAM_ID           int                 `json:"TEAM_ID"`
+	TEAM_ABBREVIATION string              `json:"TEAM_ABBREVIATION"`
+	TEAM_CITY         string              `json:"TEAM_CITY"`
+	PLAYER_ID         int                 `json:"PLAYER_ID"`
+	PLAYER_NAME       string              `json:"PLAYER_NAME"`
+	NICKNAME          string              `json:"NICKNAME"`
+	START_POSITION    string              `json:"START_POSITION"`
+	COMMENT           string              `json:"COMMENT"`
+	MIN               models.Opt[string]  `json:"MIN"`
+	USG_PCT           models.Opt[float64] `json:"USG_PCT"`
+	PCT_FGM           models.Opt[int]     `json:"PCT_FGM"`
+	PCT_FGA           models.Opt[int]     `json:"PCT_FGA"`
+	PCT_FG3M          models.Opt[int]     `json:"PCT_FG3M"`
+	PCT_FG3A          models.Opt[int]     `json:"PCT_FG3A"`
+	PCT_FTM           models.Opt[int]     `json:"PCT_FTM"`
+	PCT_FTA           models.Opt[int]     `json:"PCT_FTA"`
+	PCT_OREB          models.Opt[float64] `json:"PCT_OREB"`
+	PCT_DREB          models.Opt[float64] `json:"PCT_DREB"`
+	PCT_REB           models.Opt[float64] `json:"PCT_REB"`
+	PCT_AST           models.Opt[float64] `json:"PCT_AST"`
+	PCT_TOV           models.Opt[float64] `json:"PCT_TOV"`
+	PCT_STL           models.Opt[float64] `json:"PCT_STL"`
+	PCT_BLK           models.Opt[float64] `json:"PCT_BLK"`
+	PCT_BLKA          models.Opt[int]     `json:"PCT_BLKA"`
+	PCT_PF            models.Opt[float64] `json:"PCT_PF"`
+	PCT_PFD           models.Opt[float64] `json:"PCT_PFD"`
+	PCT_PTS           models.Opt[float64] `json:"PCT_PTS"`
+}
+
+// Minutes parses MIN ("35:12"). It is zero for a player who did not play.
+func (s BoxScoreUsageV2PlayerStats) Minutes() (time.Duration, error) {
+	return timeutil.ParseMinutes(s.MIN.Or(""))
 }
 
 // BoxScoreUsageV2TeamStats represents the TeamStats result set for BoxScoreUsageV2
@@ -58,7 +65,7 @@ type BoxScoreUsageV2TeamStats struct {
 	TEAM_NAME         string  `json:"TEAM_NAME"`
 	TEAM_ABBREVIATION string  `json:"TEAM_ABBREVIATION"`
 	TEAM_CITY         string  `json:"TEAM_CITY"`
-	MIN               float64 `json:"MIN"`
+	MIN               string  `json:"MIN"`
 	USG_PCT           float64 `json:"USG_PCT"`
 	PCT_FGM           int     `json:"PCT_FGM"`
 	PCT_FGA           int     `json:"PCT_FGA"`
@@ -79,6 +86,11 @@ type BoxScoreUsageV2TeamStats struct {
 	PCT_PTS           float64 `json:"PCT_PTS"`
 }
 
+// Minutes parses MIN ("240:00").
+func (s BoxScoreUsageV2TeamStats) Minutes() (time.Duration, error) {
+	return timeutil.ParseMinutes(s.MIN)
+}
+
 // BoxScoreUsageV2Response contains the response data from the BoxScoreUsageV2 endpoint
 type BoxScoreUsageV2Response struct {
 	PlayerStats []BoxScoreUsageV2PlayerStats
@@ -109,83 +121,76 @@ func GetBoxScoreUsageV2(ctx context.Context, client *stats.Client, req BoxScoreU
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "boxscoreusagev2", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "boxscoreusagev2", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &BoxScoreUsageV2Response{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerStats = make([]BoxScoreUsageV2PlayerStats, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 28 {
-				item := BoxScoreUsageV2PlayerStats{
-					GAME_ID:           toString(row[0]),
-					TEAM_ID:           toInt(row[1]),
-					TEAM_ABBREVIATION: toString(row[2]),
-					TEAM_CITY:         toString(row[3]),
-					PLAYER_ID:         toInt(row[4]),
-					PLAYER_NAME:       toString(row[5]),
-					NICKNAME:          toString(row[6]),
-					START_POSITION:    toString(row[7]),
-					COMMENT:           toString(row[8]),
-					MIN:               toFloat(row[9]),
-					USG_PCT:           toFloat(row[10]),
-					PCT_FGM:           toInt(row[11]),
-					PCT_FGA:           toInt(row[12]),
-					PCT_FG3M:          toInt(row[13]),
-					PCT_FG3A:          toInt(row[14]),
-					PCT_FTM:           toInt(row[15]),
-					PCT_FTA:           toInt(row[16]),
-					PCT_OREB:          toFloat(row[17]),
-					PCT_DREB:          toFloat(row[18]),
-					PCT_REB:           toFloat(row[19]),
-					PCT_AST:           toFloat(row[20]),
-					PCT_TOV:           toFloat(row[21]),
-					PCT_STL:           toFloat(row[22]),
-					PCT_BLK:           toFloat(row[23]),
-					PCT_BLKA:          toInt(row[24]),
-					PCT_PF:            toFloat(row[25]),
-					PCT_PFD:           toFloat(row[26]),
-					PCT_PTS:           toFloat(row[27]),
-				}
-				response.PlayerStats = append(response.PlayerStats, item)
+	if rs := rawResp.resultSet("PlayerStats"); rs != nil {
+		response.PlayerStats = decodeRows(rs, func(row *rowReader) BoxScoreUsageV2PlayerStats {
+			return BoxScoreUsageV2PlayerStats{
+				GAME_ID:           row.String("GAME_ID"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				TEAM_CITY:         row.String("TEAM_CITY"),
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				NICKNAME:          row.String("NICKNAME"),
+				START_POSITION:    row.String("START_POSITION"),
+				COMMENT:           row.String("COMMENT"),
+				MIN:               row.OptString("MIN"),
+				USG_PCT:           row.OptFloat("USG_PCT"),
+				PCT_FGM:           row.OptInt("PCT_FGM"),
+				PCT_FGA:           row.OptInt("PCT_FGA"),
+				PCT_FG3M:          row.OptInt("PCT_FG3M"),
+				PCT_FG3A:          row.OptInt("PCT_FG3A"),
+				PCT_FTM:           row.OptInt("PCT_FTM"),
+				PCT_FTA:           row.OptInt("PCT_FTA"),
+				PCT_OREB:          row.OptFloat("PCT_OREB"),
+				PCT_DREB:          row.OptFloat("PCT_DREB"),
+				PCT_REB:           row.OptFloat("PCT_REB"),
+				PCT_AST:           row.OptFloat("PCT_AST"),
+				PCT_TOV:           row.OptFloat("PCT_TOV"),
+				PCT_STL:           row.OptFloat("PCT_STL"),
+				PCT_BLK:           row.OptFloat("PCT_BLK"),
+				PCT_BLKA:          row.OptInt("PCT_BLKA"),
+				PCT_PF:            row.OptFloat("PCT_PF"),
+				PCT_PFD:           row.OptFloat("PCT_PFD"),
+				PCT_PTS:           row.OptFloat("PCT_PTS"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.TeamStats = make([]BoxScoreUsageV2TeamStats, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 24 {
-				item := BoxScoreUsageV2TeamStats{
-					GAME_ID:           toString(row[0]),
-					TEAM_ID:           toInt(row[1]),
-					TEAM_NAME:         toString(row[2]),
-					TEAM_ABBREVIATION: toString(row[3]),
-					TEAM_CITY:         toString(row[4]),
-					MIN:               toFloat(row[5]),
-					USG_PCT:           toFloat(row[6]),
-					PCT_FGM:           toInt(row[7]),
-					PCT_FGA:           toInt(row[8]),
-					PCT_FG3M:          toInt(row[9]),
-					PCT_FG3A:          toInt(row[10]),
-					PCT_FTM:           toInt(row[11]),
-					PCT_FTA:           toInt(row[12]),
-					PCT_OREB:          toFloat(row[13]),
-					PCT_DREB:          toFloat(row[14]),
-					PCT_REB:           toFloat(row[15]),
-					PCT_AST:           toFloat(row[16]),
-					PCT_TOV:           toFloat(row[17]),
-					PCT_STL:           toFloat(row[18]),
-					PCT_BLK:           toFloat(row[19]),
-					PCT_BLKA:          toInt(row[20]),
-					PCT_PF:            toFloat(row[21]),
-					PCT_PFD:           toFloat(row[22]),
-					PCT_PTS:           toFloat(row[23]),
-				}
-				response.TeamStats = append(response.TeamStats, item)
+	if rs := rawResp.resultSet("TeamStats"); rs != nil {
+		response.TeamStats = decodeRows(rs, func(row *rowReader) BoxScoreUsageV2TeamStats {
+			return BoxScoreUsageV2TeamStats{
+				GAME_ID:           row.String("GAME_ID"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_NAME:         row.String("TEAM_NAME"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				TEAM_CITY:         row.String("TEAM_CITY"),
+				MIN:               row.String("MIN"),
+				USG_PCT:           row.Float("USG_PCT"),
+				PCT_FGM:           row.Int("PCT_FGM"),
+				PCT_FGA:           row.Int("PCT_FGA"),
+				PCT_FG3M:          row.Int("PCT_FG3M"),
+				PCT_FG3A:          row.Int("PCT_FG3A"),
+				PCT_FTM:           row.Int("PCT_FTM"),
+				PCT_FTA:           row.Int("PCT_FTA"),
+				PCT_OREB:          row.Float("PCT_OREB"),
+				PCT_DREB:          row.Float("PCT_DREB"),
+				PCT_REB:           row.Float("PCT_REB"),
+				PCT_AST:           row.Float("PCT_AST"),
+				PCT_TOV:           row.Float("PCT_TOV"),
+				PCT_STL:           row.Float("PCT_STL"),
+				PCT_BLK:           row.Float("PCT_BLK"),
+				PCT_BLKA:          row.Int("PCT_BLKA"),
+				PCT_PF:            row.Float("PCT_PF"),
+				PCT_PFD:           row.Float("PCT_PFD"),
+				PCT_PTS:           row.Float("PCT_PTS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
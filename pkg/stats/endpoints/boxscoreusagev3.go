@@ -0,0 +1,50 @@
+package endpoints
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/stats"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
+)
+
+// BoxScoreUsageV3Statistics is an usage box score line of a player or team.
+type BoxScoreUsageV3Statistics struct {
+	Minutes                          string  `json:"minutes"`
+	UsagePercentage                  float64 `json:"usagePercentage"`
+	PercentageFieldGoalsMade         float64 `json:"percentageFieldGoalsMade"`
+	PercentageFieldGoalsAttempted    float64 `json:"percentageFieldGoalsAttempted"`
+	PercentageThreePointersMade      float64 `json:"percentageThreePointersMade"`
+	PercentageThreePointersAttempted float64 `json:"percentageThreePointersAttempted"`
+	PercentageFreeThrowsMade         float64 `json:"percentageFreeThrowsMade"`
+	PercentageFreeThrowsAttempted    float64 `json:"percentageFreeThrowsAttempted"`
+	PercentageReboundsOffensive      float64 `json:"percentageReboundsOffensive"`
+	PercentageReboundsDefensive      float64 `json:"percentageReboundsDefensive"`
+	PercentageReboundsTotal          float64 `json:"percentageReboundsTotal"`
+	PercentageAssists                float64 `json:"percentageAssists"`
+	PercentageTurnovers              float64 `json:"percentageTurnovers"`
+	PercentageSteals                 float64 `json:"percentageSteals"`
+	PercentageBlocks                 float64 `json:"percentageBlocks"`
+	PercentageBlocksAllowed          float64 `json:"percentageBlocksAllowed"`
+	PercentagePersonalFouls          float64 `json:"percentagePersonalFouls"`
+	PercentagePersonalFoulsDrawn     float64 `json:"percentagePersonalFoulsDrawn"`
+	PercentagePoints                 float64 `json:"percentagePoints"`
+}
+
+// MinutesPlayed parses Minutes ("35:12").
+func (s BoxScoreUsageV3Statistics) MinutesPlayed() (time.Duration, error) {
+	return timeutil.ParseMinutes(s.Minutes)
+}
+
+type BoxScoreUsageV3Response = BoxScoreV3[BoxScoreUsageV3Statistics]
+
+// GetBoxScoreUsageV3 retrieves the boxscoreusagev3 endpoint.
+func GetBoxScoreUsageV3(ctx context.Context, client *stats.Client, req BoxScoreV3Request) (*models.Response[*BoxScoreUsageV3Response], error) {
+	var doc struct {
+		Meta     json.RawMessage          `json:"meta"`
+		BoxScore *BoxScoreUsageV3Response `json:"boxScoreUsage"`
+	}
+	return getBoxScoreV3(ctx, client, "boxscoreusagev3", req, &doc, &doc.BoxScore)
+}
@@ -0,0 +1,104 @@
+package endpoints
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/stats"
+)
+
+// BoxScoreV3Request contains parameters for the box score V3 endpoints.
+type BoxScoreV3Request struct {
+	GameID      string
+	StartPeriod *string
+	EndPeriod   *string
+	StartRange  *string
+	EndRange    *string
+	RangeType   *string
+}
+
+func (req BoxScoreV3Request) params() (url.Values, error) {
+	if req.GameID == "" {
+		return nil, fmt.Errorf("%w: GameID is required", models.ErrInvalidRequest)
+	}
+
+	params := url.Values{}
+	params.Set("GameID", req.GameID)
+	for name, value := range map[string]*string{
+		"StartPeriod": req.StartPeriod,
+		"EndPeriod":   req.EndPeriod,
+		"StartRange":  req.StartRange,
+		"EndRange":    req.EndRange,
+		"RangeType":   req.RangeType,
+	} {
+		if value != nil {
+			params.Set(name, *value)
+		}
+	}
+	return params, nil
+}
+
+// BoxScoreV3 is the nested document shared by the box score V3 endpoints.
+// S is the statistics type of the endpoint, used for both players and teams.
+type BoxScoreV3[S any] struct {
+	GameID     string            `json:"gameId"`
+	AwayTeamID int               `json:"awayTeamId"`
+	HomeTeamID int               `json:"homeTeamId"`
+	HomeTeam   BoxScoreV3Team[S] `json:"homeTeam"`
+	AwayTeam   BoxScoreV3Team[S] `json:"awayTeam"`
+}
+
+// BoxScoreV3Team holds a team's totals and its players. Starters and Bench
+// are only returned by BoxScoreTraditionalV3.
+type BoxScoreV3Team[S any] struct {
+	TeamID      int                   `json:"teamId"`
+	TeamCity    string                `json:"teamCity"`
+	TeamName    string                `json:"teamName"`
+	TeamTricode string                `json:"teamTricode"`
+	TeamSlug    string                `json:"teamSlug"`
+	Players     []BoxScoreV3Player[S] `json:"players"`
+	Statistics  S                     `json:"statistics"`
+	Starters    *S                    `json:"starters,omitempty"`
+	Bench       *S                    `json:"bench,omitempty"`
+}
+
+// BoxScoreV3Player is one player's line. Comment explains a DNP, such as
+// "DNP - Coach's Decision".
+type BoxScoreV3Player[S any] struct {
+	PersonID   int    `json:"personId"`
+	FirstName  string `json:"firstName"`
+	FamilyName string `json:"familyName"`
+	NameI      string `json:"nameI"`
+	PlayerSlug string `json:"playerSlug"`
+	Position   string `json:"position"`
+	Comment    string `json:"comment"`
+	JerseyNum  string `json:"jerseyNum"`
+	Statistics S      `json:"statistics"`
+}
+
+// Players returns the players of both teams, home team first.
+func (b *BoxScoreV3[S]) Players() []BoxScoreV3Player[S] {
+	players := make([]BoxScoreV3Player[S], 0, len(b.HomeTeam.Players)+len(b.AwayTeam.Players))
+	players = append(players, b.HomeTeam.Players...)
+	return append(players, b.AwayTeam.Players...)
+}
+
+// getBoxScoreV3 fetches endpoint into doc, whose box score field is data.
+func getBoxScoreV3[S any](ctx context.Context, client *stats.Client, endpoint string, req BoxScoreV3Request, doc interface{}, data **BoxScoreV3[S]) (*models.Response[*BoxScoreV3[S]], error) {
+	params, err := req.params()
+	if err != nil {
+		return nil, err
+	}
+
+	raw, drift, err := getDocument(ctx, client, endpoint, params, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	if *data == nil {
+		*data = &BoxScoreV3[S]{}
+	}
+	return newDriftResponse(client, *data, raw, drift)
+}
@@ -0,0 +1,116 @@
+package endpoints
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/stats"
+)
+
+func TestBoxScoreTraditionalV3_Replay(t *testing.T) {
+	resp, err := GetBoxScoreTraditionalV3(context.Background(), replayClient(t, "boxscoretraditionalv3"), BoxScoreV3Request{GameID: "0022400061"})
+	if err != nil {
+		t.Fatalf("GetBoxScoreTraditionalV3() error = %v", err)
+	}
+	if len(resp.Warnings) != 0 {
+		t.Errorf("Warnings = %v, want none", resp.Warnings)
+	}
+
+	box := resp.Data
+	if box.HomeTeam.TeamTricode != "LAL" || box.HomeTeam.Statistics.Points != 110 || box.HomeTeam.Starters == nil || box.HomeTeam.Bench == nil {
+		t.Fatalf("home team = %+v", box.HomeTeam)
+	}
+	if players := box.Players(); len(players) != 3 || players[2].PersonID != 1630162 {
+		t.Errorf("Players() = %+v", players)
+	}
+
+	lebron := box.HomeTeam.Players[0]
+	if lebron.PersonID != 2544 || lebron.Statistics.Points != 16 || lebron.Statistics.Assists != 8 || lebron.Statistics.FieldGoalsPercentage != 0.538 {
+		t.Errorf("player = %+v", lebron)
+	}
+	if played, err := lebron.Statistics.MinutesPlayed(); err != nil || played != 35*time.Minute+12*time.Second {
+		t.Errorf("MinutesPlayed() = %v, %v", played, err)
+	}
+
+	dnp := box.HomeTeam.Players[1]
+	if dnp.Comment == "" {
+		t.Errorf("DNP player = %+v", dnp)
+	}
+	if played, err := dnp.Statistics.MinutesPlayed(); err != nil || played != 0 {
+		t.Errorf("DNP MinutesPlayed() = %v, %v", played, err)
+	}
+}
+
+func TestBoxScoreV3_Family(t *testing.T) {
+	tests := []struct {
+		name string
+		get  func(*stats.Client) (homeTeamID int, players int, warnings []models.SchemaDrift, err error)
+	}{
+		{"boxscoreadvancedv3", boxScoreV3Getter(GetBoxScoreAdvancedV3)},
+		{"boxscoremiscv3", boxScoreV3Getter(GetBoxScoreMiscV3)},
+		{"boxscorescoringv3", boxScoreV3Getter(GetBoxScoreScoringV3)},
+		{"boxscoreusagev3", boxScoreV3Getter(GetBoxScoreUsageV3)},
+		{"boxscorefourfactorsv3", boxScoreV3Getter(GetBoxScoreFourFactorsV3)},
+		{"boxscoreplayertrackv3", boxScoreV3Getter(GetBoxScorePlayerTrackV3)},
+		{"boxscoredefensivev3", boxScoreV3Getter(GetBoxScoreDefensiveV3)},
+		{"boxscorehustlev3", boxScoreV3Getter(GetBoxScoreHustleV3)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			homeTeamID, players, warnings, err := tt.get(replayClient(t, tt.name))
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if len(warnings) != 0 {
+				t.Errorf("Warnings = %v, want none", warnings)
+			}
+			if homeTeamID != 1610612747 || players != 3 {
+				t.Errorf("HomeTeamID = %d, players = %d", homeTeamID, players)
+			}
+		})
+	}
+}
+
+func boxScoreV3Getter[S any](fn func(context.Context, *stats.Client, BoxScoreV3Request) (*models.Response[*BoxScoreV3[S]], error)) func(*stats.Client) (int, int, []models.SchemaDrift, error) {
+	return func(client *stats.Client) (int, int, []models.SchemaDrift, error) {
+		resp, err := fn(context.Background(), client, BoxScoreV3Request{GameID: "0022400061"})
+		if err != nil {
+			return 0, 0, nil, err
+		}
+		return resp.Data.HomeTeamID, len(resp.Data.Players()), resp.Warnings, nil
+	}
+}
+
+func TestBoxScoreV3_MissingGameID(t *testing.T) {
+	_, err := GetBoxScoreHustleV3(context.Background(), stats.NewClient(stats.Config{}), BoxScoreV3Request{})
+	if !errors.Is(err, models.ErrInvalidRequest) {
+		t.Errorf("error = %v, want ErrInvalidRequest", err)
+	}
+}
+
+func TestBoxScoreSummaryV3_Replay(t *testing.T) {
+	resp, err := GetBoxScoreSummaryV3(context.Background(), replayClient(t, "boxscoresummaryv3"), BoxScoreSummaryV3Request{GameID: "0022400061"})
+	if err != nil {
+		t.Fatalf("GetBoxScoreSummaryV3() error = %v", err)
+	}
+	if len(resp.Warnings) != 0 {
+		t.Errorf("Warnings = %v, want none", resp.Warnings)
+	}
+
+	summary := resp.Data
+	if summary.GameStatusText != "Final" || summary.Arena.ArenaName != "Crypto.com Arena" || len(summary.Officials) != 1 {
+		t.Fatalf("summary = %+v", summary)
+	}
+	if summary.HomeTeam.Score != 110 || len(summary.HomeTeam.Periods) != 4 || summary.HomeTeam.Seed.Valid {
+		t.Errorf("home team = %+v", summary.HomeTeam)
+	}
+	if summary.GameDuration() != 2*time.Hour+22*time.Minute {
+		t.Errorf("GameDuration() = %v", summary.GameDuration())
+	}
+	if start, err := summary.StartTime(); err != nil || !start.Equal(time.Date(2024, time.October, 23, 2, 0, 0, 0, time.UTC)) {
+		t.Errorf("StartTime() = %v, %v", start, err)
+	}
+}
@@ -53,35 +53,32 @@ func GetCommonAllPlayersV2(ctx context.Context, client *stats.Client, req Common
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "commonallplayersv2", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "commonallplayersv2", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &CommonAllPlayersV2Response{}
-	if len(rawResp.ResultSets) > 0 {
-		response.CommonAllPlayers = make([]CommonAllPlayersV2CommonAllPlayers, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 14 {
-				item := CommonAllPlayersV2CommonAllPlayers{
-					PERSON_ID:                 toString(row[0]),
-					DISPLAY_LAST_COMMA_FIRST:  toFloat(row[1]),
-					DISPLAY_FIRST_LAST:        toFloat(row[2]),
-					ROSTERSTATUS:              toString(row[3]),
-					FROM_YEAR:                 toString(row[4]),
-					TO_YEAR:                   toString(row[5]),
-					PLAYERCODE:                toString(row[6]),
-					TEAM_ID:                   toInt(row[7]),
-					TEAM_CITY:                 toString(row[8]),
-					TEAM_NAME:                 toString(row[9]),
-					TEAM_ABBREVIATION:         toString(row[10]),
-					TEAM_CODE:                 toString(row[11]),
-					GAMES_PLAYED_FLAG:         toString(row[12]),
-					OTHERLEAGUE_EXPERIENCE_CH: toString(row[13]),
-				}
-				response.CommonAllPlayers = append(response.CommonAllPlayers, item)
+	if rs := rawResp.resultSet("CommonAllPlayers"); rs != nil {
+		response.CommonAllPlayers = decodeRows(rs, func(row *rowReader) CommonAllPlayersV2CommonAllPlayers {
+			return CommonAllPlayersV2CommonAllPlayers{
+				PERSON_ID:                 row.String("PERSON_ID"),
+				DISPLAY_LAST_COMMA_FIRST:  row.Float("DISPLAY_LAST_COMMA_FIRST"),
+				DISPLAY_FIRST_LAST:        row.Float("DISPLAY_FIRST_LAST"),
+				ROSTERSTATUS:              row.String("ROSTERSTATUS"),
+				FROM_YEAR:                 row.String("FROM_YEAR"),
+				TO_YEAR:                   row.String("TO_YEAR"),
+				PLAYERCODE:                row.String("PLAYERCODE"),
+				TEAM_ID:                   row.Int("TEAM_ID"),
+				TEAM_CITY:                 row.String("TEAM_CITY"),
+				TEAM_NAME:                 row.String("TEAM_NAME"),
+				TEAM_ABBREVIATION:         row.String("TEAM_ABBREVIATION"),
+				TEAM_CODE:                 row.String("TEAM_CODE"),
+				GAMES_PLAYED_FLAG:         row.String("GAMES_PLAYED_FLAG"),
+				OTHERLEAGUE_EXPERIENCE_CH: row.String("OTHERLEAGUE_EXPERIENCE_CH"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -85,99 +85,81 @@ func CommonPlayerInfo(ctx context.Context, client *stats.Client, req CommonPlaye
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "commonplayerinfo", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "commonplayerinfo", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &CommonPlayerInfoResponse{}
-	for _, resultSet := range rawResp.ResultSets {
-		switch resultSet.Name {
-		case "CommonPlayerInfo":
-			response.CommonPlayerInfo = parsePlayerInfo(resultSet.RowSet)
-		case "PlayerHeadlineStats":
-			response.PlayerHeadlineStats = parseHeadlineStats(resultSet.RowSet)
-		case "AvailableSeasons":
-			response.AvailableSeasons = parseAvailableSeasons(resultSet.RowSet)
-		}
+	if rs := rawResp.resultSet("CommonPlayerInfo"); rs != nil {
+		response.CommonPlayerInfo = parsePlayerInfo(rs)
+	}
+	if rs := rawResp.resultSet("PlayerHeadlineStats"); rs != nil {
+		response.PlayerHeadlineStats = parseHeadlineStats(rs)
+	}
+	if rs := rawResp.resultSet("AvailableSeasons"); rs != nil {
+		response.AvailableSeasons = parseAvailableSeasons(rs)
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
 
-func parsePlayerInfo(rows [][]interface{}) []PlayerInfo {
-	infos := make([]PlayerInfo, 0, len(rows))
-	for _, row := range rows {
-		if len(row) < 31 {
-			continue
+func parsePlayerInfo(rs *ResultSet) []PlayerInfo {
+	return decodeRows(rs, func(row *rowReader) PlayerInfo {
+		return PlayerInfo{
+			PersonID:              row.Int("PERSON_ID"),
+			FirstName:             row.String("FIRST_NAME"),
+			LastName:              row.String("LAST_NAME"),
+			DisplayFirstLast:      row.String("DISPLAY_FIRST_LAST"),
+			DisplayLastCommaFirst: row.String("DISPLAY_LAST_COMMA_FIRST"),
+			DisplayFILast:         row.String("DISPLAY_FI_LAST"),
+			PlayerSlug:            row.String("PLAYER_SLUG"),
+			Birthdate:             row.String("BIRTHDATE"),
+			School:                row.String("SCHOOL"),
+			Country:               row.String("COUNTRY"),
+			LastAffiliation:       row.String("LAST_AFFILIATION"),
+			Height:                row.String("HEIGHT"),
+			Weight:                row.String("WEIGHT"),
+			SeasonExp:             row.Int("SEASON_EXP"),
+			Jersey:                row.String("JERSEY"),
+			Position:              row.String("POSITION"),
+			RosterStatus:          row.String("ROSTERSTATUS"),
+			TeamID:                row.Int("TEAM_ID"),
+			TeamName:              row.String("TEAM_NAME"),
+			TeamAbbreviation:      row.String("TEAM_ABBREVIATION"),
+			TeamCode:              row.String("TEAM_CODE"),
+			TeamCity:              row.String("TEAM_CITY"),
+			PlayerCode:            row.String("PLAYERCODE"),
+			FromYear:              row.String("FROM_YEAR"),
+			ToYear:                row.String("TO_YEAR"),
+			DLeagueFlag:           row.String("DLEAGUE_FLAG"),
+			NBAFlag:               row.String("NBA_FLAG"),
+			GamesPlayedFlag:       row.String("GAMES_PLAYED_FLAG"),
+			DraftYear:             row.String("DRAFT_YEAR"),
+			DraftRound:            row.String("DRAFT_ROUND"),
+			DraftNumber:           row.String("DRAFT_NUMBER"),
 		}
-		info := PlayerInfo{
-			PersonID:              toInt(row[0]),
-			FirstName:             toString(row[1]),
-			LastName:              toString(row[2]),
-			DisplayFirstLast:      toString(row[3]),
-			DisplayLastCommaFirst: toString(row[4]),
-			DisplayFILast:         toString(row[5]),
-			PlayerSlug:            toString(row[6]),
-			Birthdate:             toString(row[7]),
-			School:                toString(row[8]),
-			Country:               toString(row[9]),
-			LastAffiliation:       toString(row[10]),
-			Height:                toString(row[11]),
-			Weight:                toString(row[12]),
-			SeasonExp:             toInt(row[13]),
-			Jersey:                toString(row[14]),
-			Position:              toString(row[15]),
-			RosterStatus:          toString(row[16]),
-			TeamID:                toInt(row[17]),
-			TeamName:              toString(row[18]),
-			TeamAbbreviation:      toString(row[19]),
-			TeamCode:              toString(row[20]),
-			TeamCity:              toString(row[21]),
-			PlayerCode:            toString(row[22]),
-			FromYear:              toString(row[23]),
-			ToYear:                toString(row[24]),
-			DLeagueFlag:           toString(row[25]),
-			NBAFlag:               toString(row[26]),
-			GamesPlayedFlag:       toString(row[27]),
-			DraftYear:             toString(row[28]),
-			DraftRound:            toString(row[29]),
-			DraftNumber:           toString(row[30]),
-		}
-		infos = append(infos, info)
-	}
-	return infos
+	})
 }
 
-func parseHeadlineStats(rows [][]interface{}) []HeadlineStats {
-	stats := make([]HeadlineStats, 0, len(rows))
-	for _, row := range rows {
-		if len(row) < 7 {
-			continue
+func parseHeadlineStats(rs *ResultSet) []HeadlineStats {
+	return decodeRows(rs, func(row *rowReader) HeadlineStats {
+		return HeadlineStats{
+			PlayerID:   row.Int("PLAYER_ID"),
+			PlayerName: row.String("PLAYER_NAME"),
+			TimeFrame:  row.String("TimeFrame"),
+			PTS:        row.Float("PTS"),
+			AST:        row.Float("AST"),
+			REB:        row.Float("REB"),
+			PIE:        row.Float("PIE"),
 		}
-		stat := HeadlineStats{
-			PlayerID:   toInt(row[0]),
-			PlayerName: toString(row[1]),
-			TimeFrame:  toString(row[2]),
-			PTS:        toFloat(row[3]),
-			AST:        toFloat(row[4]),
-			REB:        toFloat(row[5]),
-			PIE:        toFloat(row[6]),
-		}
-		stats = append(stats, stat)
-	}
-	return stats
+	})
 }
 
-func parseAvailableSeasons(rows [][]interface{}) []AvailableSeason {
-	seasons := make([]AvailableSeason, 0, len(rows))
-	for _, row := range rows {
-		if len(row) < 1 {
-			continue
+func parseAvailableSeasons(rs *ResultSet) []AvailableSeason {
+	return decodeRows(rs, func(row *rowReader) AvailableSeason {
+		return AvailableSeason{
+			SeasonID: row.String("SEASON_ID"),
 		}
-		season := AvailableSeason{
-			SeasonID: toString(row[0]),
-		}
-		seasons = append(seasons, season)
-	}
-	return seasons
+	})
 }
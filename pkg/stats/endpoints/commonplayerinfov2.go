@@ -82,71 +82,64 @@ func GetCommonPlayerInfoV2(ctx context.Context, client *stats.Client, req Common
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "commonplayerinfoV2", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "commonplayerinfoV2", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &CommonPlayerInfoV2Response{}
-	if len(rawResp.ResultSets) > 0 {
-		response.CommonPlayerInfo = make([]CommonPlayerInfoV2CommonPlayerInfo, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 33 {
-				item := CommonPlayerInfoV2CommonPlayerInfo{
-					PERSON_ID:                        toString(row[0]),
-					FIRST_NAME:                       toString(row[1]),
-					LAST_NAME:                        toString(row[2]),
-					DISPLAY_FIRST_LAST:               toFloat(row[3]),
-					DISPLAY_LAST_COMMA_FIRST:         toFloat(row[4]),
-					DISPLAY_FI_LAST:                  toFloat(row[5]),
-					PLAYER_SLUG:                      toString(row[6]),
-					BIRTHDATE:                        toString(row[7]),
-					SCHOOL:                           toString(row[8]),
-					COUNTRY:                          toString(row[9]),
-					LAST_AFFILIATION:                 toFloat(row[10]),
-					HEIGHT:                           toString(row[11]),
-					WEIGHT:                           toString(row[12]),
-					SEASON_EXP:                       toString(row[13]),
-					JERSEY:                           toString(row[14]),
-					POSITION:                         toString(row[15]),
-					ROSTERSTATUS:                     toString(row[16]),
-					GAMES_PLAYED_CURRENT_SEASON_FLAG: toString(row[17]),
-					TEAM_ID:                          toInt(row[18]),
-					TEAM_NAME:                        toString(row[19]),
-					TEAM_ABBREVIATION:                toString(row[20]),
-					TEAM_CODE:                        toString(row[21]),
-					TEAM_CITY:                        toString(row[22]),
-					PLAYERCODE:                       toString(row[23]),
-					FROM_YEAR:                        toString(row[24]),
-					TO_YEAR:                          toString(row[25]),
-					DLEAGUE_FLAG:                     toString(row[26]),
-					NBA_FLAG:                         toString(row[27]),
-					GAMES_PLAYED_FLAG:                toString(row[28]),
-					DRAFT_YEAR:                       toString(row[29]),
-					DRAFT_ROUND:                      toString(row[30]),
-					DRAFT_NUMBER:                     toString(row[31]),
-					GREATEST_75_FLAG:                 toString(row[32]),
-				}
-				response.CommonPlayerInfo = append(response.CommonPlayerInfo, item)
+	if rs := rawResp.resultSet("CommonPlayerInfo"); rs != nil {
+		response.CommonPlayerInfo = decodeRows(rs, func(row *rowReader) CommonPlayerInfoV2CommonPlayerInfo {
+			return CommonPlayerInfoV2CommonPlayerInfo{
+				PERSON_ID:                        row.String("PERSON_ID"),
+				FIRST_NAME:                       row.String("FIRST_NAME"),
+				LAST_NAME:                        row.String("LAST_NAME"),
+				DISPLAY_FIRST_LAST:               row.Float("DISPLAY_FIRST_LAST"),
+				DISPLAY_LAST_COMMA_FIRST:         row.Float("DISPLAY_LAST_COMMA_FIRST"),
+				DISPLAY_FI_LAST:                  row.Float("DISPLAY_FI_LAST"),
+				PLAYER_SLUG:                      row.String("PLAYER_SLUG"),
+				BIRTHDATE:                        row.String("BIRTHDATE"),
+				SCHOOL:                           row.String("SCHOOL"),
+				COUNTRY:                          row.String("COUNTRY"),
+				LAST_AFFILIATION:                 row.Float("LAST_AFFILIATION"),
+				HEIGHT:                           row.String("HEIGHT"),
+				WEIGHT:                           row.String("WEIGHT"),
+				SEASON_EXP:                       row.String("SEASON_EXP"),
+				JERSEY:                           row.String("JERSEY"),
+				POSITION:                         row.String("POSITION"),
+				ROSTERSTATUS:                     row.String("ROSTERSTATUS"),
+				GAMES_PLAYED_CURRENT_SEASON_FLAG: row.String("GAMES_PLAYED_CURRENT_SEASON_FLAG"),
+				TEAM_ID:                          row.Int("TEAM_ID"),
+				TEAM_NAME:                        row.String("TEAM_NAME"),
+				TEAM_ABBREVIATION:                row.String("TEAM_ABBREVIATION"),
+				TEAM_CODE:                        row.String("TEAM_CODE"),
+				TEAM_CITY:                        row.String("TEAM_CITY"),
+				PLAYERCODE:                       row.String("PLAYERCODE"),
+				FROM_YEAR:                        row.String("FROM_YEAR"),
+				TO_YEAR:                          row.String("TO_YEAR"),
+				DLEAGUE_FLAG:                     row.String("DLEAGUE_FLAG"),
+				NBA_FLAG:                         row.String("NBA_FLAG"),
+				GAMES_PLAYED_FLAG:                row.String("GAMES_PLAYED_FLAG"),
+				DRAFT_YEAR:                       row.String("DRAFT_YEAR"),
+				DRAFT_ROUND:                      row.String("DRAFT_ROUND"),
+				DRAFT_NUMBER:                     row.String("DRAFT_NUMBER"),
+				GREATEST_75_FLAG:                 row.String("GREATEST_75_FLAG"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.PlayerHeadlineStats = make([]CommonPlayerInfoV2PlayerHeadlineStats, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 7 {
-				item := CommonPlayerInfoV2PlayerHeadlineStats{
-					PLAYER_ID:   toInt(row[0]),
-					PLAYER_NAME: toString(row[1]),
-					TimeFrame:   toString(row[2]),
-					PTS:         toFloat(row[3]),
-					AST:         toFloat(row[4]),
-					REB:         toFloat(row[5]),
-					PIE:         toString(row[6]),
-				}
-				response.PlayerHeadlineStats = append(response.PlayerHeadlineStats, item)
+	if rs := rawResp.resultSet("PlayerHeadlineStats"); rs != nil {
+		response.PlayerHeadlineStats = decodeRows(rs, func(row *rowReader) CommonPlayerInfoV2PlayerHeadlineStats {
+			return CommonPlayerInfoV2PlayerHeadlineStats{
+				PLAYER_ID:   row.Int("PLAYER_ID"),
+				PLAYER_NAME: row.String("PLAYER_NAME"),
+				TimeFrame:   row.String("TimeFrame"),
+				PTS:         row.Float("PTS"),
+				AST:         row.Float("AST"),
+				REB:         row.Float("REB"),
+				PIE:         row.String("PIE"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -40,26 +40,23 @@ func GetCommonPlayoffSeriesV2(ctx context.Context, client *stats.Client, req Com
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "commonplayoffseriesv2", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "commonplayoffseriesv2", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &CommonPlayoffSeriesV2Response{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayoffSeries = make([]CommonPlayoffSeriesV2PlayoffSeries, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 5 {
-				item := CommonPlayoffSeriesV2PlayoffSeries{
-					GAME_ID:         toString(row[0]),
-					HOME_TEAM_ID:    toInt(row[1]),
-					VISITOR_TEAM_ID: toInt(row[2]),
-					SERIES_ID:       toString(row[3]),
-					GAME_NUM:        toString(row[4]),
-				}
-				response.PlayoffSeries = append(response.PlayoffSeries, item)
+	if rs := rawResp.resultSet("PlayoffSeries"); rs != nil {
+		response.PlayoffSeries = decodeRows(rs, func(row *rowReader) CommonPlayoffSeriesV2PlayoffSeries {
+			return CommonPlayoffSeriesV2PlayoffSeries{
+				GAME_ID:         row.String("GAME_ID"),
+				HOME_TEAM_ID:    row.Int("HOME_TEAM_ID"),
+				VISITOR_TEAM_ID: row.Int("VISITOR_TEAM_ID"),
+				SERIES_ID:       row.String("SERIES_ID"),
+				GAME_NUM:        row.String("GAME_NUM"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -73,58 +73,51 @@ func GetCommonTeamRoster(ctx context.Context, client *stats.Client, req CommonTe
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "commonteamroster", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "commonteamroster", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &CommonTeamRosterResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.CommonTeamRoster = make([]CommonTeamRosterCommonTeamRoster, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 16 {
-				item := CommonTeamRosterCommonTeamRoster{
-					TeamID:       toString(row[0]),
-					SEASON:       toString(row[1]),
-					LeagueID:     toString(row[2]),
-					PLAYER:       toString(row[3]),
-					NICKNAME:     toString(row[4]),
-					PLAYER_SLUG:  toString(row[5]),
-					NUM:          toString(row[6]),
-					POSITION:     toString(row[7]),
-					HEIGHT:       toString(row[8]),
-					WEIGHT:       toString(row[9]),
-					BIRTH_DATE:   toString(row[10]),
-					AGE:          toInt(row[11]),
-					EXP:          toString(row[12]),
-					SCHOOL:       toString(row[13]),
-					PLAYER_ID:    toInt(row[14]),
-					HOW_ACQUIRED: toString(row[15]),
-				}
-				response.CommonTeamRoster = append(response.CommonTeamRoster, item)
+	if rs := rawResp.resultSet("CommonTeamRoster"); rs != nil {
+		response.CommonTeamRoster = decodeRows(rs, func(row *rowReader) CommonTeamRosterCommonTeamRoster {
+			return CommonTeamRosterCommonTeamRoster{
+				TeamID:       row.String("TeamID"),
+				SEASON:       row.String("SEASON"),
+				LeagueID:     row.String("LeagueID"),
+				PLAYER:       row.String("PLAYER"),
+				NICKNAME:     row.String("NICKNAME"),
+				PLAYER_SLUG:  row.String("PLAYER_SLUG"),
+				NUM:          row.String("NUM"),
+				POSITION:     row.String("POSITION"),
+				HEIGHT:       row.String("HEIGHT"),
+				WEIGHT:       row.String("WEIGHT"),
+				BIRTH_DATE:   row.String("BIRTH_DATE"),
+				AGE:          row.Int("AGE"),
+				EXP:          row.String("EXP"),
+				SCHOOL:       row.String("SCHOOL"),
+				PLAYER_ID:    row.Int("PLAYER_ID"),
+				HOW_ACQUIRED: row.String("HOW_ACQUIRED"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.Coaches = make([]CommonTeamRosterCoaches, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 11 {
-				item := CommonTeamRosterCoaches{
-					TEAM_ID:       toInt(row[0]),
-					SEASON:        toString(row[1]),
-					COACH_ID:      toString(row[2]),
-					FIRST_NAME:    toString(row[3]),
-					LAST_NAME:     toString(row[4]),
-					COACH_NAME:    toString(row[5]),
-					COACH_CODE:    toString(row[6]),
-					IS_ASSISTANT:  toString(row[7]),
-					COACH_TYPE:    toString(row[8]),
-					SCHOOL:        toString(row[9]),
-					SORT_SEQUENCE: toInt(row[10]),
-				}
-				response.Coaches = append(response.Coaches, item)
+	if rs := rawResp.resultSet("Coaches"); rs != nil {
+		response.Coaches = decodeRows(rs, func(row *rowReader) CommonTeamRosterCoaches {
+			return CommonTeamRosterCoaches{
+				TEAM_ID:       row.Int("TEAM_ID"),
+				SEASON:        row.String("SEASON"),
+				COACH_ID:      row.String("COACH_ID"),
+				FIRST_NAME:    row.String("FIRST_NAME"),
+				LAST_NAME:     row.String("LAST_NAME"),
+				COACH_NAME:    row.String("COACH_NAME"),
+				COACH_CODE:    row.String("COACH_CODE"),
+				IS_ASSISTANT:  row.String("IS_ASSISTANT"),
+				COACH_TYPE:    row.String("COACH_TYPE"),
+				SCHOOL:        row.String("SCHOOL"),
+				SORT_SEQUENCE: row.Int("SORT_SEQUENCE"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
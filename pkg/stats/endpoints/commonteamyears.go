@@ -36,26 +36,23 @@ func GetCommonTeamYears(ctx context.Context, client *stats.Client, req CommonTea
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "commonteamyears", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "commonteamyears", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &CommonTeamYearsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.TeamYears = make([]CommonTeamYearsTeamYears, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 5 {
-				item := CommonTeamYearsTeamYears{
-					LEAGUE_ID:    toString(row[0]),
-					TEAM_ID:      toInt(row[1]),
-					MIN_YEAR:     toFloat(row[2]),
-					MAX_YEAR:     toString(row[3]),
-					ABBREVIATION: toString(row[4]),
-				}
-				response.TeamYears = append(response.TeamYears, item)
+	if rs := rawResp.resultSet("TeamYears"); rs != nil {
+		response.TeamYears = decodeRows(rs, func(row *rowReader) CommonTeamYearsTeamYears {
+			return CommonTeamYearsTeamYears{
+				LEAGUE_ID:    row.String("LEAGUE_ID"),
+				TEAM_ID:      row.Int("TEAM_ID"),
+				MIN_YEAR:     row.Float("MIN_YEAR"),
+				MAX_YEAR:     row.String("MAX_YEAR"),
+				ABBREVIATION: row.String("ABBREVIATION"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
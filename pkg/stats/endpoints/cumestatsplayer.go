@@ -100,81 +100,74 @@ func GetCumeStatsPlayer(ctx context.Context, client *stats.Client, req CumeStats
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "cumestatsplayer", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "cumestatsplayer", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &CumeStatsPlayerResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.GameByGameStats = make([]CumeStatsPlayerGameByGameStats, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 28 {
-				item := CumeStatsPlayerGameByGameStats{
-					PLAYER_ID:         toInt(row[0]),
-					SEASON_ID:         toString(row[1]),
-					TEAM_ID:           toInt(row[2]),
-					TEAM_ABBREVIATION: toString(row[3]),
-					GAME_ID:           toString(row[4]),
-					GAME_DATE:         toString(row[5]),
-					MATCHUP:           toString(row[6]),
-					WL:                toString(row[7]),
-					MIN:               toFloat(row[8]),
-					FGM:               toInt(row[9]),
-					FGA:               toInt(row[10]),
-					FG_PCT:            toFloat(row[11]),
-					FG3M:              toInt(row[12]),
-					FG3A:              toInt(row[13]),
-					FG3_PCT:           toFloat(row[14]),
-					FTM:               toInt(row[15]),
-					FTA:               toInt(row[16]),
-					FT_PCT:            toFloat(row[17]),
-					OREB:              toFloat(row[18]),
-					DREB:              toFloat(row[19]),
-					REB:               toFloat(row[20]),
-					AST:               toFloat(row[21]),
-					STL:               toFloat(row[22]),
-					BLK:               toFloat(row[23]),
-					TOV:               toFloat(row[24]),
-					PF:                toFloat(row[25]),
-					PTS:               toFloat(row[26]),
-					PLUS_MINUS:        toFloat(row[27]),
-				}
-				response.GameByGameStats = append(response.GameByGameStats, item)
+	if rs := rawResp.resultSet("GameByGameStats"); rs != nil {
+		response.GameByGameStats = decodeRows(rs, func(row *rowReader) CumeStatsPlayerGameByGameStats {
+			return CumeStatsPlayerGameByGameStats{
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				SEASON_ID:         row.String("SEASON_ID"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				GAME_ID:           row.String("GAME_ID"),
+				GAME_DATE:         row.String("GAME_DATE"),
+				MATCHUP:           row.String("MATCHUP"),
+				WL:                row.String("WL"),
+				MIN:               row.Float("MIN"),
+				FGM:               row.Int("FGM"),
+				FGA:               row.Int("FGA"),
+				FG_PCT:            row.Float("FG_PCT"),
+				FG3M:              row.Int("FG3M"),
+				FG3A:              row.Int("FG3A"),
+				FG3_PCT:           row.Float("FG3_PCT"),
+				FTM:               row.Int("FTM"),
+				FTA:               row.Int("FTA"),
+				FT_PCT:            row.Float("FT_PCT"),
+				OREB:              row.Float("OREB"),
+				DREB:              row.Float("DREB"),
+				REB:               row.Float("REB"),
+				AST:               row.Float("AST"),
+				STL:               row.Float("STL"),
+				BLK:               row.Float("BLK"),
+				TOV:               row.Float("TOV"),
+				PF:                row.Float("PF"),
+				PTS:               row.Float("PTS"),
+				PLUS_MINUS:        row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.TotalStats = make([]CumeStatsPlayerTotalStats, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 22 {
-				item := CumeStatsPlayerTotalStats{
-					PLAYER_ID: toInt(row[0]),
-					SEASON_ID: toString(row[1]),
-					GP:        toInt(row[2]),
-					MIN:       toFloat(row[3]),
-					FGM:       toInt(row[4]),
-					FGA:       toInt(row[5]),
-					FG_PCT:    toFloat(row[6]),
-					FG3M:      toInt(row[7]),
-					FG3A:      toInt(row[8]),
-					FG3_PCT:   toFloat(row[9]),
-					FTM:       toInt(row[10]),
-					FTA:       toInt(row[11]),
-					FT_PCT:    toFloat(row[12]),
-					OREB:      toFloat(row[13]),
-					DREB:      toFloat(row[14]),
-					REB:       toFloat(row[15]),
-					AST:       toFloat(row[16]),
-					STL:       toFloat(row[17]),
-					BLK:       toFloat(row[18]),
-					TOV:       toFloat(row[19]),
-					PF:        toFloat(row[20]),
-					PTS:       toFloat(row[21]),
-				}
-				response.TotalStats = append(response.TotalStats, item)
+	if rs := rawResp.resultSet("TotalStats"); rs != nil {
+		response.TotalStats = decodeRows(rs, func(row *rowReader) CumeStatsPlayerTotalStats {
+			return CumeStatsPlayerTotalStats{
+				PLAYER_ID: row.Int("PLAYER_ID"),
+				SEASON_ID: row.String("SEASON_ID"),
+				GP:        row.Int("GP"),
+				MIN:       row.Float("MIN"),
+				FGM:       row.Int("FGM"),
+				FGA:       row.Int("FGA"),
+				FG_PCT:    row.Float("FG_PCT"),
+				FG3M:      row.Int("FG3M"),
+				FG3A:      row.Int("FG3A"),
+				FG3_PCT:   row.Float("FG3_PCT"),
+				FTM:       row.Int("FTM"),
+				FTA:       row.Int("FTA"),
+				FT_PCT:    row.Float("FT_PCT"),
+				OREB:      row.Float("OREB"),
+				DREB:      row.Float("DREB"),
+				REB:       row.Float("REB"),
+				AST:       row.Float("AST"),
+				STL:       row.Float("STL"),
+				BLK:       row.Float("BLK"),
+				TOV:       row.Float("TOV"),
+				PF:        row.Float("PF"),
+				PTS:       row.Float("PTS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
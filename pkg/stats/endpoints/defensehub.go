@@ -55,33 +55,30 @@ func GetDefenseHub(ctx context.Context, client *stats.Client, req DefenseHubRequ
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "defensehub", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "defensehub", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &DefenseHubResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.DefenseHub = make([]DefenseHubDefenseHub, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 12 {
-				item := DefenseHubDefenseHub{
-					PLAYER_ID:         toInt(row[0]),
-					PLAYER_NAME:       toString(row[1]),
-					TEAM_ID:           toInt(row[2]),
-					TEAM_ABBREVIATION: toString(row[3]),
-					GP:                toInt(row[4]),
-					MIN:               toFloat(row[5]),
-					STL:               toFloat(row[6]),
-					BLK:               toFloat(row[7]),
-					DREB:              toFloat(row[8]),
-					DEF_RIM_FGM:       toInt(row[9]),
-					DEF_RIM_FGA:       toInt(row[10]),
-					DEF_RIM_FG_PCT:    toFloat(row[11]),
-				}
-				response.DefenseHub = append(response.DefenseHub, item)
+	if rs := rawResp.resultSet("DefenseHub"); rs != nil {
+		response.DefenseHub = decodeRows(rs, func(row *rowReader) DefenseHubDefenseHub {
+			return DefenseHubDefenseHub{
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				GP:                row.Int("GP"),
+				MIN:               row.Float("MIN"),
+				STL:               row.Float("STL"),
+				BLK:               row.Float("BLK"),
+				DREB:              row.Float("DREB"),
+				DEF_RIM_FGM:       row.Int("DEF_RIM_FGM"),
+				DEF_RIM_FGA:       row.Int("DEF_RIM_FGA"),
+				DEF_RIM_FG_PCT:    row.Float("DEF_RIM_FG_PCT"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
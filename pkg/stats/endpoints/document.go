@@ -0,0 +1,188 @@
+package endpoints
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/stats"
+)
+
+// getDocument fetches a V3 document into doc and returns its schema drift. A
+// value of the wrong type is left zero and reported as drift instead of
+// failing the call; malformed JSON is still an error.
+func getDocument(ctx context.Context, client *stats.Client, endpoint string, params url.Values, doc interface{}) (*models.RawResponse, []models.SchemaDrift, error) {
+	raw, err := client.Get(ctx, endpoint, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var drift []models.SchemaDrift
+	if err := json.Unmarshal(raw.Body, doc); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if !errors.As(err, &typeErr) {
+			return nil, nil, fmt.Errorf("%w: %v", models.ErrInvalidResponse, err)
+		}
+		// json.Unmarshal only reports the first value of the wrong type.
+		drift = typeDrift(endpoint, raw.Body, reflect.TypeOf(doc))
+	}
+
+	return raw, append(drift, documentDrift(endpoint, raw.Body, reflect.TypeOf(doc))...), nil
+}
+
+// typeDrift reports every value of a JSON document that cannot be decoded
+// into its field of typ, with ResultSet set to the dotted path of its object.
+// A field that is wrong in several array elements is reported once.
+func typeDrift(name string, body []byte, typ reflect.Type) []models.SchemaDrift {
+	var drift []models.SchemaDrift
+	seen := make(map[string]bool)
+	for _, d := range valueTypeDrift(name, "", json.RawMessage(body), typ) {
+		if key := d.ResultSet + "." + d.Column; !seen[key] {
+			seen[key] = true
+			drift = append(drift, d)
+		}
+	}
+	return drift
+}
+
+// valueTypeDrift checks the value of key in the object at path; the document
+// itself has no key. Objects and arrays are checked value by value, anything
+// else is decoded on its own.
+func valueTypeDrift(path, key string, data json.RawMessage, typ reflect.Type) []models.SchemaDrift {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	switch {
+	case reflect.PointerTo(typ).Implements(unmarshalerType):
+	case typ.Kind() == reflect.Slice && typ.Elem().Kind() != reflect.Uint8:
+		var items []json.RawMessage
+		if json.Unmarshal(data, &items) != nil {
+			break
+		}
+		var drift []models.SchemaDrift
+		for _, item := range items {
+			drift = append(drift, valueTypeDrift(path, key, item, typ.Elem())...)
+		}
+		return drift
+	case typ.Kind() == reflect.Struct:
+		var object map[string]json.RawMessage
+		if json.Unmarshal(data, &object) != nil {
+			break
+		}
+		if key != "" {
+			path += "." + key
+		}
+		var drift []models.SchemaDrift
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if value, ok := object[name]; ok && field.IsExported() && name != "-" {
+				drift = append(drift, valueTypeDrift(path, name, value, field.Type)...)
+			}
+		}
+		return drift
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if err := json.Unmarshal(data, reflect.New(typ).Interface()); !errors.As(err, &typeErr) {
+		return nil
+	}
+	return []models.SchemaDrift{{
+		Kind:      models.DriftInvalidValue,
+		ResultSet: path,
+		Column:    key,
+		Detail:    fmt.Sprintf("%s, want %s", typeErr.Value, typeErr.Type),
+	}}
+}
+
+// documentDrift compares a nested JSON document, as returned by the V3
+// endpoints, with the struct type it is decoded into. Each object is checked
+// for keys that are missing, unexpected or renamed relative to the json tags
+// of its struct; fields tagged omitempty are optional. Arrays are checked
+// through their first element. Drift is reported with ResultSet set to the
+// dotted path of the object.
+func documentDrift(name string, body []byte, typ reflect.Type) []models.SchemaDrift {
+	return objectDrift(name, json.RawMessage(body), typ)
+}
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+func objectDrift(path string, data json.RawMessage, typ reflect.Type) []models.SchemaDrift {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	switch {
+	case reflect.PointerTo(typ).Implements(unmarshalerType):
+		return nil
+	case typ.Kind() == reflect.Slice && typ.Elem().Kind() != reflect.Uint8:
+		var items []json.RawMessage
+		if json.Unmarshal(data, &items) != nil || len(items) == 0 {
+			return nil
+		}
+		return objectDrift(path, items[0], typ.Elem())
+	case typ.Kind() != reflect.Struct:
+		return nil
+	}
+
+	var object map[string]json.RawMessage
+	if json.Unmarshal(data, &object) != nil || object == nil {
+		return nil
+	}
+
+	var drift []models.SchemaDrift
+	var nested []models.SchemaDrift
+	wanted := make(map[string]bool)
+	var missing []string
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		key, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if !field.IsExported() || key == "" || key == "-" {
+			continue
+		}
+		wanted[key] = true
+
+		value, ok := object[key]
+		if !ok {
+			if opts != "omitempty" {
+				missing = append(missing, key)
+			}
+			continue
+		}
+		nested = append(nested, objectDrift(path+"."+key, value, field.Type)...)
+	}
+
+	extra := make(map[string]string)
+	for key := range object {
+		if !wanted[key] {
+			extra[normalizeName(key)] = key
+		}
+	}
+
+	for _, key := range missing {
+		if actual, ok := extra[normalizeName(key)]; ok {
+			delete(extra, normalizeName(key))
+			drift = append(drift, models.SchemaDrift{Kind: models.DriftRenamedColumn, ResultSet: path, Column: key, Detail: "now " + actual})
+			continue
+		}
+		drift = append(drift, models.SchemaDrift{Kind: models.DriftMissingColumn, ResultSet: path, Column: key})
+	}
+
+	unexpected := make([]string, 0, len(extra))
+	for _, key := range extra {
+		unexpected = append(unexpected, key)
+	}
+	sort.Strings(unexpected)
+	for _, key := range unexpected {
+		drift = append(drift, models.SchemaDrift{Kind: models.DriftUnexpectedColumn, ResultSet: path, Column: key})
+	}
+
+	return append(drift, nested...)
+}
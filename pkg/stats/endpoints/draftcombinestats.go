@@ -59,45 +59,42 @@ func GetDraftCombineStats(ctx context.Context, client *stats.Client, req DraftCo
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "draftcombinestats", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "draftcombinestats", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &DraftCombineStatsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.DraftCombineStats = make([]DraftCombineStatsDraftCombineStats, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 24 {
-				item := DraftCombineStatsDraftCombineStats{
-					SEASON:                     toString(row[0]),
-					PLAYER_ID:                  toInt(row[1]),
-					FIRST_NAME:                 toString(row[2]),
-					LAST_NAME:                  toString(row[3]),
-					PLAYER_NAME:                toString(row[4]),
-					POSITION:                   toString(row[5]),
-					HEIGHT_WO_SHOES:            toString(row[6]),
-					HEIGHT_WO_SHOES_FT_IN:      toString(row[7]),
-					HEIGHT_W_SHOES:             toString(row[8]),
-					HEIGHT_W_SHOES_FT_IN:       toString(row[9]),
-					WEIGHT:                     toString(row[10]),
-					WINGSPAN:                   toFloat(row[11]),
-					WINGSPAN_FT_IN:             toFloat(row[12]),
-					STANDING_REACH:             toString(row[13]),
-					STANDING_REACH_FT_IN:       toString(row[14]),
-					BODY_FAT_PCT:               toFloat(row[15]),
-					HAND_LENGTH:                toString(row[16]),
-					HAND_WIDTH:                 toString(row[17]),
-					STANDING_VERTICAL_LEAP:     toString(row[18]),
-					MAX_VERTICAL_LEAP:          toString(row[19]),
-					LANE_AGILITY_TIME:          toString(row[20]),
-					MODIFIED_LANE_AGILITY_TIME: toString(row[21]),
-					THREE_QUARTER_SPRINT:       toString(row[22]),
-					BENCH_PRESS:                toString(row[23]),
-				}
-				response.DraftCombineStats = append(response.DraftCombineStats, item)
+	if rs := rawResp.resultSet("DraftCombineStats"); rs != nil {
+		response.DraftCombineStats = decodeRows(rs, func(row *rowReader) DraftCombineStatsDraftCombineStats {
+			return DraftCombineStatsDraftCombineStats{
+				SEASON:                     row.String("SEASON"),
+				PLAYER_ID:                  row.Int("PLAYER_ID"),
+				FIRST_NAME:                 row.String("FIRST_NAME"),
+				LAST_NAME:                  row.String("LAST_NAME"),
+				PLAYER_NAME:                row.String("PLAYER_NAME"),
+				POSITION:                   row.String("POSITION"),
+				HEIGHT_WO_SHOES:            row.String("HEIGHT_WO_SHOES"),
+				HEIGHT_WO_SHOES_FT_IN:      row.String("HEIGHT_WO_SHOES_FT_IN"),
+				HEIGHT_W_SHOES:             row.String("HEIGHT_W_SHOES"),
+				HEIGHT_W_SHOES_FT_IN:       row.String("HEIGHT_W_SHOES_FT_IN"),
+				WEIGHT:                     row.String("WEIGHT"),
+				WINGSPAN:                   row.Float("WINGSPAN"),
+				WINGSPAN_FT_IN:             row.Float("WINGSPAN_FT_IN"),
+				STANDING_REACH:             row.String("STANDING_REACH"),
+				STANDING_REACH_FT_IN:       row.String("STANDING_REACH_FT_IN"),
+				BODY_FAT_PCT:               row.Float("BODY_FAT_PCT"),
+				HAND_LENGTH:                row.String("HAND_LENGTH"),
+				HAND_WIDTH:                 row.String("HAND_WIDTH"),
+				STANDING_VERTICAL_LEAP:     row.String("STANDING_VERTICAL_LEAP"),
+				MAX_VERTICAL_LEAP:          row.String("MAX_VERTICAL_LEAP"),
+				LANE_AGILITY_TIME:          row.String("LANE_AGILITY_TIME"),
+				MODIFIED_LANE_AGILITY_TIME: row.String("MODIFIED_LANE_AGILITY_TIME"),
+				THREE_QUARTER_SPRINT:       row.String("THREE_QUARTER_SPRINT"),
+				BENCH_PRESS:                row.String("BENCH_PRESS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -47,33 +47,30 @@ func GetDraftHistory(ctx context.Context, client *stats.Client, req DraftHistory
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "drafthistory", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "drafthistory", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &DraftHistoryResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.DraftHistory = make([]DraftHistoryDraftHistory, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 12 {
-				item := DraftHistoryDraftHistory{
-					PERSON_ID:         toString(row[0]),
-					PLAYER_NAME:       toString(row[1]),
-					SEASON:            toString(row[2]),
-					ROUND_NUMBER:      toString(row[3]),
-					ROUND_PICK:        toString(row[4]),
-					OVERALL_PICK:      toString(row[5]),
-					TEAM_ID:           toInt(row[6]),
-					TEAM_CITY:         toString(row[7]),
-					TEAM_NAME:         toString(row[8]),
-					TEAM_ABBREVIATION: toString(row[9]),
-					ORGANIZATION:      toString(row[10]),
-					ORGANIZATION_TYPE: toString(row[11]),
-				}
-				response.DraftHistory = append(response.DraftHistory, item)
+	if rs := rawResp.resultSet("DraftHistory"); rs != nil {
+		response.DraftHistory = decodeRows(rs, func(row *rowReader) DraftHistoryDraftHistory {
+			return DraftHistoryDraftHistory{
+				PERSON_ID:         row.String("PERSON_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				SEASON:            row.String("SEASON"),
+				ROUND_NUMBER:      row.String("ROUND_NUMBER"),
+				ROUND_PICK:        row.String("ROUND_PICK"),
+				OVERALL_PICK:      row.String("OVERALL_PICK"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_CITY:         row.String("TEAM_CITY"),
+				TEAM_NAME:         row.String("TEAM_NAME"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				ORGANIZATION:      row.String("ORGANIZATION"),
+				ORGANIZATION_TYPE: row.String("ORGANIZATION_TYPE"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
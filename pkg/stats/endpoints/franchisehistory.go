@@ -66,61 +66,54 @@ func GetFranchiseHistory(ctx context.Context, client *stats.Client, req Franchis
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "franchisehistory", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "franchisehistory", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &FranchiseHistoryResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.FranchiseHistory = make([]FranchiseHistoryFranchiseHistory, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 15 {
-				item := FranchiseHistoryFranchiseHistory{
-					LEAGUE_ID:      toString(row[0]),
-					TEAM_ID:        toInt(row[1]),
-					TEAM_CITY:      toString(row[2]),
-					TEAM_NAME:      toString(row[3]),
-					START_YEAR:     toString(row[4]),
-					END_YEAR:       toString(row[5]),
-					YEARS:          toString(row[6]),
-					GAMES:          toString(row[7]),
-					WINS:           toString(row[8]),
-					LOSSES:         toString(row[9]),
-					WIN_PCT:        toFloat(row[10]),
-					PO_APPEARANCES: toString(row[11]),
-					DIV_TITLES:     toString(row[12]),
-					CONF_TITLES:    toString(row[13]),
-					LEAGUE_TITLES:  toString(row[14]),
-				}
-				response.FranchiseHistory = append(response.FranchiseHistory, item)
+	if rs := rawResp.resultSet("FranchiseHistory"); rs != nil {
+		response.FranchiseHistory = decodeRows(rs, func(row *rowReader) FranchiseHistoryFranchiseHistory {
+			return FranchiseHistoryFranchiseHistory{
+				LEAGUE_ID:      row.String("LEAGUE_ID"),
+				TEAM_ID:        row.Int("TEAM_ID"),
+				TEAM_CITY:      row.String("TEAM_CITY"),
+				TEAM_NAME:      row.String("TEAM_NAME"),
+				START_YEAR:     row.String("START_YEAR"),
+				END_YEAR:       row.String("END_YEAR"),
+				YEARS:          row.String("YEARS"),
+				GAMES:          row.String("GAMES"),
+				WINS:           row.String("WINS"),
+				LOSSES:         row.String("LOSSES"),
+				WIN_PCT:        row.Float("WIN_PCT"),
+				PO_APPEARANCES: row.String("PO_APPEARANCES"),
+				DIV_TITLES:     row.String("DIV_TITLES"),
+				CONF_TITLES:    row.String("CONF_TITLES"),
+				LEAGUE_TITLES:  row.String("LEAGUE_TITLES"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.DefunctTeams = make([]FranchiseHistoryDefunctTeams, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 15 {
-				item := FranchiseHistoryDefunctTeams{
-					LEAGUE_ID:      toString(row[0]),
-					TEAM_ID:        toInt(row[1]),
-					TEAM_CITY:      toString(row[2]),
-					TEAM_NAME:      toString(row[3]),
-					START_YEAR:     toString(row[4]),
-					END_YEAR:       toString(row[5]),
-					YEARS:          toString(row[6]),
-					GAMES:          toString(row[7]),
-					WINS:           toString(row[8]),
-					LOSSES:         toString(row[9]),
-					WIN_PCT:        toFloat(row[10]),
-					PO_APPEARANCES: toString(row[11]),
-					DIV_TITLES:     toString(row[12]),
-					CONF_TITLES:    toString(row[13]),
-					LEAGUE_TITLES:  toString(row[14]),
-				}
-				response.DefunctTeams = append(response.DefunctTeams, item)
+	if rs := rawResp.resultSet("DefunctTeams"); rs != nil {
+		response.DefunctTeams = decodeRows(rs, func(row *rowReader) FranchiseHistoryDefunctTeams {
+			return FranchiseHistoryDefunctTeams{
+				LEAGUE_ID:      row.String("LEAGUE_ID"),
+				TEAM_ID:        row.Int("TEAM_ID"),
+				TEAM_CITY:      row.String("TEAM_CITY"),
+				TEAM_NAME:      row.String("TEAM_NAME"),
+				START_YEAR:     row.String("START_YEAR"),
+				END_YEAR:       row.String("END_YEAR"),
+				YEARS:          row.String("YEARS"),
+				GAMES:          row.String("GAMES"),
+				WINS:           row.String("WINS"),
+				LOSSES:         row.String("LOSSES"),
+				WIN_PCT:        row.Float("WIN_PCT"),
+				PO_APPEARANCES: row.String("PO_APPEARANCES"),
+				DIV_TITLES:     row.String("DIV_TITLES"),
+				CONF_TITLES:    row.String("CONF_TITLES"),
+				LEAGUE_TITLES:  row.String("LEAGUE_TITLES"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
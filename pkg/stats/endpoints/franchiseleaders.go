@@ -53,37 +53,34 @@ func GetFranchiseLeaders(ctx context.Context, client *stats.Client, req Franchis
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "franchiseleaders", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "franchiseleaders", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &FranchiseLeadersResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.FranchiseLeaders = make([]FranchiseLeadersFranchiseLeaders, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 16 {
-				item := FranchiseLeadersFranchiseLeaders{
-					TEAM_ID:       toInt(row[0]),
-					PTS:           toFloat(row[1]),
-					PTS_PERSON_ID: toString(row[2]),
-					PTS_PLAYER:    toFloat(row[3]),
-					AST:           toFloat(row[4]),
-					AST_PERSON_ID: toString(row[5]),
-					AST_PLAYER:    toFloat(row[6]),
-					REB:           toFloat(row[7]),
-					REB_PERSON_ID: toString(row[8]),
-					REB_PLAYER:    toFloat(row[9]),
-					BLK:           toFloat(row[10]),
-					BLK_PERSON_ID: toString(row[11]),
-					BLK_PLAYER:    toFloat(row[12]),
-					STL:           toFloat(row[13]),
-					STL_PERSON_ID: toString(row[14]),
-					STL_PLAYER:    toFloat(row[15]),
-				}
-				response.FranchiseLeaders = append(response.FranchiseLeaders, item)
+	if rs := rawResp.resultSet("FranchiseLeaders"); rs != nil {
+		response.FranchiseLeaders = decodeRows(rs, func(row *rowReader) FranchiseLeadersFranchiseLeaders {
+			return FranchiseLeadersFranchiseLeaders{
+				TEAM_ID:       row.Int("TEAM_ID"),
+				PTS:           row.Float("PTS"),
+				PTS_PERSON_ID: row.String("PTS_PERSON_ID"),
+				PTS_PLAYER:    row.Float("PTS_PLAYER"),
+				AST:           row.Float("AST"),
+				AST_PERSON_ID: row.String("AST_PERSON_ID"),
+				AST_PLAYER:    row.Float("AST_PLAYER"),
+				REB:           row.Float("REB"),
+				REB_PERSON_ID: row.String("REB_PERSON_ID"),
+				REB_PLAYER:    row.Float("REB_PLAYER"),
+				BLK:           row.Float("BLK"),
+				BLK_PERSON_ID: row.String("BLK_PERSON_ID"),
+				BLK_PLAYER:    row.Float("BLK_PLAYER"),
+				STL:           row.Float("STL"),
+				STL_PERSON_ID: row.String("STL_PERSON_ID"),
+				STL_PLAYER:    row.Float("STL_PLAYER"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
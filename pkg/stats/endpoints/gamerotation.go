@@ -64,53 +64,46 @@ func GetGameRotation(ctx context.Context, client *stats.Client, req GameRotation
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "gamerotation", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "gamerotation", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &GameRotationResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.AwayTeam = make([]GameRotationAwayTeam, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 11 {
-				item := GameRotationAwayTeam{
-					GAME_ID:       toString(row[0]),
-					TEAM_ID:       toInt(row[1]),
-					TEAM_NAME:     toString(row[2]),
-					PERSON_ID:     toString(row[3]),
-					PLAYER_FIRST:  toString(row[4]),
-					PLAYER_LAST:   toFloat(row[5]),
-					IN_TIME_REAL:  toString(row[6]),
-					OUT_TIME_REAL: toString(row[7]),
-					PLAYER_PTS:    toFloat(row[8]),
-					PT_DIFF:       toString(row[9]),
-					USG_PCT:       toFloat(row[10]),
-				}
-				response.AwayTeam = append(response.AwayTeam, item)
+	if rs := rawResp.resultSet("AwayTeam"); rs != nil {
+		response.AwayTeam = decodeRows(rs, func(row *rowReader) GameRotationAwayTeam {
+			return GameRotationAwayTeam{
+				GAME_ID:       row.String("GAME_ID"),
+				TEAM_ID:       row.Int("TEAM_ID"),
+				TEAM_NAME:     row.String("TEAM_NAME"),
+				PERSON_ID:     row.String("PERSON_ID"),
+				PLAYER_FIRST:  row.String("PLAYER_FIRST"),
+				PLAYER_LAST:   row.Float("PLAYER_LAST"),
+				IN_TIME_REAL:  row.String("IN_TIME_REAL"),
+				OUT_TIME_REAL: row.String("OUT_TIME_REAL"),
+				PLAYER_PTS:    row.Float("PLAYER_PTS"),
+				PT_DIFF:       row.String("PT_DIFF"),
+				USG_PCT:       row.Float("USG_PCT"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.HomeTeam = make([]GameRotationHomeTeam, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 11 {
-				item := GameRotationHomeTeam{
-					GAME_ID:       toString(row[0]),
-					TEAM_ID:       toInt(row[1]),
-					TEAM_NAME:     toString(row[2]),
-					PERSON_ID:     toString(row[3]),
-					PLAYER_FIRST:  toString(row[4]),
-					PLAYER_LAST:   toFloat(row[5]),
-					IN_TIME_REAL:  toString(row[6]),
-					OUT_TIME_REAL: toString(row[7]),
-					PLAYER_PTS:    toFloat(row[8]),
-					PT_DIFF:       toString(row[9]),
-					USG_PCT:       toFloat(row[10]),
-				}
-				response.HomeTeam = append(response.HomeTeam, item)
+	if rs := rawResp.resultSet("HomeTeam"); rs != nil {
+		response.HomeTeam = decodeRows(rs, func(row *rowReader) GameRotationHomeTeam {
+			return GameRotationHomeTeam{
+				GAME_ID:       row.String("GAME_ID"),
+				TEAM_ID:       row.Int("TEAM_ID"),
+				TEAM_NAME:     row.String("TEAM_NAME"),
+				PERSON_ID:     row.String("PERSON_ID"),
+				PLAYER_FIRST:  row.String("PLAYER_FIRST"),
+				PLAYER_LAST:   row.Float("PLAYER_LAST"),
+				IN_TIME_REAL:  row.String("IN_TIME_REAL"),
+				OUT_TIME_REAL: row.String("OUT_TIME_REAL"),
+				PLAYER_PTS:    row.Float("PLAYER_PTS"),
+				PT_DIFF:       row.String("PT_DIFF"),
+				USG_PCT:       row.Float("USG_PCT"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
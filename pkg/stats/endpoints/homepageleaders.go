@@ -80,46 +80,43 @@ func GetHomepageLeaders(ctx context.Context, client *stats.Client, req HomepageL
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "homepageleaders", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "homepageleaders", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &HomepageLeadersResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.HomepageLeaders = make([]HomepageLeadersHomepageLeaders, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 25 {
-				item := HomepageLeadersHomepageLeaders{
-					PLAYER_ID: toInt(row[0]),
-					RANK:      toInt(row[1]),
-					PLAYER:    toString(row[2]),
-					TEAM_ID:   toInt(row[3]),
-					TEAM:      toString(row[4]),
-					GP:        toInt(row[5]),
-					MIN:       toFloat(row[6]),
-					FGM:       toInt(row[7]),
-					FGA:       toInt(row[8]),
-					FG_PCT:    toFloat(row[9]),
-					FG3M:      toInt(row[10]),
-					FG3A:      toInt(row[11]),
-					FG3_PCT:   toFloat(row[12]),
-					FTM:       toInt(row[13]),
-					FTA:       toInt(row[14]),
-					FT_PCT:    toFloat(row[15]),
-					OREB:      toFloat(row[16]),
-					DREB:      toFloat(row[17]),
-					REB:       toFloat(row[18]),
-					AST:       toFloat(row[19]),
-					STL:       toFloat(row[20]),
-					BLK:       toFloat(row[21]),
-					TOV:       toFloat(row[22]),
-					PTS:       toFloat(row[23]),
-					EFF:       toString(row[24]),
-				}
-				response.HomepageLeaders = append(response.HomepageLeaders, item)
+	if rs := rawResp.resultSet("HomepageLeaders"); rs != nil {
+		response.HomepageLeaders = decodeRows(rs, func(row *rowReader) HomepageLeadersHomepageLeaders {
+			return HomepageLeadersHomepageLeaders{
+				PLAYER_ID: row.Int("PLAYER_ID"),
+				RANK:      row.Int("RANK"),
+				PLAYER:    row.String("PLAYER"),
+				TEAM_ID:   row.Int("TEAM_ID"),
+				TEAM:      row.String("TEAM"),
+				GP:        row.Int("GP"),
+				MIN:       row.Float("MIN"),
+				FGM:       row.Int("FGM"),
+				FGA:       row.Int("FGA"),
+				FG_PCT:    row.Float("FG_PCT"),
+				FG3M:      row.Int("FG3M"),
+				FG3A:      row.Int("FG3A"),
+				FG3_PCT:   row.Float("FG3_PCT"),
+				FTM:       row.Int("FTM"),
+				FTA:       row.Int("FTA"),
+				FT_PCT:    row.Float("FT_PCT"),
+				OREB:      row.Float("OREB"),
+				DREB:      row.Float("DREB"),
+				REB:       row.Float("REB"),
+				AST:       row.Float("AST"),
+				STL:       row.Float("STL"),
+				BLK:       row.Float("BLK"),
+				TOV:       row.Float("TOV"),
+				PTS:       row.Float("PTS"),
+				EFF:       row.String("EFF"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -50,32 +50,29 @@ func GetHomepageV2(ctx context.Context, client *stats.Client, req HomepageV2Requ
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "homepagev2", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "homepagev2", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &HomepageV2Response{}
-	if len(rawResp.ResultSets) > 0 {
-		response.GameHeader = make([]HomepageV2GameHeader, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 11 {
-				item := HomepageV2GameHeader{
-					GAME_ID:                   toString(row[0]),
-					GAME_DATE:                 toString(row[1]),
-					HOME_TEAM_ID:              toInt(row[2]),
-					HOME_TEAM_NAME:            toString(row[3]),
-					HOME_TEAM_ABBREVIATION:    toString(row[4]),
-					HOME_TEAM_SCORE:           toString(row[5]),
-					VISITOR_TEAM_ID:           toInt(row[6]),
-					VISITOR_TEAM_NAME:         toString(row[7]),
-					VISITOR_TEAM_ABBREVIATION: toString(row[8]),
-					VISITOR_TEAM_SCORE:        toString(row[9]),
-					GAME_STATUS_TEXT:          toString(row[10]),
-				}
-				response.GameHeader = append(response.GameHeader, item)
+	if rs := rawResp.resultSet("GameHeader"); rs != nil {
+		response.GameHeader = decodeRows(rs, func(row *rowReader) HomepageV2GameHeader {
+			return HomepageV2GameHeader{
+				GAME_ID:                   row.String("GAME_ID"),
+				GAME_DATE:                 row.String("GAME_DATE"),
+				HOME_TEAM_ID:              row.Int("HOME_TEAM_ID"),
+				HOME_TEAM_NAME:            row.String("HOME_TEAM_NAME"),
+				HOME_TEAM_ABBREVIATION:    row.String("HOME_TEAM_ABBREVIATION"),
+				HOME_TEAM_SCORE:           row.String("HOME_TEAM_SCORE"),
+				VISITOR_TEAM_ID:           row.Int("VISITOR_TEAM_ID"),
+				VISITOR_TEAM_NAME:         row.String("VISITOR_TEAM_NAME"),
+				VISITOR_TEAM_ABBREVIATION: row.String("VISITOR_TEAM_ABBREVIATION"),
+				VISITOR_TEAM_SCORE:        row.String("VISITOR_TEAM_SCORE"),
+				GAME_STATUS_TEXT:          row.String("GAME_STATUS_TEXT"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -46,35 +46,32 @@ func GetInfographicFanDuelPlayer(ctx context.Context, client *stats.Client, req
 	params.Set("PlayerID", string(req.PlayerID))
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "infographicfanduelplayer", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "infographicfanduelplayer", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &InfographicFanDuelPlayerResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.FanDuelPlayer = make([]InfographicFanDuelPlayerFanDuelPlayer, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 14 {
-				item := InfographicFanDuelPlayerFanDuelPlayer{
-					PLAYER_ID:   toInt(row[0]),
-					PLAYER_NAME: toString(row[1]),
-					FD_POSITION: toString(row[2]),
-					FD_SALARY:   toString(row[3]),
-					FD_MINUTES:  toFloat(row[4]),
-					FD_FG_PCT:   toFloat(row[5]),
-					FD_FT_PCT:   toFloat(row[6]),
-					FD_FG3_PCT:  toFloat(row[7]),
-					FD_PTS:      toFloat(row[8]),
-					FD_REB:      toFloat(row[9]),
-					FD_AST:      toFloat(row[10]),
-					FD_STL:      toFloat(row[11]),
-					FD_BLK:      toFloat(row[12]),
-					FD_TOV:      toFloat(row[13]),
-				}
-				response.FanDuelPlayer = append(response.FanDuelPlayer, item)
+	if rs := rawResp.resultSet("FanDuelPlayer"); rs != nil {
+		response.FanDuelPlayer = decodeRows(rs, func(row *rowReader) InfographicFanDuelPlayerFanDuelPlayer {
+			return InfographicFanDuelPlayerFanDuelPlayer{
+				PLAYER_ID:   row.Int("PLAYER_ID"),
+				PLAYER_NAME: row.String("PLAYER_NAME"),
+				FD_POSITION: row.String("FD_POSITION"),
+				FD_SALARY:   row.String("FD_SALARY"),
+				FD_MINUTES:  row.Float("FD_MINUTES"),
+				FD_FG_PCT:   row.Float("FD_FG_PCT"),
+				FD_FT_PCT:   row.Float("FD_FT_PCT"),
+				FD_FG3_PCT:  row.Float("FD_FG3_PCT"),
+				FD_PTS:      row.Float("FD_PTS"),
+				FD_REB:      row.Float("FD_REB"),
+				FD_AST:      row.Float("FD_AST"),
+				FD_STL:      row.Float("FD_STL"),
+				FD_BLK:      row.Float("FD_BLK"),
+				FD_TOV:      row.Float("FD_TOV"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -45,7 +45,7 @@ type InternationalBroadcasterScheduleResponse struct {
 	Games []ScheduledGame
 }
 
-func GetInternationalBroadcasterSchedule(ctx context.Context, client *stats.Client, req InternationalBroadcasterScheduleRequest) (*InternationalBroadcasterScheduleResponse, error) {
+func GetInternationalBroadcasterSchedule(ctx context.Context, client *stats.Client, req InternationalBroadcasterScheduleRequest) (*models.Response[*InternationalBroadcasterScheduleResponse], error) {
 	if err := req.LeagueID.Validate(); err != nil {
 		return nil, fmt.Errorf("%w: LeagueID: %v", models.ErrInvalidRequest, err)
 	}
@@ -79,12 +79,12 @@ func GetInternationalBroadcasterSchedule(ctx context.Context, client *stats.Clie
 	}
 
 	if len(apiResp.ResultSets) == 0 {
-		return &InternationalBroadcasterScheduleResponse{Games: []ScheduledGame{}}, nil
+		return models.NewResponseFromRaw(&InternationalBroadcasterScheduleResponse{Games: []ScheduledGame{}}, rawResp), nil
 	}
 
 	nextGameListRaw, ok := apiResp.ResultSets[0]["NextGameList"]
 	if !ok {
-		return &InternationalBroadcasterScheduleResponse{Games: []ScheduledGame{}}, nil
+		return models.NewResponseFromRaw(&InternationalBroadcasterScheduleResponse{Games: []ScheduledGame{}}, rawResp), nil
 	}
 
 	nextGameListJSON, err := json.Marshal(nextGameListRaw)
@@ -97,5 +97,5 @@ func GetInternationalBroadcasterSchedule(ctx context.Context, client *stats.Clie
 		return nil, fmt.Errorf("failed to unmarshal games: %w", err)
 	}
 
-	return &InternationalBroadcasterScheduleResponse{Games: games}, nil
+	return models.NewResponseFromRaw(&InternationalBroadcasterScheduleResponse{Games: games}, rawResp), nil
 }
@@ -61,39 +61,36 @@ func GetLeagueDashOppPtShot(ctx context.Context, client *stats.Client, req Leagu
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "leaguedashoppptshot", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "leaguedashoppptshot", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &LeagueDashOppPtShotResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.LeagueDashOppPtShot = make([]LeagueDashOppPtShotLeagueDashOppPtShot, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 18 {
-				item := LeagueDashOppPtShotLeagueDashOppPtShot{
-					TEAM_ID:           toInt(row[0]),
-					TEAM_NAME:         toString(row[1]),
-					TEAM_ABBREVIATION: toString(row[2]),
-					GP:                toInt(row[3]),
-					G:                 toString(row[4]),
-					FGA_FREQUENCY:     toFloat(row[5]),
-					FGM:               toInt(row[6]),
-					FGA:               toInt(row[7]),
-					FG_PCT:            toFloat(row[8]),
-					EFG_PCT:           toFloat(row[9]),
-					FG2A_FREQUENCY:    toString(row[10]),
-					FG2M:              toString(row[11]),
-					FG2A:              toString(row[12]),
-					FG2_PCT:           toFloat(row[13]),
-					FG3A_FREQUENCY:    toFloat(row[14]),
-					FG3M:              toInt(row[15]),
-					FG3A:              toInt(row[16]),
-					FG3_PCT:           toFloat(row[17]),
-				}
-				response.LeagueDashOppPtShot = append(response.LeagueDashOppPtShot, item)
+	if rs := rawResp.resultSet("LeagueDashOppPtShot"); rs != nil {
+		response.LeagueDashOppPtShot = decodeRows(rs, func(row *rowReader) LeagueDashOppPtShotLeagueDashOppPtShot {
+			return LeagueDashOppPtShotLeagueDashOppPtShot{
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_NAME:         row.String("TEAM_NAME"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				GP:                row.Int("GP"),
+				G:                 row.String("G"),
+				FGA_FREQUENCY:     row.Float("FGA_FREQUENCY"),
+				FGM:               row.Int("FGM"),
+				FGA:               row.Int("FGA"),
+				FG_PCT:            row.Float("FG_PCT"),
+				EFG_PCT:           row.Float("EFG_PCT"),
+				FG2A_FREQUENCY:    row.String("FG2A_FREQUENCY"),
+				FG2M:              row.String("FG2M"),
+				FG2A:              row.String("FG2A"),
+				FG2_PCT:           row.Float("FG2_PCT"),
+				FG3A_FREQUENCY:    row.Float("FG3A_FREQUENCY"),
+				FG3M:              row.Int("FG3M"),
+				FG3A:              row.Int("FG3A"),
+				FG3_PCT:           row.Float("FG3_PCT"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
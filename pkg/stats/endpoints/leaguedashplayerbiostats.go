@@ -65,43 +65,40 @@ func GetLeagueDashPlayerBioStats(ctx context.Context, client *stats.Client, req
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "leaguedashplayerbiostats", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "leaguedashplayerbiostats", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &LeagueDashPlayerBioStatsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.LeagueDashPlayerBioStats = make([]LeagueDashPlayerBioStatsLeagueDashPlayerBioStats, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 22 {
-				item := LeagueDashPlayerBioStatsLeagueDashPlayerBioStats{
-					PLAYER_ID:         toInt(row[0]),
-					PLAYER_NAME:       toString(row[1]),
-					TEAM_ID:           toInt(row[2]),
-					TEAM_ABBREVIATION: toString(row[3]),
-					AGE:               toInt(row[4]),
-					PLAYER_HEIGHT:     toString(row[5]),
-					PLAYER_WEIGHT:     toString(row[6]),
-					COLLEGE:           toString(row[7]),
-					COUNTRY:           toString(row[8]),
-					DRAFT_YEAR:        toString(row[9]),
-					DRAFT_ROUND:       toString(row[10]),
-					DRAFT_NUMBER:      toString(row[11]),
-					GP:                toInt(row[12]),
-					PTS:               toFloat(row[13]),
-					REB:               toFloat(row[14]),
-					AST:               toFloat(row[15]),
-					NET_RATING:        toString(row[16]),
-					OREB_PCT:          toFloat(row[17]),
-					DREB_PCT:          toFloat(row[18]),
-					USG_PCT:           toFloat(row[19]),
-					TS_PCT:            toFloat(row[20]),
-					AST_PCT:           toFloat(row[21]),
-				}
-				response.LeagueDashPlayerBioStats = append(response.LeagueDashPlayerBioStats, item)
+	if rs := rawResp.resultSet("LeagueDashPlayerBioStats"); rs != nil {
+		response.LeagueDashPlayerBioStats = decodeRows(rs, func(row *rowReader) LeagueDashPlayerBioStatsLeagueDashPlayerBioStats {
+			return LeagueDashPlayerBioStatsLeagueDashPlayerBioStats{
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				AGE:               row.Int("AGE"),
+				PLAYER_HEIGHT:     row.String("PLAYER_HEIGHT"),
+				PLAYER_WEIGHT:     row.String("PLAYER_WEIGHT"),
+				COLLEGE:           row.String("COLLEGE"),
+				COUNTRY:           row.String("COUNTRY"),
+				DRAFT_YEAR:        row.String("DRAFT_YEAR"),
+				DRAFT_ROUND:       row.String("DRAFT_ROUND"),
+				DRAFT_NUMBER:      row.String("DRAFT_NUMBER"),
+				GP:                row.Int("GP"),
+				PTS:               row.Float("PTS"),
+				REB:               row.Float("REB"),
+				AST:               row.Float("AST"),
+				NET_RATING:        row.String("NET_RATING"),
+				OREB_PCT:          row.Float("OREB_PCT"),
+				DREB_PCT:          row.Float("DREB_PCT"),
+				USG_PCT:           row.Float("USG_PCT"),
+				TS_PCT:            row.Float("TS_PCT"),
+				AST_PCT:           row.Float("AST_PCT"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -86,52 +86,49 @@ func GetLeagueDashPlayerClutch(ctx context.Context, client *stats.Client, req Le
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "leaguedashplayerclutch", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "leaguedashplayerclutch", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &LeagueDashPlayerClutchResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.LeagueDashPlayerClutch = make([]LeagueDashPlayerClutchLeagueDashPlayerClutch, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 31 {
-				item := LeagueDashPlayerClutchLeagueDashPlayerClutch{
-					PLAYER_ID:         toInt(row[0]),
-					PLAYER_NAME:       toString(row[1]),
-					TEAM_ID:           toInt(row[2]),
-					TEAM_ABBREVIATION: toString(row[3]),
-					AGE:               toInt(row[4]),
-					GP:                toInt(row[5]),
-					W:                 toString(row[6]),
-					L:                 toString(row[7]),
-					W_PCT:             toFloat(row[8]),
-					MIN:               toFloat(row[9]),
-					FGM:               toInt(row[10]),
-					FGA:               toInt(row[11]),
-					FG_PCT:            toFloat(row[12]),
-					FG3M:              toInt(row[13]),
-					FG3A:              toInt(row[14]),
-					FG3_PCT:           toFloat(row[15]),
-					FTM:               toInt(row[16]),
-					FTA:               toInt(row[17]),
-					FT_PCT:            toFloat(row[18]),
-					OREB:              toFloat(row[19]),
-					DREB:              toFloat(row[20]),
-					REB:               toFloat(row[21]),
-					AST:               toFloat(row[22]),
-					TOV:               toFloat(row[23]),
-					STL:               toFloat(row[24]),
-					BLK:               toFloat(row[25]),
-					BLKA:              toInt(row[26]),
-					PF:                toFloat(row[27]),
-					PFD:               toFloat(row[28]),
-					PTS:               toFloat(row[29]),
-					PLUS_MINUS:        toFloat(row[30]),
-				}
-				response.LeagueDashPlayerClutch = append(response.LeagueDashPlayerClutch, item)
+	if rs := rawResp.resultSet("LeagueDashPlayerClutch"); rs != nil {
+		response.LeagueDashPlayerClutch = decodeRows(rs, func(row *rowReader) LeagueDashPlayerClutchLeagueDashPlayerClutch {
+			return LeagueDashPlayerClutchLeagueDashPlayerClutch{
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				AGE:               row.Int("AGE"),
+				GP:                row.Int("GP"),
+				W:                 row.String("W"),
+				L:                 row.String("L"),
+				W_PCT:             row.Float("W_PCT"),
+				MIN:               row.Float("MIN"),
+				FGM:               row.Int("FGM"),
+				FGA:               row.Int("FGA"),
+				FG_PCT:            row.Float("FG_PCT"),
+				FG3M:              row.Int("FG3M"),
+				FG3A:              row.Int("FG3A"),
+				FG3_PCT:           row.Float("FG3_PCT"),
+				FTM:               row.Int("FTM"),
+				FTA:               row.Int("FTA"),
+				FT_PCT:            row.Float("FT_PCT"),
+				OREB:              row.Float("OREB"),
+				DREB:              row.Float("DREB"),
+				REB:               row.Float("REB"),
+				AST:               row.Float("AST"),
+				TOV:               row.Float("TOV"),
+				STL:               row.Float("STL"),
+				BLK:               row.Float("BLK"),
+				BLKA:              row.Int("BLKA"),
+				PF:                row.Float("PF"),
+				PFD:               row.Float("PFD"),
+				PTS:               row.Float("PTS"),
+				PLUS_MINUS:        row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
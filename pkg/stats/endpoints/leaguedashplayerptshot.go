@@ -63,41 +63,38 @@ func GetLeagueDashPlayerPtShot(ctx context.Context, client *stats.Client, req Le
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "leaguedashplayerptshot", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "leaguedashplayerptshot", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &LeagueDashPlayerPtShotResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.LeagueDashPlayerPtShot = make([]LeagueDashPlayerPtShotLeagueDashPlayerPtShot, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 20 {
-				item := LeagueDashPlayerPtShotLeagueDashPlayerPtShot{
-					PLAYER_ID:                     toInt(row[0]),
-					PLAYER_NAME:                   toString(row[1]),
-					PLAYER_LAST_TEAM_ID:           toInt(row[2]),
-					PLAYER_LAST_TEAM_ABBREVIATION: toString(row[3]),
-					AGE:                           toInt(row[4]),
-					GP:                            toInt(row[5]),
-					G:                             toString(row[6]),
-					FGA_FREQUENCY:                 toFloat(row[7]),
-					FGM:                           toInt(row[8]),
-					FGA:                           toInt(row[9]),
-					FG_PCT:                        toFloat(row[10]),
-					EFG_PCT:                       toFloat(row[11]),
-					FG2A_FREQUENCY:                toString(row[12]),
-					FG2M:                          toString(row[13]),
-					FG2A:                          toString(row[14]),
-					FG2_PCT:                       toFloat(row[15]),
-					FG3A_FREQUENCY:                toFloat(row[16]),
-					FG3M:                          toInt(row[17]),
-					FG3A:                          toInt(row[18]),
-					FG3_PCT:                       toFloat(row[19]),
-				}
-				response.LeagueDashPlayerPtShot = append(response.LeagueDashPlayerPtShot, item)
+	if rs := rawResp.resultSet("LeagueDashPlayerPtShot"); rs != nil {
+		response.LeagueDashPlayerPtShot = decodeRows(rs, func(row *rowReader) LeagueDashPlayerPtShotLeagueDashPlayerPtShot {
+			return LeagueDashPlayerPtShotLeagueDashPlayerPtShot{
+				PLAYER_ID:                     row.Int("PLAYER_ID"),
+				PLAYER_NAME:                   row.String("PLAYER_NAME"),
+				PLAYER_LAST_TEAM_ID:           row.Int("PLAYER_LAST_TEAM_ID"),
+				PLAYER_LAST_TEAM_ABBREVIATION: row.String("PLAYER_LAST_TEAM_ABBREVIATION"),
+				AGE:                           row.Int("AGE"),
+				GP:                            row.Int("GP"),
+				G:                             row.String("G"),
+				FGA_FREQUENCY:                 row.Float("FGA_FREQUENCY"),
+				FGM:                           row.Int("FGM"),
+				FGA:                           row.Int("FGA"),
+				FG_PCT:                        row.Float("FG_PCT"),
+				EFG_PCT:                       row.Float("EFG_PCT"),
+				FG2A_FREQUENCY:                row.String("FG2A_FREQUENCY"),
+				FG2M:                          row.String("FG2M"),
+				FG2A:                          row.String("FG2A"),
+				FG2_PCT:                       row.Float("FG2_PCT"),
+				FG3A_FREQUENCY:                row.Float("FG3A_FREQUENCY"),
+				FG3M:                          row.Int("FG3M"),
+				FG3A:                          row.Int("FG3A"),
+				FG3_PCT:                       row.Float("FG3_PCT"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -73,51 +73,48 @@ func GetLeagueDashPlayerShotLocations(ctx context.Context, client *stats.Client,
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "leaguedashplayershotlocations", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "leaguedashplayershotlocations", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &LeagueDashPlayerShotLocationsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.ShotLocations = make([]LeagueDashPlayerShotLocationsShotLocations, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 30 {
-				item := LeagueDashPlayerShotLocationsShotLocations{
-					PLAYER_ID:             toInt(row[0]),
-					PLAYER_NAME:           toString(row[1]),
-					TEAM_ID:               toInt(row[2]),
-					TEAM_ABBREVIATION:     toString(row[3]),
-					AGE:                   toInt(row[4]),
-					GP:                    toInt(row[5]),
-					W:                     toString(row[6]),
-					L:                     toString(row[7]),
-					W_PCT:                 toFloat(row[8]),
-					FGM_RA:                toInt(row[9]),
-					FGA_RA:                toInt(row[10]),
-					FG_PCT_RA:             toString(row[11]),
-					FGM_IN_PAINT:          toFloat(row[12]),
-					FGA_IN_PAINT:          toFloat(row[13]),
-					FG_PCT_IN_PAINT:       toString(row[14]),
-					FGM_MID_RANGE:         toFloat(row[15]),
-					FGA_MID_RANGE:         toFloat(row[16]),
-					FG_PCT_MID_RANGE:      toInt(row[17]),
-					FGM_LEFT_CORNER_3:     toFloat(row[18]),
-					FGA_LEFT_CORNER_3:     toFloat(row[19]),
-					FG_PCT_LEFT_CORNER_3:  toString(row[20]),
-					FGM_RIGHT_CORNER_3:    toFloat(row[21]),
-					FGA_RIGHT_CORNER_3:    toFloat(row[22]),
-					FG_PCT_RIGHT_CORNER_3: toString(row[23]),
-					FGM_ABOVE_BREAK_3:     toFloat(row[24]),
-					FGA_ABOVE_BREAK_3:     toFloat(row[25]),
-					FG_PCT_ABOVE_BREAK_3:  toString(row[26]),
-					FGM_BACKCOURT:         toFloat(row[27]),
-					FGA_BACKCOURT:         toFloat(row[28]),
-					FG_PCT_BACKCOURT:      toString(row[29]),
-				}
-				response.ShotLocations = append(response.ShotLocations, item)
+	if rs := rawResp.resultSet("ShotLocations"); rs != nil {
+		response.ShotLocations = decodeRows(rs, func(row *rowReader) LeagueDashPlayerShotLocationsShotLocations {
+			return LeagueDashPlayerShotLocationsShotLocations{
+				PLAYER_ID:             row.Int("PLAYER_ID"),
+				PLAYER_NAME:           row.String("PLAYER_NAME"),
+				TEAM_ID:               row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION:     row.String("TEAM_ABBREVIATION"),
+				AGE:                   row.Int("AGE"),
+				GP:                    row.Int("GP"),
+				W:                     row.String("W"),
+				L:                     row.String("L"),
+				W_PCT:                 row.Float("W_PCT"),
+				FGM_RA:                row.Int("FGM_RA"),
+				FGA_RA:                row.Int("FGA_RA"),
+				FG_PCT_RA:             row.String("FG_PCT_RA"),
+				FGM_IN_PAINT:          row.Float("FGM_IN_PAINT"),
+				FGA_IN_PAINT:          row.Float("FGA_IN_PAINT"),
+				FG_PCT_IN_PAINT:       row.String("FG_PCT_IN_PAINT"),
+				FGM_MID_RANGE:         row.Float("FGM_MID_RANGE"),
+				FGA_MID_RANGE:         row.Float("FGA_MID_RANGE"),
+				FG_PCT_MID_RANGE:      row.Int("FG_PCT_MID_RANGE"),
+				FGM_LEFT_CORNER_3:     row.Float("FGM_LEFT_CORNER_3"),
+				FGA_LEFT_CORNER_3:     row.Float("FGA_LEFT_CORNER_3"),
+				FG_PCT_LEFT_CORNER_3:  row.String("FG_PCT_LEFT_CORNER_3"),
+				FGM_RIGHT_CORNER_3:    row.Float("FGM_RIGHT_CORNER_3"),
+				FGA_RIGHT_CORNER_3:    row.Float("FGA_RIGHT_CORNER_3"),
+				FG_PCT_RIGHT_CORNER_3: row.String("FG_PCT_RIGHT_CORNER_3"),
+				FGM_ABOVE_BREAK_3:     row.Float("FGM_ABOVE_BREAK_3"),
+				FGA_ABOVE_BREAK_3:     row.Float("FGA_ABOVE_BREAK_3"),
+				FG_PCT_ABOVE_BREAK_3:  row.String("FG_PCT_ABOVE_BREAK_3"),
+				FGM_BACKCOURT:         row.Float("FGM_BACKCOURT"),
+				FGA_BACKCOURT:         row.Float("FGA_BACKCOURT"),
+				FG_PCT_BACKCOURT:      row.String("FG_PCT_BACKCOURT"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -67,41 +67,38 @@ func GetLeagueDashPlayerShotLocationV2(ctx context.Context, client *stats.Client
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "leaguedashplayershotlocationv2", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "leaguedashplayershotlocationv2", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &LeagueDashPlayerShotLocationV2Response{}
-	if len(rawResp.ResultSets) > 0 {
-		response.ShotLocationLeague = make([]LeagueDashPlayerShotLocationV2ShotLocationLeague, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 20 {
-				item := LeagueDashPlayerShotLocationV2ShotLocationLeague{
-					PLAYER_ID:         toInt(row[0]),
-					PLAYER_NAME:       toString(row[1]),
-					TEAM_ID:           toInt(row[2]),
-					TEAM_ABBREVIATION: toString(row[3]),
-					AGE:               toInt(row[4]),
-					GP:                toInt(row[5]),
-					G:                 toString(row[6]),
-					FGA_FREQUENCY:     toFloat(row[7]),
-					FGM:               toInt(row[8]),
-					FGA:               toInt(row[9]),
-					FG_PCT:            toFloat(row[10]),
-					EFG_PCT:           toFloat(row[11]),
-					FG2A_FREQUENCY:    toString(row[12]),
-					FG2M:              toString(row[13]),
-					FG2A:              toString(row[14]),
-					FG2_PCT:           toFloat(row[15]),
-					FG3A_FREQUENCY:    toFloat(row[16]),
-					FG3M:              toInt(row[17]),
-					FG3A:              toInt(row[18]),
-					FG3_PCT:           toFloat(row[19]),
-				}
-				response.ShotLocationLeague = append(response.ShotLocationLeague, item)
+	if rs := rawResp.resultSet("ShotLocationLeague"); rs != nil {
+		response.ShotLocationLeague = decodeRows(rs, func(row *rowReader) LeagueDashPlayerShotLocationV2ShotLocationLeague {
+			return LeagueDashPlayerShotLocationV2ShotLocationLeague{
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				AGE:               row.Int("AGE"),
+				GP:                row.Int("GP"),
+				G:                 row.String("G"),
+				FGA_FREQUENCY:     row.Float("FGA_FREQUENCY"),
+				FGM:               row.Int("FGM"),
+				FGA:               row.Int("FGA"),
+				FG_PCT:            row.Float("FG_PCT"),
+				EFG_PCT:           row.Float("EFG_PCT"),
+				FG2A_FREQUENCY:    row.String("FG2A_FREQUENCY"),
+				FG2M:              row.String("FG2M"),
+				FG2A:              row.String("FG2A"),
+				FG2_PCT:           row.Float("FG2_PCT"),
+				FG3A_FREQUENCY:    row.Float("FG3A_FREQUENCY"),
+				FG3M:              row.Int("FG3M"),
+				FG3A:              row.Int("FG3A"),
+				FG3_PCT:           row.Float("FG3_PCT"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -132,87 +132,84 @@ func GetLeagueDashPlayerStats(ctx context.Context, client *stats.Client, req Lea
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "leaguedashplayerstats", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "leaguedashplayerstats", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &LeagueDashPlayerStatsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.LeagueDashPlayerStats = make([]LeagueDashPlayerStatsLeagueDashPlayerStats, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 66 {
-				item := LeagueDashPlayerStatsLeagueDashPlayerStats{
-					PLAYER_ID:            toInt(row[0]),
-					PLAYER_NAME:          toString(row[1]),
-					NICKNAME:             toString(row[2]),
-					TEAM_ID:              toInt(row[3]),
-					TEAM_ABBREVIATION:    toString(row[4]),
-					AGE:                  toInt(row[5]),
-					GP:                   toInt(row[6]),
-					W:                    toString(row[7]),
-					L:                    toString(row[8]),
-					W_PCT:                toFloat(row[9]),
-					MIN:                  toFloat(row[10]),
-					FGM:                  toInt(row[11]),
-					FGA:                  toInt(row[12]),
-					FG_PCT:               toFloat(row[13]),
-					FG3M:                 toInt(row[14]),
-					FG3A:                 toInt(row[15]),
-					FG3_PCT:              toFloat(row[16]),
-					FTM:                  toInt(row[17]),
-					FTA:                  toInt(row[18]),
-					FT_PCT:               toFloat(row[19]),
-					OREB:                 toFloat(row[20]),
-					DREB:                 toFloat(row[21]),
-					REB:                  toFloat(row[22]),
-					AST:                  toFloat(row[23]),
-					TOV:                  toFloat(row[24]),
-					STL:                  toFloat(row[25]),
-					BLK:                  toFloat(row[26]),
-					BLKA:                 toInt(row[27]),
-					PF:                   toFloat(row[28]),
-					PFD:                  toFloat(row[29]),
-					PTS:                  toFloat(row[30]),
-					PLUS_MINUS:           toFloat(row[31]),
-					NBA_FANTASY_PTS:      toFloat(row[32]),
-					DD2:                  toFloat(row[33]),
-					TD3:                  toFloat(row[34]),
-					GP_RANK:              toFloat(row[35]),
-					W_RANK:               toFloat(row[36]),
-					L_RANK:               toFloat(row[37]),
-					W_PCT_RANK:           toFloat(row[38]),
-					MIN_RANK:             toFloat(row[39]),
-					FGM_RANK:             toFloat(row[40]),
-					FGA_RANK:             toFloat(row[41]),
-					FG_PCT_RANK:          toFloat(row[42]),
-					FG3M_RANK:            toFloat(row[43]),
-					FG3A_RANK:            toFloat(row[44]),
-					FG3_PCT_RANK:         toFloat(row[45]),
-					FTM_RANK:             toFloat(row[46]),
-					FTA_RANK:             toFloat(row[47]),
-					FT_PCT_RANK:          toFloat(row[48]),
-					OREB_RANK:            toFloat(row[49]),
-					DREB_RANK:            toFloat(row[50]),
-					REB_RANK:             toFloat(row[51]),
-					AST_RANK:             toFloat(row[52]),
-					TOV_RANK:             toFloat(row[53]),
-					STL_RANK:             toFloat(row[54]),
-					BLK_RANK:             toFloat(row[55]),
-					BLKA_RANK:            toFloat(row[56]),
-					PF_RANK:              toFloat(row[57]),
-					PFD_RANK:             toFloat(row[58]),
-					PTS_RANK:             toFloat(row[59]),
-					PLUS_MINUS_RANK:      toFloat(row[60]),
-					NBA_FANTASY_PTS_RANK: toFloat(row[61]),
-					DD2_RANK:             toFloat(row[62]),
-					TD3_RANK:             toFloat(row[63]),
-					CFID:                 toString(row[64]),
-					CFPARAMS:             toString(row[65]),
-				}
-				response.LeagueDashPlayerStats = append(response.LeagueDashPlayerStats, item)
+	if rs := rawResp.resultSet("LeagueDashPlayerStats"); rs != nil {
+		response.LeagueDashPlayerStats = decodeRows(rs, func(row *rowReader) LeagueDashPlayerStatsLeagueDashPlayerStats {
+			return LeagueDashPlayerStatsLeagueDashPlayerStats{
+				PLAYER_ID:            row.Int("PLAYER_ID"),
+				PLAYER_NAME:          row.String("PLAYER_NAME"),
+				NICKNAME:             row.String("NICKNAME"),
+				TEAM_ID:              row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION:    row.String("TEAM_ABBREVIATION"),
+				AGE:                  row.Int("AGE"),
+				GP:                   row.Int("GP"),
+				W:                    row.String("W"),
+				L:                    row.String("L"),
+				W_PCT:                row.Float("W_PCT"),
+				MIN:                  row.Float("MIN"),
+				FGM:                  row.Int("FGM"),
+				FGA:                  row.Int("FGA"),
+				FG_PCT:               row.Float("FG_PCT"),
+				FG3M:                 row.Int("FG3M"),
+				FG3A:                 row.Int("FG3A"),
+				FG3_PCT:              row.Float("FG3_PCT"),
+				FTM:                  row.Int("FTM"),
+				FTA:                  row.Int("FTA"),
+				FT_PCT:               row.Float("FT_PCT"),
+				OREB:                 row.Float("OREB"),
+				DREB:                 row.Float("DREB"),
+				REB:                  row.Float("REB"),
+				AST:                  row.Float("AST"),
+				TOV:                  row.Float("TOV"),
+				STL:                  row.Float("STL"),
+				BLK:                  row.Float("BLK"),
+				BLKA:                 row.Int("BLKA"),
+				PF:                   row.Float("PF"),
+				PFD:                  row.Float("PFD"),
+				PTS:                  row.Float("PTS"),
+				PLUS_MINUS:           row.Float("PLUS_MINUS"),
+				NBA_FANTASY_PTS:      row.Float("NBA_FANTASY_PTS"),
+				DD2:                  row.Float("DD2"),
+				TD3:                  row.Float("TD3"),
+				GP_RANK:              row.Float("GP_RANK"),
+				W_RANK:               row.Float("W_RANK"),
+				L_RANK:               row.Float("L_RANK"),
+				W_PCT_RANK:           row.Float("W_PCT_RANK"),
+				MIN_RANK:             row.Float("MIN_RANK"),
+				FGM_RANK:             row.Float("FGM_RANK"),
+				FGA_RANK:             row.Float("FGA_RANK"),
+				FG_PCT_RANK:          row.Float("FG_PCT_RANK"),
+				FG3M_RANK:            row.Float("FG3M_RANK"),
+				FG3A_RANK:            row.Float("FG3A_RANK"),
+				FG3_PCT_RANK:         row.Float("FG3_PCT_RANK"),
+				FTM_RANK:             row.Float("FTM_RANK"),
+				FTA_RANK:             row.Float("FTA_RANK"),
+				FT_PCT_RANK:          row.Float("FT_PCT_RANK"),
+				OREB_RANK:            row.Float("OREB_RANK"),
+				DREB_RANK:            row.Float("DREB_RANK"),
+				REB_RANK:             row.Float("REB_RANK"),
+				AST_RANK:             row.Float("AST_RANK"),
+				TOV_RANK:             row.Float("TOV_RANK"),
+				STL_RANK:             row.Float("STL_RANK"),
+				BLK_RANK:             row.Float("BLK_RANK"),
+				BLKA_RANK:            row.Float("BLKA_RANK"),
+				PF_RANK:              row.Float("PF_RANK"),
+				PFD_RANK:             row.Float("PFD_RANK"),
+				PTS_RANK:             row.Float("PTS_RANK"),
+				PLUS_MINUS_RANK:      row.Float("PLUS_MINUS_RANK"),
+				NBA_FANTASY_PTS_RANK: row.Float("NBA_FANTASY_PTS_RANK"),
+				DD2_RANK:             row.Float("DD2_RANK"),
+				TD3_RANK:             row.Float("TD3_RANK"),
+				CFID:                 row.String("CFID"),
+				CFPARAMS:             row.String("CFPARAMS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
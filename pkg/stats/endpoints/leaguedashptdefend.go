@@ -61,35 +61,32 @@ func GetLeagueDashPtDefend(ctx context.Context, client *stats.Client, req League
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "leaguedashptdefend", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "leaguedashptdefend", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &LeagueDashPtDefendResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.LeagueDashPtDefend = make([]LeagueDashPtDefendLeagueDashPtDefend, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 14 {
-				item := LeagueDashPtDefendLeagueDashPtDefend{
-					CLOSE_DEF_PERSON_ID:           toString(row[0]),
-					PLAYER_NAME:                   toString(row[1]),
-					PLAYER_LAST_TEAM_ID:           toInt(row[2]),
-					PLAYER_LAST_TEAM_ABBREVIATION: toString(row[3]),
-					PLAYER_POSITION:               toString(row[4]),
-					AGE:                           toInt(row[5]),
-					GP:                            toInt(row[6]),
-					G:                             toString(row[7]),
-					FREQ:                          toString(row[8]),
-					D_FGM:                         toInt(row[9]),
-					D_FGA:                         toInt(row[10]),
-					D_FG_PCT:                      toFloat(row[11]),
-					NORMAL_FG_PCT:                 toFloat(row[12]),
-					PCT_PLUSMINUS:                 toFloat(row[13]),
-				}
-				response.LeagueDashPtDefend = append(response.LeagueDashPtDefend, item)
+	if rs := rawResp.resultSet("LeagueDashPtDefend"); rs != nil {
+		response.LeagueDashPtDefend = decodeRows(rs, func(row *rowReader) LeagueDashPtDefendLeagueDashPtDefend {
+			return LeagueDashPtDefendLeagueDashPtDefend{
+				CLOSE_DEF_PERSON_ID:           row.String("CLOSE_DEF_PERSON_ID"),
+				PLAYER_NAME:                   row.String("PLAYER_NAME"),
+				PLAYER_LAST_TEAM_ID:           row.Int("PLAYER_LAST_TEAM_ID"),
+				PLAYER_LAST_TEAM_ABBREVIATION: row.String("PLAYER_LAST_TEAM_ABBREVIATION"),
+				PLAYER_POSITION:               row.String("PLAYER_POSITION"),
+				AGE:                           row.Int("AGE"),
+				GP:                            row.Int("GP"),
+				G:                             row.String("G"),
+				FREQ:                          row.String("FREQ"),
+				D_FGM:                         row.Int("D_FGM"),
+				D_FGA:                         row.Int("D_FGA"),
+				D_FG_PCT:                      row.Float("D_FG_PCT"),
+				NORMAL_FG_PCT:                 row.Float("NORMAL_FG_PCT"),
+				PCT_PLUSMINUS:                 row.Float("PCT_PLUSMINUS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
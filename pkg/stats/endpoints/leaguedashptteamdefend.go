@@ -58,32 +58,29 @@ func GetLeagueDashPtTeamDefend(ctx context.Context, client *stats.Client, req Le
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "leaguedashptteamdefend", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "leaguedashptteamdefend", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &LeagueDashPtTeamDefendResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.LeagueDashPtTeamDefend = make([]LeagueDashPtTeamDefendLeagueDashPtTeamDefend, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 11 {
-				item := LeagueDashPtTeamDefendLeagueDashPtTeamDefend{
-					TEAM_ID:           toInt(row[0]),
-					TEAM_NAME:         toString(row[1]),
-					TEAM_ABBREVIATION: toString(row[2]),
-					GP:                toInt(row[3]),
-					G:                 toString(row[4]),
-					FREQ:              toString(row[5]),
-					D_FGM:             toInt(row[6]),
-					D_FGA:             toInt(row[7]),
-					D_FG_PCT:          toFloat(row[8]),
-					NORMAL_FG_PCT:     toFloat(row[9]),
-					PCT_PLUSMINUS:     toFloat(row[10]),
-				}
-				response.LeagueDashPtTeamDefend = append(response.LeagueDashPtTeamDefend, item)
+	if rs := rawResp.resultSet("LeagueDashPtTeamDefend"); rs != nil {
+		response.LeagueDashPtTeamDefend = decodeRows(rs, func(row *rowReader) LeagueDashPtTeamDefendLeagueDashPtTeamDefend {
+			return LeagueDashPtTeamDefendLeagueDashPtTeamDefend{
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_NAME:         row.String("TEAM_NAME"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				GP:                row.Int("GP"),
+				G:                 row.String("G"),
+				FREQ:              row.String("FREQ"),
+				D_FGM:             row.Int("D_FGM"),
+				D_FGA:             row.Int("D_FGA"),
+				D_FG_PCT:          row.Float("D_FG_PCT"),
+				NORMAL_FG_PCT:     row.Float("NORMAL_FG_PCT"),
+				PCT_PLUSMINUS:     row.Float("PCT_PLUSMINUS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
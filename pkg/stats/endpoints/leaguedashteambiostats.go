@@ -65,43 +65,40 @@ func GetLeagueDashTeamBioStats(ctx context.Context, client *stats.Client, req Le
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "leaguedashteambiostats", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "leaguedashteambiostats", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &LeagueDashTeamBioStatsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.LeagueDashTeamBioStats = make([]LeagueDashTeamBioStatsLeagueDashTeamBioStats, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 22 {
-				item := LeagueDashTeamBioStatsLeagueDashTeamBioStats{
-					TEAM_ID:           toInt(row[0]),
-					TEAM_NAME:         toString(row[1]),
-					TEAM_ABBREVIATION: toString(row[2]),
-					GP:                toInt(row[3]),
-					W:                 toString(row[4]),
-					L:                 toString(row[5]),
-					W_PCT:             toFloat(row[6]),
-					PLUS_MINUS:        toFloat(row[7]),
-					OFF_RATING:        toString(row[8]),
-					DEF_RATING:        toString(row[9]),
-					NET_RATING:        toString(row[10]),
-					AST_PCT:           toFloat(row[11]),
-					AST_TO:            toFloat(row[12]),
-					AST_RATIO:         toFloat(row[13]),
-					OREB_PCT:          toFloat(row[14]),
-					DREB_PCT:          toFloat(row[15]),
-					REB_PCT:           toFloat(row[16]),
-					TM_TOV_PCT:        toFloat(row[17]),
-					EFG_PCT:           toFloat(row[18]),
-					TS_PCT:            toFloat(row[19]),
-					PACE:              toString(row[20]),
-					PIE:               toString(row[21]),
-				}
-				response.LeagueDashTeamBioStats = append(response.LeagueDashTeamBioStats, item)
+	if rs := rawResp.resultSet("LeagueDashTeamBioStats"); rs != nil {
+		response.LeagueDashTeamBioStats = decodeRows(rs, func(row *rowReader) LeagueDashTeamBioStatsLeagueDashTeamBioStats {
+			return LeagueDashTeamBioStatsLeagueDashTeamBioStats{
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_NAME:         row.String("TEAM_NAME"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				GP:                row.Int("GP"),
+				W:                 row.String("W"),
+				L:                 row.String("L"),
+				W_PCT:             row.Float("W_PCT"),
+				PLUS_MINUS:        row.Float("PLUS_MINUS"),
+				OFF_RATING:        row.String("OFF_RATING"),
+				DEF_RATING:        row.String("DEF_RATING"),
+				NET_RATING:        row.String("NET_RATING"),
+				AST_PCT:           row.Float("AST_PCT"),
+				AST_TO:            row.Float("AST_TO"),
+				AST_RATIO:         row.Float("AST_RATIO"),
+				OREB_PCT:          row.Float("OREB_PCT"),
+				DREB_PCT:          row.Float("DREB_PCT"),
+				REB_PCT:           row.Float("REB_PCT"),
+				TM_TOV_PCT:        row.Float("TM_TOV_PCT"),
+				EFG_PCT:           row.Float("EFG_PCT"),
+				TS_PCT:            row.Float("TS_PCT"),
+				PACE:              row.String("PACE"),
+				PIE:               row.String("PIE"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
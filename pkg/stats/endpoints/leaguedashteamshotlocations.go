@@ -71,49 +71,46 @@ func GetLeagueDashTeamShotLocations(ctx context.Context, client *stats.Client, r
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "leaguedashteamshotlocations", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "leaguedashteamshotlocations", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &LeagueDashTeamShotLocationsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.ShotLocations = make([]LeagueDashTeamShotLocationsShotLocations, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 28 {
-				item := LeagueDashTeamShotLocationsShotLocations{
-					TEAM_ID:               toInt(row[0]),
-					TEAM_NAME:             toString(row[1]),
-					TEAM_ABBREVIATION:     toString(row[2]),
-					GP:                    toInt(row[3]),
-					W:                     toString(row[4]),
-					L:                     toString(row[5]),
-					W_PCT:                 toFloat(row[6]),
-					FGM_RA:                toInt(row[7]),
-					FGA_RA:                toInt(row[8]),
-					FG_PCT_RA:             toString(row[9]),
-					FGM_IN_PAINT:          toFloat(row[10]),
-					FGA_IN_PAINT:          toFloat(row[11]),
-					FG_PCT_IN_PAINT:       toString(row[12]),
-					FGM_MID_RANGE:         toFloat(row[13]),
-					FGA_MID_RANGE:         toFloat(row[14]),
-					FG_PCT_MID_RANGE:      toInt(row[15]),
-					FGM_LEFT_CORNER_3:     toFloat(row[16]),
-					FGA_LEFT_CORNER_3:     toFloat(row[17]),
-					FG_PCT_LEFT_CORNER_3:  toString(row[18]),
-					FGM_RIGHT_CORNER_3:    toFloat(row[19]),
-					FGA_RIGHT_CORNER_3:    toFloat(row[20]),
-					FG_PCT_RIGHT_CORNER_3: toString(row[21]),
-					FGM_ABOVE_BREAK_3:     toFloat(row[22]),
-					FGA_ABOVE_BREAK_3:     toFloat(row[23]),
-					FG_PCT_ABOVE_BREAK_3:  toString(row[24]),
-					FGM_BACKCOURT:         toFloat(row[25]),
-					FGA_BACKCOURT:         toFloat(row[26]),
-					FG_PCT_BACKCOURT:      toString(row[27]),
-				}
-				response.ShotLocations = append(response.ShotLocations, item)
+	if rs := rawResp.resultSet("ShotLocations"); rs != nil {
+		response.ShotLocations = decodeRows(rs, func(row *rowReader) LeagueDashTeamShotLocationsShotLocations {
+			return LeagueDashTeamShotLocationsShotLocations{
+				TEAM_ID:               row.Int("TEAM_ID"),
+				TEAM_NAME:             row.String("TEAM_NAME"),
+				TEAM_ABBREVIATION:     row.String("TEAM_ABBREVIATION"),
+				GP:                    row.Int("GP"),
+				W:                     row.String("W"),
+				L:                     row.String("L"),
+				W_PCT:                 row.Float("W_PCT"),
+				FGM_RA:                row.Int("FGM_RA"),
+				FGA_RA:                row.Int("FGA_RA"),
+				FG_PCT_RA:             row.String("FG_PCT_RA"),
+				FGM_IN_PAINT:          row.Float("FGM_IN_PAINT"),
+				FGA_IN_PAINT:          row.Float("FGA_IN_PAINT"),
+				FG_PCT_IN_PAINT:       row.String("FG_PCT_IN_PAINT"),
+				FGM_MID_RANGE:         row.Float("FGM_MID_RANGE"),
+				FGA_MID_RANGE:         row.Float("FGA_MID_RANGE"),
+				FG_PCT_MID_RANGE:      row.Int("FG_PCT_MID_RANGE"),
+				FGM_LEFT_CORNER_3:     row.Float("FGM_LEFT_CORNER_3"),
+				FGA_LEFT_CORNER_3:     row.Float("FGA_LEFT_CORNER_3"),
+				FG_PCT_LEFT_CORNER_3:  row.String("FG_PCT_LEFT_CORNER_3"),
+				FGM_RIGHT_CORNER_3:    row.Float("FGM_RIGHT_CORNER_3"),
+				FGA_RIGHT_CORNER_3:    row.Float("FGA_RIGHT_CORNER_3"),
+				FG_PCT_RIGHT_CORNER_3: row.String("FG_PCT_RIGHT_CORNER_3"),
+				FGM_ABOVE_BREAK_3:     row.Float("FGM_ABOVE_BREAK_3"),
+				FGA_ABOVE_BREAK_3:     row.Float("FGA_ABOVE_BREAK_3"),
+				FG_PCT_ABOVE_BREAK_3:  row.String("FG_PCT_ABOVE_BREAK_3"),
+				FGM_BACKCOURT:         row.Float("FGM_BACKCOURT"),
+				FGA_BACKCOURT:         row.Float("FGA_BACKCOURT"),
+				FG_PCT_BACKCOURT:      row.String("FG_PCT_BACKCOURT"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
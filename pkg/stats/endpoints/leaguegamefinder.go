@@ -3,10 +3,12 @@ package endpoints
 import (
 	"context"
 	"net/url"
+	"time"
 
 	"github.com/n-ae/nba-api-go/pkg/models"
 	"github.com/n-ae/nba-api-go/pkg/stats"
 	"github.com/n-ae/nba-api-go/pkg/stats/parameters"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
 )
 
 // LeagueGameFinderRequest contains parameters for the LeagueGameFinder endpoint
@@ -62,6 +64,15 @@ type LeagueGameFinderLeagueGameFinderResults struct {
 	PLUS_MINUS        float64 `json:"PLUS_MINUS"`
 }
 
+// Date parses GAME_DATE ("2023-10-24") as midnight Eastern.
+func (r LeagueGameFinderLeagueGameFinderResults) Date() (time.Time, error) {
+	return timeutil.ParseGameDate(r.GAME_DATE)
+}
+
+func (r LeagueGameFinderLeagueGameFinderResults) Minutes() time.Duration {
+	return timeutil.Minutes(r.MIN)
+}
+
 // LeagueGameFinderResponse contains the response data from the LeagueGameFinder endpoint
 type LeagueGameFinderResponse struct {
 	LeagueGameFinderResults []LeagueGameFinderLeagueGameFinderResults
@@ -123,49 +134,46 @@ func GetLeagueGameFinder(ctx context.Context, client *stats.Client, req LeagueGa
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "leaguegamefinder", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "leaguegamefinder", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &LeagueGameFinderResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.LeagueGameFinderResults = make([]LeagueGameFinderLeagueGameFinderResults, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 28 {
-				item := LeagueGameFinderLeagueGameFinderResults{
-					SEASON_ID:         toString(row[0]),
-					TEAM_ID:           toInt(row[1]),
-					TEAM_ABBREVIATION: toString(row[2]),
-					TEAM_NAME:         toString(row[3]),
-					GAME_ID:           toString(row[4]),
-					GAME_DATE:         toString(row[5]),
-					MATCHUP:           toString(row[6]),
-					WL:                toString(row[7]),
-					MIN:               toFloat(row[8]),
-					FGM:               toInt(row[9]),
-					FGA:               toInt(row[10]),
-					FG_PCT:            toFloat(row[11]),
-					FG3M:              toInt(row[12]),
-					FG3A:              toInt(row[13]),
-					FG3_PCT:           toFloat(row[14]),
-					FTM:               toInt(row[15]),
-					FTA:               toInt(row[16]),
-					FT_PCT:            toFloat(row[17]),
-					OREB:              toInt(row[18]),
-					DREB:              toInt(row[19]),
-					REB:               toInt(row[20]),
-					AST:               toInt(row[21]),
-					STL:               toInt(row[22]),
-					BLK:               toInt(row[23]),
-					TOV:               toInt(row[24]),
-					PF:                toInt(row[25]),
-					PTS:               toInt(row[26]),
-					PLUS_MINUS:        toFloat(row[27]),
-				}
-				response.LeagueGameFinderResults = append(response.LeagueGameFinderResults, item)
+	if rs := rawResp.resultSet("LeagueGameFinderResults"); rs != nil {
+		response.LeagueGameFinderResults = decodeRows(rs, func(row *rowReader) LeagueGameFinderLeagueGameFinderResults {
+			return LeagueGameFinderLeagueGameFinderResults{
+				SEASON_ID:         row.String("SEASON_ID"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				TEAM_NAME:         row.String("TEAM_NAME"),
+				GAME_ID:           row.String("GAME_ID"),
+				GAME_DATE:         row.String("GAME_DATE"),
+				MATCHUP:           row.String("MATCHUP"),
+				WL:                row.String("WL"),
+				MIN:               row.Float("MIN"),
+				FGM:               row.Int("FGM"),
+				FGA:               row.Int("FGA"),
+				FG_PCT:            row.Float("FG_PCT"),
+				FG3M:              row.Int("FG3M"),
+				FG3A:              row.Int("FG3A"),
+				FG3_PCT:           row.Float("FG3_PCT"),
+				FTM:               row.Int("FTM"),
+				FTA:               row.Int("FTA"),
+				FT_PCT:            row.Float("FT_PCT"),
+				OREB:              row.Int("OREB"),
+				DREB:              row.Int("DREB"),
+				REB:               row.Int("REB"),
+				AST:               row.Int("AST"),
+				STL:               row.Int("STL"),
+				BLK:               row.Int("BLK"),
+				TOV:               row.Int("TOV"),
+				PF:                row.Int("PF"),
+				PTS:               row.Int("PTS"),
+				PLUS_MINUS:        row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
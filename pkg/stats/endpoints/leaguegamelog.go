@@ -94,50 +94,47 @@ func GetLeagueGameLog(ctx context.Context, client *stats.Client, req LeagueGameL
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "leaguegamelog", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "leaguegamelog", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &LeagueGameLogResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.LeagueGameLog = make([]LeagueGameLogLeagueGameLog, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 29 {
-				item := LeagueGameLogLeagueGameLog{
-					SEASON_ID:         toString(row[0]),
-					TEAM_ID:           toInt(row[1]),
-					TEAM_ABBREVIATION: toString(row[2]),
-					TEAM_NAME:         toString(row[3]),
-					GAME_ID:           toString(row[4]),
-					GAME_DATE:         toString(row[5]),
-					MATCHUP:           toString(row[6]),
-					WL:                toString(row[7]),
-					MIN:               toFloat(row[8]),
-					FGM:               toInt(row[9]),
-					FGA:               toInt(row[10]),
-					FG_PCT:            toFloat(row[11]),
-					FG3M:              toInt(row[12]),
-					FG3A:              toInt(row[13]),
-					FG3_PCT:           toFloat(row[14]),
-					FTM:               toInt(row[15]),
-					FTA:               toInt(row[16]),
-					FT_PCT:            toFloat(row[17]),
-					OREB:              toFloat(row[18]),
-					DREB:              toFloat(row[19]),
-					REB:               toFloat(row[20]),
-					AST:               toFloat(row[21]),
-					STL:               toFloat(row[22]),
-					BLK:               toFloat(row[23]),
-					TOV:               toFloat(row[24]),
-					PF:                toFloat(row[25]),
-					PTS:               toFloat(row[26]),
-					PLUS_MINUS:        toFloat(row[27]),
-					VIDEO_AVAILABLE:   toString(row[28]),
-				}
-				response.LeagueGameLog = append(response.LeagueGameLog, item)
+	if rs := rawResp.resultSet("LeagueGameLog"); rs != nil {
+		response.LeagueGameLog = decodeRows(rs, func(row *rowReader) LeagueGameLogLeagueGameLog {
+			return LeagueGameLogLeagueGameLog{
+				SEASON_ID:         row.String("SEASON_ID"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				TEAM_NAME:         row.String("TEAM_NAME"),
+				GAME_ID:           row.String("GAME_ID"),
+				GAME_DATE:         row.String("GAME_DATE"),
+				MATCHUP:           row.String("MATCHUP"),
+				WL:                row.String("WL"),
+				MIN:               row.Float("MIN"),
+				FGM:               row.Int("FGM"),
+				FGA:               row.Int("FGA"),
+				FG_PCT:            row.Float("FG_PCT"),
+				FG3M:              row.Int("FG3M"),
+				FG3A:              row.Int("FG3A"),
+				FG3_PCT:           row.Float("FG3_PCT"),
+				FTM:               row.Int("FTM"),
+				FTA:               row.Int("FTA"),
+				FT_PCT:            row.Float("FT_PCT"),
+				OREB:              row.Float("OREB"),
+				DREB:              row.Float("DREB"),
+				REB:               row.Float("REB"),
+				AST:               row.Float("AST"),
+				STL:               row.Float("STL"),
+				BLK:               row.Float("BLK"),
+				TOV:               row.Float("TOV"),
+				PF:                row.Float("PF"),
+				PTS:               row.Float("PTS"),
+				PLUS_MINUS:        row.Float("PLUS_MINUS"),
+				VIDEO_AVAILABLE:   row.String("VIDEO_AVAILABLE"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -68,46 +68,43 @@ func GetLeagueHustleStatsPlayer(ctx context.Context, client *stats.Client, req L
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "leaguehustlestatsp layer", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "leaguehustlestatsp layer", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &LeagueHustleStatsPlayerResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.HustleStatsPlayer = make([]LeagueHustleStatsPlayerHustleStatsPlayer, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 25 {
-				item := LeagueHustleStatsPlayerHustleStatsPlayer{
-					PLAYER_ID:                 toInt(row[0]),
-					PLAYER_NAME:               toString(row[1]),
-					TEAM_ID:                   toInt(row[2]),
-					TEAM_ABBREVIATION:         toString(row[3]),
-					AGE:                       toInt(row[4]),
-					GP:                        toInt(row[5]),
-					W:                         toString(row[6]),
-					L:                         toString(row[7]),
-					W_PCT:                     toFloat(row[8]),
-					MIN:                       toFloat(row[9]),
-					CONTESTED_SHOTS:           toString(row[10]),
-					CONTESTED_SHOTS_2PT:       toString(row[11]),
-					CONTESTED_SHOTS_3PT:       toString(row[12]),
-					DEFLECTIONS:               toString(row[13]),
-					CHARGES_DRAWN:             toString(row[14]),
-					SCREEN_ASSISTS:            toString(row[15]),
-					SCREEN_AST_PTS:            toFloat(row[16]),
-					OFF_LOOSE_BALLS_RECOVERED: toString(row[17]),
-					DEF_LOOSE_BALLS_RECOVERED: toString(row[18]),
-					LOOSE_BALLS_RECOVERED:     toString(row[19]),
-					OFF_BOXOUTS:               toString(row[20]),
-					DEF_BOXOUTS:               toString(row[21]),
-					BOX_OUT_PLAYER_TEAM_REBS:  toFloat(row[22]),
-					BOX_OUT_PLAYER_REBS:       toFloat(row[23]),
-					BOX_OUTS:                  toString(row[24]),
-				}
-				response.HustleStatsPlayer = append(response.HustleStatsPlayer, item)
+	if rs := rawResp.resultSet("HustleStatsPlayer"); rs != nil {
+		response.HustleStatsPlayer = decodeRows(rs, func(row *rowReader) LeagueHustleStatsPlayerHustleStatsPlayer {
+			return LeagueHustleStatsPlayerHustleStatsPlayer{
+				PLAYER_ID:                 row.Int("PLAYER_ID"),
+				PLAYER_NAME:               row.String("PLAYER_NAME"),
+				TEAM_ID:                   row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION:         row.String("TEAM_ABBREVIATION"),
+				AGE:                       row.Int("AGE"),
+				GP:                        row.Int("GP"),
+				W:                         row.String("W"),
+				L:                         row.String("L"),
+				W_PCT:                     row.Float("W_PCT"),
+				MIN:                       row.Float("MIN"),
+				CONTESTED_SHOTS:           row.String("CONTESTED_SHOTS"),
+				CONTESTED_SHOTS_2PT:       row.String("CONTESTED_SHOTS_2PT"),
+				CONTESTED_SHOTS_3PT:       row.String("CONTESTED_SHOTS_3PT"),
+				DEFLECTIONS:               row.String("DEFLECTIONS"),
+				CHARGES_DRAWN:             row.String("CHARGES_DRAWN"),
+				SCREEN_ASSISTS:            row.String("SCREEN_ASSISTS"),
+				SCREEN_AST_PTS:            row.Float("SCREEN_AST_PTS"),
+				OFF_LOOSE_BALLS_RECOVERED: row.String("OFF_LOOSE_BALLS_RECOVERED"),
+				DEF_LOOSE_BALLS_RECOVERED: row.String("DEF_LOOSE_BALLS_RECOVERED"),
+				LOOSE_BALLS_RECOVERED:     row.String("LOOSE_BALLS_RECOVERED"),
+				OFF_BOXOUTS:               row.String("OFF_BOXOUTS"),
+				DEF_BOXOUTS:               row.String("DEF_BOXOUTS"),
+				BOX_OUT_PLAYER_TEAM_REBS:  row.Float("BOX_OUT_PLAYER_TEAM_REBS"),
+				BOX_OUT_PLAYER_REBS:       row.Float("BOX_OUT_PLAYER_REBS"),
+				BOX_OUTS:                  row.String("BOX_OUTS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -64,42 +64,39 @@ func GetLeagueHustleStatsTeam(ctx context.Context, client *stats.Client, req Lea
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "leaguehustlestats team", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "leaguehustlestats team", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &LeagueHustleStatsTeamResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.HustleStatsTeam = make([]LeagueHustleStatsTeamHustleStatsTeam, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 21 {
-				item := LeagueHustleStatsTeamHustleStatsTeam{
-					TEAM_ID:                   toInt(row[0]),
-					TEAM_NAME:                 toString(row[1]),
-					GP:                        toInt(row[2]),
-					W:                         toString(row[3]),
-					L:                         toString(row[4]),
-					W_PCT:                     toFloat(row[5]),
-					MIN:                       toFloat(row[6]),
-					CONTESTED_SHOTS:           toString(row[7]),
-					CONTESTED_SHOTS_2PT:       toString(row[8]),
-					CONTESTED_SHOTS_3PT:       toString(row[9]),
-					DEFLECTIONS:               toString(row[10]),
-					CHARGES_DRAWN:             toString(row[11]),
-					SCREEN_ASSISTS:            toString(row[12]),
-					SCREEN_AST_PTS:            toFloat(row[13]),
-					OFF_LOOSE_BALLS_RECOVERED: toString(row[14]),
-					DEF_LOOSE_BALLS_RECOVERED: toString(row[15]),
-					LOOSE_BALLS_RECOVERED:     toString(row[16]),
-					OFF_BOXOUTS:               toString(row[17]),
-					DEF_BOXOUTS:               toString(row[18]),
-					BOX_OUT_TEAM_REBS:         toFloat(row[19]),
-					BOX_OUTS:                  toString(row[20]),
-				}
-				response.HustleStatsTeam = append(response.HustleStatsTeam, item)
+	if rs := rawResp.resultSet("HustleStatsTeam"); rs != nil {
+		response.HustleStatsTeam = decodeRows(rs, func(row *rowReader) LeagueHustleStatsTeamHustleStatsTeam {
+			return LeagueHustleStatsTeamHustleStatsTeam{
+				TEAM_ID:                   row.Int("TEAM_ID"),
+				TEAM_NAME:                 row.String("TEAM_NAME"),
+				GP:                        row.Int("GP"),
+				W:                         row.String("W"),
+				L:                         row.String("L"),
+				W_PCT:                     row.Float("W_PCT"),
+				MIN:                       row.Float("MIN"),
+				CONTESTED_SHOTS:           row.String("CONTESTED_SHOTS"),
+				CONTESTED_SHOTS_2PT:       row.String("CONTESTED_SHOTS_2PT"),
+				CONTESTED_SHOTS_3PT:       row.String("CONTESTED_SHOTS_3PT"),
+				DEFLECTIONS:               row.String("DEFLECTIONS"),
+				CHARGES_DRAWN:             row.String("CHARGES_DRAWN"),
+				SCREEN_ASSISTS:            row.String("SCREEN_ASSISTS"),
+				SCREEN_AST_PTS:            row.Float("SCREEN_AST_PTS"),
+				OFF_LOOSE_BALLS_RECOVERED: row.String("OFF_LOOSE_BALLS_RECOVERED"),
+				DEF_LOOSE_BALLS_RECOVERED: row.String("DEF_LOOSE_BALLS_RECOVERED"),
+				LOOSE_BALLS_RECOVERED:     row.String("LOOSE_BALLS_RECOVERED"),
+				OFF_BOXOUTS:               row.String("OFF_BOXOUTS"),
+				DEF_BOXOUTS:               row.String("DEF_BOXOUTS"),
+				BOX_OUT_TEAM_REBS:         row.Float("BOX_OUT_TEAM_REBS"),
+				BOX_OUTS:                  row.String("BOX_OUTS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
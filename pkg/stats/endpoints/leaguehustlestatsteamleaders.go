@@ -60,38 +60,35 @@ func GetLeagueHustleStatsTeamLeaders(ctx context.Context, client *stats.Client,
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "leaguehustlestatsTeamleaders", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "leaguehustlestatsTeamleaders", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &LeagueHustleStatsTeamLeadersResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.HustleStatsTeamLeaders = make([]LeagueHustleStatsTeamLeadersHustleStatsTeamLeaders, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 17 {
-				item := LeagueHustleStatsTeamLeadersHustleStatsTeamLeaders{
-					TEAM_ID:                   toInt(row[0]),
-					TEAM_NAME:                 toString(row[1]),
-					GP:                        toInt(row[2]),
-					MIN:                       toFloat(row[3]),
-					SCREEN_ASSISTS:            toString(row[4]),
-					SCREEN_AST_PTS:            toFloat(row[5]),
-					OFF_LOOSE_BALLS_RECOVERED: toString(row[6]),
-					DEF_LOOSE_BALLS_RECOVERED: toString(row[7]),
-					LOOSE_BALLS_RECOVERED:     toString(row[8]),
-					OFF_BOXOUTS:               toString(row[9]),
-					DEF_BOXOUTS:               toString(row[10]),
-					BOX_OUTS:                  toString(row[11]),
-					CONTESTED_SHOTS:           toString(row[12]),
-					CONTESTED_SHOTS_2PT:       toString(row[13]),
-					CONTESTED_SHOTS_3PT:       toString(row[14]),
-					CHARGES_DRAWN:             toString(row[15]),
-					DEFLECTIONS:               toString(row[16]),
-				}
-				response.HustleStatsTeamLeaders = append(response.HustleStatsTeamLeaders, item)
+	if rs := rawResp.resultSet("HustleStatsTeamLeaders"); rs != nil {
+		response.HustleStatsTeamLeaders = decodeRows(rs, func(row *rowReader) LeagueHustleStatsTeamLeadersHustleStatsTeamLeaders {
+			return LeagueHustleStatsTeamLeadersHustleStatsTeamLeaders{
+				TEAM_ID:                   row.Int("TEAM_ID"),
+				TEAM_NAME:                 row.String("TEAM_NAME"),
+				GP:                        row.Int("GP"),
+				MIN:                       row.Float("MIN"),
+				SCREEN_ASSISTS:            row.String("SCREEN_ASSISTS"),
+				SCREEN_AST_PTS:            row.Float("SCREEN_AST_PTS"),
+				OFF_LOOSE_BALLS_RECOVERED: row.String("OFF_LOOSE_BALLS_RECOVERED"),
+				DEF_LOOSE_BALLS_RECOVERED: row.String("DEF_LOOSE_BALLS_RECOVERED"),
+				LOOSE_BALLS_RECOVERED:     row.String("LOOSE_BALLS_RECOVERED"),
+				OFF_BOXOUTS:               row.String("OFF_BOXOUTS"),
+				DEF_BOXOUTS:               row.String("DEF_BOXOUTS"),
+				BOX_OUTS:                  row.String("BOX_OUTS"),
+				CONTESTED_SHOTS:           row.String("CONTESTED_SHOTS"),
+				CONTESTED_SHOTS_2PT:       row.String("CONTESTED_SHOTS_2PT"),
+				CONTESTED_SHOTS_3PT:       row.String("CONTESTED_SHOTS_3PT"),
+				CHARGES_DRAWN:             row.String("CHARGES_DRAWN"),
+				DEFLECTIONS:               row.String("DEFLECTIONS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
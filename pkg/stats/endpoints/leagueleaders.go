@@ -80,56 +80,49 @@ func LeagueLeaders(ctx context.Context, client *stats.Client, req LeagueLeadersR
 	params.Set("Scope", "S")
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "leagueleaders", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "leagueleaders", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &LeagueLeadersResponse{}
-	for _, resultSet := range rawResp.ResultSets {
-		if resultSet.Name == "LeagueLeaders" {
-			response.LeagueLeaders = parseLeagueLeaders(resultSet.RowSet)
-		}
+	if rs := rawResp.resultSet("LeagueLeaders"); rs != nil {
+		response.LeagueLeaders = parseLeagueLeaders(rs)
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
 
-func parseLeagueLeaders(rows [][]interface{}) []LeagueLeader {
-	leaders := make([]LeagueLeader, 0, len(rows))
-	for _, row := range rows {
-		if len(row) < 27 {
-			continue
-		}
-		leader := LeagueLeader{
-			PlayerID: toInt(row[0]),
-			Rank:     toInt(row[1]),
-			Player:   toString(row[2]),
-			Team:     toString(row[3]),
-			GP:       toInt(row[4]),
-			MIN:      toFloat(row[5]),
-			FGM:      toFloat(row[6]),
-			FGA:      toFloat(row[7]),
-			FGPct:    toFloat(row[8]),
-			FG3M:     toFloat(row[9]),
-			FG3A:     toFloat(row[10]),
-			FG3Pct:   toFloat(row[11]),
-			FTM:      toFloat(row[12]),
-			FTA:      toFloat(row[13]),
-			FTPct:    toFloat(row[14]),
-			OREB:     toFloat(row[15]),
-			DREB:     toFloat(row[16]),
-			REB:      toFloat(row[17]),
-			AST:      toFloat(row[18]),
-			STL:      toFloat(row[19]),
-			BLK:      toFloat(row[20]),
-			TOV:      toFloat(row[21]),
-			PF:       toFloat(row[22]),
-			PTS:      toFloat(row[23]),
-			EFF:      toFloat(row[24]),
-			ASTTOV:   toFloat(row[25]),
-			STLTOV:   toFloat(row[26]),
+func parseLeagueLeaders(rs *ResultSet) []LeagueLeader {
+	return decodeRows(rs, func(row *rowReader) LeagueLeader {
+		return LeagueLeader{
+			PlayerID: row.Int("PLAYER_ID"),
+			Rank:     row.Int("RANK"),
+			Player:   row.String("PLAYER"),
+			Team:     row.String("TEAM"),
+			GP:       row.Int("GP"),
+			MIN:      row.Float("MIN"),
+			FGM:      row.Float("FGM"),
+			FGA:      row.Float("FGA"),
+			FGPct:    row.Float("FG_PCT"),
+			FG3M:     row.Float("FG3M"),
+			FG3A:     row.Float("FG3A"),
+			FG3Pct:   row.Float("FG3_PCT"),
+			FTM:      row.Float("FTM"),
+			FTA:      row.Float("FTA"),
+			FTPct:    row.Float("FT_PCT"),
+			OREB:     row.Float("OREB"),
+			DREB:     row.Float("DREB"),
+			REB:      row.Float("REB"),
+			AST:      row.Float("AST"),
+			STL:      row.Float("STL"),
+			BLK:      row.Float("BLK"),
+			TOV:      row.Float("TOV"),
+			PF:       row.Float("PF"),
+			PTS:      row.Float("PTS"),
+			EFF:      row.Float("EFF"),
+			ASTTOV:   row.Float("AST_TOV"),
+			STLTOV:   row.Float("STL_TOV"),
 		}
-		leaders = append(leaders, leader)
-	}
-	return leaders
+	})
 }
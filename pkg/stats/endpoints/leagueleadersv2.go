@@ -79,49 +79,46 @@ func GetLeagueLeadersV2(ctx context.Context, client *stats.Client, req LeagueLea
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "leagueleadersv2", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "leagueleadersv2", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &LeagueLeadersV2Response{}
-	if len(rawResp.ResultSets) > 0 {
-		response.LeagueLeaders = make([]LeagueLeadersV2LeagueLeaders, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 28 {
-				item := LeagueLeadersV2LeagueLeaders{
-					PLAYER_ID: toInt(row[0]),
-					RANK:      toInt(row[1]),
-					PLAYER:    toString(row[2]),
-					TEAM_ID:   toInt(row[3]),
-					TEAM:      toString(row[4]),
-					GP:        toInt(row[5]),
-					MIN:       toFloat(row[6]),
-					FGM:       toInt(row[7]),
-					FGA:       toInt(row[8]),
-					FG_PCT:    toFloat(row[9]),
-					FG3M:      toInt(row[10]),
-					FG3A:      toInt(row[11]),
-					FG3_PCT:   toFloat(row[12]),
-					FTM:       toInt(row[13]),
-					FTA:       toInt(row[14]),
-					FT_PCT:    toFloat(row[15]),
-					OREB:      toFloat(row[16]),
-					DREB:      toFloat(row[17]),
-					REB:       toFloat(row[18]),
-					AST:       toFloat(row[19]),
-					STL:       toFloat(row[20]),
-					BLK:       toFloat(row[21]),
-					TOV:       toFloat(row[22]),
-					PF:        toFloat(row[23]),
-					PTS:       toFloat(row[24]),
-					EFF:       toString(row[25]),
-					AST_TOV:   toFloat(row[26]),
-					STL_TOV:   toFloat(row[27]),
-				}
-				response.LeagueLeaders = append(response.LeagueLeaders, item)
+	if rs := rawResp.resultSet("LeagueLeaders"); rs != nil {
+		response.LeagueLeaders = decodeRows(rs, func(row *rowReader) LeagueLeadersV2LeagueLeaders {
+			return LeagueLeadersV2LeagueLeaders{
+				PLAYER_ID: row.Int("PLAYER_ID"),
+				RANK:      row.Int("RANK"),
+				PLAYER:    row.String("PLAYER"),
+				TEAM_ID:   row.Int("TEAM_ID"),
+				TEAM:      row.String("TEAM"),
+				GP:        row.Int("GP"),
+				MIN:       row.Float("MIN"),
+				FGM:       row.Int("FGM"),
+				FGA:       row.Int("FGA"),
+				FG_PCT:    row.Float("FG_PCT"),
+				FG3M:      row.Int("FG3M"),
+				FG3A:      row.Int("FG3A"),
+				FG3_PCT:   row.Float("FG3_PCT"),
+				FTM:       row.Int("FTM"),
+				FTA:       row.Int("FTA"),
+				FT_PCT:    row.Float("FT_PCT"),
+				OREB:      row.Float("OREB"),
+				DREB:      row.Float("DREB"),
+				REB:       row.Float("REB"),
+				AST:       row.Float("AST"),
+				STL:       row.Float("STL"),
+				BLK:       row.Float("BLK"),
+				TOV:       row.Float("TOV"),
+				PF:        row.Float("PF"),
+				PTS:       row.Float("PTS"),
+				EFF:       row.String("EFF"),
+				AST_TOV:   row.Float("AST_TOV"),
+				STL_TOV:   row.Float("STL_TOV"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
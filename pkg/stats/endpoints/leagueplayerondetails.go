@@ -63,33 +63,30 @@ func GetLeaguePlayerOnDetails(ctx context.Context, client *stats.Client, req Lea
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "leagueplayerondetails", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "leagueplayerondetails", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &LeaguePlayerOnDetailsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.LeaguePlayerOnDetails = make([]LeaguePlayerOnDetailsLeaguePlayerOnDetails, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 12 {
-				item := LeaguePlayerOnDetailsLeaguePlayerOnDetails{
-					TEAM_ID:           toInt(row[0]),
-					TEAM_NAME:         toString(row[1]),
-					TEAM_ABBREVIATION: toString(row[2]),
-					VS_PLAYER_ID:      toInt(row[3]),
-					VS_PLAYER_NAME:    toString(row[4]),
-					COURT_STATUS:      toString(row[5]),
-					GP:                toInt(row[6]),
-					MIN:               toFloat(row[7]),
-					PLUS_MINUS:        toFloat(row[8]),
-					NET_RATING:        toString(row[9]),
-					OFF_RATING:        toString(row[10]),
-					DEF_RATING:        toString(row[11]),
-				}
-				response.LeaguePlayerOnDetails = append(response.LeaguePlayerOnDetails, item)
+	if rs := rawResp.resultSet("LeaguePlayerOnDetails"); rs != nil {
+		response.LeaguePlayerOnDetails = decodeRows(rs, func(row *rowReader) LeaguePlayerOnDetailsLeaguePlayerOnDetails {
+			return LeaguePlayerOnDetailsLeaguePlayerOnDetails{
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_NAME:         row.String("TEAM_NAME"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				VS_PLAYER_ID:      row.Int("VS_PLAYER_ID"),
+				VS_PLAYER_NAME:    row.String("VS_PLAYER_NAME"),
+				COURT_STATUS:      row.String("COURT_STATUS"),
+				GP:                row.Int("GP"),
+				MIN:               row.Float("MIN"),
+				PLUS_MINUS:        row.Float("PLUS_MINUS"),
+				NET_RATING:        row.String("NET_RATING"),
+				OFF_RATING:        row.String("OFF_RATING"),
+				DEF_RATING:        row.String("DEF_RATING"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -68,38 +68,35 @@ func GetLeagueSeasonMatchups(ctx context.Context, client *stats.Client, req Leag
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "leagueseasonmatchups", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "leagueseasonmatchups", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &LeagueSeasonMatchupsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.SeasonMatchups = make([]LeagueSeasonMatchupsSeasonMatchups, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 17 {
-				item := LeagueSeasonMatchupsSeasonMatchups{
-					SEASON_ID:       toString(row[0]),
-					OFF_PLAYER_ID:   toInt(row[1]),
-					OFF_PLAYER_NAME: toString(row[2]),
-					DEF_PLAYER_ID:   toInt(row[3]),
-					DEF_PLAYER_NAME: toString(row[4]),
-					GP:              toInt(row[5]),
-					MATCHUP_MIN:     toString(row[6]),
-					PARTIAL_POSS:    toString(row[7]),
-					PLAYER_PTS:      toFloat(row[8]),
-					TEAM_PTS:        toFloat(row[9]),
-					MATCHUP_AST:     toString(row[10]),
-					MATCHUP_TOV:     toString(row[11]),
-					MATCHUP_BLK:     toString(row[12]),
-					MATCHUP_FGM:     toString(row[13]),
-					MATCHUP_FGA:     toString(row[14]),
-					MATCHUP_FG_PCT:  toFloat(row[15]),
-					SFL:             toString(row[16]),
-				}
-				response.SeasonMatchups = append(response.SeasonMatchups, item)
+	if rs := rawResp.resultSet("SeasonMatchups"); rs != nil {
+		response.SeasonMatchups = decodeRows(rs, func(row *rowReader) LeagueSeasonMatchupsSeasonMatchups {
+			return LeagueSeasonMatchupsSeasonMatchups{
+				SEASON_ID:       row.String("SEASON_ID"),
+				OFF_PLAYER_ID:   row.Int("OFF_PLAYER_ID"),
+				OFF_PLAYER_NAME: row.String("OFF_PLAYER_NAME"),
+				DEF_PLAYER_ID:   row.Int("DEF_PLAYER_ID"),
+				DEF_PLAYER_NAME: row.String("DEF_PLAYER_NAME"),
+				GP:              row.Int("GP"),
+				MATCHUP_MIN:     row.String("MATCHUP_MIN"),
+				PARTIAL_POSS:    row.String("PARTIAL_POSS"),
+				PLAYER_PTS:      row.Float("PLAYER_PTS"),
+				TEAM_PTS:        row.Float("TEAM_PTS"),
+				MATCHUP_AST:     row.String("MATCHUP_AST"),
+				MATCHUP_TOV:     row.String("MATCHUP_TOV"),
+				MATCHUP_BLK:     row.String("MATCHUP_BLK"),
+				MATCHUP_FGM:     row.String("MATCHUP_FGM"),
+				MATCHUP_FGA:     row.String("MATCHUP_FGA"),
+				MATCHUP_FG_PCT:  row.Float("MATCHUP_FG_PCT"),
+				SFL:             row.String("SFL"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
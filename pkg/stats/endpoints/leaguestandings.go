@@ -38,23 +38,23 @@ type LeagueStandingsStandings struct {
 	HOME                    string  `json:"HOME"`
 	ROAD                    string  `json:"ROAD"`
 	L10                     string  `json:"L10"`
-	Last10Home              float64 `json:"Last10Home"`
-	Last10Road              float64 `json:"Last10Road"`
+	Last10Home              string  `json:"Last10Home"`
+	Last10Road              string  `json:"Last10Road"`
 	OT                      string  `json:"OT"`
-	ThreePTSOrLess          float64 `json:"ThreePTSOrLess"`
-	TenPTSOrMore            float64 `json:"TenPTSOrMore"`
+	ThreePTSOrLess          string  `json:"ThreePTSOrLess"`
+	TenPTSOrMore            string  `json:"TenPTSOrMore"`
 	LongHomeStreak          string  `json:"LongHomeStreak"`
-	strLongHomeStreak       string  `json:"strLongHomeStreak"`
+	StrLongHomeStreak       string  `json:"strLongHomeStreak"`
 	LongRoadStreak          string  `json:"LongRoadStreak"`
-	strLongRoadStreak       string  `json:"strLongRoadStreak"`
+	StrLongRoadStreak       string  `json:"strLongRoadStreak"`
 	LongWinStreak           string  `json:"LongWinStreak"`
 	LongLossStreak          string  `json:"LongLossStreak"`
 	CurrentHomeStreak       string  `json:"CurrentHomeStreak"`
-	strCurrentHomeStreak    string  `json:"strCurrentHomeStreak"`
+	StrCurrentHomeStreak    string  `json:"strCurrentHomeStreak"`
 	CurrentRoadStreak       string  `json:"CurrentRoadStreak"`
-	strCurrentRoadStreak    string  `json:"strCurrentRoadStreak"`
+	StrCurrentRoadStreak    string  `json:"strCurrentRoadStreak"`
 	CurrentStreak           string  `json:"CurrentStreak"`
-	strCurrentStreak        string  `json:"strCurrentStreak"`
+	StrCurrentStreak        string  `json:"strCurrentStreak"`
 	ConferenceGamesBack     string  `json:"ConferenceGamesBack"`
 	DivisionGamesBack       string  `json:"DivisionGamesBack"`
 	ClinchedConferenceTitle string  `json:"ClinchedConferenceTitle"`
@@ -68,23 +68,23 @@ type LeagueStandingsStandings struct {
 	AheadAtThird            string  `json:"AheadAtThird"`
 	BehindAtThird           string  `json:"BehindAtThird"`
 	TiedAtThird             string  `json:"TiedAtThird"`
-	Score100PTS             float64 `json:"Score100PTS"`
-	OppScore100PTS          float64 `json:"OppScore100PTS"`
+	Score100PTS             string  `json:"Score100PTS"`
+	OppScore100PTS          string  `json:"OppScore100PTS"`
 	OppOver500              string  `json:"OppOver500"`
-	LeadInFGPCT             float64 `json:"LeadInFGPCT"`
-	LeadInReb               float64 `json:"LeadInReb"`
+	LeadInFGPCT             string  `json:"LeadInFGPCT"`
+	LeadInReb               string  `json:"LeadInReb"`
 	FewerTurnovers          string  `json:"FewerTurnovers"`
 	PointsPG                string  `json:"PointsPG"`
 	OppPointsPG             string  `json:"OppPointsPG"`
 	DiffPointsPG            string  `json:"DiffPointsPG"`
-	vsEast                  float64 `json:"vsEast"`
-	vsAtlantic              string  `json:"vsAtlantic"`
-	vsCentral               string  `json:"vsCentral"`
-	vsSoutheast             float64 `json:"vsSoutheast"`
-	vsWest                  string  `json:"vsWest"`
-	vsNorthwest             string  `json:"vsNorthwest"`
-	vsPacific               string  `json:"vsPacific"`
-	vsSouthwest             string  `json:"vsSouthwest"`
+	VsEast                  string  `json:"vsEast"`
+	VsAtlantic              string  `json:"vsAtlantic"`
+	VsCentral               string  `json:"vsCentral"`
+	VsSoutheast             string  `json:"vsSoutheast"`
+	VsWest                  string  `json:"vsWest"`
+	VsNorthwest             string  `json:"vsNorthwest"`
+	VsPacific               string  `json:"vsPacific"`
+	VsSouthwest             string  `json:"vsSouthwest"`
 	Jan                     string  `json:"Jan"`
 	Feb                     string  `json:"Feb"`
 	Mar                     string  `json:"Mar"`
@@ -125,107 +125,104 @@ func GetLeagueStandings(ctx context.Context, client *stats.Client, req LeagueSta
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "leaguestandings", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "leaguestandings", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &LeagueStandingsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.Standings = make([]LeagueStandingsStandings, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 86 {
-				item := LeagueStandingsStandings{
-					LeagueID:                toString(row[0]),
-					SeasonID:                toString(row[1]),
-					TeamID:                  toString(row[2]),
-					TeamCity:                toString(row[3]),
-					TeamName:                toString(row[4]),
-					Conference:              toString(row[5]),
-					ConferenceRecord:        toString(row[6]),
-					PlayoffRank:             toInt(row[7]),
-					ClinchIndicator:         toString(row[8]),
-					Division:                toString(row[9]),
-					DivisionRecord:          toString(row[10]),
-					DivisionRank:            toInt(row[11]),
-					WINS:                    toString(row[12]),
-					LOSSES:                  toString(row[13]),
-					WinPCT:                  toString(row[14]),
-					LeagueRank:              toInt(row[15]),
-					Record:                  toString(row[16]),
-					HOME:                    toString(row[17]),
-					ROAD:                    toString(row[18]),
-					L10:                     toString(row[19]),
-					Last10Home:              toFloat(row[20]),
-					Last10Road:              toFloat(row[21]),
-					OT:                      toString(row[22]),
-					ThreePTSOrLess:          toFloat(row[23]),
-					TenPTSOrMore:            toFloat(row[24]),
-					LongHomeStreak:          toString(row[25]),
-					strLongHomeStreak:       toString(row[26]),
-					LongRoadStreak:          toString(row[27]),
-					strLongRoadStreak:       toString(row[28]),
-					LongWinStreak:           toString(row[29]),
-					LongLossStreak:          toString(row[30]),
-					CurrentHomeStreak:       toString(row[31]),
-					strCurrentHomeStreak:    toString(row[32]),
-					CurrentRoadStreak:       toString(row[33]),
-					strCurrentRoadStreak:    toString(row[34]),
-					CurrentStreak:           toString(row[35]),
-					strCurrentStreak:        toString(row[36]),
-					ConferenceGamesBack:     toString(row[37]),
-					DivisionGamesBack:       toString(row[38]),
-					ClinchedConferenceTitle: toString(row[39]),
-					ClinchedDivisionTitle:   toString(row[40]),
-					ClinchedPlayoffBirth:    toString(row[41]),
-					EliminatedConference:    toFloat(row[42]),
-					EliminatedDivision:      toFloat(row[43]),
-					AheadAtHalf:             toString(row[44]),
-					BehindAtHalf:            toString(row[45]),
-					TiedAtHalf:              toString(row[46]),
-					AheadAtThird:            toString(row[47]),
-					BehindAtThird:           toString(row[48]),
-					TiedAtThird:             toString(row[49]),
-					Score100PTS:             toFloat(row[50]),
-					OppScore100PTS:          toFloat(row[51]),
-					OppOver500:              toString(row[52]),
-					LeadInFGPCT:             toFloat(row[53]),
-					LeadInReb:               toFloat(row[54]),
-					FewerTurnovers:          toString(row[55]),
-					PointsPG:                toString(row[56]),
-					OppPointsPG:             toString(row[57]),
-					DiffPointsPG:            toString(row[58]),
-					vsEast:                  toFloat(row[59]),
-					vsAtlantic:              toString(row[60]),
-					vsCentral:               toString(row[61]),
-					vsSoutheast:             toFloat(row[62]),
-					vsWest:                  toString(row[63]),
-					vsNorthwest:             toString(row[64]),
-					vsPacific:               toString(row[65]),
-					vsSouthwest:             toString(row[66]),
-					Jan:                     toString(row[67]),
-					Feb:                     toString(row[68]),
-					Mar:                     toString(row[69]),
-					Apr:                     toString(row[70]),
-					May:                     toString(row[71]),
-					Jun:                     toString(row[72]),
-					Jul:                     toString(row[73]),
-					Aug:                     toString(row[74]),
-					Sep:                     toString(row[75]),
-					Oct:                     toString(row[76]),
-					Nov:                     toString(row[77]),
-					Dec:                     toString(row[78]),
-					Score_80_Plus:           toString(row[79]),
-					Opp_Score_80_Plus:       toString(row[80]),
-					Score_Below_80:          toString(row[81]),
-					Opp_Score_Below_80:      toString(row[82]),
-					TotalPoints:             toString(row[83]),
-					OppTotalPoints:          toString(row[84]),
-					DiffTotalPoints:         toString(row[85]),
-				}
-				response.Standings = append(response.Standings, item)
+	if rs := rawResp.resultSet("Standings"); rs != nil {
+		response.Standings = decodeRows(rs, func(row *rowReader) LeagueStandingsStandings {
+			return LeagueStandingsStandings{
+				LeagueID:                row.String("LeagueID"),
+				SeasonID:                row.String("SeasonID"),
+				TeamID:                  row.String("TeamID"),
+				TeamCity:                row.String("TeamCity"),
+				TeamName:                row.String("TeamName"),
+				Conference:              row.String("Conference"),
+				ConferenceRecord:        row.String("ConferenceRecord"),
+				PlayoffRank:             row.Int("PlayoffRank"),
+				ClinchIndicator:         row.String("ClinchIndicator"),
+				Division:                row.String("Division"),
+				DivisionRecord:          row.String("DivisionRecord"),
+				DivisionRank:            row.Int("DivisionRank"),
+				WINS:                    row.String("WINS"),
+				LOSSES:                  row.String("LOSSES"),
+				WinPCT:                  row.String("WinPCT"),
+				LeagueRank:              row.Int("LeagueRank"),
+				Record:                  row.String("Record"),
+				HOME:                    row.String("HOME"),
+				ROAD:                    row.String("ROAD"),
+				L10:                     row.String("L10"),
+				Last10Home:              row.String("Last10Home"),
+				Last10Road:              row.String("Last10Road"),
+				OT:                      row.String("OT"),
+				ThreePTSOrLess:          row.String("ThreePTSOrLess"),
+				TenPTSOrMore:            row.String("TenPTSOrMore"),
+				LongHomeStreak:          row.String("LongHomeStreak"),
+				StrLongHomeStreak:       row.String("strLongHomeStreak"),
+				LongRoadStreak:          row.String("LongRoadStreak"),
+				StrLongRoadStreak:       row.String("strLongRoadStreak"),
+				LongWinStreak:           row.String("LongWinStreak"),
+				LongLossStreak:          row.String("LongLossStreak"),
+				CurrentHomeStreak:       row.String("CurrentHomeStreak"),
+				StrCurrentHomeStreak:    row.String("strCurrentHomeStreak"),
+				CurrentRoadStreak:       row.String("CurrentRoadStreak"),
+				StrCurrentRoadStreak:    row.String("strCurrentRoadStreak"),
+				CurrentStreak:           row.String("CurrentStreak"),
+				StrCurrentStreak:        row.String("strCurrentStreak"),
+				ConferenceGamesBack:     row.String("ConferenceGamesBack"),
+				DivisionGamesBack:       row.String("DivisionGamesBack"),
+				ClinchedConferenceTitle: row.String("ClinchedConferenceTitle"),
+				ClinchedDivisionTitle:   row.String("ClinchedDivisionTitle"),
+				ClinchedPlayoffBirth:    row.String("ClinchedPlayoffBirth"),
+				EliminatedConference:    row.Float("EliminatedConference"),
+				EliminatedDivision:      row.Float("EliminatedDivision"),
+				AheadAtHalf:             row.String("AheadAtHalf"),
+				BehindAtHalf:            row.String("BehindAtHalf"),
+				TiedAtHalf:              row.String("TiedAtHalf"),
+				AheadAtThird:            row.String("AheadAtThird"),
+				BehindAtThird:           row.String("BehindAtThird"),
+				TiedAtThird:             row.String("TiedAtThird"),
+				Score100PTS:             row.String("Score100PTS"),
+				OppScore100PTS:          row.String("OppScore100PTS"),
+				OppOver500:              row.String("OppOver500"),
+				LeadInFGPCT:             row.String("LeadInFGPCT"),
+				LeadInReb:               row.String("LeadInReb"),
+				FewerTurnovers:          row.String("FewerTurnovers"),
+				PointsPG:                row.String("PointsPG"),
+				OppPointsPG:             row.String("OppPointsPG"),
+				DiffPointsPG:            row.String("DiffPointsPG"),
+				VsEast:                  row.String("vsEast"),
+				VsAtlantic:              row.String("vsAtlantic"),
+				VsCentral:               row.String("vsCentral"),
+				VsSoutheast:             row.String("vsSoutheast"),
+				VsWest:                  row.String("vsWest"),
+				VsNorthwest:             row.String("vsNorthwest"),
+				VsPacific:               row.String("vsPacific"),
+				VsSouthwest:             row.String("vsSouthwest"),
+				Jan:                     row.String("Jan"),
+				Feb:                     row.String("Feb"),
+				Mar:                     row.String("Mar"),
+				Apr:                     row.String("Apr"),
+				May:                     row.String("May"),
+				Jun:                     row.String("Jun"),
+				Jul:                     row.String("Jul"),
+				Aug:                     row.String("Aug"),
+				Sep:                     row.String("Sep"),
+				Oct:                     row.String("Oct"),
+				Nov:                     row.String("Nov"),
+				Dec:                     row.String("Dec"),
+				Score_80_Plus:           row.String("Score_80_Plus"),
+				Opp_Score_80_Plus:       row.String("Opp_Score_80_Plus"),
+				Score_Below_80:          row.String("Score_Below_80"),
+				Opp_Score_Below_80:      row.String("Opp_Score_Below_80"),
+				TotalPoints:             row.String("TotalPoints"),
+				OppTotalPoints:          row.String("OppTotalPoints"),
+				DiffTotalPoints:         row.String("DiffTotalPoints"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
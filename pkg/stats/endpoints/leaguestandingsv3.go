@@ -35,23 +35,23 @@ type LeagueStandingsV3Standings struct {
 	HOME                    string  `json:"HOME"`
 	ROAD                    string  `json:"ROAD"`
 	L10                     string  `json:"L10"`
-	Last10Home              float64 `json:"Last10Home"`
-	Last10Road              float64 `json:"Last10Road"`
+	Last10Home              string  `json:"Last10Home"`
+	Last10Road              string  `json:"Last10Road"`
 	OT                      string  `json:"OT"`
-	ThreePTSOrLess          float64 `json:"ThreePTSOrLess"`
-	TenPTSOrMore            float64 `json:"TenPTSOrMore"`
+	ThreePTSOrLess          string  `json:"ThreePTSOrLess"`
+	TenPTSOrMore            string  `json:"TenPTSOrMore"`
 	LongHomeStreak          string  `json:"LongHomeStreak"`
-	strLongHomeStreak       string  `json:"strLongHomeStreak"`
+	StrLongHomeStreak       string  `json:"strLongHomeStreak"`
 	LongRoadStreak          string  `json:"LongRoadStreak"`
-	strLongRoadStreak       string  `json:"strLongRoadStreak"`
+	StrLongRoadStreak       string  `json:"strLongRoadStreak"`
 	LongWinStreak           string  `json:"LongWinStreak"`
 	LongLossStreak          string  `json:"LongLossStreak"`
 	CurrentHomeStreak       string  `json:"CurrentHomeStreak"`
-	strCurrentHomeStreak    string  `json:"strCurrentHomeStreak"`
+	StrCurrentHomeStreak    string  `json:"strCurrentHomeStreak"`
 	CurrentRoadStreak       string  `json:"CurrentRoadStreak"`
-	strCurrentRoadStreak    string  `json:"strCurrentRoadStreak"`
+	StrCurrentRoadStreak    string  `json:"strCurrentRoadStreak"`
 	CurrentStreak           string  `json:"CurrentStreak"`
-	strCurrentStreak        string  `json:"strCurrentStreak"`
+	StrCurrentStreak        string  `json:"strCurrentStreak"`
 	ConferenceGamesBack     string  `json:"ConferenceGamesBack"`
 	ClinchedConferenceTitle string  `json:"ClinchedConferenceTitle"`
 	ClinchedDivisionTitle   string  `json:"ClinchedDivisionTitle"`
@@ -64,23 +64,23 @@ type LeagueStandingsV3Standings struct {
 	AheadAtThird            string  `json:"AheadAtThird"`
 	BehindAtThird           string  `json:"BehindAtThird"`
 	TiedAtThird             string  `json:"TiedAtThird"`
-	Score100PTS             float64 `json:"Score100PTS"`
-	OppScore100PTS          float64 `json:"OppScore100PTS"`
+	Score100PTS             string  `json:"Score100PTS"`
+	OppScore100PTS          string  `json:"OppScore100PTS"`
 	OppOver500              string  `json:"OppOver500"`
-	LeadInFGPCT             float64 `json:"LeadInFGPCT"`
-	LeadInReb               float64 `json:"LeadInReb"`
+	LeadInFGPCT             string  `json:"LeadInFGPCT"`
+	LeadInReb               string  `json:"LeadInReb"`
 	FewerTurnovers          string  `json:"FewerTurnovers"`
 	PointsPG                string  `json:"PointsPG"`
 	OppPointsPG             string  `json:"OppPointsPG"`
 	DiffPointsPG            string  `json:"DiffPointsPG"`
-	vsEast                  float64 `json:"vsEast"`
-	vsAtlantic              string  `json:"vsAtlantic"`
-	vsCentral               string  `json:"vsCentral"`
-	vsSoutheast             float64 `json:"vsSoutheast"`
-	vsWest                  string  `json:"vsWest"`
-	vsNorthwest             string  `json:"vsNorthwest"`
-	vsPacific               string  `json:"vsPacific"`
-	vsSouthwest             string  `json:"vsSouthwest"`
+	VsEast                  string  `json:"vsEast"`
+	VsAtlantic              string  `json:"vsAtlantic"`
+	VsCentral               string  `json:"vsCentral"`
+	VsSoutheast             string  `json:"vsSoutheast"`
+	VsWest                  string  `json:"vsWest"`
+	VsNorthwest             string  `json:"vsNorthwest"`
+	VsPacific               string  `json:"vsPacific"`
+	VsSouthwest             string  `json:"vsSouthwest"`
 	Jan                     string  `json:"Jan"`
 	Feb                     string  `json:"Feb"`
 	Mar                     string  `json:"Mar"`
@@ -114,96 +114,93 @@ func GetLeagueStandingsV3(ctx context.Context, client *stats.Client, req LeagueS
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "leaguestandingsv3", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "leaguestandingsv3", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &LeagueStandingsV3Response{}
-	if len(rawResp.ResultSets) > 0 {
-		response.Standings = make([]LeagueStandingsV3Standings, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 75 {
-				item := LeagueStandingsV3Standings{
-					TeamID:                  toString(row[0]),
-					TeamCity:                toString(row[1]),
-					TeamName:                toString(row[2]),
-					Conference:              toString(row[3]),
-					ConferenceRecord:        toString(row[4]),
-					PlayoffRank:             toInt(row[5]),
-					ClinchIndicator:         toString(row[6]),
-					DivisionRecord:          toString(row[7]),
-					DivisionRank:            toInt(row[8]),
-					WINS:                    toString(row[9]),
-					LOSSES:                  toString(row[10]),
-					WinPCT:                  toString(row[11]),
-					LeagueRank:              toInt(row[12]),
-					Record:                  toString(row[13]),
-					HOME:                    toString(row[14]),
-					ROAD:                    toString(row[15]),
-					L10:                     toString(row[16]),
-					Last10Home:              toFloat(row[17]),
-					Last10Road:              toFloat(row[18]),
-					OT:                      toString(row[19]),
-					ThreePTSOrLess:          toFloat(row[20]),
-					TenPTSOrMore:            toFloat(row[21]),
-					LongHomeStreak:          toString(row[22]),
-					strLongHomeStreak:       toString(row[23]),
-					LongRoadStreak:          toString(row[24]),
-					strLongRoadStreak:       toString(row[25]),
-					LongWinStreak:           toString(row[26]),
-					LongLossStreak:          toString(row[27]),
-					CurrentHomeStreak:       toString(row[28]),
-					strCurrentHomeStreak:    toString(row[29]),
-					CurrentRoadStreak:       toString(row[30]),
-					strCurrentRoadStreak:    toString(row[31]),
-					CurrentStreak:           toString(row[32]),
-					strCurrentStreak:        toString(row[33]),
-					ConferenceGamesBack:     toString(row[34]),
-					ClinchedConferenceTitle: toString(row[35]),
-					ClinchedDivisionTitle:   toString(row[36]),
-					ClinchedPlayoffBirth:    toString(row[37]),
-					EliminatedConference:    toFloat(row[38]),
-					EliminatedDivision:      toFloat(row[39]),
-					AheadAtHalf:             toString(row[40]),
-					BehindAtHalf:            toString(row[41]),
-					TiedAtHalf:              toString(row[42]),
-					AheadAtThird:            toString(row[43]),
-					BehindAtThird:           toString(row[44]),
-					TiedAtThird:             toString(row[45]),
-					Score100PTS:             toFloat(row[46]),
-					OppScore100PTS:          toFloat(row[47]),
-					OppOver500:              toString(row[48]),
-					LeadInFGPCT:             toFloat(row[49]),
-					LeadInReb:               toFloat(row[50]),
-					FewerTurnovers:          toString(row[51]),
-					PointsPG:                toString(row[52]),
-					OppPointsPG:             toString(row[53]),
-					DiffPointsPG:            toString(row[54]),
-					vsEast:                  toFloat(row[55]),
-					vsAtlantic:              toString(row[56]),
-					vsCentral:               toString(row[57]),
-					vsSoutheast:             toFloat(row[58]),
-					vsWest:                  toString(row[59]),
-					vsNorthwest:             toString(row[60]),
-					vsPacific:               toString(row[61]),
-					vsSouthwest:             toString(row[62]),
-					Jan:                     toString(row[63]),
-					Feb:                     toString(row[64]),
-					Mar:                     toString(row[65]),
-					Apr:                     toString(row[66]),
-					May:                     toString(row[67]),
-					Jun:                     toString(row[68]),
-					Jul:                     toString(row[69]),
-					Aug:                     toString(row[70]),
-					Sep:                     toString(row[71]),
-					Oct:                     toString(row[72]),
-					Nov:                     toString(row[73]),
-					Dec:                     toString(row[74]),
-				}
-				response.Standings = append(response.Standings, item)
+	if rs := rawResp.resultSet("Standings"); rs != nil {
+		response.Standings = decodeRows(rs, func(row *rowReader) LeagueStandingsV3Standings {
+			return LeagueStandingsV3Standings{
+				TeamID:                  row.String("TeamID"),
+				TeamCity:                row.String("TeamCity"),
+				TeamName:                row.String("TeamName"),
+				Conference:              row.String("Conference"),
+				ConferenceRecord:        row.String("ConferenceRecord"),
+				PlayoffRank:             row.Int("PlayoffRank"),
+				ClinchIndicator:         row.String("ClinchIndicator"),
+				DivisionRecord:          row.String("DivisionRecord"),
+				DivisionRank:            row.Int("DivisionRank"),
+				WINS:                    row.String("WINS"),
+				LOSSES:                  row.String("LOSSES"),
+				WinPCT:                  row.String("WinPCT"),
+				LeagueRank:              row.Int("LeagueRank"),
+				Record:                  row.String("Record"),
+				HOME:                    row.String("HOME"),
+				ROAD:                    row.String("ROAD"),
+				L10:                     row.String("L10"),
+				Last10Home:              row.String("Last10Home"),
+				Last10Road:              row.String("Last10Road"),
+				OT:                      row.String("OT"),
+				ThreePTSOrLess:          row.String("ThreePTSOrLess"),
+				TenPTSOrMore:            row.String("TenPTSOrMore"),
+				LongHomeStreak:          row.String("LongHomeStreak"),
+				StrLongHomeStreak:       row.String("strLongHomeStreak"),
+				LongRoadStreak:          row.String("LongRoadStreak"),
+				StrLongRoadStreak:       row.String("strLongRoadStreak"),
+				LongWinStreak:           row.String("LongWinStreak"),
+				LongLossStreak:          row.String("LongLossStreak"),
+				CurrentHomeStreak:       row.String("CurrentHomeStreak"),
+				StrCurrentHomeStreak:    row.String("strCurrentHomeStreak"),
+				CurrentRoadStreak:       row.String("CurrentRoadStreak"),
+				StrCurrentRoadStreak:    row.String("strCurrentRoadStreak"),
+				CurrentStreak:           row.String("CurrentStreak"),
+				StrCurrentStreak:        row.String("strCurrentStreak"),
+				ConferenceGamesBack:     row.String("ConferenceGamesBack"),
+				ClinchedConferenceTitle: row.String("ClinchedConferenceTitle"),
+				ClinchedDivisionTitle:   row.String("ClinchedDivisionTitle"),
+				ClinchedPlayoffBirth:    row.String("ClinchedPlayoffBirth"),
+				EliminatedConference:    row.Float("EliminatedConference"),
+				EliminatedDivision:      row.Float("EliminatedDivision"),
+				AheadAtHalf:             row.String("AheadAtHalf"),
+				BehindAtHalf:            row.String("BehindAtHalf"),
+				TiedAtHalf:              row.String("TiedAtHalf"),
+				AheadAtThird:            row.String("AheadAtThird"),
+				BehindAtThird:           row.String("BehindAtThird"),
+				TiedAtThird:             row.String("TiedAtThird"),
+				Score100PTS:             row.String("Score100PTS"),
+				OppScore100PTS:          row.String("OppScore100PTS"),
+				OppOver500:              row.String("OppOver500"),
+				LeadInFGPCT:             row.String("LeadInFGPCT"),
+				LeadInReb:               row.String("LeadInReb"),
+				FewerTurnovers:          row.String("FewerTurnovers"),
+				PointsPG:                row.String("PointsPG"),
+				OppPointsPG:             row.String("OppPointsPG"),
+				DiffPointsPG:            row.String("DiffPointsPG"),
+				VsEast:                  row.String("vsEast"),
+				VsAtlantic:              row.String("vsAtlantic"),
+				VsCentral:               row.String("vsCentral"),
+				VsSoutheast:             row.String("vsSoutheast"),
+				VsWest:                  row.String("vsWest"),
+				VsNorthwest:             row.String("vsNorthwest"),
+				VsPacific:               row.String("vsPacific"),
+				VsSouthwest:             row.String("vsSouthwest"),
+				Jan:                     row.String("Jan"),
+				Feb:                     row.String("Feb"),
+				Mar:                     row.String("Mar"),
+				Apr:                     row.String("Apr"),
+				May:                     row.String("May"),
+				Jun:                     row.String("Jun"),
+				Jul:                     row.String("Jul"),
+				Aug:                     row.String("Aug"),
+				Sep:                     row.String("Sep"),
+				Oct:                     row.String("Oct"),
+				Nov:                     row.String("Nov"),
+				Dec:                     row.String("Dec"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -0,0 +1,35 @@
+package endpoints
+
+import (
+	"context"
+	"testing"
+
+	"github.com/n-ae/nba-api-go/pkg/stats/parameters"
+)
+
+func TestLeagueStandingsV3_Replay(t *testing.T) {
+	season := parameters.Season("2023-24")
+	seasonType := parameters.SeasonTypeRegular
+	leagueID := parameters.LeagueIDNBA
+	resp, err := GetLeagueStandingsV3(context.Background(), replayClient(t, "leaguestandingsv3"), LeagueStandingsV3Request{
+		Season:     &season,
+		SeasonType: &seasonType,
+		LeagueID:   &leagueID,
+	})
+	if err != nil {
+		t.Fatalf("GetLeagueStandingsV3() error = %v", err)
+	}
+	if len(resp.Warnings) != 0 {
+		t.Errorf("Warnings = %v, want none", resp.Warnings)
+	}
+
+	if len(resp.Data.Standings) != 1 {
+		t.Fatalf("Standings = %+v", resp.Data.Standings)
+	}
+	// Columns named like stats hold "W-L" records.
+	lakers := resp.Data.Standings[0]
+	if lakers.VsEast != "20-10" || lakers.VsSoutheast != "6-4" || lakers.Score100PTS != "45-27" ||
+		lakers.LeadInFGPCT != "35-11" || lakers.LeadInReb != "28-15" || lakers.ThreePTSOrLess != "6-5" {
+		t.Errorf("standings = %+v", lakers)
+	}
+}
@@ -66,36 +66,33 @@ func GetMatchupRollup(ctx context.Context, client *stats.Client, req MatchupRoll
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "matchuprollup", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "matchuprollup", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &MatchupRollupResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.MatchupRollup = make([]MatchupRollupMatchupRollup, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 15 {
-				item := MatchupRollupMatchupRollup{
-					PERSON_ID:         toString(row[0]),
-					PLAYER_NAME:       toString(row[1]),
-					TEAM_ABBREVIATION: toString(row[2]),
-					GP:                toInt(row[3]),
-					MATCHUP_MIN:       toString(row[4]),
-					PARTIAL_POSS:      toString(row[5]),
-					PLAYER_PTS:        toFloat(row[6]),
-					TEAM_PTS:          toFloat(row[7]),
-					MATCHUP_AST:       toString(row[8]),
-					MATCHUP_TOV:       toString(row[9]),
-					MATCHUP_BLK:       toString(row[10]),
-					MATCHUP_FGM:       toString(row[11]),
-					MATCHUP_FGA:       toString(row[12]),
-					MATCHUP_FG_PCT:    toFloat(row[13]),
-					SFL:               toString(row[14]),
-				}
-				response.MatchupRollup = append(response.MatchupRollup, item)
+	if rs := rawResp.resultSet("MatchupRollup"); rs != nil {
+		response.MatchupRollup = decodeRows(rs, func(row *rowReader) MatchupRollupMatchupRollup {
+			return MatchupRollupMatchupRollup{
+				PERSON_ID:         row.String("PERSON_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				GP:                row.Int("GP"),
+				MATCHUP_MIN:       row.String("MATCHUP_MIN"),
+				PARTIAL_POSS:      row.String("PARTIAL_POSS"),
+				PLAYER_PTS:        row.Float("PLAYER_PTS"),
+				TEAM_PTS:          row.Float("TEAM_PTS"),
+				MATCHUP_AST:       row.String("MATCHUP_AST"),
+				MATCHUP_TOV:       row.String("MATCHUP_TOV"),
+				MATCHUP_BLK:       row.String("MATCHUP_BLK"),
+				MATCHUP_FGM:       row.String("MATCHUP_FGM"),
+				MATCHUP_FGA:       row.String("MATCHUP_FGA"),
+				MATCHUP_FG_PCT:    row.Float("MATCHUP_FG_PCT"),
+				SFL:               row.String("SFL"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -58,36 +58,33 @@ func GetOpponentShooting(ctx context.Context, client *stats.Client, req Opponent
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "opponentshooting", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "opponentshooting", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &OpponentShootingResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.OpponentShooting = make([]OpponentShootingOpponentShooting, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 15 {
-				item := OpponentShootingOpponentShooting{
-					PLAYER_ID:         toInt(row[0]),
-					PLAYER_NAME:       toString(row[1]),
-					TEAM_ID:           toInt(row[2]),
-					TEAM_ABBREVIATION: toString(row[3]),
-					GP:                toInt(row[4]),
-					MIN:               toFloat(row[5]),
-					OPP_FGM:           toInt(row[6]),
-					OPP_FGA:           toInt(row[7]),
-					OPP_FG_PCT:        toFloat(row[8]),
-					OPP_FG2M:          toString(row[9]),
-					OPP_FG2A:          toString(row[10]),
-					OPP_FG2_PCT:       toFloat(row[11]),
-					OPP_FG3M:          toInt(row[12]),
-					OPP_FG3A:          toInt(row[13]),
-					OPP_FG3_PCT:       toFloat(row[14]),
-				}
-				response.OpponentShooting = append(response.OpponentShooting, item)
+	if rs := rawResp.resultSet("OpponentShooting"); rs != nil {
+		response.OpponentShooting = decodeRows(rs, func(row *rowReader) OpponentShootingOpponentShooting {
+			return OpponentShootingOpponentShooting{
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				GP:                row.Int("GP"),
+				MIN:               row.Float("MIN"),
+				OPP_FGM:           row.Int("OPP_FGM"),
+				OPP_FGA:           row.Int("OPP_FGA"),
+				OPP_FG_PCT:        row.Float("OPP_FG_PCT"),
+				OPP_FG2M:          row.String("OPP_FG2M"),
+				OPP_FG2A:          row.String("OPP_FG2A"),
+				OPP_FG2_PCT:       row.Float("OPP_FG2_PCT"),
+				OPP_FG3M:          row.Int("OPP_FG3M"),
+				OPP_FG3A:          row.Int("OPP_FG3A"),
+				OPP_FG3_PCT:       row.Float("OPP_FG3_PCT"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
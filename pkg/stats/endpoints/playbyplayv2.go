@@ -81,67 +81,60 @@ func GetPlayByPlayV2(ctx context.Context, client *stats.Client, req PlayByPlayV2
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playbyplayv2", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playbyplayv2", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayByPlayV2Response{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayByPlay = make([]PlayByPlayV2PlayByPlay, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 34 {
-				item := PlayByPlayV2PlayByPlay{
-					GAME_ID:                   toString(row[0]),
-					EVENTNUM:                  toInt(row[1]),
-					EVENTMSGTYPE:              toInt(row[2]),
-					EVENTMSGACTIONTYPE:        toInt(row[3]),
-					PERIOD:                    toInt(row[4]),
-					WCTIMESTRING:              toString(row[5]),
-					PCTIMESTRING:              toString(row[6]),
-					HOMEDESCRIPTION:           toString(row[7]),
-					NEUTRALDESCRIPTION:        toString(row[8]),
-					VISITORDESCRIPTION:        toString(row[9]),
-					SCORE:                     toString(row[10]),
-					SCOREMARGIN:               toString(row[11]),
-					PERSON1TYPE:               toInt(row[12]),
-					PLAYER1_ID:                toInt(row[13]),
-					PLAYER1_NAME:              toString(row[14]),
-					PLAYER1_TEAM_ID:           toInt(row[15]),
-					PLAYER1_TEAM_CITY:         toString(row[16]),
-					PLAYER1_TEAM_NICKNAME:     toString(row[17]),
-					PLAYER1_TEAM_ABBREVIATION: toString(row[18]),
-					PERSON2TYPE:               toInt(row[19]),
-					PLAYER2_ID:                toInt(row[20]),
-					PLAYER2_NAME:              toString(row[21]),
-					PLAYER2_TEAM_ID:           toInt(row[22]),
-					PLAYER2_TEAM_CITY:         toString(row[23]),
-					PLAYER2_TEAM_NICKNAME:     toString(row[24]),
-					PLAYER2_TEAM_ABBREVIATION: toString(row[25]),
-					PERSON3TYPE:               toInt(row[26]),
-					PLAYER3_ID:                toInt(row[27]),
-					PLAYER3_NAME:              toString(row[28]),
-					PLAYER3_TEAM_ID:           toInt(row[29]),
-					PLAYER3_TEAM_CITY:         toString(row[30]),
-					PLAYER3_TEAM_NICKNAME:     toString(row[31]),
-					PLAYER3_TEAM_ABBREVIATION: toString(row[32]),
-					VIDEO_AVAILABLE_FLAG:      toInt(row[33]),
-				}
-				response.PlayByPlay = append(response.PlayByPlay, item)
+	if rs := rawResp.resultSet("PlayByPlay"); rs != nil {
+		response.PlayByPlay = decodeRows(rs, func(row *rowReader) PlayByPlayV2PlayByPlay {
+			return PlayByPlayV2PlayByPlay{
+				GAME_ID:                   row.String("GAME_ID"),
+				EVENTNUM:                  row.Int("EVENTNUM"),
+				EVENTMSGTYPE:              row.Int("EVENTMSGTYPE"),
+				EVENTMSGACTIONTYPE:        row.Int("EVENTMSGACTIONTYPE"),
+				PERIOD:                    row.Int("PERIOD"),
+				WCTIMESTRING:              row.String("WCTIMESTRING"),
+				PCTIMESTRING:              row.String("PCTIMESTRING"),
+				HOMEDESCRIPTION:           row.String("HOMEDESCRIPTION"),
+				NEUTRALDESCRIPTION:        row.String("NEUTRALDESCRIPTION"),
+				VISITORDESCRIPTION:        row.String("VISITORDESCRIPTION"),
+				SCORE:                     row.String("SCORE"),
+				SCOREMARGIN:               row.String("SCOREMARGIN"),
+				PERSON1TYPE:               row.Int("PERSON1TYPE"),
+				PLAYER1_ID:                row.Int("PLAYER1_ID"),
+				PLAYER1_NAME:              row.String("PLAYER1_NAME"),
+				PLAYER1_TEAM_ID:           row.Int("PLAYER1_TEAM_ID"),
+				PLAYER1_TEAM_CITY:         row.String("PLAYER1_TEAM_CITY"),
+				PLAYER1_TEAM_NICKNAME:     row.String("PLAYER1_TEAM_NICKNAME"),
+				PLAYER1_TEAM_ABBREVIATION: row.String("PLAYER1_TEAM_ABBREVIATION"),
+				PERSON2TYPE:               row.Int("PERSON2TYPE"),
+				PLAYER2_ID:                row.Int("PLAYER2_ID"),
+				PLAYER2_NAME:              row.String("PLAYER2_NAME"),
+				PLAYER2_TEAM_ID:           row.Int("PLAYER2_TEAM_ID"),
+				PLAYER2_TEAM_CITY:         row.String("PLAYER2_TEAM_CITY"),
+				PLAYER2_TEAM_NICKNAME:     row.String("PLAYER2_TEAM_NICKNAME"),
+				PLAYER2_TEAM_ABBREVIATION: row.String("PLAYER2_TEAM_ABBREVIATION"),
+				PERSON3TYPE:               row.Int("PERSON3TYPE"),
+				PLAYER3_ID:                row.Int("PLAYER3_ID"),
+				PLAYER3_NAME:              row.String("PLAYER3_NAME"),
+				PLAYER3_TEAM_ID:           row.Int("PLAYER3_TEAM_ID"),
+				PLAYER3_TEAM_CITY:         row.String("PLAYER3_TEAM_CITY"),
+				PLAYER3_TEAM_NICKNAME:     row.String("PLAYER3_TEAM_NICKNAME"),
+				PLAYER3_TEAM_ABBREVIATION: row.String("PLAYER3_TEAM_ABBREVIATION"),
+				VIDEO_AVAILABLE_FLAG:      row.Int("VIDEO_AVAILABLE_FLAG"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.AvailableVideo = make([]PlayByPlayV2AvailableVideo, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 2 {
-				item := PlayByPlayV2AvailableVideo{
-					GAME_ID:              toString(row[0]),
-					VIDEO_AVAILABLE_FLAG: toInt(row[1]),
-				}
-				response.AvailableVideo = append(response.AvailableVideo, item)
+	if rs := rawResp.resultSet("AvailableVideo"); rs != nil {
+		response.AvailableVideo = decodeRows(rs, func(row *rowReader) PlayByPlayV2AvailableVideo {
+			return PlayByPlayV2AvailableVideo{
+				GAME_ID:              row.String("GAME_ID"),
+				VIDEO_AVAILABLE_FLAG: row.Int("VIDEO_AVAILABLE_FLAG"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
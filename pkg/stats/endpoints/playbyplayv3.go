@@ -2,11 +2,14 @@ package endpoints
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/n-ae/nba-api-go/pkg/models"
 	"github.com/n-ae/nba-api-go/pkg/stats"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
 )
 
 // PlayByPlayV3Request contains parameters for the PlayByPlayV3 endpoint
@@ -16,46 +19,48 @@ type PlayByPlayV3Request struct {
 	EndPeriod   *string
 }
 
-// PlayByPlayV3PlayByPlay represents the PlayByPlay result set for PlayByPlayV3
-type PlayByPlayV3PlayByPlay struct {
-	gameId                  string  `json:"gameId"`
-	actionNumber            string  `json:"actionNumber"`
-	clock                   string  `json:"clock"`
-	timeActual              string  `json:"timeActual"`
-	period                  int     `json:"period"`
-	periodType              int     `json:"periodType"`
-	teamId                  string  `json:"teamId"`
-	teamTricode             string  `json:"teamTricode"`
-	actionType              string  `json:"actionType"`
-	subType                 string  `json:"subType"`
-	descriptor              string  `json:"descriptor"`
-	qualifiers              string  `json:"qualifiers"`
-	personId                string  `json:"personId"`
-	playerName              string  `json:"playerName"`
-	playerNameI             string  `json:"playerNameI"`
-	jerseyNum               string  `json:"jerseyNum"`
-	assistPersonId          string  `json:"assistPersonId"`
-	assistPlayerNameI       string  `json:"assistPlayerNameI"`
-	assistTotal             string  `json:"assistTotal"`
-	officialId              string  `json:"officialId"`
-	description             string  `json:"description"`
-	shotDistance            string  `json:"shotDistance"`
-	shotResult              string  `json:"shotResult"`
-	isFieldGoal             string  `json:"isFieldGoal"`
-	scoreHome               string  `json:"scoreHome"`
-	scoreAway               string  `json:"scoreAway"`
-	pointsTotal             string  `json:"pointsTotal"`
-	location                string  `json:"location"`
-	xLegacy                 string  `json:"xLegacy"`
-	yLegacy                 string  `json:"yLegacy"`
-	isTargetScoreLastPeriod float64 `json:"isTargetScoreLastPeriod"`
-	orderNumber             string  `json:"orderNumber"`
-	edited                  string  `json:"edited"`
+// PlayByPlayV3Action is one event of the game.actions array.
+type PlayByPlayV3Action struct {
+	ActionNumber   int    `json:"actionNumber"`
+	Clock          string `json:"clock"`
+	Period         int    `json:"period"`
+	TeamID         int    `json:"teamId"`
+	TeamTricode    string `json:"teamTricode"`
+	PersonID       int    `json:"personId"`
+	PlayerName     string `json:"playerName"`
+	PlayerNameI    string `json:"playerNameI"`
+	XLegacy        int    `json:"xLegacy"`
+	YLegacy        int    `json:"yLegacy"`
+	ShotDistance   int    `json:"shotDistance"`
+	ShotResult     string `json:"shotResult"`
+	IsFieldGoal    int    `json:"isFieldGoal"`
+	ScoreHome      string `json:"scoreHome"`
+	ScoreAway      string `json:"scoreAway"`
+	PointsTotal    int    `json:"pointsTotal"`
+	Location       string `json:"location"`
+	Description    string `json:"description"`
+	ActionType     string `json:"actionType"`
+	SubType        string `json:"subType"`
+	VideoAvailable int    `json:"videoAvailable"`
+	ShotValue      int    `json:"shotValue"`
+	ActionID       int    `json:"actionId"`
 }
 
-// PlayByPlayV3Response contains the response data from the PlayByPlayV3 endpoint
+// Remaining parses Clock ("PT11M42.00S"), the time left in the period.
+func (a PlayByPlayV3Action) Remaining() (time.Duration, error) {
+	return timeutil.ParseClock(a.Clock)
+}
+
+// PlayByPlayV3Response is the game object of the playbyplayv3 document.
 type PlayByPlayV3Response struct {
-	PlayByPlay []PlayByPlayV3PlayByPlay
+	GameID         string               `json:"gameId"`
+	VideoAvailable int                  `json:"videoAvailable"`
+	Actions        []PlayByPlayV3Action `json:"actions"`
+}
+
+type playByPlayV3Document struct {
+	Meta json.RawMessage       `json:"meta"`
+	Game *PlayByPlayV3Response `json:"game"`
 }
 
 // GetPlayByPlayV3 retrieves data from the playbyplayv3 endpoint
@@ -72,55 +77,16 @@ func GetPlayByPlayV3(ctx context.Context, client *stats.Client, req PlayByPlayV3
 		params.Set("EndPeriod", string(*req.EndPeriod))
 	}
 
-	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playbyplayv3", params, &rawResp); err != nil {
+	var doc playByPlayV3Document
+	raw, drift, err := getDocument(ctx, client, "playbyplayv3", params, &doc)
+	if err != nil {
 		return nil, err
 	}
 
-	response := &PlayByPlayV3Response{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayByPlay = make([]PlayByPlayV3PlayByPlay, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 33 {
-				item := PlayByPlayV3PlayByPlay{
-					gameId:                  toString(row[0]),
-					actionNumber:            toString(row[1]),
-					clock:                   toString(row[2]),
-					timeActual:              toString(row[3]),
-					period:                  toInt(row[4]),
-					periodType:              toInt(row[5]),
-					teamId:                  toString(row[6]),
-					teamTricode:             toString(row[7]),
-					actionType:              toString(row[8]),
-					subType:                 toString(row[9]),
-					descriptor:              toString(row[10]),
-					qualifiers:              toString(row[11]),
-					personId:                toString(row[12]),
-					playerName:              toString(row[13]),
-					playerNameI:             toString(row[14]),
-					jerseyNum:               toString(row[15]),
-					assistPersonId:          toString(row[16]),
-					assistPlayerNameI:       toString(row[17]),
-					assistTotal:             toString(row[18]),
-					officialId:              toString(row[19]),
-					description:             toString(row[20]),
-					shotDistance:            toString(row[21]),
-					shotResult:              toString(row[22]),
-					isFieldGoal:             toString(row[23]),
-					scoreHome:               toString(row[24]),
-					scoreAway:               toString(row[25]),
-					pointsTotal:             toString(row[26]),
-					location:                toString(row[27]),
-					xLegacy:                 toString(row[28]),
-					yLegacy:                 toString(row[29]),
-					isTargetScoreLastPeriod: toFloat(row[30]),
-					orderNumber:             toString(row[31]),
-					edited:                  toString(row[32]),
-				}
-				response.PlayByPlay = append(response.PlayByPlay, item)
-			}
-		}
+	response := doc.Game
+	if response == nil {
+		response = &PlayByPlayV3Response{}
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newDriftResponse(client, response, raw, drift)
 }
@@ -46,35 +46,32 @@ func GetPlayerAwards(ctx context.Context, client *stats.Client, req PlayerAwards
 	params.Set("PlayerID", string(req.PlayerID))
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playerawards", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playerawards", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerAwardsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerAwards = make([]PlayerAwardsPlayerAwards, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 14 {
-				item := PlayerAwardsPlayerAwards{
-					PERSON_ID:           toString(row[0]),
-					FIRST_NAME:          toString(row[1]),
-					LAST_NAME:           toString(row[2]),
-					TEAM:                toString(row[3]),
-					DESCRIPTION:         toString(row[4]),
-					ALL_NBA_TEAM_NUMBER: toString(row[5]),
-					SEASON:              toString(row[6]),
-					MONTH:               toString(row[7]),
-					WEEK:                toString(row[8]),
-					CONFERENCE:          toString(row[9]),
-					TYPE:                toString(row[10]),
-					SUBTYPE1:            toString(row[11]),
-					SUBTYPE2:            toString(row[12]),
-					SUBTYPE3:            toString(row[13]),
-				}
-				response.PlayerAwards = append(response.PlayerAwards, item)
+	if rs := rawResp.resultSet("PlayerAwards"); rs != nil {
+		response.PlayerAwards = decodeRows(rs, func(row *rowReader) PlayerAwardsPlayerAwards {
+			return PlayerAwardsPlayerAwards{
+				PERSON_ID:           row.String("PERSON_ID"),
+				FIRST_NAME:          row.String("FIRST_NAME"),
+				LAST_NAME:           row.String("LAST_NAME"),
+				TEAM:                row.String("TEAM"),
+				DESCRIPTION:         row.String("DESCRIPTION"),
+				ALL_NBA_TEAM_NUMBER: row.String("ALL_NBA_TEAM_NUMBER"),
+				SEASON:              row.String("SEASON"),
+				MONTH:               row.String("MONTH"),
+				WEEK:                row.String("WEEK"),
+				CONFERENCE:          row.String("CONFERENCE"),
+				TYPE:                row.String("TYPE"),
+				SUBTYPE1:            row.String("SUBTYPE1"),
+				SUBTYPE2:            row.String("SUBTYPE2"),
+				SUBTYPE3:            row.String("SUBTYPE3"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -46,32 +46,29 @@ func GetPlayerCareerByCollege(ctx context.Context, client *stats.Client, req Pla
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playercareerbycollege", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playercareerbycollege", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerCareerByCollegeResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerCareerByCollege = make([]PlayerCareerByCollegePlayerCareerByCollege, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 11 {
-				item := PlayerCareerByCollegePlayerCareerByCollege{
-					PERSON_ID:   toString(row[0]),
-					PLAYER_NAME: toString(row[1]),
-					SCHOOL_NAME: toString(row[2]),
-					FIRST_YEAR:  toString(row[3]),
-					LAST_YEAR:   toFloat(row[4]),
-					SEASONS:     toString(row[5]),
-					GP:          toInt(row[6]),
-					MIN:         toFloat(row[7]),
-					PTS:         toFloat(row[8]),
-					REB:         toFloat(row[9]),
-					AST:         toFloat(row[10]),
-				}
-				response.PlayerCareerByCollege = append(response.PlayerCareerByCollege, item)
+	if rs := rawResp.resultSet("PlayerCareerByCollege"); rs != nil {
+		response.PlayerCareerByCollege = decodeRows(rs, func(row *rowReader) PlayerCareerByCollegePlayerCareerByCollege {
+			return PlayerCareerByCollegePlayerCareerByCollege{
+				PERSON_ID:   row.String("PERSON_ID"),
+				PLAYER_NAME: row.String("PLAYER_NAME"),
+				SCHOOL_NAME: row.String("SCHOOL_NAME"),
+				FIRST_YEAR:  row.String("FIRST_YEAR"),
+				LAST_YEAR:   row.Float("LAST_YEAR"),
+				SEASONS:     row.String("SEASONS"),
+				GP:          row.Int("GP"),
+				MIN:         row.Float("MIN"),
+				PTS:         row.Float("PTS"),
+				REB:         row.Float("REB"),
+				AST:         row.Float("AST"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
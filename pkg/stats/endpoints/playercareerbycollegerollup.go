@@ -46,32 +46,29 @@ func GetPlayerCareerByCollegeRollup(ctx context.Context, client *stats.Client, r
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playercareerbyrollegerollup", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playercareerbyrollegerollup", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerCareerByCollegeRollupResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.CollegeStats = make([]PlayerCareerByCollegeRollupCollegeStats, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 11 {
-				item := PlayerCareerByCollegeRollupCollegeStats{
-					SCHOOL_NAME:         toString(row[0]),
-					SEASON_COUNT:        toString(row[1]),
-					PLAYER_COUNT:        toFloat(row[2]),
-					ACTIVE_PLAYER_COUNT: toFloat(row[3]),
-					MIN:                 toFloat(row[4]),
-					PTS:                 toFloat(row[5]),
-					REB:                 toFloat(row[6]),
-					AST:                 toFloat(row[7]),
-					FG_PCT:              toFloat(row[8]),
-					FG3_PCT:             toFloat(row[9]),
-					FT_PCT:              toFloat(row[10]),
-				}
-				response.CollegeStats = append(response.CollegeStats, item)
+	if rs := rawResp.resultSet("CollegeStats"); rs != nil {
+		response.CollegeStats = decodeRows(rs, func(row *rowReader) PlayerCareerByCollegeRollupCollegeStats {
+			return PlayerCareerByCollegeRollupCollegeStats{
+				SCHOOL_NAME:         row.String("SCHOOL_NAME"),
+				SEASON_COUNT:        row.String("SEASON_COUNT"),
+				PLAYER_COUNT:        row.Float("PLAYER_COUNT"),
+				ACTIVE_PLAYER_COUNT: row.Float("ACTIVE_PLAYER_COUNT"),
+				MIN:                 row.Float("MIN"),
+				PTS:                 row.Float("PTS"),
+				REB:                 row.Float("REB"),
+				AST:                 row.Float("AST"),
+				FG_PCT:              row.Float("FG_PCT"),
+				FG3_PCT:             row.Float("FG3_PCT"),
+				FT_PCT:              row.Float("FT_PCT"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -109,108 +109,112 @@ func PlayerCareerStats(ctx context.Context, client *stats.Client, req PlayerCare
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playercareerstats", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playercareerstats", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerCareerStatsResponse{}
-	for _, resultSet := range rawResp.ResultSets {
-		switch resultSet.Name {
-		case "SeasonTotalsRegularSeason":
-			response.SeasonTotalsRegularSeason = parseSeasonStats(resultSet.RowSet)
-		case "CareerTotalsRegularSeason":
-			response.CareerTotalsRegularSeason = parseCareerTotals(resultSet.RowSet)
-		case "SeasonTotalsPostSeason":
-			response.SeasonTotalsPostSeason = parseSeasonStats(resultSet.RowSet)
-		case "CareerTotalsPostSeason":
-			response.CareerTotalsPostSeason = parseCareerTotals(resultSet.RowSet)
-		case "SeasonTotalsAllStarSeason":
-			response.SeasonTotalsAllStarSeason = parseSeasonStats(resultSet.RowSet)
-		case "CareerTotalsAllStarSeason":
-			response.CareerTotalsAllStarSeason = parseCareerTotals(resultSet.RowSet)
-		case "SeasonTotalsCollegeSeason":
-			response.SeasonTotalsCollegeSeason = parseSeasonStats(resultSet.RowSet)
-		case "CareerTotalsCollegeSeason":
-			response.CareerTotalsCollegeSeason = parseCareerTotals(resultSet.RowSet)
-		}
+	if rs := rawResp.resultSet("SeasonTotalsRegularSeason"); rs != nil {
+		response.SeasonTotalsRegularSeason = parseSeasonStats(rs)
+	}
+	if rs := rawResp.resultSet("CareerTotalsRegularSeason"); rs != nil {
+		response.CareerTotalsRegularSeason = parseCareerTotals(rs)
+	}
+	if rs := rawResp.resultSet("SeasonTotalsPostSeason"); rs != nil {
+		response.SeasonTotalsPostSeason = parseSeasonStats(rs)
+	}
+	if rs := rawResp.resultSet("CareerTotalsPostSeason"); rs != nil {
+		response.CareerTotalsPostSeason = parseCareerTotals(rs)
+	}
+	if rs := rawResp.resultSet("SeasonTotalsAllStarSeason"); rs != nil {
+		response.SeasonTotalsAllStarSeason = parseSeasonStats(rs)
+	}
+	if rs := rawResp.resultSet("CareerTotalsAllStarSeason"); rs != nil {
+		response.CareerTotalsAllStarSeason = parseCareerTotals(rs)
+	}
+	if rs := rawResp.resultSet("SeasonTotalsCollegeSeason"); rs != nil {
+		response.SeasonTotalsCollegeSeason = parseSeasonStats(rs)
+	}
+	if rs := rawResp.resultSet("CareerTotalsCollegeSeason"); rs != nil {
+		response.CareerTotalsCollegeSeason = parseCareerTotals(rs)
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
 
-func parseSeasonStats(rows [][]interface{}) []SeasonStat {
-	stats := make([]SeasonStat, 0, len(rows))
-	for _, row := range rows {
-		if len(row) < 28 {
-			continue
-		}
-		stat := SeasonStat{
-			PlayerID:         toInt(row[0]),
-			SeasonID:         toString(row[1]),
-			LeagueID:         toString(row[2]),
-			TeamID:           toInt(row[3]),
-			TeamAbbreviation: toString(row[4]),
-			PlayerAge:        toInt(row[5]),
-			GP:               toInt(row[6]),
-			GS:               toInt(row[7]),
-			MIN:              toFloat(row[8]),
-			FGM:              toFloat(row[9]),
-			FGA:              toFloat(row[10]),
-			FGPct:            toFloat(row[11]),
-			FG3M:             toFloat(row[12]),
-			FG3A:             toFloat(row[13]),
-			FG3Pct:           toFloat(row[14]),
-			FTM:              toFloat(row[15]),
-			FTA:              toFloat(row[16]),
-			FTPct:            toFloat(row[17]),
-			OREB:             toFloat(row[18]),
-			DREB:             toFloat(row[19]),
-			REB:              toFloat(row[20]),
-			AST:              toFloat(row[21]),
-			STL:              toFloat(row[22]),
-			BLK:              toFloat(row[23]),
-			TOV:              toFloat(row[24]),
-			PF:               toFloat(row[25]),
-			PTS:              toFloat(row[26]),
-		}
-		stats = append(stats, stat)
+func parseSeasonStats(rs *ResultSet) []SeasonStat {
+	// College seasons identify the school rather than a team.
+	teamID, team := "TEAM_ID", "TEAM_ABBREVIATION"
+	if !rs.HasColumn(teamID) {
+		teamID, team = "ORGANIZATION_ID", "SCHOOL_NAME"
 	}
-	return stats
-}
 
-func parseCareerTotals(rows [][]interface{}) []CareerTotalStat {
-	stats := make([]CareerTotalStat, 0, len(rows))
-	for _, row := range rows {
-		if len(row) < 24 {
-			continue
-		}
-		stat := CareerTotalStat{
-			PlayerID: toInt(row[0]),
-			LeagueID: toString(row[1]),
-			TeamID:   toInt(row[2]),
-			GP:       toInt(row[3]),
-			GS:       toInt(row[4]),
-			MIN:      toFloat(row[5]),
-			FGM:      toFloat(row[6]),
-			FGA:      toFloat(row[7]),
-			FGPct:    toFloat(row[8]),
-			FG3M:     toFloat(row[9]),
-			FG3A:     toFloat(row[10]),
-			FG3Pct:   toFloat(row[11]),
-			FTM:      toFloat(row[12]),
-			FTA:      toFloat(row[13]),
-			FTPct:    toFloat(row[14]),
-			OREB:     toFloat(row[15]),
-			DREB:     toFloat(row[16]),
-			REB:      toFloat(row[17]),
-			AST:      toFloat(row[18]),
-			STL:      toFloat(row[19]),
-			BLK:      toFloat(row[20]),
-			TOV:      toFloat(row[21]),
-			PF:       toFloat(row[22]),
-			PTS:      toFloat(row[23]),
+	return decodeRows(rs, func(row *rowReader) SeasonStat {
+		return SeasonStat{
+			PlayerID:         row.Int("PLAYER_ID"),
+			SeasonID:         row.String("SEASON_ID"),
+			LeagueID:         row.String("LEAGUE_ID"),
+			TeamID:           row.Int(teamID),
+			TeamAbbreviation: row.String(team),
+			PlayerAge:        row.Int("PLAYER_AGE"),
+			GP:               row.Int("GP"),
+			GS:               row.Int("GS"),
+			MIN:              row.Float("MIN"),
+			FGM:              row.Float("FGM"),
+			FGA:              row.Float("FGA"),
+			FGPct:            row.Float("FG_PCT"),
+			FG3M:             row.Float("FG3M"),
+			FG3A:             row.Float("FG3A"),
+			FG3Pct:           row.Float("FG3_PCT"),
+			FTM:              row.Float("FTM"),
+			FTA:              row.Float("FTA"),
+			FTPct:            row.Float("FT_PCT"),
+			OREB:             row.Float("OREB"),
+			DREB:             row.Float("DREB"),
+			REB:              row.Float("REB"),
+			AST:              row.Float("AST"),
+			STL:              row.Float("STL"),
+			BLK:              row.Float("BLK"),
+			TOV:              row.Float("TOV"),
+			PF:               row.Float("PF"),
+			PTS:              row.Float("PTS"),
 		}
-		stats = append(stats, stat)
+	})
+}
+
+func parseCareerTotals(rs *ResultSet) []CareerTotalStat {
+	teamID := "Team_ID"
+	if !rs.HasColumn(teamID) {
+		teamID = "ORGANIZATION_ID"
 	}
-	return stats
+
+	return decodeRows(rs, func(row *rowReader) CareerTotalStat {
+		return CareerTotalStat{
+			PlayerID: row.Int("PLAYER_ID"),
+			LeagueID: row.String("LEAGUE_ID"),
+			TeamID:   row.Int(teamID),
+			GP:       row.Int("GP"),
+			GS:       row.Int("GS"),
+			MIN:      row.Float("MIN"),
+			FGM:      row.Float("FGM"),
+			FGA:      row.Float("FGA"),
+			FGPct:    row.Float("FG_PCT"),
+			FG3M:     row.Float("FG3M"),
+			FG3A:     row.Float("FG3A"),
+			FG3Pct:   row.Float("FG3_PCT"),
+			FTM:      row.Float("FTM"),
+			FTA:      row.Float("FTA"),
+			FTPct:    row.Float("FT_PCT"),
+			OREB:     row.Float("OREB"),
+			DREB:     row.Float("DREB"),
+			REB:      row.Float("REB"),
+			AST:      row.Float("AST"),
+			STL:      row.Float("STL"),
+			BLK:      row.Float("BLK"),
+			TOV:      row.Float("TOV"),
+			PF:       row.Float("PF"),
+			PTS:      row.Float("PTS"),
+		}
+	})
 }
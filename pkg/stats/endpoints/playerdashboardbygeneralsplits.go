@@ -435,356 +435,342 @@ func GetPlayerDashboardByGeneralSplits(ctx context.Context, client *stats.Client
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playerdashboardbygeneralsplits", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playerdashboardbygeneralsplits", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerDashboardByGeneralSplitsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.OverallPlayerDashboard = make([]PlayerDashboardByGeneralSplitsOverallPlayerDashboard, len(rawResp.ResultSets[0].RowSet))
-		for i, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 60 {
-				response.OverallPlayerDashboard[i] = PlayerDashboardByGeneralSplitsOverallPlayerDashboard{
-					GROUP_SET:            row[0],
-					GROUP_VALUE:          row[1],
-					GP:                   row[2],
-					W:                    row[3],
-					L:                    row[4],
-					W_PCT:                row[5],
-					MIN:                  row[6],
-					FGM:                  row[7],
-					FGA:                  row[8],
-					FG_PCT:               row[9],
-					FG3M:                 row[10],
-					FG3A:                 row[11],
-					FG3_PCT:              row[12],
-					FTM:                  row[13],
-					FTA:                  row[14],
-					FT_PCT:               row[15],
-					OREB:                 row[16],
-					DREB:                 row[17],
-					REB:                  row[18],
-					AST:                  row[19],
-					TOV:                  row[20],
-					STL:                  row[21],
-					BLK:                  row[22],
-					BLKA:                 row[23],
-					PF:                   row[24],
-					PFD:                  row[25],
-					PTS:                  row[26],
-					PLUS_MINUS:           row[27],
-					NBA_FANTASY_PTS:      row[28],
-					DD2:                  row[29],
-					TD3:                  row[30],
-					GP_RANK:              row[31],
-					W_RANK:               row[32],
-					L_RANK:               row[33],
-					W_PCT_RANK:           row[34],
-					MIN_RANK:             row[35],
-					FGM_RANK:             row[36],
-					FGA_RANK:             row[37],
-					FG_PCT_RANK:          row[38],
-					FG3M_RANK:            row[39],
-					FG3A_RANK:            row[40],
-					FG3_PCT_RANK:         row[41],
-					FTM_RANK:             row[42],
-					FTA_RANK:             row[43],
-					FT_PCT_RANK:          row[44],
-					OREB_RANK:            row[45],
-					DREB_RANK:            row[46],
-					REB_RANK:             row[47],
-					AST_RANK:             row[48],
-					TOV_RANK:             row[49],
-					STL_RANK:             row[50],
-					BLK_RANK:             row[51],
-					BLKA_RANK:            row[52],
-					PF_RANK:              row[53],
-					PFD_RANK:             row[54],
-					PTS_RANK:             row[55],
-					PLUS_MINUS_RANK:      row[56],
-					NBA_FANTASY_PTS_RANK: row[57],
-					DD2_RANK:             row[58],
-					TD3_RANK:             row[59],
-				}
+	if rs := rawResp.resultSet("OverallPlayerDashboard"); rs != nil {
+		response.OverallPlayerDashboard = decodeRows(rs, func(row *rowReader) PlayerDashboardByGeneralSplitsOverallPlayerDashboard {
+			return PlayerDashboardByGeneralSplitsOverallPlayerDashboard{
+				GROUP_SET:            row.Value("GROUP_SET"),
+				GROUP_VALUE:          row.Value("GROUP_VALUE"),
+				GP:                   row.Value("GP"),
+				W:                    row.Value("W"),
+				L:                    row.Value("L"),
+				W_PCT:                row.Value("W_PCT"),
+				MIN:                  row.Value("MIN"),
+				FGM:                  row.Value("FGM"),
+				FGA:                  row.Value("FGA"),
+				FG_PCT:               row.Value("FG_PCT"),
+				FG3M:                 row.Value("FG3M"),
+				FG3A:                 row.Value("FG3A"),
+				FG3_PCT:              row.Value("FG3_PCT"),
+				FTM:                  row.Value("FTM"),
+				FTA:                  row.Value("FTA"),
+				FT_PCT:               row.Value("FT_PCT"),
+				OREB:                 row.Value("OREB"),
+				DREB:                 row.Value("DREB"),
+				REB:                  row.Value("REB"),
+				AST:                  row.Value("AST"),
+				TOV:                  row.Value("TOV"),
+				STL:                  row.Value("STL"),
+				BLK:                  row.Value("BLK"),
+				BLKA:                 row.Value("BLKA"),
+				PF:                   row.Value("PF"),
+				PFD:                  row.Value("PFD"),
+				PTS:                  row.Value("PTS"),
+				PLUS_MINUS:           row.Value("PLUS_MINUS"),
+				NBA_FANTASY_PTS:      row.Value("NBA_FANTASY_PTS"),
+				DD2:                  row.Value("DD2"),
+				TD3:                  row.Value("TD3"),
+				GP_RANK:              row.Value("GP_RANK"),
+				W_RANK:               row.Value("W_RANK"),
+				L_RANK:               row.Value("L_RANK"),
+				W_PCT_RANK:           row.Value("W_PCT_RANK"),
+				MIN_RANK:             row.Value("MIN_RANK"),
+				FGM_RANK:             row.Value("FGM_RANK"),
+				FGA_RANK:             row.Value("FGA_RANK"),
+				FG_PCT_RANK:          row.Value("FG_PCT_RANK"),
+				FG3M_RANK:            row.Value("FG3M_RANK"),
+				FG3A_RANK:            row.Value("FG3A_RANK"),
+				FG3_PCT_RANK:         row.Value("FG3_PCT_RANK"),
+				FTM_RANK:             row.Value("FTM_RANK"),
+				FTA_RANK:             row.Value("FTA_RANK"),
+				FT_PCT_RANK:          row.Value("FT_PCT_RANK"),
+				OREB_RANK:            row.Value("OREB_RANK"),
+				DREB_RANK:            row.Value("DREB_RANK"),
+				REB_RANK:             row.Value("REB_RANK"),
+				AST_RANK:             row.Value("AST_RANK"),
+				TOV_RANK:             row.Value("TOV_RANK"),
+				STL_RANK:             row.Value("STL_RANK"),
+				BLK_RANK:             row.Value("BLK_RANK"),
+				BLKA_RANK:            row.Value("BLKA_RANK"),
+				PF_RANK:              row.Value("PF_RANK"),
+				PFD_RANK:             row.Value("PFD_RANK"),
+				PTS_RANK:             row.Value("PTS_RANK"),
+				PLUS_MINUS_RANK:      row.Value("PLUS_MINUS_RANK"),
+				NBA_FANTASY_PTS_RANK: row.Value("NBA_FANTASY_PTS_RANK"),
+				DD2_RANK:             row.Value("DD2_RANK"),
+				TD3_RANK:             row.Value("TD3_RANK"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.LocationPlayerDashboard = make([]PlayerDashboardByGeneralSplitsLocationPlayerDashboard, len(rawResp.ResultSets[1].RowSet))
-		for i, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 60 {
-				response.LocationPlayerDashboard[i] = PlayerDashboardByGeneralSplitsLocationPlayerDashboard{
-					GROUP_SET:            row[0],
-					GROUP_VALUE:          row[1],
-					GP:                   row[2],
-					W:                    row[3],
-					L:                    row[4],
-					W_PCT:                row[5],
-					MIN:                  row[6],
-					FGM:                  row[7],
-					FGA:                  row[8],
-					FG_PCT:               row[9],
-					FG3M:                 row[10],
-					FG3A:                 row[11],
-					FG3_PCT:              row[12],
-					FTM:                  row[13],
-					FTA:                  row[14],
-					FT_PCT:               row[15],
-					OREB:                 row[16],
-					DREB:                 row[17],
-					REB:                  row[18],
-					AST:                  row[19],
-					TOV:                  row[20],
-					STL:                  row[21],
-					BLK:                  row[22],
-					BLKA:                 row[23],
-					PF:                   row[24],
-					PFD:                  row[25],
-					PTS:                  row[26],
-					PLUS_MINUS:           row[27],
-					NBA_FANTASY_PTS:      row[28],
-					DD2:                  row[29],
-					TD3:                  row[30],
-					GP_RANK:              row[31],
-					W_RANK:               row[32],
-					L_RANK:               row[33],
-					W_PCT_RANK:           row[34],
-					MIN_RANK:             row[35],
-					FGM_RANK:             row[36],
-					FGA_RANK:             row[37],
-					FG_PCT_RANK:          row[38],
-					FG3M_RANK:            row[39],
-					FG3A_RANK:            row[40],
-					FG3_PCT_RANK:         row[41],
-					FTM_RANK:             row[42],
-					FTA_RANK:             row[43],
-					FT_PCT_RANK:          row[44],
-					OREB_RANK:            row[45],
-					DREB_RANK:            row[46],
-					REB_RANK:             row[47],
-					AST_RANK:             row[48],
-					TOV_RANK:             row[49],
-					STL_RANK:             row[50],
-					BLK_RANK:             row[51],
-					BLKA_RANK:            row[52],
-					PF_RANK:              row[53],
-					PFD_RANK:             row[54],
-					PTS_RANK:             row[55],
-					PLUS_MINUS_RANK:      row[56],
-					NBA_FANTASY_PTS_RANK: row[57],
-					DD2_RANK:             row[58],
-					TD3_RANK:             row[59],
-				}
+	if rs := rawResp.resultSet("LocationPlayerDashboard"); rs != nil {
+		response.LocationPlayerDashboard = decodeRows(rs, func(row *rowReader) PlayerDashboardByGeneralSplitsLocationPlayerDashboard {
+			return PlayerDashboardByGeneralSplitsLocationPlayerDashboard{
+				GROUP_SET:            row.Value("GROUP_SET"),
+				GROUP_VALUE:          row.Value("GROUP_VALUE"),
+				GP:                   row.Value("GP"),
+				W:                    row.Value("W"),
+				L:                    row.Value("L"),
+				W_PCT:                row.Value("W_PCT"),
+				MIN:                  row.Value("MIN"),
+				FGM:                  row.Value("FGM"),
+				FGA:                  row.Value("FGA"),
+				FG_PCT:               row.Value("FG_PCT"),
+				FG3M:                 row.Value("FG3M"),
+				FG3A:                 row.Value("FG3A"),
+				FG3_PCT:              row.Value("FG3_PCT"),
+				FTM:                  row.Value("FTM"),
+				FTA:                  row.Value("FTA"),
+				FT_PCT:               row.Value("FT_PCT"),
+				OREB:                 row.Value("OREB"),
+				DREB:                 row.Value("DREB"),
+				REB:                  row.Value("REB"),
+				AST:                  row.Value("AST"),
+				TOV:                  row.Value("TOV"),
+				STL:                  row.Value("STL"),
+				BLK:                  row.Value("BLK"),
+				BLKA:                 row.Value("BLKA"),
+				PF:                   row.Value("PF"),
+				PFD:                  row.Value("PFD"),
+				PTS:                  row.Value("PTS"),
+				PLUS_MINUS:           row.Value("PLUS_MINUS"),
+				NBA_FANTASY_PTS:      row.Value("NBA_FANTASY_PTS"),
+				DD2:                  row.Value("DD2"),
+				TD3:                  row.Value("TD3"),
+				GP_RANK:              row.Value("GP_RANK"),
+				W_RANK:               row.Value("W_RANK"),
+				L_RANK:               row.Value("L_RANK"),
+				W_PCT_RANK:           row.Value("W_PCT_RANK"),
+				MIN_RANK:             row.Value("MIN_RANK"),
+				FGM_RANK:             row.Value("FGM_RANK"),
+				FGA_RANK:             row.Value("FGA_RANK"),
+				FG_PCT_RANK:          row.Value("FG_PCT_RANK"),
+				FG3M_RANK:            row.Value("FG3M_RANK"),
+				FG3A_RANK:            row.Value("FG3A_RANK"),
+				FG3_PCT_RANK:         row.Value("FG3_PCT_RANK"),
+				FTM_RANK:             row.Value("FTM_RANK"),
+				FTA_RANK:             row.Value("FTA_RANK"),
+				FT_PCT_RANK:          row.Value("FT_PCT_RANK"),
+				OREB_RANK:            row.Value("OREB_RANK"),
+				DREB_RANK:            row.Value("DREB_RANK"),
+				REB_RANK:             row.Value("REB_RANK"),
+				AST_RANK:             row.Value("AST_RANK"),
+				TOV_RANK:             row.Value("TOV_RANK"),
+				STL_RANK:             row.Value("STL_RANK"),
+				BLK_RANK:             row.Value("BLK_RANK"),
+				BLKA_RANK:            row.Value("BLKA_RANK"),
+				PF_RANK:              row.Value("PF_RANK"),
+				PFD_RANK:             row.Value("PFD_RANK"),
+				PTS_RANK:             row.Value("PTS_RANK"),
+				PLUS_MINUS_RANK:      row.Value("PLUS_MINUS_RANK"),
+				NBA_FANTASY_PTS_RANK: row.Value("NBA_FANTASY_PTS_RANK"),
+				DD2_RANK:             row.Value("DD2_RANK"),
+				TD3_RANK:             row.Value("TD3_RANK"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 2 {
-		response.WinsLossesPlayerDashboard = make([]PlayerDashboardByGeneralSplitsWinsLossesPlayerDashboard, len(rawResp.ResultSets[2].RowSet))
-		for i, row := range rawResp.ResultSets[2].RowSet {
-			if len(row) >= 60 {
-				response.WinsLossesPlayerDashboard[i] = PlayerDashboardByGeneralSplitsWinsLossesPlayerDashboard{
-					GROUP_SET:            row[0],
-					GROUP_VALUE:          row[1],
-					GP:                   row[2],
-					W:                    row[3],
-					L:                    row[4],
-					W_PCT:                row[5],
-					MIN:                  row[6],
-					FGM:                  row[7],
-					FGA:                  row[8],
-					FG_PCT:               row[9],
-					FG3M:                 row[10],
-					FG3A:                 row[11],
-					FG3_PCT:              row[12],
-					FTM:                  row[13],
-					FTA:                  row[14],
-					FT_PCT:               row[15],
-					OREB:                 row[16],
-					DREB:                 row[17],
-					REB:                  row[18],
-					AST:                  row[19],
-					TOV:                  row[20],
-					STL:                  row[21],
-					BLK:                  row[22],
-					BLKA:                 row[23],
-					PF:                   row[24],
-					PFD:                  row[25],
-					PTS:                  row[26],
-					PLUS_MINUS:           row[27],
-					NBA_FANTASY_PTS:      row[28],
-					DD2:                  row[29],
-					TD3:                  row[30],
-					GP_RANK:              row[31],
-					W_RANK:               row[32],
-					L_RANK:               row[33],
-					W_PCT_RANK:           row[34],
-					MIN_RANK:             row[35],
-					FGM_RANK:             row[36],
-					FGA_RANK:             row[37],
-					FG_PCT_RANK:          row[38],
-					FG3M_RANK:            row[39],
-					FG3A_RANK:            row[40],
-					FG3_PCT_RANK:         row[41],
-					FTM_RANK:             row[42],
-					FTA_RANK:             row[43],
-					FT_PCT_RANK:          row[44],
-					OREB_RANK:            row[45],
-					DREB_RANK:            row[46],
-					REB_RANK:             row[47],
-					AST_RANK:             row[48],
-					TOV_RANK:             row[49],
-					STL_RANK:             row[50],
-					BLK_RANK:             row[51],
-					BLKA_RANK:            row[52],
-					PF_RANK:              row[53],
-					PFD_RANK:             row[54],
-					PTS_RANK:             row[55],
-					PLUS_MINUS_RANK:      row[56],
-					NBA_FANTASY_PTS_RANK: row[57],
-					DD2_RANK:             row[58],
-					TD3_RANK:             row[59],
-				}
+	if rs := rawResp.resultSet("WinsLossesPlayerDashboard"); rs != nil {
+		response.WinsLossesPlayerDashboard = decodeRows(rs, func(row *rowReader) PlayerDashboardByGeneralSplitsWinsLossesPlayerDashboard {
+			return PlayerDashboardByGeneralSplitsWinsLossesPlayerDashboard{
+				GROUP_SET:            row.Value("GROUP_SET"),
+				GROUP_VALUE:          row.Value("GROUP_VALUE"),
+				GP:                   row.Value("GP"),
+				W:                    row.Value("W"),
+				L:                    row.Value("L"),
+				W_PCT:                row.Value("W_PCT"),
+				MIN:                  row.Value("MIN"),
+				FGM:                  row.Value("FGM"),
+				FGA:                  row.Value("FGA"),
+				FG_PCT:               row.Value("FG_PCT"),
+				FG3M:                 row.Value("FG3M"),
+				FG3A:                 row.Value("FG3A"),
+				FG3_PCT:              row.Value("FG3_PCT"),
+				FTM:                  row.Value("FTM"),
+				FTA:                  row.Value("FTA"),
+				FT_PCT:               row.Value("FT_PCT"),
+				OREB:                 row.Value("OREB"),
+				DREB:                 row.Value("DREB"),
+				REB:                  row.Value("REB"),
+				AST:                  row.Value("AST"),
+				TOV:                  row.Value("TOV"),
+				STL:                  row.Value("STL"),
+				BLK:                  row.Value("BLK"),
+				BLKA:                 row.Value("BLKA"),
+				PF:                   row.Value("PF"),
+				PFD:                  row.Value("PFD"),
+				PTS:                  row.Value("PTS"),
+				PLUS_MINUS:           row.Value("PLUS_MINUS"),
+				NBA_FANTASY_PTS:      row.Value("NBA_FANTASY_PTS"),
+				DD2:                  row.Value("DD2"),
+				TD3:                  row.Value("TD3"),
+				GP_RANK:              row.Value("GP_RANK"),
+				W_RANK:               row.Value("W_RANK"),
+				L_RANK:               row.Value("L_RANK"),
+				W_PCT_RANK:           row.Value("W_PCT_RANK"),
+				MIN_RANK:             row.Value("MIN_RANK"),
+				FGM_RANK:             row.Value("FGM_RANK"),
+				FGA_RANK:             row.Value("FGA_RANK"),
+				FG_PCT_RANK:          row.Value("FG_PCT_RANK"),
+				FG3M_RANK:            row.Value("FG3M_RANK"),
+				FG3A_RANK:            row.Value("FG3A_RANK"),
+				FG3_PCT_RANK:         row.Value("FG3_PCT_RANK"),
+				FTM_RANK:             row.Value("FTM_RANK"),
+				FTA_RANK:             row.Value("FTA_RANK"),
+				FT_PCT_RANK:          row.Value("FT_PCT_RANK"),
+				OREB_RANK:            row.Value("OREB_RANK"),
+				DREB_RANK:            row.Value("DREB_RANK"),
+				REB_RANK:             row.Value("REB_RANK"),
+				AST_RANK:             row.Value("AST_RANK"),
+				TOV_RANK:             row.Value("TOV_RANK"),
+				STL_RANK:             row.Value("STL_RANK"),
+				BLK_RANK:             row.Value("BLK_RANK"),
+				BLKA_RANK:            row.Value("BLKA_RANK"),
+				PF_RANK:              row.Value("PF_RANK"),
+				PFD_RANK:             row.Value("PFD_RANK"),
+				PTS_RANK:             row.Value("PTS_RANK"),
+				PLUS_MINUS_RANK:      row.Value("PLUS_MINUS_RANK"),
+				NBA_FANTASY_PTS_RANK: row.Value("NBA_FANTASY_PTS_RANK"),
+				DD2_RANK:             row.Value("DD2_RANK"),
+				TD3_RANK:             row.Value("TD3_RANK"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 3 {
-		response.MonthPlayerDashboard = make([]PlayerDashboardByGeneralSplitsMonthPlayerDashboard, len(rawResp.ResultSets[3].RowSet))
-		for i, row := range rawResp.ResultSets[3].RowSet {
-			if len(row) >= 60 {
-				response.MonthPlayerDashboard[i] = PlayerDashboardByGeneralSplitsMonthPlayerDashboard{
-					GROUP_SET:            row[0],
-					GROUP_VALUE:          row[1],
-					GP:                   row[2],
-					W:                    row[3],
-					L:                    row[4],
-					W_PCT:                row[5],
-					MIN:                  row[6],
-					FGM:                  row[7],
-					FGA:                  row[8],
-					FG_PCT:               row[9],
-					FG3M:                 row[10],
-					FG3A:                 row[11],
-					FG3_PCT:              row[12],
-					FTM:                  row[13],
-					FTA:                  row[14],
-					FT_PCT:               row[15],
-					OREB:                 row[16],
-					DREB:                 row[17],
-					REB:                  row[18],
-					AST:                  row[19],
-					TOV:                  row[20],
-					STL:                  row[21],
-					BLK:                  row[22],
-					BLKA:                 row[23],
-					PF:                   row[24],
-					PFD:                  row[25],
-					PTS:                  row[26],
-					PLUS_MINUS:           row[27],
-					NBA_FANTASY_PTS:      row[28],
-					DD2:                  row[29],
-					TD3:                  row[30],
-					GP_RANK:              row[31],
-					W_RANK:               row[32],
-					L_RANK:               row[33],
-					W_PCT_RANK:           row[34],
-					MIN_RANK:             row[35],
-					FGM_RANK:             row[36],
-					FGA_RANK:             row[37],
-					FG_PCT_RANK:          row[38],
-					FG3M_RANK:            row[39],
-					FG3A_RANK:            row[40],
-					FG3_PCT_RANK:         row[41],
-					FTM_RANK:             row[42],
-					FTA_RANK:             row[43],
-					FT_PCT_RANK:          row[44],
-					OREB_RANK:            row[45],
-					DREB_RANK:            row[46],
-					REB_RANK:             row[47],
-					AST_RANK:             row[48],
-					TOV_RANK:             row[49],
-					STL_RANK:             row[50],
-					BLK_RANK:             row[51],
-					BLKA_RANK:            row[52],
-					PF_RANK:              row[53],
-					PFD_RANK:             row[54],
-					PTS_RANK:             row[55],
-					PLUS_MINUS_RANK:      row[56],
-					NBA_FANTASY_PTS_RANK: row[57],
-					DD2_RANK:             row[58],
-					TD3_RANK:             row[59],
-				}
+	if rs := rawResp.resultSet("MonthPlayerDashboard"); rs != nil {
+		response.MonthPlayerDashboard = decodeRows(rs, func(row *rowReader) PlayerDashboardByGeneralSplitsMonthPlayerDashboard {
+			return PlayerDashboardByGeneralSplitsMonthPlayerDashboard{
+				GROUP_SET:            row.Value("GROUP_SET"),
+				GROUP_VALUE:          row.Value("GROUP_VALUE"),
+				GP:                   row.Value("GP"),
+				W:                    row.Value("W"),
+				L:                    row.Value("L"),
+				W_PCT:                row.Value("W_PCT"),
+				MIN:                  row.Value("MIN"),
+				FGM:                  row.Value("FGM"),
+				FGA:                  row.Value("FGA"),
+				FG_PCT:               row.Value("FG_PCT"),
+				FG3M:                 row.Value("FG3M"),
+				FG3A:                 row.Value("FG3A"),
+				FG3_PCT:              row.Value("FG3_PCT"),
+				FTM:                  row.Value("FTM"),
+				FTA:                  row.Value("FTA"),
+				FT_PCT:               row.Value("FT_PCT"),
+				OREB:                 row.Value("OREB"),
+				DREB:                 row.Value("DREB"),
+				REB:                  row.Value("REB"),
+				AST:                  row.Value("AST"),
+				TOV:                  row.Value("TOV"),
+				STL:                  row.Value("STL"),
+				BLK:                  row.Value("BLK"),
+				BLKA:                 row.Value("BLKA"),
+				PF:                   row.Value("PF"),
+				PFD:                  row.Value("PFD"),
+				PTS:                  row.Value("PTS"),
+				PLUS_MINUS:           row.Value("PLUS_MINUS"),
+				NBA_FANTASY_PTS:      row.Value("NBA_FANTASY_PTS"),
+				DD2:                  row.Value("DD2"),
+				TD3:                  row.Value("TD3"),
+				GP_RANK:              row.Value("GP_RANK"),
+				W_RANK:               row.Value("W_RANK"),
+				L_RANK:               row.Value("L_RANK"),
+				W_PCT_RANK:           row.Value("W_PCT_RANK"),
+				MIN_RANK:             row.Value("MIN_RANK"),
+				FGM_RANK:             row.Value("FGM_RANK"),
+				FGA_RANK:             row.Value("FGA_RANK"),
+				FG_PCT_RANK:          row.Value("FG_PCT_RANK"),
+				FG3M_RANK:            row.Value("FG3M_RANK"),
+				FG3A_RANK:            row.Value("FG3A_RANK"),
+				FG3_PCT_RANK:         row.Value("FG3_PCT_RANK"),
+				FTM_RANK:             row.Value("FTM_RANK"),
+				FTA_RANK:             row.Value("FTA_RANK"),
+				FT_PCT_RANK:          row.Value("FT_PCT_RANK"),
+				OREB_RANK:            row.Value("OREB_RANK"),
+				DREB_RANK:            row.Value("DREB_RANK"),
+				REB_RANK:             row.Value("REB_RANK"),
+				AST_RANK:             row.Value("AST_RANK"),
+				TOV_RANK:             row.Value("TOV_RANK"),
+				STL_RANK:             row.Value("STL_RANK"),
+				BLK_RANK:             row.Value("BLK_RANK"),
+				BLKA_RANK:            row.Value("BLKA_RANK"),
+				PF_RANK:              row.Value("PF_RANK"),
+				PFD_RANK:             row.Value("PFD_RANK"),
+				PTS_RANK:             row.Value("PTS_RANK"),
+				PLUS_MINUS_RANK:      row.Value("PLUS_MINUS_RANK"),
+				NBA_FANTASY_PTS_RANK: row.Value("NBA_FANTASY_PTS_RANK"),
+				DD2_RANK:             row.Value("DD2_RANK"),
+				TD3_RANK:             row.Value("TD3_RANK"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 4 {
-		response.PrePostAllStarPlayerDashboard = make([]PlayerDashboardByGeneralSplitsPrePostAllStarPlayerDashboard, len(rawResp.ResultSets[4].RowSet))
-		for i, row := range rawResp.ResultSets[4].RowSet {
-			if len(row) >= 60 {
-				response.PrePostAllStarPlayerDashboard[i] = PlayerDashboardByGeneralSplitsPrePostAllStarPlayerDashboard{
-					GROUP_SET:            row[0],
-					GROUP_VALUE:          row[1],
-					GP:                   row[2],
-					W:                    row[3],
-					L:                    row[4],
-					W_PCT:                row[5],
-					MIN:                  row[6],
-					FGM:                  row[7],
-					FGA:                  row[8],
-					FG_PCT:               row[9],
-					FG3M:                 row[10],
-					FG3A:                 row[11],
-					FG3_PCT:              row[12],
-					FTM:                  row[13],
-					FTA:                  row[14],
-					FT_PCT:               row[15],
-					OREB:                 row[16],
-					DREB:                 row[17],
-					REB:                  row[18],
-					AST:                  row[19],
-					TOV:                  row[20],
-					STL:                  row[21],
-					BLK:                  row[22],
-					BLKA:                 row[23],
-					PF:                   row[24],
-					PFD:                  row[25],
-					PTS:                  row[26],
-					PLUS_MINUS:           row[27],
-					NBA_FANTASY_PTS:      row[28],
-					DD2:                  row[29],
-					TD3:                  row[30],
-					GP_RANK:              row[31],
-					W_RANK:               row[32],
-					L_RANK:               row[33],
-					W_PCT_RANK:           row[34],
-					MIN_RANK:             row[35],
-					FGM_RANK:             row[36],
-					FGA_RANK:             row[37],
-					FG_PCT_RANK:          row[38],
-					FG3M_RANK:            row[39],
-					FG3A_RANK:            row[40],
-					FG3_PCT_RANK:         row[41],
-					FTM_RANK:             row[42],
-					FTA_RANK:             row[43],
-					FT_PCT_RANK:          row[44],
-					OREB_RANK:            row[45],
-					DREB_RANK:            row[46],
-					REB_RANK:             row[47],
-					AST_RANK:             row[48],
-					TOV_RANK:             row[49],
-					STL_RANK:             row[50],
-					BLK_RANK:             row[51],
-					BLKA_RANK:            row[52],
-					PF_RANK:              row[53],
-					PFD_RANK:             row[54],
-					PTS_RANK:             row[55],
-					PLUS_MINUS_RANK:      row[56],
-					NBA_FANTASY_PTS_RANK: row[57],
-					DD2_RANK:             row[58],
-					TD3_RANK:             row[59],
-				}
+	if rs := rawResp.resultSet("PrePostAllStarPlayerDashboard"); rs != nil {
+		response.PrePostAllStarPlayerDashboard = decodeRows(rs, func(row *rowReader) PlayerDashboardByGeneralSplitsPrePostAllStarPlayerDashboard {
+			return PlayerDashboardByGeneralSplitsPrePostAllStarPlayerDashboard{
+				GROUP_SET:            row.Value("GROUP_SET"),
+				GROUP_VALUE:          row.Value("GROUP_VALUE"),
+				GP:                   row.Value("GP"),
+				W:                    row.Value("W"),
+				L:                    row.Value("L"),
+				W_PCT:                row.Value("W_PCT"),
+				MIN:                  row.Value("MIN"),
+				FGM:                  row.Value("FGM"),
+				FGA:                  row.Value("FGA"),
+				FG_PCT:               row.Value("FG_PCT"),
+				FG3M:                 row.Value("FG3M"),
+				FG3A:                 row.Value("FG3A"),
+				FG3_PCT:              row.Value("FG3_PCT"),
+				FTM:                  row.Value("FTM"),
+				FTA:                  row.Value("FTA"),
+				FT_PCT:               row.Value("FT_PCT"),
+				OREB:                 row.Value("OREB"),
+				DREB:                 row.Value("DREB"),
+				REB:                  row.Value("REB"),
+				AST:                  row.Value("AST"),
+				TOV:                  row.Value("TOV"),
+				STL:                  row.Value("STL"),
+				BLK:                  row.Value("BLK"),
+				BLKA:                 row.Value("BLKA"),
+				PF:                   row.Value("PF"),
+				PFD:                  row.Value("PFD"),
+				PTS:                  row.Value("PTS"),
+				PLUS_MINUS:           row.Value("PLUS_MINUS"),
+				NBA_FANTASY_PTS:      row.Value("NBA_FANTASY_PTS"),
+				DD2:                  row.Value("DD2"),
+				TD3:                  row.Value("TD3"),
+				GP_RANK:              row.Value("GP_RANK"),
+				W_RANK:               row.Value("W_RANK"),
+				L_RANK:               row.Value("L_RANK"),
+				W_PCT_RANK:           row.Value("W_PCT_RANK"),
+				MIN_RANK:             row.Value("MIN_RANK"),
+				FGM_RANK:             row.Value("FGM_RANK"),
+				FGA_RANK:             row.Value("FGA_RANK"),
+				FG_PCT_RANK:          row.Value("FG_PCT_RANK"),
+				FG3M_RANK:            row.Value("FG3M_RANK"),
+				FG3A_RANK:            row.Value("FG3A_RANK"),
+				FG3_PCT_RANK:         row.Value("FG3_PCT_RANK"),
+				FTM_RANK:             row.Value("FTM_RANK"),
+				FTA_RANK:             row.Value("FTA_RANK"),
+				FT_PCT_RANK:          row.Value("FT_PCT_RANK"),
+				OREB_RANK:            row.Value("OREB_RANK"),
+				DREB_RANK:            row.Value("DREB_RANK"),
+				REB_RANK:             row.Value("REB_RANK"),
+				AST_RANK:             row.Value("AST_RANK"),
+				TOV_RANK:             row.Value("TOV_RANK"),
+				STL_RANK:             row.Value("STL_RANK"),
+				BLK_RANK:             row.Value("BLK_RANK"),
+				BLKA_RANK:            row.Value("BLKA_RANK"),
+				PF_RANK:              row.Value("PF_RANK"),
+				PFD_RANK:             row.Value("PFD_RANK"),
+				PTS_RANK:             row.Value("PTS_RANK"),
+				PLUS_MINUS_RANK:      row.Value("PLUS_MINUS_RANK"),
+				NBA_FANTASY_PTS_RANK: row.Value("NBA_FANTASY_PTS_RANK"),
+				DD2_RANK:             row.Value("DD2_RANK"),
+				TD3_RANK:             row.Value("TD3_RANK"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
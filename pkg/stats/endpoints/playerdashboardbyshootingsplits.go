@@ -229,205 +229,186 @@ func GetPlayerDashboardByShootingSplits(ctx context.Context, client *stats.Clien
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playerdashboardbyshootingsplits", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playerdashboardbyshootingsplits", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerDashboardByShootingSplitsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.OverallPlayerDashboard = make([]PlayerDashboardByShootingSplitsOverallPlayerDashboard, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 28 {
-				item := PlayerDashboardByShootingSplitsOverallPlayerDashboard{
-					PLAYER_ID:   toInt(row[0]),
-					PLAYER_NAME: toString(row[1]),
-					GP:          toInt(row[2]),
-					W:           toString(row[3]),
-					L:           toString(row[4]),
-					W_PCT:       toFloat(row[5]),
-					MIN:         toFloat(row[6]),
-					FGM:         toInt(row[7]),
-					FGA:         toInt(row[8]),
-					FG_PCT:      toFloat(row[9]),
-					FG3M:        toInt(row[10]),
-					FG3A:        toInt(row[11]),
-					FG3_PCT:     toFloat(row[12]),
-					FTM:         toInt(row[13]),
-					FTA:         toInt(row[14]),
-					FT_PCT:      toFloat(row[15]),
-					OREB:        toFloat(row[16]),
-					DREB:        toFloat(row[17]),
-					REB:         toFloat(row[18]),
-					AST:         toFloat(row[19]),
-					TOV:         toFloat(row[20]),
-					STL:         toFloat(row[21]),
-					BLK:         toFloat(row[22]),
-					BLKA:        toInt(row[23]),
-					PF:          toFloat(row[24]),
-					PFD:         toFloat(row[25]),
-					PTS:         toFloat(row[26]),
-					PLUS_MINUS:  toFloat(row[27]),
-				}
-				response.OverallPlayerDashboard = append(response.OverallPlayerDashboard, item)
+	if rs := rawResp.resultSet("OverallPlayerDashboard"); rs != nil {
+		response.OverallPlayerDashboard = decodeRows(rs, func(row *rowReader) PlayerDashboardByShootingSplitsOverallPlayerDashboard {
+			return PlayerDashboardByShootingSplitsOverallPlayerDashboard{
+				PLAYER_ID:   row.Int("PLAYER_ID"),
+				PLAYER_NAME: row.String("PLAYER_NAME"),
+				GP:          row.Int("GP"),
+				W:           row.String("W"),
+				L:           row.String("L"),
+				W_PCT:       row.Float("W_PCT"),
+				MIN:         row.Float("MIN"),
+				FGM:         row.Int("FGM"),
+				FGA:         row.Int("FGA"),
+				FG_PCT:      row.Float("FG_PCT"),
+				FG3M:        row.Int("FG3M"),
+				FG3A:        row.Int("FG3A"),
+				FG3_PCT:     row.Float("FG3_PCT"),
+				FTM:         row.Int("FTM"),
+				FTA:         row.Int("FTA"),
+				FT_PCT:      row.Float("FT_PCT"),
+				OREB:        row.Float("OREB"),
+				DREB:        row.Float("DREB"),
+				REB:         row.Float("REB"),
+				AST:         row.Float("AST"),
+				TOV:         row.Float("TOV"),
+				STL:         row.Float("STL"),
+				BLK:         row.Float("BLK"),
+				BLKA:        row.Int("BLKA"),
+				PF:          row.Float("PF"),
+				PFD:         row.Float("PFD"),
+				PTS:         row.Float("PTS"),
+				PLUS_MINUS:  row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.Shot5FTPlayerDashboard = make([]PlayerDashboardByShootingSplitsShot5FTPlayerDashboard, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 29 {
-				item := PlayerDashboardByShootingSplitsShot5FTPlayerDashboard{
-					PLAYER_ID:   toInt(row[0]),
-					PLAYER_NAME: toString(row[1]),
-					SHOT_5FT:    toString(row[2]),
-					GP:          toInt(row[3]),
-					W:           toString(row[4]),
-					L:           toString(row[5]),
-					W_PCT:       toFloat(row[6]),
-					MIN:         toFloat(row[7]),
-					FGM:         toInt(row[8]),
-					FGA:         toInt(row[9]),
-					FG_PCT:      toFloat(row[10]),
-					FG3M:        toInt(row[11]),
-					FG3A:        toInt(row[12]),
-					FG3_PCT:     toFloat(row[13]),
-					FTM:         toInt(row[14]),
-					FTA:         toInt(row[15]),
-					FT_PCT:      toFloat(row[16]),
-					OREB:        toFloat(row[17]),
-					DREB:        toFloat(row[18]),
-					REB:         toFloat(row[19]),
-					AST:         toFloat(row[20]),
-					TOV:         toFloat(row[21]),
-					STL:         toFloat(row[22]),
-					BLK:         toFloat(row[23]),
-					BLKA:        toInt(row[24]),
-					PF:          toFloat(row[25]),
-					PFD:         toFloat(row[26]),
-					PTS:         toFloat(row[27]),
-					PLUS_MINUS:  toFloat(row[28]),
-				}
-				response.Shot5FTPlayerDashboard = append(response.Shot5FTPlayerDashboard, item)
+	if rs := rawResp.resultSet("Shot5FTPlayerDashboard"); rs != nil {
+		response.Shot5FTPlayerDashboard = decodeRows(rs, func(row *rowReader) PlayerDashboardByShootingSplitsShot5FTPlayerDashboard {
+			return PlayerDashboardByShootingSplitsShot5FTPlayerDashboard{
+				PLAYER_ID:   row.Int("PLAYER_ID"),
+				PLAYER_NAME: row.String("PLAYER_NAME"),
+				SHOT_5FT:    row.String("SHOT_5FT"),
+				GP:          row.Int("GP"),
+				W:           row.String("W"),
+				L:           row.String("L"),
+				W_PCT:       row.Float("W_PCT"),
+				MIN:         row.Float("MIN"),
+				FGM:         row.Int("FGM"),
+				FGA:         row.Int("FGA"),
+				FG_PCT:      row.Float("FG_PCT"),
+				FG3M:        row.Int("FG3M"),
+				FG3A:        row.Int("FG3A"),
+				FG3_PCT:     row.Float("FG3_PCT"),
+				FTM:         row.Int("FTM"),
+				FTA:         row.Int("FTA"),
+				FT_PCT:      row.Float("FT_PCT"),
+				OREB:        row.Float("OREB"),
+				DREB:        row.Float("DREB"),
+				REB:         row.Float("REB"),
+				AST:         row.Float("AST"),
+				TOV:         row.Float("TOV"),
+				STL:         row.Float("STL"),
+				BLK:         row.Float("BLK"),
+				BLKA:        row.Int("BLKA"),
+				PF:          row.Float("PF"),
+				PFD:         row.Float("PFD"),
+				PTS:         row.Float("PTS"),
+				PLUS_MINUS:  row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 2 {
-		response.Shot8FTPlayerDashboard = make([]PlayerDashboardByShootingSplitsShot8FTPlayerDashboard, 0, len(rawResp.ResultSets[2].RowSet))
-		for _, row := range rawResp.ResultSets[2].RowSet {
-			if len(row) >= 29 {
-				item := PlayerDashboardByShootingSplitsShot8FTPlayerDashboard{
-					PLAYER_ID:   toInt(row[0]),
-					PLAYER_NAME: toString(row[1]),
-					SHOT_8FT:    toString(row[2]),
-					GP:          toInt(row[3]),
-					W:           toString(row[4]),
-					L:           toString(row[5]),
-					W_PCT:       toFloat(row[6]),
-					MIN:         toFloat(row[7]),
-					FGM:         toInt(row[8]),
-					FGA:         toInt(row[9]),
-					FG_PCT:      toFloat(row[10]),
-					FG3M:        toInt(row[11]),
-					FG3A:        toInt(row[12]),
-					FG3_PCT:     toFloat(row[13]),
-					FTM:         toInt(row[14]),
-					FTA:         toInt(row[15]),
-					FT_PCT:      toFloat(row[16]),
-					OREB:        toFloat(row[17]),
-					DREB:        toFloat(row[18]),
-					REB:         toFloat(row[19]),
-					AST:         toFloat(row[20]),
-					TOV:         toFloat(row[21]),
-					STL:         toFloat(row[22]),
-					BLK:         toFloat(row[23]),
-					BLKA:        toInt(row[24]),
-					PF:          toFloat(row[25]),
-					PFD:         toFloat(row[26]),
-					PTS:         toFloat(row[27]),
-					PLUS_MINUS:  toFloat(row[28]),
-				}
-				response.Shot8FTPlayerDashboard = append(response.Shot8FTPlayerDashboard, item)
+	if rs := rawResp.resultSet("Shot8FTPlayerDashboard"); rs != nil {
+		response.Shot8FTPlayerDashboard = decodeRows(rs, func(row *rowReader) PlayerDashboardByShootingSplitsShot8FTPlayerDashboard {
+			return PlayerDashboardByShootingSplitsShot8FTPlayerDashboard{
+				PLAYER_ID:   row.Int("PLAYER_ID"),
+				PLAYER_NAME: row.String("PLAYER_NAME"),
+				SHOT_8FT:    row.String("SHOT_8FT"),
+				GP:          row.Int("GP"),
+				W:           row.String("W"),
+				L:           row.String("L"),
+				W_PCT:       row.Float("W_PCT"),
+				MIN:         row.Float("MIN"),
+				FGM:         row.Int("FGM"),
+				FGA:         row.Int("FGA"),
+				FG_PCT:      row.Float("FG_PCT"),
+				FG3M:        row.Int("FG3M"),
+				FG3A:        row.Int("FG3A"),
+				FG3_PCT:     row.Float("FG3_PCT"),
+				FTM:         row.Int("FTM"),
+				FTA:         row.Int("FTA"),
+				FT_PCT:      row.Float("FT_PCT"),
+				OREB:        row.Float("OREB"),
+				DREB:        row.Float("DREB"),
+				REB:         row.Float("REB"),
+				AST:         row.Float("AST"),
+				TOV:         row.Float("TOV"),
+				STL:         row.Float("STL"),
+				BLK:         row.Float("BLK"),
+				BLKA:        row.Int("BLKA"),
+				PF:          row.Float("PF"),
+				PFD:         row.Float("PFD"),
+				PTS:         row.Float("PTS"),
+				PLUS_MINUS:  row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 3 {
-		response.ShotAreaPlayerDashboard = make([]PlayerDashboardByShootingSplitsShotAreaPlayerDashboard, 0, len(rawResp.ResultSets[3].RowSet))
-		for _, row := range rawResp.ResultSets[3].RowSet {
-			if len(row) >= 29 {
-				item := PlayerDashboardByShootingSplitsShotAreaPlayerDashboard{
-					PLAYER_ID:   toInt(row[0]),
-					PLAYER_NAME: toString(row[1]),
-					SHOT_AREA:   toString(row[2]),
-					GP:          toInt(row[3]),
-					W:           toString(row[4]),
-					L:           toString(row[5]),
-					W_PCT:       toFloat(row[6]),
-					MIN:         toFloat(row[7]),
-					FGM:         toInt(row[8]),
-					FGA:         toInt(row[9]),
-					FG_PCT:      toFloat(row[10]),
-					FG3M:        toInt(row[11]),
-					FG3A:        toInt(row[12]),
-					FG3_PCT:     toFloat(row[13]),
-					FTM:         toInt(row[14]),
-					FTA:         toInt(row[15]),
-					FT_PCT:      toFloat(row[16]),
-					OREB:        toFloat(row[17]),
-					DREB:        toFloat(row[18]),
-					REB:         toFloat(row[19]),
-					AST:         toFloat(row[20]),
-					TOV:         toFloat(row[21]),
-					STL:         toFloat(row[22]),
-					BLK:         toFloat(row[23]),
-					BLKA:        toInt(row[24]),
-					PF:          toFloat(row[25]),
-					PFD:         toFloat(row[26]),
-					PTS:         toFloat(row[27]),
-					PLUS_MINUS:  toFloat(row[28]),
-				}
-				response.ShotAreaPlayerDashboard = append(response.ShotAreaPlayerDashboard, item)
+	if rs := rawResp.resultSet("ShotAreaPlayerDashboard"); rs != nil {
+		response.ShotAreaPlayerDashboard = decodeRows(rs, func(row *rowReader) PlayerDashboardByShootingSplitsShotAreaPlayerDashboard {
+			return PlayerDashboardByShootingSplitsShotAreaPlayerDashboard{
+				PLAYER_ID:   row.Int("PLAYER_ID"),
+				PLAYER_NAME: row.String("PLAYER_NAME"),
+				SHOT_AREA:   row.String("SHOT_AREA"),
+				GP:          row.Int("GP"),
+				W:           row.String("W"),
+				L:           row.String("L"),
+				W_PCT:       row.Float("W_PCT"),
+				MIN:         row.Float("MIN"),
+				FGM:         row.Int("FGM"),
+				FGA:         row.Int("FGA"),
+				FG_PCT:      row.Float("FG_PCT"),
+				FG3M:        row.Int("FG3M"),
+				FG3A:        row.Int("FG3A"),
+				FG3_PCT:     row.Float("FG3_PCT"),
+				FTM:         row.Int("FTM"),
+				FTA:         row.Int("FTA"),
+				FT_PCT:      row.Float("FT_PCT"),
+				OREB:        row.Float("OREB"),
+				DREB:        row.Float("DREB"),
+				REB:         row.Float("REB"),
+				AST:         row.Float("AST"),
+				TOV:         row.Float("TOV"),
+				STL:         row.Float("STL"),
+				BLK:         row.Float("BLK"),
+				BLKA:        row.Int("BLKA"),
+				PF:          row.Float("PF"),
+				PFD:         row.Float("PFD"),
+				PTS:         row.Float("PTS"),
+				PLUS_MINUS:  row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 4 {
-		response.AssistedShotsPlayerDashboard = make([]PlayerDashboardByShootingSplitsAssistedShotsPlayerDashboard, 0, len(rawResp.ResultSets[4].RowSet))
-		for _, row := range rawResp.ResultSets[4].RowSet {
-			if len(row) >= 29 {
-				item := PlayerDashboardByShootingSplitsAssistedShotsPlayerDashboard{
-					PLAYER_ID:          toInt(row[0]),
-					PLAYER_NAME:        toString(row[1]),
-					ASSISTED_SHOT_TYPE: toString(row[2]),
-					GP:                 toInt(row[3]),
-					W:                  toString(row[4]),
-					L:                  toString(row[5]),
-					W_PCT:              toFloat(row[6]),
-					MIN:                toFloat(row[7]),
-					FGM:                toInt(row[8]),
-					FGA:                toInt(row[9]),
-					FG_PCT:             toFloat(row[10]),
-					FG3M:               toInt(row[11]),
-					FG3A:               toInt(row[12]),
-					FG3_PCT:            toFloat(row[13]),
-					FTM:                toInt(row[14]),
-					FTA:                toInt(row[15]),
-					FT_PCT:             toFloat(row[16]),
-					OREB:               toFloat(row[17]),
-					DREB:               toFloat(row[18]),
-					REB:                toFloat(row[19]),
-					AST:                toFloat(row[20]),
-					TOV:                toFloat(row[21]),
-					STL:                toFloat(row[22]),
-					BLK:                toFloat(row[23]),
-					BLKA:               toInt(row[24]),
-					PF:                 toFloat(row[25]),
-					PFD:                toFloat(row[26]),
-					PTS:                toFloat(row[27]),
-					PLUS_MINUS:         toFloat(row[28]),
-				}
-				response.AssistedShotsPlayerDashboard = append(response.AssistedShotsPlayerDashboard, item)
+	if rs := rawResp.resultSet("AssistedShotsPlayerDashboard"); rs != nil {
+		response.AssistedShotsPlayerDashboard = decodeRows(rs, func(row *rowReader) PlayerDashboardByShootingSplitsAssistedShotsPlayerDashboard {
+			return PlayerDashboardByShootingSplitsAssistedShotsPlayerDashboard{
+				PLAYER_ID:          row.Int("PLAYER_ID"),
+				PLAYER_NAME:        row.String("PLAYER_NAME"),
+				ASSISTED_SHOT_TYPE: row.String("ASSISTED_SHOT_TYPE"),
+				GP:                 row.Int("GP"),
+				W:                  row.String("W"),
+				L:                  row.String("L"),
+				W_PCT:              row.Float("W_PCT"),
+				MIN:                row.Float("MIN"),
+				FGM:                row.Int("FGM"),
+				FGA:                row.Int("FGA"),
+				FG_PCT:             row.Float("FG_PCT"),
+				FG3M:               row.Int("FG3M"),
+				FG3A:               row.Int("FG3A"),
+				FG3_PCT:            row.Float("FG3_PCT"),
+				FTM:                row.Int("FTM"),
+				FTA:                row.Int("FTA"),
+				FT_PCT:             row.Float("FT_PCT"),
+				OREB:               row.Float("OREB"),
+				DREB:               row.Float("DREB"),
+				REB:                row.Float("REB"),
+				AST:                row.Float("AST"),
+				TOV:                row.Float("TOV"),
+				STL:                row.Float("STL"),
+				BLK:                row.Float("BLK"),
+				BLKA:               row.Int("BLKA"),
+				PF:                 row.Float("PF"),
+				PFD:                row.Float("PFD"),
+				PTS:                row.Float("PTS"),
+				PLUS_MINUS:         row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -149,127 +149,116 @@ func GetPlayerDashboardByTeamPerformance(ctx context.Context, client *stats.Clie
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playerdashboardbyteamperformance", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playerdashboardbyteamperformance", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerDashboardByTeamPerformanceResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.OverallPlayerDashboard = make([]PlayerDashboardByTeamPerformanceOverallPlayerDashboard, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 28 {
-				item := PlayerDashboardByTeamPerformanceOverallPlayerDashboard{
-					PLAYER_ID:   toInt(row[0]),
-					PLAYER_NAME: toString(row[1]),
-					GP:          toInt(row[2]),
-					W:           toString(row[3]),
-					L:           toString(row[4]),
-					W_PCT:       toFloat(row[5]),
-					MIN:         toFloat(row[6]),
-					FGM:         toInt(row[7]),
-					FGA:         toInt(row[8]),
-					FG_PCT:      toFloat(row[9]),
-					FG3M:        toInt(row[10]),
-					FG3A:        toInt(row[11]),
-					FG3_PCT:     toFloat(row[12]),
-					FTM:         toInt(row[13]),
-					FTA:         toInt(row[14]),
-					FT_PCT:      toFloat(row[15]),
-					OREB:        toFloat(row[16]),
-					DREB:        toFloat(row[17]),
-					REB:         toFloat(row[18]),
-					AST:         toFloat(row[19]),
-					TOV:         toFloat(row[20]),
-					STL:         toFloat(row[21]),
-					BLK:         toFloat(row[22]),
-					BLKA:        toInt(row[23]),
-					PF:          toFloat(row[24]),
-					PFD:         toFloat(row[25]),
-					PTS:         toFloat(row[26]),
-					PLUS_MINUS:  toFloat(row[27]),
-				}
-				response.OverallPlayerDashboard = append(response.OverallPlayerDashboard, item)
+	if rs := rawResp.resultSet("OverallPlayerDashboard"); rs != nil {
+		response.OverallPlayerDashboard = decodeRows(rs, func(row *rowReader) PlayerDashboardByTeamPerformanceOverallPlayerDashboard {
+			return PlayerDashboardByTeamPerformanceOverallPlayerDashboard{
+				PLAYER_ID:   row.Int("PLAYER_ID"),
+				PLAYER_NAME: row.String("PLAYER_NAME"),
+				GP:          row.Int("GP"),
+				W:           row.String("W"),
+				L:           row.String("L"),
+				W_PCT:       row.Float("W_PCT"),
+				MIN:         row.Float("MIN"),
+				FGM:         row.Int("FGM"),
+				FGA:         row.Int("FGA"),
+				FG_PCT:      row.Float("FG_PCT"),
+				FG3M:        row.Int("FG3M"),
+				FG3A:        row.Int("FG3A"),
+				FG3_PCT:     row.Float("FG3_PCT"),
+				FTM:         row.Int("FTM"),
+				FTA:         row.Int("FTA"),
+				FT_PCT:      row.Float("FT_PCT"),
+				OREB:        row.Float("OREB"),
+				DREB:        row.Float("DREB"),
+				REB:         row.Float("REB"),
+				AST:         row.Float("AST"),
+				TOV:         row.Float("TOV"),
+				STL:         row.Float("STL"),
+				BLK:         row.Float("BLK"),
+				BLKA:        row.Int("BLKA"),
+				PF:          row.Float("PF"),
+				PFD:         row.Float("PFD"),
+				PTS:         row.Float("PTS"),
+				PLUS_MINUS:  row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.WinsPlayerDashboard = make([]PlayerDashboardByTeamPerformanceWinsPlayerDashboard, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 29 {
-				item := PlayerDashboardByTeamPerformanceWinsPlayerDashboard{
-					PLAYER_ID:   toInt(row[0]),
-					PLAYER_NAME: toString(row[1]),
-					W_L:         toString(row[2]),
-					GP:          toInt(row[3]),
-					W:           toString(row[4]),
-					L:           toString(row[5]),
-					W_PCT:       toFloat(row[6]),
-					MIN:         toFloat(row[7]),
-					FGM:         toInt(row[8]),
-					FGA:         toInt(row[9]),
-					FG_PCT:      toFloat(row[10]),
-					FG3M:        toInt(row[11]),
-					FG3A:        toInt(row[12]),
-					FG3_PCT:     toFloat(row[13]),
-					FTM:         toInt(row[14]),
-					FTA:         toInt(row[15]),
-					FT_PCT:      toFloat(row[16]),
-					OREB:        toFloat(row[17]),
-					DREB:        toFloat(row[18]),
-					REB:         toFloat(row[19]),
-					AST:         toFloat(row[20]),
-					TOV:         toFloat(row[21]),
-					STL:         toFloat(row[22]),
-					BLK:         toFloat(row[23]),
-					BLKA:        toInt(row[24]),
-					PF:          toFloat(row[25]),
-					PFD:         toFloat(row[26]),
-					PTS:         toFloat(row[27]),
-					PLUS_MINUS:  toFloat(row[28]),
-				}
-				response.WinsPlayerDashboard = append(response.WinsPlayerDashboard, item)
+	if rs := rawResp.resultSet("WinsPlayerDashboard"); rs != nil {
+		response.WinsPlayerDashboard = decodeRows(rs, func(row *rowReader) PlayerDashboardByTeamPerformanceWinsPlayerDashboard {
+			return PlayerDashboardByTeamPerformanceWinsPlayerDashboard{
+				PLAYER_ID:   row.Int("PLAYER_ID"),
+				PLAYER_NAME: row.String("PLAYER_NAME"),
+				W_L:         row.String("W_L"),
+				GP:          row.Int("GP"),
+				W:           row.String("W"),
+				L:           row.String("L"),
+				W_PCT:       row.Float("W_PCT"),
+				MIN:         row.Float("MIN"),
+				FGM:         row.Int("FGM"),
+				FGA:         row.Int("FGA"),
+				FG_PCT:      row.Float("FG_PCT"),
+				FG3M:        row.Int("FG3M"),
+				FG3A:        row.Int("FG3A"),
+				FG3_PCT:     row.Float("FG3_PCT"),
+				FTM:         row.Int("FTM"),
+				FTA:         row.Int("FTA"),
+				FT_PCT:      row.Float("FT_PCT"),
+				OREB:        row.Float("OREB"),
+				DREB:        row.Float("DREB"),
+				REB:         row.Float("REB"),
+				AST:         row.Float("AST"),
+				TOV:         row.Float("TOV"),
+				STL:         row.Float("STL"),
+				BLK:         row.Float("BLK"),
+				BLKA:        row.Int("BLKA"),
+				PF:          row.Float("PF"),
+				PFD:         row.Float("PFD"),
+				PTS:         row.Float("PTS"),
+				PLUS_MINUS:  row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 2 {
-		response.ScoreMarginPlayerDashboard = make([]PlayerDashboardByTeamPerformanceScoreMarginPlayerDashboard, 0, len(rawResp.ResultSets[2].RowSet))
-		for _, row := range rawResp.ResultSets[2].RowSet {
-			if len(row) >= 29 {
-				item := PlayerDashboardByTeamPerformanceScoreMarginPlayerDashboard{
-					PLAYER_ID:    toInt(row[0]),
-					PLAYER_NAME:  toString(row[1]),
-					SCORE_MARGIN: toString(row[2]),
-					GP:           toInt(row[3]),
-					W:            toString(row[4]),
-					L:            toString(row[5]),
-					W_PCT:        toFloat(row[6]),
-					MIN:          toFloat(row[7]),
-					FGM:          toInt(row[8]),
-					FGA:          toInt(row[9]),
-					FG_PCT:       toFloat(row[10]),
-					FG3M:         toInt(row[11]),
-					FG3A:         toInt(row[12]),
-					FG3_PCT:      toFloat(row[13]),
-					FTM:          toInt(row[14]),
-					FTA:          toInt(row[15]),
-					FT_PCT:       toFloat(row[16]),
-					OREB:         toFloat(row[17]),
-					DREB:         toFloat(row[18]),
-					REB:          toFloat(row[19]),
-					AST:          toFloat(row[20]),
-					TOV:          toFloat(row[21]),
-					STL:          toFloat(row[22]),
-					BLK:          toFloat(row[23]),
-					BLKA:         toInt(row[24]),
-					PF:           toFloat(row[25]),
-					PFD:          toFloat(row[26]),
-					PTS:          toFloat(row[27]),
-					PLUS_MINUS:   toFloat(row[28]),
-				}
-				response.ScoreMarginPlayerDashboard = append(response.ScoreMarginPlayerDashboard, item)
+	if rs := rawResp.resultSet("ScoreMarginPlayerDashboard"); rs != nil {
+		response.ScoreMarginPlayerDashboard = decodeRows(rs, func(row *rowReader) PlayerDashboardByTeamPerformanceScoreMarginPlayerDashboard {
+			return PlayerDashboardByTeamPerformanceScoreMarginPlayerDashboard{
+				PLAYER_ID:    row.Int("PLAYER_ID"),
+				PLAYER_NAME:  row.String("PLAYER_NAME"),
+				SCORE_MARGIN: row.String("SCORE_MARGIN"),
+				GP:           row.Int("GP"),
+				W:            row.String("W"),
+				L:            row.String("L"),
+				W_PCT:        row.Float("W_PCT"),
+				MIN:          row.Float("MIN"),
+				FGM:          row.Int("FGM"),
+				FGA:          row.Int("FGA"),
+				FG_PCT:       row.Float("FG_PCT"),
+				FG3M:         row.Int("FG3M"),
+				FG3A:         row.Int("FG3A"),
+				FG3_PCT:      row.Float("FG3_PCT"),
+				FTM:          row.Int("FTM"),
+				FTA:          row.Int("FTA"),
+				FT_PCT:       row.Float("FT_PCT"),
+				OREB:         row.Float("OREB"),
+				DREB:         row.Float("DREB"),
+				REB:          row.Float("REB"),
+				AST:          row.Float("AST"),
+				TOV:          row.Float("TOV"),
+				STL:          row.Float("STL"),
+				BLK:          row.Float("BLK"),
+				BLKA:         row.Int("BLKA"),
+				PF:           row.Float("PF"),
+				PFD:          row.Float("PFD"),
+				PTS:          row.Float("PTS"),
+				PLUS_MINUS:   row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
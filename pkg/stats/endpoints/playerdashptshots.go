@@ -172,169 +172,146 @@ func GetPlayerDashPtShots(ctx context.Context, client *stats.Client, req PlayerD
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playerdashptshots", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playerdashptshots", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerDashPtShotsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.OverallShooting = make([]PlayerDashPtShotsOverallShooting, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 18 {
-				item := PlayerDashPtShotsOverallShooting{
-					PLAYER_ID:              toInt(row[0]),
-					PLAYER_NAME_LAST_FIRST: toFloat(row[1]),
-					SORT_ORDER:             toString(row[2]),
-					GP:                     toInt(row[3]),
-					G:                      toString(row[4]),
-					FGA_FREQUENCY:          toFloat(row[5]),
-					FGM:                    toInt(row[6]),
-					FGA:                    toInt(row[7]),
-					FG_PCT:                 toFloat(row[8]),
-					EFG_PCT:                toFloat(row[9]),
-					FG2A_FREQUENCY:         toString(row[10]),
-					FG2M:                   toString(row[11]),
-					FG2A:                   toString(row[12]),
-					FG2_PCT:                toFloat(row[13]),
-					FG3A_FREQUENCY:         toFloat(row[14]),
-					FG3M:                   toInt(row[15]),
-					FG3A:                   toInt(row[16]),
-					FG3_PCT:                toFloat(row[17]),
-				}
-				response.OverallShooting = append(response.OverallShooting, item)
+	if rs := rawResp.resultSet("OverallShooting"); rs != nil {
+		response.OverallShooting = decodeRows(rs, func(row *rowReader) PlayerDashPtShotsOverallShooting {
+			return PlayerDashPtShotsOverallShooting{
+				PLAYER_ID:              row.Int("PLAYER_ID"),
+				PLAYER_NAME_LAST_FIRST: row.Float("PLAYER_NAME_LAST_FIRST"),
+				SORT_ORDER:             row.String("SORT_ORDER"),
+				GP:                     row.Int("GP"),
+				G:                      row.String("G"),
+				FGA_FREQUENCY:          row.Float("FGA_FREQUENCY"),
+				FGM:                    row.Int("FGM"),
+				FGA:                    row.Int("FGA"),
+				FG_PCT:                 row.Float("FG_PCT"),
+				EFG_PCT:                row.Float("EFG_PCT"),
+				FG2A_FREQUENCY:         row.String("FG2A_FREQUENCY"),
+				FG2M:                   row.String("FG2M"),
+				FG2A:                   row.String("FG2A"),
+				FG2_PCT:                row.Float("FG2_PCT"),
+				FG3A_FREQUENCY:         row.Float("FG3A_FREQUENCY"),
+				FG3M:                   row.Int("FG3M"),
+				FG3A:                   row.Int("FG3A"),
+				FG3_PCT:                row.Float("FG3_PCT"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.GeneralShooting = make([]PlayerDashPtShotsGeneralShooting, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 16 {
-				item := PlayerDashPtShotsGeneralShooting{
-					PLAYER_ID:              toInt(row[0]),
-					PLAYER_NAME_LAST_FIRST: toFloat(row[1]),
-					SHOT_TYPE:              toString(row[2]),
-					FGA_FREQUENCY:          toFloat(row[3]),
-					FGM:                    toInt(row[4]),
-					FGA:                    toInt(row[5]),
-					FG_PCT:                 toFloat(row[6]),
-					EFG_PCT:                toFloat(row[7]),
-					FG2A_FREQUENCY:         toString(row[8]),
-					FG2M:                   toString(row[9]),
-					FG2A:                   toString(row[10]),
-					FG2_PCT:                toFloat(row[11]),
-					FG3A_FREQUENCY:         toFloat(row[12]),
-					FG3M:                   toInt(row[13]),
-					FG3A:                   toInt(row[14]),
-					FG3_PCT:                toFloat(row[15]),
-				}
-				response.GeneralShooting = append(response.GeneralShooting, item)
+	if rs := rawResp.resultSet("GeneralShooting"); rs != nil {
+		response.GeneralShooting = decodeRows(rs, func(row *rowReader) PlayerDashPtShotsGeneralShooting {
+			return PlayerDashPtShotsGeneralShooting{
+				PLAYER_ID:              row.Int("PLAYER_ID"),
+				PLAYER_NAME_LAST_FIRST: row.Float("PLAYER_NAME_LAST_FIRST"),
+				SHOT_TYPE:              row.String("SHOT_TYPE"),
+				FGA_FREQUENCY:          row.Float("FGA_FREQUENCY"),
+				FGM:                    row.Int("FGM"),
+				FGA:                    row.Int("FGA"),
+				FG_PCT:                 row.Float("FG_PCT"),
+				EFG_PCT:                row.Float("EFG_PCT"),
+				FG2A_FREQUENCY:         row.String("FG2A_FREQUENCY"),
+				FG2M:                   row.String("FG2M"),
+				FG2A:                   row.String("FG2A"),
+				FG2_PCT:                row.Float("FG2_PCT"),
+				FG3A_FREQUENCY:         row.Float("FG3A_FREQUENCY"),
+				FG3M:                   row.Int("FG3M"),
+				FG3A:                   row.Int("FG3A"),
+				FG3_PCT:                row.Float("FG3_PCT"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 2 {
-		response.ShotClockShooting = make([]PlayerDashPtShotsShotClockShooting, 0, len(rawResp.ResultSets[2].RowSet))
-		for _, row := range rawResp.ResultSets[2].RowSet {
-			if len(row) >= 16 {
-				item := PlayerDashPtShotsShotClockShooting{
-					PLAYER_ID:              toInt(row[0]),
-					PLAYER_NAME_LAST_FIRST: toFloat(row[1]),
-					SHOT_CLOCK_RANGE:       toInt(row[2]),
-					FGA_FREQUENCY:          toFloat(row[3]),
-					FGM:                    toInt(row[4]),
-					FGA:                    toInt(row[5]),
-					FG_PCT:                 toFloat(row[6]),
-					EFG_PCT:                toFloat(row[7]),
-					FG2A_FREQUENCY:         toString(row[8]),
-					FG2M:                   toString(row[9]),
-					FG2A:                   toString(row[10]),
-					FG2_PCT:                toFloat(row[11]),
-					FG3A_FREQUENCY:         toFloat(row[12]),
-					FG3M:                   toInt(row[13]),
-					FG3A:                   toInt(row[14]),
-					FG3_PCT:                toFloat(row[15]),
-				}
-				response.ShotClockShooting = append(response.ShotClockShooting, item)
+	if rs := rawResp.resultSet("ShotClockShooting"); rs != nil {
+		response.ShotClockShooting = decodeRows(rs, func(row *rowReader) PlayerDashPtShotsShotClockShooting {
+			return PlayerDashPtShotsShotClockShooting{
+				PLAYER_ID:              row.Int("PLAYER_ID"),
+				PLAYER_NAME_LAST_FIRST: row.Float("PLAYER_NAME_LAST_FIRST"),
+				SHOT_CLOCK_RANGE:       row.Int("SHOT_CLOCK_RANGE"),
+				FGA_FREQUENCY:          row.Float("FGA_FREQUENCY"),
+				FGM:                    row.Int("FGM"),
+				FGA:                    row.Int("FGA"),
+				FG_PCT:                 row.Float("FG_PCT"),
+				EFG_PCT:                row.Float("EFG_PCT"),
+				FG2A_FREQUENCY:         row.String("FG2A_FREQUENCY"),
+				FG2M:                   row.String("FG2M"),
+				FG2A:                   row.String("FG2A"),
+				FG2_PCT:                row.Float("FG2_PCT"),
+				FG3A_FREQUENCY:         row.Float("FG3A_FREQUENCY"),
+				FG3M:                   row.Int("FG3M"),
+				FG3A:                   row.Int("FG3A"),
+				FG3_PCT:                row.Float("FG3_PCT"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 3 {
-		response.DribbleShooting = make([]PlayerDashPtShotsDribbleShooting, 0, len(rawResp.ResultSets[3].RowSet))
-		for _, row := range rawResp.ResultSets[3].RowSet {
-			if len(row) >= 16 {
-				item := PlayerDashPtShotsDribbleShooting{
-					PLAYER_ID:              toInt(row[0]),
-					PLAYER_NAME_LAST_FIRST: toFloat(row[1]),
-					DRIBBLE_RANGE:          toInt(row[2]),
-					FGA_FREQUENCY:          toFloat(row[3]),
-					FGM:                    toInt(row[4]),
-					FGA:                    toInt(row[5]),
-					FG_PCT:                 toFloat(row[6]),
-					EFG_PCT:                toFloat(row[7]),
-					FG2A_FREQUENCY:         toString(row[8]),
-					FG2M:                   toString(row[9]),
-					FG2A:                   toString(row[10]),
-					FG2_PCT:                toFloat(row[11]),
-					FG3A_FREQUENCY:         toFloat(row[12]),
-					FG3M:                   toInt(row[13]),
-					FG3A:                   toInt(row[14]),
-					FG3_PCT:                toFloat(row[15]),
-				}
-				response.DribbleShooting = append(response.DribbleShooting, item)
+	if rs := rawResp.resultSet("DribbleShooting"); rs != nil {
+		response.DribbleShooting = decodeRows(rs, func(row *rowReader) PlayerDashPtShotsDribbleShooting {
+			return PlayerDashPtShotsDribbleShooting{
+				PLAYER_ID:              row.Int("PLAYER_ID"),
+				PLAYER_NAME_LAST_FIRST: row.Float("PLAYER_NAME_LAST_FIRST"),
+				DRIBBLE_RANGE:          row.Int("DRIBBLE_RANGE"),
+				FGA_FREQUENCY:          row.Float("FGA_FREQUENCY"),
+				FGM:                    row.Int("FGM"),
+				FGA:                    row.Int("FGA"),
+				FG_PCT:                 row.Float("FG_PCT"),
+				EFG_PCT:                row.Float("EFG_PCT"),
+				FG2A_FREQUENCY:         row.String("FG2A_FREQUENCY"),
+				FG2M:                   row.String("FG2M"),
+				FG2A:                   row.String("FG2A"),
+				FG2_PCT:                row.Float("FG2_PCT"),
+				FG3A_FREQUENCY:         row.Float("FG3A_FREQUENCY"),
+				FG3M:                   row.Int("FG3M"),
+				FG3A:                   row.Int("FG3A"),
+				FG3_PCT:                row.Float("FG3_PCT"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 4 {
-		response.ClosestDefenderShooting = make([]PlayerDashPtShotsClosestDefenderShooting, 0, len(rawResp.ResultSets[4].RowSet))
-		for _, row := range rawResp.ResultSets[4].RowSet {
-			if len(row) >= 16 {
-				item := PlayerDashPtShotsClosestDefenderShooting{
-					PLAYER_ID:              toInt(row[0]),
-					PLAYER_NAME_LAST_FIRST: toFloat(row[1]),
-					CLOSE_DEF_DIST_RANGE:   toInt(row[2]),
-					FGA_FREQUENCY:          toFloat(row[3]),
-					FGM:                    toInt(row[4]),
-					FGA:                    toInt(row[5]),
-					FG_PCT:                 toFloat(row[6]),
-					EFG_PCT:                toFloat(row[7]),
-					FG2A_FREQUENCY:         toString(row[8]),
-					FG2M:                   toString(row[9]),
-					FG2A:                   toString(row[10]),
-					FG2_PCT:                toFloat(row[11]),
-					FG3A_FREQUENCY:         toFloat(row[12]),
-					FG3M:                   toInt(row[13]),
-					FG3A:                   toInt(row[14]),
-					FG3_PCT:                toFloat(row[15]),
-				}
-				response.ClosestDefenderShooting = append(response.ClosestDefenderShooting, item)
+	if rs := rawResp.resultSet("ClosestDefenderShooting"); rs != nil {
+		response.ClosestDefenderShooting = decodeRows(rs, func(row *rowReader) PlayerDashPtShotsClosestDefenderShooting {
+			return PlayerDashPtShotsClosestDefenderShooting{
+				PLAYER_ID:              row.Int("PLAYER_ID"),
+				PLAYER_NAME_LAST_FIRST: row.Float("PLAYER_NAME_LAST_FIRST"),
+				CLOSE_DEF_DIST_RANGE:   row.Int("CLOSE_DEF_DIST_RANGE"),
+				FGA_FREQUENCY:          row.Float("FGA_FREQUENCY"),
+				FGM:                    row.Int("FGM"),
+				FGA:                    row.Int("FGA"),
+				FG_PCT:                 row.Float("FG_PCT"),
+				EFG_PCT:                row.Float("EFG_PCT"),
+				FG2A_FREQUENCY:         row.String("FG2A_FREQUENCY"),
+				FG2M:                   row.String("FG2M"),
+				FG2A:                   row.String("FG2A"),
+				FG2_PCT:                row.Float("FG2_PCT"),
+				FG3A_FREQUENCY:         row.Float("FG3A_FREQUENCY"),
+				FG3M:                   row.Int("FG3M"),
+				FG3A:                   row.Int("FG3A"),
+				FG3_PCT:                row.Float("FG3_PCT"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 5 {
-		response.TouchTimeShooting = make([]PlayerDashPtShotsTouchTimeShooting, 0, len(rawResp.ResultSets[5].RowSet))
-		for _, row := range rawResp.ResultSets[5].RowSet {
-			if len(row) >= 16 {
-				item := PlayerDashPtShotsTouchTimeShooting{
-					PLAYER_ID:              toInt(row[0]),
-					PLAYER_NAME_LAST_FIRST: toFloat(row[1]),
-					TOUCH_TIME_RANGE:       toInt(row[2]),
-					FGA_FREQUENCY:          toFloat(row[3]),
-					FGM:                    toInt(row[4]),
-					FGA:                    toInt(row[5]),
-					FG_PCT:                 toFloat(row[6]),
-					EFG_PCT:                toFloat(row[7]),
-					FG2A_FREQUENCY:         toString(row[8]),
-					FG2M:                   toString(row[9]),
-					FG2A:                   toString(row[10]),
-					FG2_PCT:                toFloat(row[11]),
-					FG3A_FREQUENCY:         toFloat(row[12]),
-					FG3M:                   toInt(row[13]),
-					FG3A:                   toInt(row[14]),
-					FG3_PCT:                toFloat(row[15]),
-				}
-				response.TouchTimeShooting = append(response.TouchTimeShooting, item)
+	if rs := rawResp.resultSet("TouchTimeShooting"); rs != nil {
+		response.TouchTimeShooting = decodeRows(rs, func(row *rowReader) PlayerDashPtShotsTouchTimeShooting {
+			return PlayerDashPtShotsTouchTimeShooting{
+				PLAYER_ID:              row.Int("PLAYER_ID"),
+				PLAYER_NAME_LAST_FIRST: row.Float("PLAYER_NAME_LAST_FIRST"),
+				TOUCH_TIME_RANGE:       row.Int("TOUCH_TIME_RANGE"),
+				FGA_FREQUENCY:          row.Float("FGA_FREQUENCY"),
+				FGM:                    row.Int("FGM"),
+				FGA:                    row.Int("FGA"),
+				FG_PCT:                 row.Float("FG_PCT"),
+				EFG_PCT:                row.Float("EFG_PCT"),
+				FG2A_FREQUENCY:         row.String("FG2A_FREQUENCY"),
+				FG2M:                   row.String("FG2M"),
+				FG2A:                   row.String("FG2A"),
+				FG2_PCT:                row.Float("FG2_PCT"),
+				FG3A_FREQUENCY:         row.Float("FG3A_FREQUENCY"),
+				FG3M:                   row.Int("FG3M"),
+				FG3A:                   row.Int("FG3A"),
+				FG3_PCT:                row.Float("FG3_PCT"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -55,37 +55,34 @@ func GetPlayerEstimatedAdvancedStats(ctx context.Context, client *stats.Client,
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playerestimatedadvancedstats", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playerestimatedadvancedstats", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerEstimatedAdvancedStatsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerEstimatedAdvancedStats = make([]PlayerEstimatedAdvancedStatsPlayerEstimatedAdvancedStats, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 16 {
-				item := PlayerEstimatedAdvancedStatsPlayerEstimatedAdvancedStats{
-					PLAYER_ID:         toInt(row[0]),
-					PLAYER_NAME:       toString(row[1]),
-					TEAM_ID:           toInt(row[2]),
-					TEAM_ABBREVIATION: toString(row[3]),
-					GP:                toInt(row[4]),
-					MIN:               toFloat(row[5]),
-					E_OFF_RATING:      toString(row[6]),
-					E_DEF_RATING:      toString(row[7]),
-					E_NET_RATING:      toString(row[8]),
-					E_AST_RATIO:       toFloat(row[9]),
-					E_OREB_PCT:        toFloat(row[10]),
-					E_DREB_PCT:        toFloat(row[11]),
-					E_REB_PCT:         toFloat(row[12]),
-					E_TOV_PCT:         toFloat(row[13]),
-					E_USG_PCT:         toFloat(row[14]),
-					E_PACE:            toString(row[15]),
-				}
-				response.PlayerEstimatedAdvancedStats = append(response.PlayerEstimatedAdvancedStats, item)
+	if rs := rawResp.resultSet("PlayerEstimatedAdvancedStats"); rs != nil {
+		response.PlayerEstimatedAdvancedStats = decodeRows(rs, func(row *rowReader) PlayerEstimatedAdvancedStatsPlayerEstimatedAdvancedStats {
+			return PlayerEstimatedAdvancedStatsPlayerEstimatedAdvancedStats{
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				GP:                row.Int("GP"),
+				MIN:               row.Float("MIN"),
+				E_OFF_RATING:      row.String("E_OFF_RATING"),
+				E_DEF_RATING:      row.String("E_DEF_RATING"),
+				E_NET_RATING:      row.String("E_NET_RATING"),
+				E_AST_RATIO:       row.Float("E_AST_RATIO"),
+				E_OREB_PCT:        row.Float("E_OREB_PCT"),
+				E_DREB_PCT:        row.Float("E_DREB_PCT"),
+				E_REB_PCT:         row.Float("E_REB_PCT"),
+				E_TOV_PCT:         row.Float("E_TOV_PCT"),
+				E_USG_PCT:         row.Float("E_USG_PCT"),
+				E_PACE:            row.String("E_PACE"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
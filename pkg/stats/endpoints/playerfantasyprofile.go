@@ -53,42 +53,39 @@ func GetPlayerFantasyProfile(ctx context.Context, client *stats.Client, req Play
 	params.Set("PlayerID", string(req.PlayerID))
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playerfantasyprofile", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playerfantasyprofile", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerFantasyProfileResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.LastNGames = make([]PlayerFantasyProfileLastNGames, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 21 {
-				item := PlayerFantasyProfileLastNGames{
-					PLAYER_ID:       toInt(row[0]),
-					PLAYER_NAME:     toString(row[1]),
-					LAST_N_GAMES:    toFloat(row[2]),
-					GP:              toInt(row[3]),
-					MIN:             toFloat(row[4]),
-					FGM:             toInt(row[5]),
-					FGA:             toInt(row[6]),
-					FG_PCT:          toFloat(row[7]),
-					FG3M:            toInt(row[8]),
-					FG3A:            toInt(row[9]),
-					FG3_PCT:         toFloat(row[10]),
-					FTM:             toInt(row[11]),
-					FTA:             toInt(row[12]),
-					FT_PCT:          toFloat(row[13]),
-					REB:             toFloat(row[14]),
-					AST:             toFloat(row[15]),
-					TOV:             toFloat(row[16]),
-					STL:             toFloat(row[17]),
-					BLK:             toFloat(row[18]),
-					PTS:             toFloat(row[19]),
-					NBA_FANTASY_PTS: toFloat(row[20]),
-				}
-				response.LastNGames = append(response.LastNGames, item)
+	if rs := rawResp.resultSet("LastNGames"); rs != nil {
+		response.LastNGames = decodeRows(rs, func(row *rowReader) PlayerFantasyProfileLastNGames {
+			return PlayerFantasyProfileLastNGames{
+				PLAYER_ID:       row.Int("PLAYER_ID"),
+				PLAYER_NAME:     row.String("PLAYER_NAME"),
+				LAST_N_GAMES:    row.Float("LAST_N_GAMES"),
+				GP:              row.Int("GP"),
+				MIN:             row.Float("MIN"),
+				FGM:             row.Int("FGM"),
+				FGA:             row.Int("FGA"),
+				FG_PCT:          row.Float("FG_PCT"),
+				FG3M:            row.Int("FG3M"),
+				FG3A:            row.Int("FG3A"),
+				FG3_PCT:         row.Float("FG3_PCT"),
+				FTM:             row.Int("FTM"),
+				FTA:             row.Int("FTA"),
+				FT_PCT:          row.Float("FT_PCT"),
+				REB:             row.Float("REB"),
+				AST:             row.Float("AST"),
+				TOV:             row.Float("TOV"),
+				STL:             row.Float("STL"),
+				BLK:             row.Float("BLK"),
+				PTS:             row.Float("PTS"),
+				NBA_FANTASY_PTS: row.Float("NBA_FANTASY_PTS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/n-ae/nba-api-go/pkg/models"
 	"github.com/n-ae/nba-api-go/pkg/stats"
 	"github.com/n-ae/nba-api-go/pkg/stats/parameters"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
 )
 
 type GameLog struct {
@@ -17,7 +19,7 @@ type GameLog struct {
 	GameDate       string  `json:"GAME_DATE"`
 	Matchup        string  `json:"MATCHUP"`
 	WL             string  `json:"WL"`
-	MIN            int     `json:"MIN"`
+	MIN            float64 `json:"MIN"`
 	FGM            int     `json:"FGM"`
 	FGA            int     `json:"FGA"`
 	FGPct          float64 `json:"FG_PCT"`
@@ -40,6 +42,15 @@ type GameLog struct {
 	VideoAvailable int     `json:"VIDEO_AVAILABLE"`
 }
 
+// Date parses GameDate ("APR 14, 2024") as midnight Eastern.
+func (g GameLog) Date() (time.Time, error) {
+	return timeutil.ParseGameDate(g.GameDate)
+}
+
+func (g GameLog) Minutes() time.Duration {
+	return timeutil.Minutes(g.MIN)
+}
+
 type PlayerGameLogResponse struct {
 	PlayerGameLog []GameLog `json:"PlayerGameLog"`
 }
@@ -87,56 +98,49 @@ func PlayerGameLog(ctx context.Context, client *stats.Client, req PlayerGameLogR
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playergamelog", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playergamelog", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerGameLogResponse{}
-	for _, resultSet := range rawResp.ResultSets {
-		if resultSet.Name == "PlayerGameLog" {
-			response.PlayerGameLog = parseGameLogs(resultSet.RowSet)
-		}
+	if rs := rawResp.resultSet("PlayerGameLog"); rs != nil {
+		response.PlayerGameLog = parseGameLogs(rs)
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
 
-func parseGameLogs(rows [][]interface{}) []GameLog {
-	logs := make([]GameLog, 0, len(rows))
-	for _, row := range rows {
-		if len(row) < 27 {
-			continue
-		}
-		log := GameLog{
-			SeasonID:       toString(row[0]),
-			PlayerID:       toInt(row[1]),
-			GameID:         toString(row[2]),
-			GameDate:       toString(row[3]),
-			Matchup:        toString(row[4]),
-			WL:             toString(row[5]),
-			MIN:            toInt(row[6]),
-			FGM:            toInt(row[7]),
-			FGA:            toInt(row[8]),
-			FGPct:          toFloat(row[9]),
-			FG3M:           toInt(row[10]),
-			FG3A:           toInt(row[11]),
-			FG3Pct:         toFloat(row[12]),
-			FTM:            toInt(row[13]),
-			FTA:            toInt(row[14]),
-			FTPct:          toFloat(row[15]),
-			OREB:           toInt(row[16]),
-			DREB:           toInt(row[17]),
-			REB:            toInt(row[18]),
-			AST:            toInt(row[19]),
-			STL:            toInt(row[20]),
-			BLK:            toInt(row[21]),
-			TOV:            toInt(row[22]),
-			PF:             toInt(row[23]),
-			PTS:            toInt(row[24]),
-			PlusMinus:      toInt(row[25]),
-			VideoAvailable: toInt(row[26]),
+func parseGameLogs(rs *ResultSet) []GameLog {
+	return decodeRows(rs, func(row *rowReader) GameLog {
+		return GameLog{
+			SeasonID:       row.String("SEASON_ID"),
+			PlayerID:       row.Int("Player_ID"),
+			GameID:         row.String("Game_ID"),
+			GameDate:       row.String("GAME_DATE"),
+			Matchup:        row.String("MATCHUP"),
+			WL:             row.String("WL"),
+			MIN:            row.Float("MIN"),
+			FGM:            row.Int("FGM"),
+			FGA:            row.Int("FGA"),
+			FGPct:          row.Float("FG_PCT"),
+			FG3M:           row.Int("FG3M"),
+			FG3A:           row.Int("FG3A"),
+			FG3Pct:         row.Float("FG3_PCT"),
+			FTM:            row.Int("FTM"),
+			FTA:            row.Int("FTA"),
+			FTPct:          row.Float("FT_PCT"),
+			OREB:           row.Int("OREB"),
+			DREB:           row.Int("DREB"),
+			REB:            row.Int("REB"),
+			AST:            row.Int("AST"),
+			STL:            row.Int("STL"),
+			BLK:            row.Int("BLK"),
+			TOV:            row.Int("TOV"),
+			PF:             row.Int("PF"),
+			PTS:            row.Int("PTS"),
+			PlusMinus:      row.Int("PLUS_MINUS"),
+			VideoAvailable: row.Int("VIDEO_AVAILABLE"),
 		}
-		logs = append(logs, log)
-	}
-	return logs
+	})
 }
@@ -0,0 +1,138 @@
+package endpoints
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/stats"
+	"github.com/n-ae/nba-api-go/pkg/stats/parameters"
+	"github.com/n-ae/nba-api-go/pkg/transport"
+)
+
+// replayClient returns a stats client that serves responses only from the
+// named cassette in testdata/cassettes.
+func replayClient(t *testing.T, name string) *stats.Client {
+	t.Helper()
+
+	cassette, err := transport.LoadCassette("testdata/cassettes/" + name + ".json")
+	if err != nil {
+		t.Fatalf("LoadCassette() error = %v", err)
+	}
+
+	return stats.NewClient(stats.Config{
+		Middlewares: []transport.Middleware{transport.WithCassette(cassette, transport.ModeReplay)},
+	})
+}
+
+func TestPlayerGameLog_Replay(t *testing.T) {
+	client := replayClient(t, "playergamelog")
+
+	resp, err := PlayerGameLog(context.Background(), client, PlayerGameLogRequest{
+		PlayerID:   "2544",
+		Season:     parameters.NewSeason(2023),
+		SeasonType: parameters.SeasonTypeRegular,
+		LeagueID:   parameters.LeagueIDNBA,
+	})
+	if err != nil {
+		t.Fatalf("PlayerGameLog() error = %v", err)
+	}
+
+	if len(resp.Warnings) != 0 {
+		t.Errorf("Warnings = %v, want none", resp.Warnings)
+	}
+
+	logs := resp.Data.PlayerGameLog
+	if len(logs) != 2 {
+		t.Fatalf("len(PlayerGameLog) = %d, want 2", len(logs))
+	}
+	if logs[0].GameID != "0022301195" || logs[0].PTS != 28 || logs[0].AST != 17 {
+		t.Errorf("first game = %+v", logs[0])
+	}
+	if date, err := logs[0].Date(); err != nil || date.Format("2006-01-02") != "2024-04-14" {
+		t.Errorf("Date() = %v, %v", date, err)
+	}
+	if logs[0].Minutes() != 38*time.Minute {
+		t.Errorf("Minutes() = %v, want 38m", logs[0].Minutes())
+	}
+
+	wantURL := "https://stats.nba.com/stats/playergamelog?LeagueID=00&PlayerID=2544&Season=2023-24&SeasonType=Regular+Season"
+	if resp.URL != wantURL || resp.StatusCode != 200 {
+		t.Errorf("resp URL = %q, status = %d", resp.URL, resp.StatusCode)
+	}
+	if resp.Headers.Get("Content-Type") == "" || resp.FetchedAt.IsZero() || resp.CacheHit {
+		t.Errorf("unexpected metadata: headers=%v fetchedAt=%v cacheHit=%v", resp.Headers, resp.FetchedAt, resp.CacheHit)
+	}
+}
+
+// driftedClient replays the playergamelog cassette with Player_ID renamed and
+// PTS removed from the headers.
+func driftedClient(t *testing.T, mode stats.SchemaMode) *stats.Client {
+	t.Helper()
+
+	data, err := os.ReadFile("testdata/cassettes/playergamelog.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data = bytes.Replace(data, []byte(`\"Player_ID\"`), []byte(`\"PLAYER_ID\"`), 1)
+	data = bytes.Replace(data, []byte(`\"PTS\"`), []byte(`\"POINTS\"`), 1)
+
+	path := filepath.Join(t.TempDir(), "playergamelog.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cassette, err := transport.LoadCassette(path)
+	if err != nil {
+		t.Fatalf("LoadCassette() error = %v", err)
+	}
+
+	return stats.NewClient(stats.Config{
+		Middlewares: []transport.Middleware{transport.WithCassette(cassette, transport.ModeReplay)},
+		SchemaMode:  mode,
+	})
+}
+
+var driftRequest = PlayerGameLogRequest{
+	PlayerID:   "2544",
+	Season:     parameters.NewSeason(2023),
+	SeasonType: parameters.SeasonTypeRegular,
+	LeagueID:   parameters.LeagueIDNBA,
+}
+
+func TestPlayerGameLog_LenientDrift(t *testing.T) {
+	resp, err := PlayerGameLog(context.Background(), driftedClient(t, stats.SchemaLenient), driftRequest)
+	if err != nil {
+		t.Fatalf("PlayerGameLog() error = %v", err)
+	}
+
+	logs := resp.Data.PlayerGameLog
+	if len(logs) != 2 || logs[0].PlayerID != 2544 || logs[0].PTS != 0 {
+		t.Errorf("first game = %+v", logs[0])
+	}
+
+	kinds := make(map[models.DriftKind]string)
+	for _, d := range resp.Warnings {
+		kinds[d.Kind] = d.Column
+	}
+	if kinds[models.DriftRenamedColumn] != "Player_ID" || kinds[models.DriftMissingColumn] != "PTS" ||
+		kinds[models.DriftUnexpectedColumn] != "POINTS" {
+		t.Errorf("Warnings = %v", resp.Warnings)
+	}
+}
+
+func TestPlayerGameLog_StrictDrift(t *testing.T) {
+	_, err := PlayerGameLog(context.Background(), driftedClient(t, stats.SchemaStrict), driftRequest)
+
+	var driftErr *models.SchemaDriftError
+	if !errors.As(err, &driftErr) || !errors.Is(err, models.ErrSchemaDrift) {
+		t.Fatalf("PlayerGameLog() error = %v, want SchemaDriftError", err)
+	}
+	if driftErr.Endpoint != "playergamelog" || len(driftErr.Drift) != 3 {
+		t.Errorf("SchemaDriftError = %+v", driftErr)
+	}
+}
@@ -81,55 +81,52 @@ func GetPlayerGameLogs(ctx context.Context, client *stats.Client, req PlayerGame
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playergamelogs", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playergamelogs", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerGameLogsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerGameLogs = make([]PlayerGameLogsPlayerGameLogs, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 34 {
-				item := PlayerGameLogsPlayerGameLogs{
-					SEASON_YEAR:       toString(row[0]),
-					PLAYER_ID:         toInt(row[1]),
-					PLAYER_NAME:       toString(row[2]),
-					NICKNAME:          toString(row[3]),
-					TEAM_ID:           toInt(row[4]),
-					TEAM_ABBREVIATION: toString(row[5]),
-					TEAM_NAME:         toString(row[6]),
-					GAME_ID:           toString(row[7]),
-					GAME_DATE:         toString(row[8]),
-					MATCHUP:           toString(row[9]),
-					WL:                toString(row[10]),
-					MIN:               toFloat(row[11]),
-					FGM:               toInt(row[12]),
-					FGA:               toInt(row[13]),
-					FG_PCT:            toFloat(row[14]),
-					FG3M:              toInt(row[15]),
-					FG3A:              toInt(row[16]),
-					FG3_PCT:           toFloat(row[17]),
-					FTM:               toInt(row[18]),
-					FTA:               toInt(row[19]),
-					FT_PCT:            toFloat(row[20]),
-					OREB:              toFloat(row[21]),
-					DREB:              toFloat(row[22]),
-					REB:               toFloat(row[23]),
-					AST:               toFloat(row[24]),
-					STL:               toFloat(row[25]),
-					BLK:               toFloat(row[26]),
-					TOV:               toFloat(row[27]),
-					PF:                toFloat(row[28]),
-					PTS:               toFloat(row[29]),
-					PLUS_MINUS:        toFloat(row[30]),
-					NBA_FANTASY_PTS:   toFloat(row[31]),
-					DD2:               toFloat(row[32]),
-					TD3:               toFloat(row[33]),
-				}
-				response.PlayerGameLogs = append(response.PlayerGameLogs, item)
+	if rs := rawResp.resultSet("PlayerGameLogs"); rs != nil {
+		response.PlayerGameLogs = decodeRows(rs, func(row *rowReader) PlayerGameLogsPlayerGameLogs {
+			return PlayerGameLogsPlayerGameLogs{
+				SEASON_YEAR:       row.String("SEASON_YEAR"),
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				NICKNAME:          row.String("NICKNAME"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				TEAM_NAME:         row.String("TEAM_NAME"),
+				GAME_ID:           row.String("GAME_ID"),
+				GAME_DATE:         row.String("GAME_DATE"),
+				MATCHUP:           row.String("MATCHUP"),
+				WL:                row.String("WL"),
+				MIN:               row.Float("MIN"),
+				FGM:               row.Int("FGM"),
+				FGA:               row.Int("FGA"),
+				FG_PCT:            row.Float("FG_PCT"),
+				FG3M:              row.Int("FG3M"),
+				FG3A:              row.Int("FG3A"),
+				FG3_PCT:           row.Float("FG3_PCT"),
+				FTM:               row.Int("FTM"),
+				FTA:               row.Int("FTA"),
+				FT_PCT:            row.Float("FT_PCT"),
+				OREB:              row.Float("OREB"),
+				DREB:              row.Float("DREB"),
+				REB:               row.Float("REB"),
+				AST:               row.Float("AST"),
+				STL:               row.Float("STL"),
+				BLK:               row.Float("BLK"),
+				TOV:               row.Float("TOV"),
+				PF:                row.Float("PF"),
+				PTS:               row.Float("PTS"),
+				PLUS_MINUS:        row.Float("PLUS_MINUS"),
+				NBA_FANTASY_PTS:   row.Float("NBA_FANTASY_PTS"),
+				DD2:               row.Float("DD2"),
+				TD3:               row.Float("TD3"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
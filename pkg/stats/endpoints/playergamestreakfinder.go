@@ -67,49 +67,46 @@ func GetPlayerGameStreakFinder(ctx context.Context, client *stats.Client, req Pl
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playergamestreakfinder", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playergamestreakfinder", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerGameStreakFinderResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerGameStreakFinder = make([]PlayerGameStreakFinderPlayerGameStreakFinder, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 28 {
-				item := PlayerGameStreakFinderPlayerGameStreakFinder{
-					PLAYER_ID:         toInt(row[0]),
-					PLAYER_NAME:       toString(row[1]),
-					TEAM_ID:           toInt(row[2]),
-					TEAM_ABBREVIATION: toString(row[3]),
-					GAME_ID:           toString(row[4]),
-					GAME_DATE:         toString(row[5]),
-					MATCHUP:           toString(row[6]),
-					WL:                toString(row[7]),
-					MIN:               toFloat(row[8]),
-					PTS:               toFloat(row[9]),
-					FGM:               toInt(row[10]),
-					FGA:               toInt(row[11]),
-					FG_PCT:            toFloat(row[12]),
-					FG3M:              toInt(row[13]),
-					FG3A:              toInt(row[14]),
-					FG3_PCT:           toFloat(row[15]),
-					FTM:               toInt(row[16]),
-					FTA:               toInt(row[17]),
-					FT_PCT:            toFloat(row[18]),
-					OREB:              toFloat(row[19]),
-					DREB:              toFloat(row[20]),
-					REB:               toFloat(row[21]),
-					AST:               toFloat(row[22]),
-					TOV:               toFloat(row[23]),
-					STL:               toFloat(row[24]),
-					BLK:               toFloat(row[25]),
-					PF:                toFloat(row[26]),
-					PLUS_MINUS:        toFloat(row[27]),
-				}
-				response.PlayerGameStreakFinder = append(response.PlayerGameStreakFinder, item)
+	if rs := rawResp.resultSet("PlayerGameStreakFinder"); rs != nil {
+		response.PlayerGameStreakFinder = decodeRows(rs, func(row *rowReader) PlayerGameStreakFinderPlayerGameStreakFinder {
+			return PlayerGameStreakFinderPlayerGameStreakFinder{
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				GAME_ID:           row.String("GAME_ID"),
+				GAME_DATE:         row.String("GAME_DATE"),
+				MATCHUP:           row.String("MATCHUP"),
+				WL:                row.String("WL"),
+				MIN:               row.Float("MIN"),
+				PTS:               row.Float("PTS"),
+				FGM:               row.Int("FGM"),
+				FGA:               row.Int("FGA"),
+				FG_PCT:            row.Float("FG_PCT"),
+				FG3M:              row.Int("FG3M"),
+				FG3A:              row.Int("FG3A"),
+				FG3_PCT:           row.Float("FG3_PCT"),
+				FTM:               row.Int("FTM"),
+				FTA:               row.Int("FTA"),
+				FT_PCT:            row.Float("FT_PCT"),
+				OREB:              row.Float("OREB"),
+				DREB:              row.Float("DREB"),
+				REB:               row.Float("REB"),
+				AST:               row.Float("AST"),
+				TOV:               row.Float("TOV"),
+				STL:               row.Float("STL"),
+				BLK:               row.Float("BLK"),
+				PF:                row.Float("PF"),
+				PLUS_MINUS:        row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
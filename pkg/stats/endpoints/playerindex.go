@@ -65,43 +65,40 @@ func GetPlayerIndex(ctx context.Context, client *stats.Client, req PlayerIndexRe
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playerindex", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playerindex", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerIndexResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerIndex = make([]PlayerIndexPlayerIndex, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 22 {
-				item := PlayerIndexPlayerIndex{
-					PERSON_ID:         toString(row[0]),
-					PLAYER_LAST_NAME:  toString(row[1]),
-					PLAYER_FIRST_NAME: toString(row[2]),
-					PLAYER_SLUG:       toString(row[3]),
-					TEAM_ID:           toInt(row[4]),
-					TEAM_SLUG:         toString(row[5]),
-					IS_DEFUNCT:        toString(row[6]),
-					TEAM_CITY:         toString(row[7]),
-					TEAM_NAME:         toString(row[8]),
-					TEAM_ABBREVIATION: toString(row[9]),
-					JERSEY_NUMBER:     toString(row[10]),
-					POSITION:          toString(row[11]),
-					HEIGHT:            toString(row[12]),
-					WEIGHT:            toString(row[13]),
-					COLLEGE:           toString(row[14]),
-					COUNTRY:           toString(row[15]),
-					DRAFT_YEAR:        toString(row[16]),
-					DRAFT_ROUND:       toString(row[17]),
-					DRAFT_NUMBER:      toString(row[18]),
-					ROSTER_STATUS:     toString(row[19]),
-					FROM_YEAR:         toString(row[20]),
-					TO_YEAR:           toString(row[21]),
-				}
-				response.PlayerIndex = append(response.PlayerIndex, item)
+	if rs := rawResp.resultSet("PlayerIndex"); rs != nil {
+		response.PlayerIndex = decodeRows(rs, func(row *rowReader) PlayerIndexPlayerIndex {
+			return PlayerIndexPlayerIndex{
+				PERSON_ID:         row.String("PERSON_ID"),
+				PLAYER_LAST_NAME:  row.String("PLAYER_LAST_NAME"),
+				PLAYER_FIRST_NAME: row.String("PLAYER_FIRST_NAME"),
+				PLAYER_SLUG:       row.String("PLAYER_SLUG"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_SLUG:         row.String("TEAM_SLUG"),
+				IS_DEFUNCT:        row.String("IS_DEFUNCT"),
+				TEAM_CITY:         row.String("TEAM_CITY"),
+				TEAM_NAME:         row.String("TEAM_NAME"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				JERSEY_NUMBER:     row.String("JERSEY_NUMBER"),
+				POSITION:          row.String("POSITION"),
+				HEIGHT:            row.String("HEIGHT"),
+				WEIGHT:            row.String("WEIGHT"),
+				COLLEGE:           row.String("COLLEGE"),
+				COUNTRY:           row.String("COUNTRY"),
+				DRAFT_YEAR:        row.String("DRAFT_YEAR"),
+				DRAFT_ROUND:       row.String("DRAFT_ROUND"),
+				DRAFT_NUMBER:      row.String("DRAFT_NUMBER"),
+				ROSTER_STATUS:     row.String("ROSTER_STATUS"),
+				FROM_YEAR:         row.String("FROM_YEAR"),
+				TO_YEAR:           row.String("TO_YEAR"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
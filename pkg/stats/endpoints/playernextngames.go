@@ -55,27 +55,24 @@ func GetPlayerNextNGames(ctx context.Context, client *stats.Client, req PlayerNe
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playernextnGames", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playernextnGames", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerNextNGamesResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.NextNGames = make([]PlayerNextNGamesNextNGames, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 6 {
-				item := PlayerNextNGamesNextNGames{
-					GAME_ID:           toString(row[0]),
-					GAME_DATE:         toString(row[1]),
-					HOME_TEAM_ID:      toInt(row[2]),
-					VISITOR_TEAM_ID:   toInt(row[3]),
-					HOME_TEAM_NAME:    toString(row[4]),
-					VISITOR_TEAM_NAME: toString(row[5]),
-				}
-				response.NextNGames = append(response.NextNGames, item)
+	if rs := rawResp.resultSet("NextNGames"); rs != nil {
+		response.NextNGames = decodeRows(rs, func(row *rowReader) PlayerNextNGamesNextNGames {
+			return PlayerNextNGamesNextNGames{
+				GAME_ID:           row.String("GAME_ID"),
+				GAME_DATE:         row.String("GAME_DATE"),
+				HOME_TEAM_ID:      row.Int("HOME_TEAM_ID"),
+				VISITOR_TEAM_ID:   row.Int("VISITOR_TEAM_ID"),
+				HOME_TEAM_NAME:    row.String("HOME_TEAM_NAME"),
+				VISITOR_TEAM_NAME: row.String("VISITOR_TEAM_NAME"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
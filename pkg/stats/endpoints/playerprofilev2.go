@@ -97,82 +97,75 @@ func GetPlayerProfileV2(ctx context.Context, client *stats.Client, req PlayerPro
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playerprofilev2", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playerprofilev2", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerProfileV2Response{}
-	if len(rawResp.ResultSets) > 0 {
-		response.SeasonTotalsRegularSeason = make([]PlayerProfileV2SeasonTotalsRegularSeason, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 27 {
-				item := PlayerProfileV2SeasonTotalsRegularSeason{
-					PLAYER_ID:         toInt(row[0]),
-					SEASON_ID:         toString(row[1]),
-					LEAGUE_ID:         toString(row[2]),
-					TEAM_ID:           toInt(row[3]),
-					TEAM_ABBREVIATION: toString(row[4]),
-					PLAYER_AGE:        toInt(row[5]),
-					GP:                toInt(row[6]),
-					GS:                toInt(row[7]),
-					MIN:               toFloat(row[8]),
-					FGM:               toInt(row[9]),
-					FGA:               toInt(row[10]),
-					FG_PCT:            toFloat(row[11]),
-					FG3M:              toInt(row[12]),
-					FG3A:              toInt(row[13]),
-					FG3_PCT:           toFloat(row[14]),
-					FTM:               toInt(row[15]),
-					FTA:               toInt(row[16]),
-					FT_PCT:            toFloat(row[17]),
-					OREB:              toFloat(row[18]),
-					DREB:              toFloat(row[19]),
-					REB:               toFloat(row[20]),
-					AST:               toFloat(row[21]),
-					STL:               toFloat(row[22]),
-					BLK:               toFloat(row[23]),
-					TOV:               toFloat(row[24]),
-					PF:                toFloat(row[25]),
-					PTS:               toFloat(row[26]),
-				}
-				response.SeasonTotalsRegularSeason = append(response.SeasonTotalsRegularSeason, item)
+	if rs := rawResp.resultSet("SeasonTotalsRegularSeason"); rs != nil {
+		response.SeasonTotalsRegularSeason = decodeRows(rs, func(row *rowReader) PlayerProfileV2SeasonTotalsRegularSeason {
+			return PlayerProfileV2SeasonTotalsRegularSeason{
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				SEASON_ID:         row.String("SEASON_ID"),
+				LEAGUE_ID:         row.String("LEAGUE_ID"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				PLAYER_AGE:        row.Int("PLAYER_AGE"),
+				GP:                row.Int("GP"),
+				GS:                row.Int("GS"),
+				MIN:               row.Float("MIN"),
+				FGM:               row.Int("FGM"),
+				FGA:               row.Int("FGA"),
+				FG_PCT:            row.Float("FG_PCT"),
+				FG3M:              row.Int("FG3M"),
+				FG3A:              row.Int("FG3A"),
+				FG3_PCT:           row.Float("FG3_PCT"),
+				FTM:               row.Int("FTM"),
+				FTA:               row.Int("FTA"),
+				FT_PCT:            row.Float("FT_PCT"),
+				OREB:              row.Float("OREB"),
+				DREB:              row.Float("DREB"),
+				REB:               row.Float("REB"),
+				AST:               row.Float("AST"),
+				STL:               row.Float("STL"),
+				BLK:               row.Float("BLK"),
+				TOV:               row.Float("TOV"),
+				PF:                row.Float("PF"),
+				PTS:               row.Float("PTS"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.CareerTotalsRegularSeason = make([]PlayerProfileV2CareerTotalsRegularSeason, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 24 {
-				item := PlayerProfileV2CareerTotalsRegularSeason{
-					PLAYER_ID: toInt(row[0]),
-					LEAGUE_ID: toString(row[1]),
-					Team_ID:   toInt(row[2]),
-					GP:        toInt(row[3]),
-					GS:        toInt(row[4]),
-					MIN:       toFloat(row[5]),
-					FGM:       toInt(row[6]),
-					FGA:       toInt(row[7]),
-					FG_PCT:    toFloat(row[8]),
-					FG3M:      toInt(row[9]),
-					FG3A:      toInt(row[10]),
-					FG3_PCT:   toFloat(row[11]),
-					FTM:       toInt(row[12]),
-					FTA:       toInt(row[13]),
-					FT_PCT:    toFloat(row[14]),
-					OREB:      toFloat(row[15]),
-					DREB:      toFloat(row[16]),
-					REB:       toFloat(row[17]),
-					AST:       toFloat(row[18]),
-					STL:       toFloat(row[19]),
-					BLK:       toFloat(row[20]),
-					TOV:       toFloat(row[21]),
-					PF:        toFloat(row[22]),
-					PTS:       toFloat(row[23]),
-				}
-				response.CareerTotalsRegularSeason = append(response.CareerTotalsRegularSeason, item)
+	if rs := rawResp.resultSet("CareerTotalsRegularSeason"); rs != nil {
+		response.CareerTotalsRegularSeason = decodeRows(rs, func(row *rowReader) PlayerProfileV2CareerTotalsRegularSeason {
+			return PlayerProfileV2CareerTotalsRegularSeason{
+				PLAYER_ID: row.Int("PLAYER_ID"),
+				LEAGUE_ID: row.String("LEAGUE_ID"),
+				Team_ID:   row.Int("Team_ID"),
+				GP:        row.Int("GP"),
+				GS:        row.Int("GS"),
+				MIN:       row.Float("MIN"),
+				FGM:       row.Int("FGM"),
+				FGA:       row.Int("FGA"),
+				FG_PCT:    row.Float("FG_PCT"),
+				FG3M:      row.Int("FG3M"),
+				FG3A:      row.Int("FG3A"),
+				FG3_PCT:   row.Float("FG3_PCT"),
+				FTM:       row.Int("FTM"),
+				FTA:       row.Int("FTA"),
+				FT_PCT:    row.Float("FT_PCT"),
+				OREB:      row.Float("OREB"),
+				DREB:      row.Float("DREB"),
+				REB:       row.Float("REB"),
+				AST:       row.Float("AST"),
+				STL:       row.Float("STL"),
+				BLK:       row.Float("BLK"),
+				TOV:       row.Float("TOV"),
+				PF:        row.Float("PF"),
+				PTS:       row.Float("PTS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -57,35 +57,32 @@ func GetPlayerTrackingCatchShoot(ctx context.Context, client *stats.Client, req
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playertrackingcatchshoot", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playertrackingcatchshoot", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerTrackingCatchShootResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerTrackingCatchShoot = make([]PlayerTrackingCatchShootPlayerTrackingCatchShoot, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 14 {
-				item := PlayerTrackingCatchShootPlayerTrackingCatchShoot{
-					PLAYER_ID:           toInt(row[0]),
-					PLAYER_NAME:         toString(row[1]),
-					TEAM_ID:             toInt(row[2]),
-					TEAM_ABBREVIATION:   toString(row[3]),
-					GP:                  toInt(row[4]),
-					MIN:                 toFloat(row[5]),
-					CATCH_SHOOT_FGM:     toInt(row[6]),
-					CATCH_SHOOT_FGA:     toInt(row[7]),
-					CATCH_SHOOT_FG_PCT:  toFloat(row[8]),
-					CATCH_SHOOT_PTS:     toFloat(row[9]),
-					CATCH_SHOOT_FG3M:    toInt(row[10]),
-					CATCH_SHOOT_FG3A:    toInt(row[11]),
-					CATCH_SHOOT_FG3_PCT: toFloat(row[12]),
-					CATCH_SHOOT_EFG_PCT: toFloat(row[13]),
-				}
-				response.PlayerTrackingCatchShoot = append(response.PlayerTrackingCatchShoot, item)
+	if rs := rawResp.resultSet("PlayerTrackingCatchShoot"); rs != nil {
+		response.PlayerTrackingCatchShoot = decodeRows(rs, func(row *rowReader) PlayerTrackingCatchShootPlayerTrackingCatchShoot {
+			return PlayerTrackingCatchShootPlayerTrackingCatchShoot{
+				PLAYER_ID:           row.Int("PLAYER_ID"),
+				PLAYER_NAME:         row.String("PLAYER_NAME"),
+				TEAM_ID:             row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION:   row.String("TEAM_ABBREVIATION"),
+				GP:                  row.Int("GP"),
+				MIN:                 row.Float("MIN"),
+				CATCH_SHOOT_FGM:     row.Int("CATCH_SHOOT_FGM"),
+				CATCH_SHOOT_FGA:     row.Int("CATCH_SHOOT_FGA"),
+				CATCH_SHOOT_FG_PCT:  row.Float("CATCH_SHOOT_FG_PCT"),
+				CATCH_SHOOT_PTS:     row.Float("CATCH_SHOOT_PTS"),
+				CATCH_SHOOT_FG3M:    row.Int("CATCH_SHOOT_FG3M"),
+				CATCH_SHOOT_FG3A:    row.Int("CATCH_SHOOT_FG3A"),
+				CATCH_SHOOT_FG3_PCT: row.Float("CATCH_SHOOT_FG3_PCT"),
+				CATCH_SHOOT_EFG_PCT: row.Float("CATCH_SHOOT_EFG_PCT"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -53,31 +53,28 @@ func GetPlayerTrackingDefense(ctx context.Context, client *stats.Client, req Pla
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playertrackingdefense", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playertrackingdefense", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerTrackingDefenseResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerTrackingDefense = make([]PlayerTrackingDefensePlayerTrackingDefense, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 10 {
-				item := PlayerTrackingDefensePlayerTrackingDefense{
-					PLAYER_ID:         toInt(row[0]),
-					PLAYER_NAME:       toString(row[1]),
-					TEAM_ID:           toInt(row[2]),
-					TEAM_ABBREVIATION: toString(row[3]),
-					GP:                toInt(row[4]),
-					MIN:               toFloat(row[5]),
-					DEF_RIM_FGM:       toInt(row[6]),
-					DEF_RIM_FGA:       toInt(row[7]),
-					DEF_RIM_FG_PCT:    toFloat(row[8]),
-					DREB:              toFloat(row[9]),
-				}
-				response.PlayerTrackingDefense = append(response.PlayerTrackingDefense, item)
+	if rs := rawResp.resultSet("PlayerTrackingDefense"); rs != nil {
+		response.PlayerTrackingDefense = decodeRows(rs, func(row *rowReader) PlayerTrackingDefensePlayerTrackingDefense {
+			return PlayerTrackingDefensePlayerTrackingDefense{
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				GP:                row.Int("GP"),
+				MIN:               row.Float("MIN"),
+				DEF_RIM_FGM:       row.Int("DEF_RIM_FGM"),
+				DEF_RIM_FGA:       row.Int("DEF_RIM_FGA"),
+				DEF_RIM_FG_PCT:    row.Float("DEF_RIM_FG_PCT"),
+				DREB:              row.Float("DREB"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
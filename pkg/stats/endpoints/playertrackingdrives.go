@@ -61,39 +61,36 @@ func GetPlayerTrackingDrives(ctx context.Context, client *stats.Client, req Play
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playertrackingdrives", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playertrackingdrives", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerTrackingDrivesResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerTrackingDrives = make([]PlayerTrackingDrivesPlayerTrackingDrives, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 18 {
-				item := PlayerTrackingDrivesPlayerTrackingDrives{
-					PLAYER_ID:         toInt(row[0]),
-					PLAYER_NAME:       toString(row[1]),
-					TEAM_ID:           toInt(row[2]),
-					TEAM_ABBREVIATION: toString(row[3]),
-					GP:                toInt(row[4]),
-					MIN:               toFloat(row[5]),
-					DRIVES:            toString(row[6]),
-					DRIVE_FGM:         toInt(row[7]),
-					DRIVE_FGA:         toInt(row[8]),
-					DRIVE_FG_PCT:      toFloat(row[9]),
-					DRIVE_FTM:         toInt(row[10]),
-					DRIVE_FTA:         toInt(row[11]),
-					DRIVE_FT_PCT:      toFloat(row[12]),
-					DRIVE_PTS:         toFloat(row[13]),
-					DRIVE_PASS:        toString(row[14]),
-					DRIVE_AST:         toFloat(row[15]),
-					DRIVE_TOV:         toFloat(row[16]),
-					DRIVE_PF:          toFloat(row[17]),
-				}
-				response.PlayerTrackingDrives = append(response.PlayerTrackingDrives, item)
+	if rs := rawResp.resultSet("PlayerTrackingDrives"); rs != nil {
+		response.PlayerTrackingDrives = decodeRows(rs, func(row *rowReader) PlayerTrackingDrivesPlayerTrackingDrives {
+			return PlayerTrackingDrivesPlayerTrackingDrives{
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				GP:                row.Int("GP"),
+				MIN:               row.Float("MIN"),
+				DRIVES:            row.String("DRIVES"),
+				DRIVE_FGM:         row.Int("DRIVE_FGM"),
+				DRIVE_FGA:         row.Int("DRIVE_FGA"),
+				DRIVE_FG_PCT:      row.Float("DRIVE_FG_PCT"),
+				DRIVE_FTM:         row.Int("DRIVE_FTM"),
+				DRIVE_FTA:         row.Int("DRIVE_FTA"),
+				DRIVE_FT_PCT:      row.Float("DRIVE_FT_PCT"),
+				DRIVE_PTS:         row.Float("DRIVE_PTS"),
+				DRIVE_PASS:        row.String("DRIVE_PASS"),
+				DRIVE_AST:         row.Float("DRIVE_AST"),
+				DRIVE_TOV:         row.Float("DRIVE_TOV"),
+				DRIVE_PF:          row.Float("DRIVE_PF"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
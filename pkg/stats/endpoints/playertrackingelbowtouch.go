@@ -60,38 +60,35 @@ func GetPlayerTrackingElbowTouch(ctx context.Context, client *stats.Client, req
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playertrackingelbowtouch", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playertrackingelbowtouch", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerTrackingElbowTouchResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerTrackingElbowTouch = make([]PlayerTrackingElbowTouchPlayerTrackingElbowTouch, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 17 {
-				item := PlayerTrackingElbowTouchPlayerTrackingElbowTouch{
-					PLAYER_ID:          toInt(row[0]),
-					PLAYER_NAME:        toString(row[1]),
-					TEAM_ID:            toInt(row[2]),
-					TEAM_ABBREVIATION:  toString(row[3]),
-					GP:                 toInt(row[4]),
-					MIN:                toFloat(row[5]),
-					ELBOW_TOUCHES:      toString(row[6]),
-					ELBOW_TOUCH_FGM:    toInt(row[7]),
-					ELBOW_TOUCH_FGA:    toInt(row[8]),
-					ELBOW_TOUCH_FG_PCT: toFloat(row[9]),
-					ELBOW_TOUCH_FTM:    toInt(row[10]),
-					ELBOW_TOUCH_FTA:    toInt(row[11]),
-					ELBOW_TOUCH_FT_PCT: toFloat(row[12]),
-					ELBOW_TOUCH_PTS:    toFloat(row[13]),
-					ELBOW_TOUCH_PASS:   toString(row[14]),
-					ELBOW_TOUCH_AST:    toFloat(row[15]),
-					ELBOW_TOUCH_TOV:    toFloat(row[16]),
-				}
-				response.PlayerTrackingElbowTouch = append(response.PlayerTrackingElbowTouch, item)
+	if rs := rawResp.resultSet("PlayerTrackingElbowTouch"); rs != nil {
+		response.PlayerTrackingElbowTouch = decodeRows(rs, func(row *rowReader) PlayerTrackingElbowTouchPlayerTrackingElbowTouch {
+			return PlayerTrackingElbowTouchPlayerTrackingElbowTouch{
+				PLAYER_ID:          row.Int("PLAYER_ID"),
+				PLAYER_NAME:        row.String("PLAYER_NAME"),
+				TEAM_ID:            row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION:  row.String("TEAM_ABBREVIATION"),
+				GP:                 row.Int("GP"),
+				MIN:                row.Float("MIN"),
+				ELBOW_TOUCHES:      row.String("ELBOW_TOUCHES"),
+				ELBOW_TOUCH_FGM:    row.Int("ELBOW_TOUCH_FGM"),
+				ELBOW_TOUCH_FGA:    row.Int("ELBOW_TOUCH_FGA"),
+				ELBOW_TOUCH_FG_PCT: row.Float("ELBOW_TOUCH_FG_PCT"),
+				ELBOW_TOUCH_FTM:    row.Int("ELBOW_TOUCH_FTM"),
+				ELBOW_TOUCH_FTA:    row.Int("ELBOW_TOUCH_FTA"),
+				ELBOW_TOUCH_FT_PCT: row.Float("ELBOW_TOUCH_FT_PCT"),
+				ELBOW_TOUCH_PTS:    row.Float("ELBOW_TOUCH_PTS"),
+				ELBOW_TOUCH_PASS:   row.String("ELBOW_TOUCH_PASS"),
+				ELBOW_TOUCH_AST:    row.Float("ELBOW_TOUCH_AST"),
+				ELBOW_TOUCH_TOV:    row.Float("ELBOW_TOUCH_TOV"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -60,38 +60,35 @@ func GetPlayerTrackingPaintTouch(ctx context.Context, client *stats.Client, req
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playertrackingpainttouch", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playertrackingpainttouch", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerTrackingPaintTouchResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerTrackingPaintTouch = make([]PlayerTrackingPaintTouchPlayerTrackingPaintTouch, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 17 {
-				item := PlayerTrackingPaintTouchPlayerTrackingPaintTouch{
-					PLAYER_ID:          toInt(row[0]),
-					PLAYER_NAME:        toString(row[1]),
-					TEAM_ID:            toInt(row[2]),
-					TEAM_ABBREVIATION:  toString(row[3]),
-					GP:                 toInt(row[4]),
-					MIN:                toFloat(row[5]),
-					PAINT_TOUCHES:      toString(row[6]),
-					PAINT_TOUCH_FGM:    toInt(row[7]),
-					PAINT_TOUCH_FGA:    toInt(row[8]),
-					PAINT_TOUCH_FG_PCT: toFloat(row[9]),
-					PAINT_TOUCH_FTM:    toInt(row[10]),
-					PAINT_TOUCH_FTA:    toInt(row[11]),
-					PAINT_TOUCH_FT_PCT: toFloat(row[12]),
-					PAINT_TOUCH_PTS:    toFloat(row[13]),
-					PAINT_TOUCH_PASS:   toString(row[14]),
-					PAINT_TOUCH_AST:    toFloat(row[15]),
-					PAINT_TOUCH_TOV:    toFloat(row[16]),
-				}
-				response.PlayerTrackingPaintTouch = append(response.PlayerTrackingPaintTouch, item)
+	if rs := rawResp.resultSet("PlayerTrackingPaintTouch"); rs != nil {
+		response.PlayerTrackingPaintTouch = decodeRows(rs, func(row *rowReader) PlayerTrackingPaintTouchPlayerTrackingPaintTouch {
+			return PlayerTrackingPaintTouchPlayerTrackingPaintTouch{
+				PLAYER_ID:          row.Int("PLAYER_ID"),
+				PLAYER_NAME:        row.String("PLAYER_NAME"),
+				TEAM_ID:            row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION:  row.String("TEAM_ABBREVIATION"),
+				GP:                 row.Int("GP"),
+				MIN:                row.Float("MIN"),
+				PAINT_TOUCHES:      row.String("PAINT_TOUCHES"),
+				PAINT_TOUCH_FGM:    row.Int("PAINT_TOUCH_FGM"),
+				PAINT_TOUCH_FGA:    row.Int("PAINT_TOUCH_FGA"),
+				PAINT_TOUCH_FG_PCT: row.Float("PAINT_TOUCH_FG_PCT"),
+				PAINT_TOUCH_FTM:    row.Int("PAINT_TOUCH_FTM"),
+				PAINT_TOUCH_FTA:    row.Int("PAINT_TOUCH_FTA"),
+				PAINT_TOUCH_FT_PCT: row.Float("PAINT_TOUCH_FT_PCT"),
+				PAINT_TOUCH_PTS:    row.Float("PAINT_TOUCH_PTS"),
+				PAINT_TOUCH_PASS:   row.String("PAINT_TOUCH_PASS"),
+				PAINT_TOUCH_AST:    row.Float("PAINT_TOUCH_AST"),
+				PAINT_TOUCH_TOV:    row.Float("PAINT_TOUCH_TOV"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
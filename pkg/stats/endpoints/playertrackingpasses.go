@@ -58,36 +58,33 @@ func GetPlayerTrackingPasses(ctx context.Context, client *stats.Client, req Play
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playertrackingpasses", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playertrackingpasses", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerTrackingPassesResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerTrackingPasses = make([]PlayerTrackingPassesPlayerTrackingPasses, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 15 {
-				item := PlayerTrackingPassesPlayerTrackingPasses{
-					PLAYER_ID:           toInt(row[0]),
-					PLAYER_NAME:         toString(row[1]),
-					TEAM_ID:             toInt(row[2]),
-					TEAM_ABBREVIATION:   toString(row[3]),
-					GP:                  toInt(row[4]),
-					MIN:                 toFloat(row[5]),
-					PASSES_MADE:         toString(row[6]),
-					PASSES_RECEIVED:     toString(row[7]),
-					AST:                 toFloat(row[8]),
-					SECONDARY_AST:       toFloat(row[9]),
-					POTENTIAL_AST:       toFloat(row[10]),
-					AST_POINTS_CREATED:  toFloat(row[11]),
-					AST_ADJ:             toFloat(row[12]),
-					AST_TO_PASS_PCT:     toFloat(row[13]),
-					AST_TO_PASS_PCT_ADJ: toFloat(row[14]),
-				}
-				response.PlayerTrackingPasses = append(response.PlayerTrackingPasses, item)
+	if rs := rawResp.resultSet("PlayerTrackingPasses"); rs != nil {
+		response.PlayerTrackingPasses = decodeRows(rs, func(row *rowReader) PlayerTrackingPassesPlayerTrackingPasses {
+			return PlayerTrackingPassesPlayerTrackingPasses{
+				PLAYER_ID:           row.Int("PLAYER_ID"),
+				PLAYER_NAME:         row.String("PLAYER_NAME"),
+				TEAM_ID:             row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION:   row.String("TEAM_ABBREVIATION"),
+				GP:                  row.Int("GP"),
+				MIN:                 row.Float("MIN"),
+				PASSES_MADE:         row.String("PASSES_MADE"),
+				PASSES_RECEIVED:     row.String("PASSES_RECEIVED"),
+				AST:                 row.Float("AST"),
+				SECONDARY_AST:       row.Float("SECONDARY_AST"),
+				POTENTIAL_AST:       row.Float("POTENTIAL_AST"),
+				AST_POINTS_CREATED:  row.Float("AST_POINTS_CREATED"),
+				AST_ADJ:             row.Float("AST_ADJ"),
+				AST_TO_PASS_PCT:     row.Float("AST_TO_PASS_PCT"),
+				AST_TO_PASS_PCT_ADJ: row.Float("AST_TO_PASS_PCT_ADJ"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
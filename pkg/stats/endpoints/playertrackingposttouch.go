@@ -60,38 +60,35 @@ func GetPlayerTrackingPostTouch(ctx context.Context, client *stats.Client, req P
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playertrackingposttouch", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playertrackingposttouch", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerTrackingPostTouchResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerTrackingPostTouch = make([]PlayerTrackingPostTouchPlayerTrackingPostTouch, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 17 {
-				item := PlayerTrackingPostTouchPlayerTrackingPostTouch{
-					PLAYER_ID:         toInt(row[0]),
-					PLAYER_NAME:       toString(row[1]),
-					TEAM_ID:           toInt(row[2]),
-					TEAM_ABBREVIATION: toString(row[3]),
-					GP:                toInt(row[4]),
-					MIN:               toFloat(row[5]),
-					POST_TOUCHES:      toString(row[6]),
-					POST_TOUCH_FGM:    toInt(row[7]),
-					POST_TOUCH_FGA:    toInt(row[8]),
-					POST_TOUCH_FG_PCT: toFloat(row[9]),
-					POST_TOUCH_FTM:    toInt(row[10]),
-					POST_TOUCH_FTA:    toInt(row[11]),
-					POST_TOUCH_FT_PCT: toFloat(row[12]),
-					POST_TOUCH_PTS:    toFloat(row[13]),
-					POST_TOUCH_PASS:   toString(row[14]),
-					POST_TOUCH_AST:    toFloat(row[15]),
-					POST_TOUCH_TOV:    toFloat(row[16]),
-				}
-				response.PlayerTrackingPostTouch = append(response.PlayerTrackingPostTouch, item)
+	if rs := rawResp.resultSet("PlayerTrackingPostTouch"); rs != nil {
+		response.PlayerTrackingPostTouch = decodeRows(rs, func(row *rowReader) PlayerTrackingPostTouchPlayerTrackingPostTouch {
+			return PlayerTrackingPostTouchPlayerTrackingPostTouch{
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				GP:                row.Int("GP"),
+				MIN:               row.Float("MIN"),
+				POST_TOUCHES:      row.String("POST_TOUCHES"),
+				POST_TOUCH_FGM:    row.Int("POST_TOUCH_FGM"),
+				POST_TOUCH_FGA:    row.Int("POST_TOUCH_FGA"),
+				POST_TOUCH_FG_PCT: row.Float("POST_TOUCH_FG_PCT"),
+				POST_TOUCH_FTM:    row.Int("POST_TOUCH_FTM"),
+				POST_TOUCH_FTA:    row.Int("POST_TOUCH_FTA"),
+				POST_TOUCH_FT_PCT: row.Float("POST_TOUCH_FT_PCT"),
+				POST_TOUCH_PTS:    row.Float("POST_TOUCH_PTS"),
+				POST_TOUCH_PASS:   row.String("POST_TOUCH_PASS"),
+				POST_TOUCH_AST:    row.Float("POST_TOUCH_AST"),
+				POST_TOUCH_TOV:    row.Float("POST_TOUCH_TOV"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -57,35 +57,32 @@ func GetPlayerTrackingPullUpShot(ctx context.Context, client *stats.Client, req
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playertrackingpullupshot", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playertrackingpullupshot", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerTrackingPullUpShotResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerTrackingPullUpShot = make([]PlayerTrackingPullUpShotPlayerTrackingPullUpShot, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 14 {
-				item := PlayerTrackingPullUpShotPlayerTrackingPullUpShot{
-					PLAYER_ID:         toInt(row[0]),
-					PLAYER_NAME:       toString(row[1]),
-					TEAM_ID:           toInt(row[2]),
-					TEAM_ABBREVIATION: toString(row[3]),
-					GP:                toInt(row[4]),
-					MIN:               toFloat(row[5]),
-					PULL_UP_FGM:       toInt(row[6]),
-					PULL_UP_FGA:       toInt(row[7]),
-					PULL_UP_FG_PCT:    toFloat(row[8]),
-					PULL_UP_PTS:       toFloat(row[9]),
-					PULL_UP_FG3M:      toInt(row[10]),
-					PULL_UP_FG3A:      toInt(row[11]),
-					PULL_UP_FG3_PCT:   toFloat(row[12]),
-					PULL_UP_EFG_PCT:   toFloat(row[13]),
-				}
-				response.PlayerTrackingPullUpShot = append(response.PlayerTrackingPullUpShot, item)
+	if rs := rawResp.resultSet("PlayerTrackingPullUpShot"); rs != nil {
+		response.PlayerTrackingPullUpShot = decodeRows(rs, func(row *rowReader) PlayerTrackingPullUpShotPlayerTrackingPullUpShot {
+			return PlayerTrackingPullUpShotPlayerTrackingPullUpShot{
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				GP:                row.Int("GP"),
+				MIN:               row.Float("MIN"),
+				PULL_UP_FGM:       row.Int("PULL_UP_FGM"),
+				PULL_UP_FGA:       row.Int("PULL_UP_FGA"),
+				PULL_UP_FG_PCT:    row.Float("PULL_UP_FG_PCT"),
+				PULL_UP_PTS:       row.Float("PULL_UP_PTS"),
+				PULL_UP_FG3M:      row.Int("PULL_UP_FG3M"),
+				PULL_UP_FG3A:      row.Int("PULL_UP_FG3A"),
+				PULL_UP_FG3_PCT:   row.Float("PULL_UP_FG3_PCT"),
+				PULL_UP_EFG_PCT:   row.Float("PULL_UP_EFG_PCT"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -62,40 +62,37 @@ func GetPlayerTrackingRebounding(ctx context.Context, client *stats.Client, req
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playertrackingebounding", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playertrackingebounding", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerTrackingReboundingResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerTrackingRebounding = make([]PlayerTrackingReboundingPlayerTrackingRebounding, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 19 {
-				item := PlayerTrackingReboundingPlayerTrackingRebounding{
-					PLAYER_ID:         toInt(row[0]),
-					PLAYER_NAME:       toString(row[1]),
-					TEAM_ID:           toInt(row[2]),
-					TEAM_ABBREVIATION: toString(row[3]),
-					GP:                toInt(row[4]),
-					MIN:               toFloat(row[5]),
-					OREB:              toFloat(row[6]),
-					DREB:              toFloat(row[7]),
-					REB:               toFloat(row[8]),
-					OREB_CONTEST:      toFloat(row[9]),
-					OREB_UNCONTEST:    toFloat(row[10]),
-					OREB_CONTEST_PCT:  toFloat(row[11]),
-					DREB_CONTEST:      toFloat(row[12]),
-					DREB_UNCONTEST:    toFloat(row[13]),
-					DREB_CONTEST_PCT:  toFloat(row[14]),
-					REB_CONTEST:       toFloat(row[15]),
-					REB_UNCONTEST:     toFloat(row[16]),
-					REB_CONTEST_PCT:   toFloat(row[17]),
-					AVG_REB_DIST:      toFloat(row[18]),
-				}
-				response.PlayerTrackingRebounding = append(response.PlayerTrackingRebounding, item)
+	if rs := rawResp.resultSet("PlayerTrackingRebounding"); rs != nil {
+		response.PlayerTrackingRebounding = decodeRows(rs, func(row *rowReader) PlayerTrackingReboundingPlayerTrackingRebounding {
+			return PlayerTrackingReboundingPlayerTrackingRebounding{
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				GP:                row.Int("GP"),
+				MIN:               row.Float("MIN"),
+				OREB:              row.Float("OREB"),
+				DREB:              row.Float("DREB"),
+				REB:               row.Float("REB"),
+				OREB_CONTEST:      row.Float("OREB_CONTEST"),
+				OREB_UNCONTEST:    row.Float("OREB_UNCONTEST"),
+				OREB_CONTEST_PCT:  row.Float("OREB_CONTEST_PCT"),
+				DREB_CONTEST:      row.Float("DREB_CONTEST"),
+				DREB_UNCONTEST:    row.Float("DREB_UNCONTEST"),
+				DREB_CONTEST_PCT:  row.Float("DREB_CONTEST_PCT"),
+				REB_CONTEST:       row.Float("REB_CONTEST"),
+				REB_UNCONTEST:     row.Float("REB_UNCONTEST"),
+				REB_CONTEST_PCT:   row.Float("REB_CONTEST_PCT"),
+				AVG_REB_DIST:      row.Float("AVG_REB_DIST"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
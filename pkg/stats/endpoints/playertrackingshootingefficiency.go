@@ -55,33 +55,30 @@ func GetPlayerTrackingShootingEfficiency(ctx context.Context, client *stats.Clie
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playertrackingshootingefficiency", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playertrackingshootingefficiency", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerTrackingShootingEfficiencyResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerTrackingShootingEfficiency = make([]PlayerTrackingShootingEfficiencyPlayerTrackingShootingEfficiency, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 12 {
-				item := PlayerTrackingShootingEfficiencyPlayerTrackingShootingEfficiency{
-					PLAYER_ID:          toInt(row[0]),
-					PLAYER_NAME:        toString(row[1]),
-					TEAM_ID:            toInt(row[2]),
-					TEAM_ABBREVIATION:  toString(row[3]),
-					GP:                 toInt(row[4]),
-					MIN:                toFloat(row[5]),
-					DRIVE_PTS:          toFloat(row[6]),
-					DRIVE_FG_PCT:       toFloat(row[7]),
-					CATCH_SHOOT_PTS:    toFloat(row[8]),
-					CATCH_SHOOT_FG_PCT: toFloat(row[9]),
-					PULL_UP_PTS:        toFloat(row[10]),
-					PULL_UP_FG_PCT:     toFloat(row[11]),
-				}
-				response.PlayerTrackingShootingEfficiency = append(response.PlayerTrackingShootingEfficiency, item)
+	if rs := rawResp.resultSet("PlayerTrackingShootingEfficiency"); rs != nil {
+		response.PlayerTrackingShootingEfficiency = decodeRows(rs, func(row *rowReader) PlayerTrackingShootingEfficiencyPlayerTrackingShootingEfficiency {
+			return PlayerTrackingShootingEfficiencyPlayerTrackingShootingEfficiency{
+				PLAYER_ID:          row.Int("PLAYER_ID"),
+				PLAYER_NAME:        row.String("PLAYER_NAME"),
+				TEAM_ID:            row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION:  row.String("TEAM_ABBREVIATION"),
+				GP:                 row.Int("GP"),
+				MIN:                row.Float("MIN"),
+				DRIVE_PTS:          row.Float("DRIVE_PTS"),
+				DRIVE_FG_PCT:       row.Float("DRIVE_FG_PCT"),
+				CATCH_SHOOT_PTS:    row.Float("CATCH_SHOOT_PTS"),
+				CATCH_SHOOT_FG_PCT: row.Float("CATCH_SHOOT_FG_PCT"),
+				PULL_UP_PTS:        row.Float("PULL_UP_PTS"),
+				PULL_UP_FG_PCT:     row.Float("PULL_UP_FG_PCT"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
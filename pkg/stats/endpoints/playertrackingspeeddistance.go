@@ -56,34 +56,31 @@ func GetPlayerTrackingSpeedDistance(ctx context.Context, client *stats.Client, r
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playertrackingspeeddistance", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playertrackingspeeddistance", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerTrackingSpeedDistanceResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerTrackingSpeedDistance = make([]PlayerTrackingSpeedDistancePlayerTrackingSpeedDistance, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 13 {
-				item := PlayerTrackingSpeedDistancePlayerTrackingSpeedDistance{
-					PLAYER_ID:         toInt(row[0]),
-					PLAYER_NAME:       toString(row[1]),
-					TEAM_ID:           toInt(row[2]),
-					TEAM_ABBREVIATION: toString(row[3]),
-					GP:                toInt(row[4]),
-					MIN:               toFloat(row[5]),
-					DIST_FEET:         toString(row[6]),
-					DIST_MILES:        toString(row[7]),
-					DIST_MILES_OFF:    toString(row[8]),
-					DIST_MILES_DEF:    toString(row[9]),
-					AVG_SPEED:         toString(row[10]),
-					AVG_SPEED_OFF:     toString(row[11]),
-					AVG_SPEED_DEF:     toString(row[12]),
-				}
-				response.PlayerTrackingSpeedDistance = append(response.PlayerTrackingSpeedDistance, item)
+	if rs := rawResp.resultSet("PlayerTrackingSpeedDistance"); rs != nil {
+		response.PlayerTrackingSpeedDistance = decodeRows(rs, func(row *rowReader) PlayerTrackingSpeedDistancePlayerTrackingSpeedDistance {
+			return PlayerTrackingSpeedDistancePlayerTrackingSpeedDistance{
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				GP:                row.Int("GP"),
+				MIN:               row.Float("MIN"),
+				DIST_FEET:         row.String("DIST_FEET"),
+				DIST_MILES:        row.String("DIST_MILES"),
+				DIST_MILES_OFF:    row.String("DIST_MILES_OFF"),
+				DIST_MILES_DEF:    row.String("DIST_MILES_DEF"),
+				AVG_SPEED:         row.String("AVG_SPEED"),
+				AVG_SPEED_OFF:     row.String("AVG_SPEED_OFF"),
+				AVG_SPEED_DEF:     row.String("AVG_SPEED_DEF"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -162,149 +162,130 @@ func GetPlayerVsPlayer(ctx context.Context, client *stats.Client, req PlayerVsPl
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playervsplayer", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playervsplayer", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerVsPlayerResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.Overall = make([]PlayerVsPlayerOverall, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 29 {
-				item := PlayerVsPlayerOverall{
-					PLAYER_ID:   toInt(row[0]),
-					PLAYER_NAME: toString(row[1]),
-					SORT_ORDER:  toString(row[2]),
-					GP:          toInt(row[3]),
-					W:           toString(row[4]),
-					L:           toString(row[5]),
-					W_PCT:       toFloat(row[6]),
-					MIN:         toFloat(row[7]),
-					FGM:         toInt(row[8]),
-					FGA:         toInt(row[9]),
-					FG_PCT:      toFloat(row[10]),
-					FG3M:        toInt(row[11]),
-					FG3A:        toInt(row[12]),
-					FG3_PCT:     toFloat(row[13]),
-					FTM:         toInt(row[14]),
-					FTA:         toInt(row[15]),
-					FT_PCT:      toFloat(row[16]),
-					OREB:        toFloat(row[17]),
-					DREB:        toFloat(row[18]),
-					REB:         toFloat(row[19]),
-					AST:         toFloat(row[20]),
-					TOV:         toFloat(row[21]),
-					STL:         toFloat(row[22]),
-					BLK:         toFloat(row[23]),
-					BLKA:        toInt(row[24]),
-					PF:          toFloat(row[25]),
-					PFD:         toFloat(row[26]),
-					PTS:         toFloat(row[27]),
-					PLUS_MINUS:  toFloat(row[28]),
-				}
-				response.Overall = append(response.Overall, item)
+	if rs := rawResp.resultSet("Overall"); rs != nil {
+		response.Overall = decodeRows(rs, func(row *rowReader) PlayerVsPlayerOverall {
+			return PlayerVsPlayerOverall{
+				PLAYER_ID:   row.Int("PLAYER_ID"),
+				PLAYER_NAME: row.String("PLAYER_NAME"),
+				SORT_ORDER:  row.String("SORT_ORDER"),
+				GP:          row.Int("GP"),
+				W:           row.String("W"),
+				L:           row.String("L"),
+				W_PCT:       row.Float("W_PCT"),
+				MIN:         row.Float("MIN"),
+				FGM:         row.Int("FGM"),
+				FGA:         row.Int("FGA"),
+				FG_PCT:      row.Float("FG_PCT"),
+				FG3M:        row.Int("FG3M"),
+				FG3A:        row.Int("FG3A"),
+				FG3_PCT:     row.Float("FG3_PCT"),
+				FTM:         row.Int("FTM"),
+				FTA:         row.Int("FTA"),
+				FT_PCT:      row.Float("FT_PCT"),
+				OREB:        row.Float("OREB"),
+				DREB:        row.Float("DREB"),
+				REB:         row.Float("REB"),
+				AST:         row.Float("AST"),
+				TOV:         row.Float("TOV"),
+				STL:         row.Float("STL"),
+				BLK:         row.Float("BLK"),
+				BLKA:        row.Int("BLKA"),
+				PF:          row.Float("PF"),
+				PFD:         row.Float("PFD"),
+				PTS:         row.Float("PTS"),
+				PLUS_MINUS:  row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.OnOffCourt = make([]PlayerVsPlayerOnOffCourt, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 32 {
-				item := PlayerVsPlayerOnOffCourt{
-					PLAYER_ID:      toInt(row[0]),
-					PLAYER_NAME:    toString(row[1]),
-					SORT_ORDER:     toString(row[2]),
-					VS_PLAYER_ID:   toInt(row[3]),
-					VS_PLAYER_NAME: toString(row[4]),
-					COURT_STATUS:   toString(row[5]),
-					GP:             toInt(row[6]),
-					W:              toString(row[7]),
-					L:              toString(row[8]),
-					W_PCT:          toFloat(row[9]),
-					MIN:            toFloat(row[10]),
-					FGM:            toInt(row[11]),
-					FGA:            toInt(row[12]),
-					FG_PCT:         toFloat(row[13]),
-					FG3M:           toInt(row[14]),
-					FG3A:           toInt(row[15]),
-					FG3_PCT:        toFloat(row[16]),
-					FTM:            toInt(row[17]),
-					FTA:            toInt(row[18]),
-					FT_PCT:         toFloat(row[19]),
-					OREB:           toFloat(row[20]),
-					DREB:           toFloat(row[21]),
-					REB:            toFloat(row[22]),
-					AST:            toFloat(row[23]),
-					TOV:            toFloat(row[24]),
-					STL:            toFloat(row[25]),
-					BLK:            toFloat(row[26]),
-					BLKA:           toInt(row[27]),
-					PF:             toFloat(row[28]),
-					PFD:            toFloat(row[29]),
-					PTS:            toFloat(row[30]),
-					PLUS_MINUS:     toFloat(row[31]),
-				}
-				response.OnOffCourt = append(response.OnOffCourt, item)
+	if rs := rawResp.resultSet("OnOffCourt"); rs != nil {
+		response.OnOffCourt = decodeRows(rs, func(row *rowReader) PlayerVsPlayerOnOffCourt {
+			return PlayerVsPlayerOnOffCourt{
+				PLAYER_ID:      row.Int("PLAYER_ID"),
+				PLAYER_NAME:    row.String("PLAYER_NAME"),
+				SORT_ORDER:     row.String("SORT_ORDER"),
+				VS_PLAYER_ID:   row.Int("VS_PLAYER_ID"),
+				VS_PLAYER_NAME: row.String("VS_PLAYER_NAME"),
+				COURT_STATUS:   row.String("COURT_STATUS"),
+				GP:             row.Int("GP"),
+				W:              row.String("W"),
+				L:              row.String("L"),
+				W_PCT:          row.Float("W_PCT"),
+				MIN:            row.Float("MIN"),
+				FGM:            row.Int("FGM"),
+				FGA:            row.Int("FGA"),
+				FG_PCT:         row.Float("FG_PCT"),
+				FG3M:           row.Int("FG3M"),
+				FG3A:           row.Int("FG3A"),
+				FG3_PCT:        row.Float("FG3_PCT"),
+				FTM:            row.Int("FTM"),
+				FTA:            row.Int("FTA"),
+				FT_PCT:         row.Float("FT_PCT"),
+				OREB:           row.Float("OREB"),
+				DREB:           row.Float("DREB"),
+				REB:            row.Float("REB"),
+				AST:            row.Float("AST"),
+				TOV:            row.Float("TOV"),
+				STL:            row.Float("STL"),
+				BLK:            row.Float("BLK"),
+				BLKA:           row.Int("BLKA"),
+				PF:             row.Float("PF"),
+				PFD:            row.Float("PFD"),
+				PTS:            row.Float("PTS"),
+				PLUS_MINUS:     row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 2 {
-		response.ShotDistanceOverall = make([]PlayerVsPlayerShotDistanceOverall, 0, len(rawResp.ResultSets[2].RowSet))
-		for _, row := range rawResp.ResultSets[2].RowSet {
-			if len(row) >= 9 {
-				item := PlayerVsPlayerShotDistanceOverall{
-					PLAYER_ID:       toInt(row[0]),
-					PLAYER_NAME:     toString(row[1]),
-					SORT_ORDER:      toString(row[2]),
-					VS_PLAYER_ID:    toInt(row[3]),
-					VS_PLAYER_NAME:  toString(row[4]),
-					SHOT_DIST_RANGE: toInt(row[5]),
-					FGA:             toInt(row[6]),
-					FGM:             toInt(row[7]),
-					FG_PCT:          toFloat(row[8]),
-				}
-				response.ShotDistanceOverall = append(response.ShotDistanceOverall, item)
+	if rs := rawResp.resultSet("ShotDistanceOverall"); rs != nil {
+		response.ShotDistanceOverall = decodeRows(rs, func(row *rowReader) PlayerVsPlayerShotDistanceOverall {
+			return PlayerVsPlayerShotDistanceOverall{
+				PLAYER_ID:       row.Int("PLAYER_ID"),
+				PLAYER_NAME:     row.String("PLAYER_NAME"),
+				SORT_ORDER:      row.String("SORT_ORDER"),
+				VS_PLAYER_ID:    row.Int("VS_PLAYER_ID"),
+				VS_PLAYER_NAME:  row.String("VS_PLAYER_NAME"),
+				SHOT_DIST_RANGE: row.Int("SHOT_DIST_RANGE"),
+				FGA:             row.Int("FGA"),
+				FGM:             row.Int("FGM"),
+				FG_PCT:          row.Float("FG_PCT"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 3 {
-		response.ShotDistanceOnCourt = make([]PlayerVsPlayerShotDistanceOnCourt, 0, len(rawResp.ResultSets[3].RowSet))
-		for _, row := range rawResp.ResultSets[3].RowSet {
-			if len(row) >= 9 {
-				item := PlayerVsPlayerShotDistanceOnCourt{
-					PLAYER_ID:       toInt(row[0]),
-					PLAYER_NAME:     toString(row[1]),
-					SORT_ORDER:      toString(row[2]),
-					VS_PLAYER_ID:    toInt(row[3]),
-					VS_PLAYER_NAME:  toString(row[4]),
-					SHOT_DIST_RANGE: toInt(row[5]),
-					FGA:             toInt(row[6]),
-					FGM:             toInt(row[7]),
-					FG_PCT:          toFloat(row[8]),
-				}
-				response.ShotDistanceOnCourt = append(response.ShotDistanceOnCourt, item)
+	if rs := rawResp.resultSet("ShotDistanceOnCourt"); rs != nil {
+		response.ShotDistanceOnCourt = decodeRows(rs, func(row *rowReader) PlayerVsPlayerShotDistanceOnCourt {
+			return PlayerVsPlayerShotDistanceOnCourt{
+				PLAYER_ID:       row.Int("PLAYER_ID"),
+				PLAYER_NAME:     row.String("PLAYER_NAME"),
+				SORT_ORDER:      row.String("SORT_ORDER"),
+				VS_PLAYER_ID:    row.Int("VS_PLAYER_ID"),
+				VS_PLAYER_NAME:  row.String("VS_PLAYER_NAME"),
+				SHOT_DIST_RANGE: row.Int("SHOT_DIST_RANGE"),
+				FGA:             row.Int("FGA"),
+				FGM:             row.Int("FGM"),
+				FG_PCT:          row.Float("FG_PCT"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 4 {
-		response.ShotDistanceOffCourt = make([]PlayerVsPlayerShotDistanceOffCourt, 0, len(rawResp.ResultSets[4].RowSet))
-		for _, row := range rawResp.ResultSets[4].RowSet {
-			if len(row) >= 9 {
-				item := PlayerVsPlayerShotDistanceOffCourt{
-					PLAYER_ID:       toInt(row[0]),
-					PLAYER_NAME:     toString(row[1]),
-					SORT_ORDER:      toString(row[2]),
-					VS_PLAYER_ID:    toInt(row[3]),
-					VS_PLAYER_NAME:  toString(row[4]),
-					SHOT_DIST_RANGE: toInt(row[5]),
-					FGA:             toInt(row[6]),
-					FGM:             toInt(row[7]),
-					FG_PCT:          toFloat(row[8]),
-				}
-				response.ShotDistanceOffCourt = append(response.ShotDistanceOffCourt, item)
+	if rs := rawResp.resultSet("ShotDistanceOffCourt"); rs != nil {
+		response.ShotDistanceOffCourt = decodeRows(rs, func(row *rowReader) PlayerVsPlayerShotDistanceOffCourt {
+			return PlayerVsPlayerShotDistanceOffCourt{
+				PLAYER_ID:       row.Int("PLAYER_ID"),
+				PLAYER_NAME:     row.String("PLAYER_NAME"),
+				SORT_ORDER:      row.String("SORT_ORDER"),
+				VS_PLAYER_ID:    row.Int("VS_PLAYER_ID"),
+				VS_PLAYER_NAME:  row.String("VS_PLAYER_NAME"),
+				SHOT_DIST_RANGE: row.Int("SHOT_DIST_RANGE"),
+				FGA:             row.Int("FGA"),
+				FGM:             row.Int("FGM"),
+				FG_PCT:          row.Float("FG_PCT"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -68,48 +68,45 @@ func GetPlayerYearByYearStats(ctx context.Context, client *stats.Client, req Pla
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playeryearbyyearstats", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playeryearbyyearstats", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayerYearByYearStatsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayerStats = make([]PlayerYearByYearStatsPlayerStats, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 27 {
-				item := PlayerYearByYearStatsPlayerStats{
-					PLAYER_ID:         toInt(row[0]),
-					SEASON_ID:         toString(row[1]),
-					LEAGUE_ID:         toString(row[2]),
-					TEAM_ID:           toInt(row[3]),
-					TEAM_ABBREVIATION: toString(row[4]),
-					PLAYER_AGE:        toInt(row[5]),
-					GP:                toInt(row[6]),
-					GS:                toInt(row[7]),
-					MIN:               toFloat(row[8]),
-					FGM:               toInt(row[9]),
-					FGA:               toInt(row[10]),
-					FG_PCT:            toFloat(row[11]),
-					FG3M:              toInt(row[12]),
-					FG3A:              toInt(row[13]),
-					FG3_PCT:           toFloat(row[14]),
-					FTM:               toInt(row[15]),
-					FTA:               toInt(row[16]),
-					FT_PCT:            toFloat(row[17]),
-					OREB:              toFloat(row[18]),
-					DREB:              toFloat(row[19]),
-					REB:               toFloat(row[20]),
-					AST:               toFloat(row[21]),
-					STL:               toFloat(row[22]),
-					BLK:               toFloat(row[23]),
-					TOV:               toFloat(row[24]),
-					PF:                toFloat(row[25]),
-					PTS:               toFloat(row[26]),
-				}
-				response.PlayerStats = append(response.PlayerStats, item)
+	if rs := rawResp.resultSet("PlayerStats"); rs != nil {
+		response.PlayerStats = decodeRows(rs, func(row *rowReader) PlayerYearByYearStatsPlayerStats {
+			return PlayerYearByYearStatsPlayerStats{
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				SEASON_ID:         row.String("SEASON_ID"),
+				LEAGUE_ID:         row.String("LEAGUE_ID"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				PLAYER_AGE:        row.Int("PLAYER_AGE"),
+				GP:                row.Int("GP"),
+				GS:                row.Int("GS"),
+				MIN:               row.Float("MIN"),
+				FGM:               row.Int("FGM"),
+				FGA:               row.Int("FGA"),
+				FG_PCT:            row.Float("FG_PCT"),
+				FG3M:              row.Int("FG3M"),
+				FG3A:              row.Int("FG3A"),
+				FG3_PCT:           row.Float("FG3_PCT"),
+				FTM:               row.Int("FTM"),
+				FTA:               row.Int("FTA"),
+				FT_PCT:            row.Float("FT_PCT"),
+				OREB:              row.Float("OREB"),
+				DREB:              row.Float("DREB"),
+				REB:               row.Float("REB"),
+				AST:               row.Float("AST"),
+				STL:               row.Float("STL"),
+				BLK:               row.Float("BLK"),
+				TOV:               row.Float("TOV"),
+				PF:                row.Float("PF"),
+				PTS:               row.Float("PTS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -70,59 +70,52 @@ func GetPlayoffPicture(ctx context.Context, client *stats.Client, req PlayoffPic
 	params.Set("SeasonID", string(req.SeasonID))
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "playoffpicture", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "playoffpicture", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &PlayoffPictureResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.EastConfPlayoffPicture = make([]PlayoffPictureEastConfPlayoffPicture, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 14 {
-				item := PlayoffPictureEastConfPlayoffPicture{
-					TEAM_ID:                  toInt(row[0]),
-					LEAGUE_ID:                toString(row[1]),
-					SEASON_ID:                toString(row[2]),
-					CONFERENCE:               toString(row[3]),
-					RANK:                     toInt(row[4]),
-					TEAM:                     toString(row[5]),
-					WINS:                     toString(row[6]),
-					LOSSES:                   toString(row[7]),
-					WIN_PCT:                  toFloat(row[8]),
-					GAMES_BACK:               toString(row[9]),
-					CLINCHED:                 toString(row[10]),
-					ELIMINATED_FROM_PLAYOFFS: toFloat(row[11]),
-					CAN_WIN_CONF:             toString(row[12]),
-					CAN_WIN_DIV:              toString(row[13]),
-				}
-				response.EastConfPlayoffPicture = append(response.EastConfPlayoffPicture, item)
+	if rs := rawResp.resultSet("EastConfPlayoffPicture"); rs != nil {
+		response.EastConfPlayoffPicture = decodeRows(rs, func(row *rowReader) PlayoffPictureEastConfPlayoffPicture {
+			return PlayoffPictureEastConfPlayoffPicture{
+				TEAM_ID:                  row.Int("TEAM_ID"),
+				LEAGUE_ID:                row.String("LEAGUE_ID"),
+				SEASON_ID:                row.String("SEASON_ID"),
+				CONFERENCE:               row.String("CONFERENCE"),
+				RANK:                     row.Int("RANK"),
+				TEAM:                     row.String("TEAM"),
+				WINS:                     row.String("WINS"),
+				LOSSES:                   row.String("LOSSES"),
+				WIN_PCT:                  row.Float("WIN_PCT"),
+				GAMES_BACK:               row.String("GAMES_BACK"),
+				CLINCHED:                 row.String("CLINCHED"),
+				ELIMINATED_FROM_PLAYOFFS: row.Float("ELIMINATED_FROM_PLAYOFFS"),
+				CAN_WIN_CONF:             row.String("CAN_WIN_CONF"),
+				CAN_WIN_DIV:              row.String("CAN_WIN_DIV"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.WestConfPlayoffPicture = make([]PlayoffPictureWestConfPlayoffPicture, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 14 {
-				item := PlayoffPictureWestConfPlayoffPicture{
-					TEAM_ID:                  toInt(row[0]),
-					LEAGUE_ID:                toString(row[1]),
-					SEASON_ID:                toString(row[2]),
-					CONFERENCE:               toString(row[3]),
-					RANK:                     toInt(row[4]),
-					TEAM:                     toString(row[5]),
-					WINS:                     toString(row[6]),
-					LOSSES:                   toString(row[7]),
-					WIN_PCT:                  toFloat(row[8]),
-					GAMES_BACK:               toString(row[9]),
-					CLINCHED:                 toString(row[10]),
-					ELIMINATED_FROM_PLAYOFFS: toFloat(row[11]),
-					CAN_WIN_CONF:             toString(row[12]),
-					CAN_WIN_DIV:              toString(row[13]),
-				}
-				response.WestConfPlayoffPicture = append(response.WestConfPlayoffPicture, item)
+	if rs := rawResp.resultSet("WestConfPlayoffPicture"); rs != nil {
+		response.WestConfPlayoffPicture = decodeRows(rs, func(row *rowReader) PlayoffPictureWestConfPlayoffPicture {
+			return PlayoffPictureWestConfPlayoffPicture{
+				TEAM_ID:                  row.Int("TEAM_ID"),
+				LEAGUE_ID:                row.String("LEAGUE_ID"),
+				SEASON_ID:                row.String("SEASON_ID"),
+				CONFERENCE:               row.String("CONFERENCE"),
+				RANK:                     row.Int("RANK"),
+				TEAM:                     row.String("TEAM"),
+				WINS:                     row.String("WINS"),
+				LOSSES:                   row.String("LOSSES"),
+				WIN_PCT:                  row.Float("WIN_PCT"),
+				GAMES_BACK:               row.String("GAMES_BACK"),
+				CLINCHED:                 row.String("CLINCHED"),
+				ELIMINATED_FROM_PLAYOFFS: row.Float("ELIMINATED_FROM_PLAYOFFS"),
+				CAN_WIN_CONF:             row.String("CAN_WIN_CONF"),
+				CAN_WIN_DIV:              row.String("CAN_WIN_DIV"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
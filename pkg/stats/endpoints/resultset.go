@@ -0,0 +1,401 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+)
+
+var (
+	ErrResultSetNotFound = errors.New("result set not found")
+	ErrColumnNotFound    = errors.New("column not found")
+	ErrColumnType        = errors.New("unexpected column type")
+)
+
+// ResultSet is one named table of a stats.nba.com response. Columns are
+// looked up by header name, so reordered columns are harmless and removed
+// ones are reported as ErrColumnNotFound.
+type ResultSet struct {
+	Name    string          `json:"name"`
+	Headers []string        `json:"headers"`
+	RowSet  [][]interface{} `json:"rowSet"`
+
+	columns map[string]int
+	drift   []models.SchemaDrift
+}
+
+func (rs *ResultSet) UnmarshalJSON(data []byte) error {
+	type plain ResultSet
+	if err := json.Unmarshal(data, (*plain)(rs)); err != nil {
+		return err
+	}
+	rs.columns = indexColumns(rs.Headers)
+	return nil
+}
+
+// ParseResultSets decodes the result sets of a raw stats.nba.com body, such
+// as models.RawResponse.Body.
+func ParseResultSets(body []byte) ([]ResultSet, error) {
+	var resp rawStatsResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidResponse, err)
+	}
+	return resp.ResultSets, nil
+}
+
+// FindResultSet returns the result set called name.
+func FindResultSet(sets []ResultSet, name string) (*ResultSet, error) {
+	for i := range sets {
+		if sets[i].Name == name {
+			return &sets[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrResultSetNotFound, name)
+}
+
+func (rs *ResultSet) Len() int {
+	return len(rs.RowSet)
+}
+
+// ColumnIndex returns the position of the named column.
+func (rs *ResultSet) ColumnIndex(column string) (int, error) {
+	if rs.columns == nil {
+		rs.columns = indexColumns(rs.Headers)
+	}
+	i, ok := rs.columns[column]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s.%s", ErrColumnNotFound, rs.Name, column)
+	}
+	return i, nil
+}
+
+func (rs *ResultSet) HasColumn(column string) bool {
+	_, err := rs.ColumnIndex(column)
+	return err == nil
+}
+
+func (rs *ResultSet) Row(i int) Row {
+	return Row{set: rs, index: i, values: rs.RowSet[i]}
+}
+
+func (rs *ResultSet) Rows() []Row {
+	rows := make([]Row, len(rs.RowSet))
+	for i := range rs.RowSet {
+		rows[i] = rs.Row(i)
+	}
+	return rows
+}
+
+func indexColumns(headers []string) map[string]int {
+	columns := make(map[string]int, len(headers))
+	for i, header := range headers {
+		if _, dup := columns[header]; !dup {
+			columns[header] = i
+		}
+	}
+	return columns
+}
+
+// Row is a single row of a ResultSet. Null values read as the zero value of
+// the requested type.
+type Row struct {
+	set    *ResultSet
+	index  int
+	values []interface{}
+}
+
+// Value returns the raw decoded JSON value of column.
+func (r Row) Value(column string) (interface{}, error) {
+	i, err := r.set.ColumnIndex(column)
+	if err != nil {
+		return nil, err
+	}
+	if i >= len(r.values) {
+		return nil, fmt.Errorf("%w: %s row %d has %d values, want at least %d",
+			ErrColumnNotFound, r.set.Name, r.index, len(r.values), i+1)
+	}
+	return r.values[i], nil
+}
+
+func (r Row) Int(column string) (int, error) {
+	v, err := r.Value(column)
+	if err != nil {
+		return 0, err
+	}
+	switch val := v.(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		return int(val), nil
+	case int:
+		return val, nil
+	case string:
+		if n, err := strconv.Atoi(val); err == nil {
+			return n, nil
+		}
+	}
+	return 0, r.typeError(column, "int", v)
+}
+
+func (r Row) Float(column string) (float64, error) {
+	v, err := r.Value(column)
+	if err != nil {
+		return 0, err
+	}
+	switch val := v.(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		return val, nil
+	case int:
+		return float64(val), nil
+	case string:
+		if f, err := strconv.ParseFloat(val, 64); err == nil {
+			return f, nil
+		}
+	}
+	return 0, r.typeError(column, "float", v)
+}
+
+// String returns text columns as is and formats numbers and booleans.
+func (r Row) String(column string) (string, error) {
+	v, err := r.Value(column)
+	if err != nil {
+		return "", err
+	}
+	switch val := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return val, nil
+	case float64:
+		if val == math.Trunc(val) && math.Abs(val) < 1e15 {
+			return strconv.FormatInt(int64(val), 10), nil
+		}
+		return strconv.FormatFloat(val, 'f', -1, 64), nil
+	case int:
+		return strconv.Itoa(val), nil
+	case bool:
+		return strconv.FormatBool(val), nil
+	}
+	return "", r.typeError(column, "string", v)
+}
+
+func (r Row) typeError(column, want string, v interface{}) error {
+	return fmt.Errorf("%w: %s.%s row %d is %T, want %s", ErrColumnType, r.set.Name, column, r.index, v, want)
+}
+
+// rowReader reads typed columns for decodeRows. Values that cannot be read
+// decode as zero and are recorded as drift instead of aborting the row. With
+// probe set it only records the columns that are read.
+type rowReader struct {
+	row     Row
+	probe   bool
+	columns []string
+	renamed map[string]string
+	missing map[string]bool
+	invalid map[string]*invalidColumn
+}
+
+type invalidColumn struct {
+	rows  int
+	first error
+}
+
+func (r *rowReader) Value(column string) interface{} {
+	header, ok := r.lookup(column)
+	if !ok {
+		return nil
+	}
+	v, err := r.row.Value(header)
+	r.check(column, err)
+	return v
+}
+
+func (r *rowReader) Int(column string) int {
+	header, ok := r.lookup(column)
+	if !ok {
+		return 0
+	}
+	v, err := r.row.Int(header)
+	r.check(column, err)
+	return v
+}
+
+func (r *rowReader) Float(column string) float64 {
+	header, ok := r.lookup(column)
+	if !ok {
+		return 0
+	}
+	v, err := r.row.Float(header)
+	r.check(column, err)
+	return v
+}
+
+func (r *rowReader) String(column string) string {
+	header, ok := r.lookup(column)
+	if !ok {
+		return ""
+	}
+	v, err := r.row.String(header)
+	r.check(column, err)
+	return v
+}
+
+// OptInt, OptFloat and OptString read null, missing and invalid values as
+// absent rather than zero.
+func (r *rowReader) OptInt(column string) models.Opt[int] {
+	return readOpt(r, column, Row.Int)
+}
+
+func (r *rowReader) OptFloat(column string) models.Opt[float64] {
+	return readOpt(r, column, Row.Float)
+}
+
+func (r *rowReader) OptString(column string) models.Opt[string] {
+	return readOpt(r, column, Row.String)
+}
+
+func readOpt[T any](r *rowReader, column string, get func(Row, string) (T, error)) models.Opt[T] {
+	header, ok := r.lookup(column)
+	if !ok {
+		return models.None[T]()
+	}
+	if v, err := r.row.Value(header); err != nil || v == nil {
+		r.check(column, err)
+		return models.None[T]()
+	}
+	v, err := get(r.row, header)
+	if err != nil {
+		r.check(column, err)
+		return models.None[T]()
+	}
+	return models.Some(v)
+}
+
+// lookup returns the header to read for column, following renames, and
+// false when there is nothing to read.
+func (r *rowReader) lookup(column string) (string, bool) {
+	if r.probe {
+		r.columns = append(r.columns, column)
+		return "", false
+	}
+	if r.missing[column] {
+		return "", false
+	}
+	if header, ok := r.renamed[column]; ok {
+		return header, true
+	}
+	return column, true
+}
+
+func (r *rowReader) check(column string, err error) {
+	if err == nil {
+		return
+	}
+	invalid, ok := r.invalid[column]
+	if !ok {
+		invalid = &invalidColumn{first: err}
+		r.invalid[column] = invalid
+	}
+	invalid.rows++
+}
+
+// decodeRows decodes every row of rs with decode and records any schema drift
+// on rs. The columns decode reads are compared with the headers up front, so
+// a removed column is reported even when the result set is empty.
+func decodeRows[T any](rs *ResultSet, decode func(row *rowReader) T) []T {
+	probe := &rowReader{probe: true}
+	decode(probe)
+	renamed, missing := rs.compareColumns(probe.columns)
+
+	invalid := make(map[string]*invalidColumn)
+	items := make([]T, 0, len(rs.RowSet))
+	for i := range rs.RowSet {
+		reader := &rowReader{row: rs.Row(i), renamed: renamed, missing: missing, invalid: invalid}
+		items = append(items, decode(reader))
+	}
+
+	for _, column := range probe.columns {
+		if v, ok := invalid[column]; ok {
+			rs.drift = append(rs.drift, models.SchemaDrift{
+				Kind:      models.DriftInvalidValue,
+				ResultSet: rs.Name,
+				Column:    column,
+				Detail:    fmt.Sprintf("%d row(s), first: %v", v.rows, v.first),
+			})
+			delete(invalid, column)
+		}
+	}
+	return items
+}
+
+// compareColumns records missing, renamed and unexpected columns against the
+// expected ones. A missing column whose name matches an unexpected header
+// apart from case and underscores is treated as renamed and read from there.
+func (rs *ResultSet) compareColumns(expected []string) (renamed map[string]string, missing map[string]bool) {
+	renamed = make(map[string]string)
+	missing = make(map[string]bool)
+
+	wanted := make(map[string]bool, len(expected))
+	var absent []string
+	for _, column := range expected {
+		if wanted[column] {
+			continue
+		}
+		wanted[column] = true
+		if !rs.HasColumn(column) {
+			absent = append(absent, column)
+		}
+	}
+
+	extra := make(map[string]string)
+	var extraOrder []string
+	for _, header := range rs.Headers {
+		if !wanted[header] {
+			extra[normalizeName(header)] = header
+			extraOrder = append(extraOrder, header)
+		}
+	}
+
+	for _, column := range absent {
+		if header, ok := extra[normalizeName(column)]; ok {
+			renamed[column] = header
+			delete(extra, normalizeName(column))
+			rs.drift = append(rs.drift, models.SchemaDrift{
+				Kind:      models.DriftRenamedColumn,
+				ResultSet: rs.Name,
+				Column:    column,
+				Detail:    "now " + header,
+			})
+			continue
+		}
+		missing[column] = true
+		rs.drift = append(rs.drift, models.SchemaDrift{
+			Kind:      models.DriftMissingColumn,
+			ResultSet: rs.Name,
+			Column:    column,
+		})
+	}
+
+	for _, header := range extraOrder {
+		if _, ok := extra[normalizeName(header)]; ok {
+			rs.drift = append(rs.drift, models.SchemaDrift{
+				Kind:      models.DriftUnexpectedColumn,
+				ResultSet: rs.Name,
+				Column:    header,
+			})
+		}
+	}
+
+	return renamed, missing
+}
+
+func normalizeName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
@@ -0,0 +1,215 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+)
+
+func TestParseResultSets_ByName(t *testing.T) {
+	body := []byte(`{"resultSets":[
+		{"name":"Other","headers":["X"],"rowSet":[[1]]},
+		{"name":"Players","headers":["PTS","PLAYER_NAME","FG_PCT"],"rowSet":[[30,"Nikola Jokic",null],["12",77,0.5]]}
+	]}`)
+
+	sets, err := ParseResultSets(body)
+	if err != nil {
+		t.Fatalf("ParseResultSets() error = %v", err)
+	}
+	rs, err := FindResultSet(sets, "Players")
+	if err != nil {
+		t.Fatalf("FindResultSet() error = %v", err)
+	}
+	if _, err := FindResultSet(sets, "Missing"); !errors.Is(err, ErrResultSetNotFound) {
+		t.Errorf("FindResultSet(Missing) error = %v, want ErrResultSetNotFound", err)
+	}
+
+	row := rs.Row(0)
+	if pts, err := row.Int("PTS"); err != nil || pts != 30 {
+		t.Errorf("Int(PTS) = %d, %v", pts, err)
+	}
+	if name, err := row.String("PLAYER_NAME"); err != nil || name != "Nikola Jokic" {
+		t.Errorf("String(PLAYER_NAME) = %q, %v", name, err)
+	}
+	if pct, err := row.Float("FG_PCT"); err != nil || pct != 0 {
+		t.Errorf("Float(null) = %v, %v, want 0", pct, err)
+	}
+
+	row = rs.Row(1)
+	if pts, err := row.Int("PTS"); err != nil || pts != 12 {
+		t.Errorf("Int(numeric string) = %d, %v", pts, err)
+	}
+	if name, err := row.String("PLAYER_NAME"); err != nil || name != "77" {
+		t.Errorf("String(number) = %q, %v", name, err)
+	}
+	if _, err := row.Int("REB"); !errors.Is(err, ErrColumnNotFound) {
+		t.Errorf("Int(REB) error = %v, want ErrColumnNotFound", err)
+	}
+	if _, err := rs.Row(0).Int("PLAYER_NAME"); !errors.Is(err, ErrColumnType) {
+		t.Errorf("Int(PLAYER_NAME) error = %v, want ErrColumnType", err)
+	}
+}
+
+func TestParseGameLogs_ColumnsByName(t *testing.T) {
+	rs := &ResultSet{
+		Name: "PlayerGameLog",
+		Headers: []string{
+			"PTS", "AST", "SEASON_ID", "Player_ID", "Game_ID", "GAME_DATE", "MATCHUP", "WL", "NEW_COLUMN",
+			"MIN", "FGM", "FGA", "FG_PCT", "FG3M", "FG3A", "FG3_PCT", "FTM", "FTA", "FT_PCT",
+			"OREB", "DREB", "REB", "STL", "BLK", "TOV", "PF", "PLUS_MINUS", "VIDEO_AVAILABLE",
+		},
+		RowSet: [][]interface{}{{
+			28.0, 17.0, "22023", 2544.0, "0022301195", "APR 14, 2024", "LAL @ NOP", "W", "x",
+			38.0, 11.0, 17.0, 0.647, 1.0, 3.0, 0.333, 5.0, 6.0, 0.833,
+			1.0, 10.0, 11.0, 1.0, 1.0, 4.0, 1.0, 15.0, 1.0,
+		}},
+	}
+
+	logs := parseGameLogs(rs)
+	if logs[0].PTS != 28 || logs[0].AST != 17 || logs[0].GameID != "0022301195" || logs[0].FGPct != 0.647 {
+		t.Errorf("reordered columns decoded as %+v", logs[0])
+	}
+}
+
+func TestDecodeRows_Drift(t *testing.T) {
+	rs := &ResultSet{
+		Name:    "Players",
+		Headers: []string{"player_id", "PTS", "NEW_COLUMN"},
+		RowSet: [][]interface{}{
+			{1.0, 30.0, "x"},
+			{2.0, "DNP", "y"},
+			{3.0, "n/a", "z"},
+		},
+	}
+
+	type player struct {
+		ID  int
+		PTS float64
+		REB float64
+	}
+	players := decodeRows(rs, func(row *rowReader) player {
+		return player{ID: row.Int("PLAYER_ID"), PTS: row.Float("PTS"), REB: row.Float("REB")}
+	})
+
+	if len(players) != 3 || players[2].ID != 3 || players[0].PTS != 30 || players[1].PTS != 0 {
+		t.Errorf("decodeRows() = %+v", players)
+	}
+
+	want := []models.SchemaDrift{
+		{Kind: models.DriftRenamedColumn, ResultSet: "Players", Column: "PLAYER_ID", Detail: "now player_id"},
+		{Kind: models.DriftMissingColumn, ResultSet: "Players", Column: "REB"},
+		{Kind: models.DriftUnexpectedColumn, ResultSet: "Players", Column: "NEW_COLUMN"},
+		{Kind: models.DriftInvalidValue, ResultSet: "Players", Column: "PTS"},
+	}
+	if len(rs.drift) != len(want) {
+		t.Fatalf("drift = %v, want %d entries", rs.drift, len(want))
+	}
+	for i, d := range rs.drift {
+		if d.Kind != want[i].Kind || d.Column != want[i].Column {
+			t.Errorf("drift[%d] = %v, want %v", i, d, want[i])
+		}
+	}
+	if detail := rs.drift[3].Detail; !strings.HasPrefix(detail, "2 row(s)") {
+		t.Errorf("invalid value detail = %q, want row count", detail)
+	}
+}
+
+func TestDecodeRows_Opt(t *testing.T) {
+	rs := &ResultSet{
+		Name:    "PlayerStats",
+		Headers: []string{"PTS", "MIN"},
+		RowSet: [][]interface{}{
+			{0.0, 12.5},
+			{nil, nil},
+			{"DNP", nil},
+		},
+	}
+
+	type line struct {
+		PTS models.Opt[int]
+		MIN models.Opt[float64]
+		AST models.Opt[int]
+	}
+	lines := decodeRows(rs, func(row *rowReader) line {
+		return line{PTS: row.OptInt("PTS"), MIN: row.OptFloat("MIN"), AST: row.OptInt("AST")}
+	})
+
+	if !lines[0].PTS.Valid || lines[0].PTS.Value != 0 || lines[0].MIN.Value != 12.5 {
+		t.Errorf("played line = %+v, want present values", lines[0])
+	}
+	if lines[1].PTS.Valid || lines[1].MIN.Valid || lines[2].PTS.Valid || lines[0].AST.Valid {
+		t.Errorf("lines = %+v, want null, invalid and missing values absent", lines)
+	}
+
+	var kinds []models.DriftKind
+	for _, d := range rs.drift {
+		kinds = append(kinds, d.Kind)
+	}
+	want := []models.DriftKind{models.DriftMissingColumn, models.DriftInvalidValue}
+	if fmt.Sprint(kinds) != fmt.Sprint(want) {
+		t.Errorf("drift = %v, want %v", rs.drift, want)
+	}
+}
+
+func TestDecodeRows_MissingColumnWhenEmpty(t *testing.T) {
+	rs := &ResultSet{Name: "PlayerGameLog", Headers: []string{"SEASON_ID"}}
+
+	parseGameLogs(rs)
+	if len(rs.drift) == 0 || rs.drift[0].Kind != models.DriftMissingColumn {
+		t.Errorf("drift = %v, want missing columns", rs.drift)
+	}
+}
+
+func TestRawStatsResponse_Drift(t *testing.T) {
+	var resp rawStatsResponse
+	if err := json.Unmarshal([]byte(`{"resultSets":[
+		{"name":"playerGameLog","headers":[],"rowSet":[]},
+		{"name":"Extra","headers":[],"rowSet":[]}
+	]}`), &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	if rs := resp.resultSet("PlayerGameLog"); rs == nil || rs.Name != "playerGameLog" {
+		t.Errorf("resultSet(PlayerGameLog) = %v, want renamed set", rs)
+	}
+	resp.resultSet("Totals")
+
+	var kinds []models.DriftKind
+	for _, d := range resp.drift() {
+		if d.Column == "" {
+			kinds = append(kinds, d.Kind)
+		}
+	}
+	want := []models.DriftKind{models.DriftRenamedResultSet, models.DriftMissingResultSet, models.DriftUnexpectedResultSet}
+	if fmt.Sprint(kinds) != fmt.Sprint(want) {
+		t.Errorf("result set drift = %v, want %v", kinds, want)
+	}
+}
+
+func TestParseSeasonStats_College(t *testing.T) {
+	rs := &ResultSet{
+		Name: "SeasonTotalsCollegeSeason",
+		Headers: []string{
+			"PLAYER_ID", "SEASON_ID", "LEAGUE_ID", "ORGANIZATION_ID", "SCHOOL_NAME", "PLAYER_AGE", "GP", "GS",
+			"MIN", "FGM", "FGA", "FG_PCT", "FG3M", "FG3A", "FG3_PCT", "FTM", "FTA", "FT_PCT",
+			"OREB", "DREB", "REB", "AST", "STL", "BLK", "TOV", "PF", "PTS",
+		},
+		RowSet: [][]interface{}{{
+			201939.0, "2006-07", "00", 97.0, "Davidson", 19.0, 34.0, 34.0,
+			30.0, 7.6, 16.4, 0.463, 4.5, 10.6, 0.408, 2.9, 3.3, 0.855,
+			0.9, 3.7, 4.6, 2.8, 1.8, 0.2, 3.0, 2.2, 21.5,
+		}},
+	}
+
+	stats := parseSeasonStats(rs)
+	if len(rs.drift) != 0 {
+		t.Errorf("college season drift = %v, want none", rs.drift)
+	}
+	if stats[0].TeamID != 97 || stats[0].TeamAbbreviation != "Davidson" || stats[0].PTS != 21.5 {
+		t.Errorf("college season decoded as %+v", stats[0])
+	}
+}
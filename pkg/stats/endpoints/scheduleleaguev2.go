@@ -0,0 +1,236 @@
+package endpoints
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/stats"
+	"github.com/n-ae/nba-api-go/pkg/stats/parameters"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
+)
+
+// ScheduleLeagueV2Request contains parameters for the ScheduleLeagueV2
+// endpoint. Season defaults to parameters.CurrentSeason() and LeagueID to
+// the NBA.
+type ScheduleLeagueV2Request struct {
+	Season   *parameters.Season
+	LeagueID *parameters.LeagueID
+}
+
+type ScheduleLeagueV2Broadcaster struct {
+	BroadcasterScope        string `json:"broadcasterScope"`
+	BroadcasterMedia        string `json:"broadcasterMedia"`
+	BroadcasterID           int    `json:"broadcasterId"`
+	BroadcasterDisplay      string `json:"broadcasterDisplay"`
+	BroadcasterAbbreviation string `json:"broadcasterAbbreviation"`
+	BroadcasterDescription  string `json:"broadcasterDescription"`
+	TapeDelayComments       string `json:"tapeDelayComments"`
+	BroadcasterVideoLink    string `json:"broadcasterVideoLink"`
+	BroadcasterTeamID       int    `json:"broadcasterTeamId"`
+}
+
+type ScheduleLeagueV2Broadcasters struct {
+	NationalBroadcasters      []ScheduleLeagueV2Broadcaster `json:"nationalBroadcasters"`
+	NationalRadioBroadcasters []ScheduleLeagueV2Broadcaster `json:"nationalRadioBroadcasters"`
+	NationalOttBroadcasters   []ScheduleLeagueV2Broadcaster `json:"nationalOttBroadcasters"`
+	HomeTvBroadcasters        []ScheduleLeagueV2Broadcaster `json:"homeTvBroadcasters"`
+	HomeRadioBroadcasters     []ScheduleLeagueV2Broadcaster `json:"homeRadioBroadcasters"`
+	HomeOttBroadcasters       []ScheduleLeagueV2Broadcaster `json:"homeOttBroadcasters"`
+	AwayTvBroadcasters        []ScheduleLeagueV2Broadcaster `json:"awayTvBroadcasters"`
+	AwayRadioBroadcasters     []ScheduleLeagueV2Broadcaster `json:"awayRadioBroadcasters"`
+	AwayOttBroadcasters       []ScheduleLeagueV2Broadcaster `json:"awayOttBroadcasters"`
+	IntlRadioBroadcasters     []ScheduleLeagueV2Broadcaster `json:"intlRadioBroadcasters"`
+	IntlTvBroadcasters        []ScheduleLeagueV2Broadcaster `json:"intlTvBroadcasters"`
+	IntlOttBroadcasters       []ScheduleLeagueV2Broadcaster `json:"intlOttBroadcasters"`
+}
+
+// ScheduleLeagueV2Team is a team in a scheduled game. Wins, Losses and Score
+// are zero until the game has been played.
+type ScheduleLeagueV2Team struct {
+	TeamID      int    `json:"teamId"`
+	TeamName    string `json:"teamName"`
+	TeamCity    string `json:"teamCity"`
+	TeamTricode string `json:"teamTricode"`
+	TeamSlug    string `json:"teamSlug"`
+	Wins        int    `json:"wins"`
+	Losses      int    `json:"losses"`
+	Score       int    `json:"score"`
+	Seed        int    `json:"seed"`
+}
+
+type ScheduleLeagueV2PointsLeader struct {
+	PersonID    int     `json:"personId"`
+	FirstName   string  `json:"firstName"`
+	LastName    string  `json:"lastName"`
+	TeamID      int     `json:"teamId"`
+	TeamCity    string  `json:"teamCity"`
+	TeamName    string  `json:"teamName"`
+	TeamTricode string  `json:"teamTricode"`
+	Points      float64 `json:"points"`
+}
+
+// ScheduleLeagueV2Arena is where a game is played.
+type ScheduleLeagueV2Arena struct {
+	Name    string
+	City    string
+	State   string
+	Neutral bool
+}
+
+type ScheduleLeagueV2Game struct {
+	GameID           string                         `json:"gameId"`
+	GameCode         string                         `json:"gameCode"`
+	GameStatus       int                            `json:"gameStatus"`
+	GameStatusText   string                         `json:"gameStatusText"`
+	GameSequence     int                            `json:"gameSequence"`
+	GameDateEst      string                         `json:"gameDateEst"`
+	GameTimeEst      string                         `json:"gameTimeEst"`
+	GameDateTimeEst  string                         `json:"gameDateTimeEst"`
+	GameDateUTC      string                         `json:"gameDateUTC"`
+	GameTimeUTC      string                         `json:"gameTimeUTC"`
+	GameDateTimeUTC  string                         `json:"gameDateTimeUTC"`
+	AwayTeamTime     string                         `json:"awayTeamTime"`
+	HomeTeamTime     string                         `json:"homeTeamTime"`
+	Day              string                         `json:"day"`
+	MonthNum         int                            `json:"monthNum"`
+	WeekNumber       int                            `json:"weekNumber"`
+	WeekName         string                         `json:"weekName"`
+	IfNecessary      bool                           `json:"ifNecessary"`
+	SeriesGameNumber string                         `json:"seriesGameNumber"`
+	GameLabel        string                         `json:"gameLabel"`
+	GameSubLabel     string                         `json:"gameSubLabel"`
+	SeriesText       string                         `json:"seriesText"`
+	ArenaName        string                         `json:"arenaName"`
+	ArenaState       string                         `json:"arenaState"`
+	ArenaCity        string                         `json:"arenaCity"`
+	PostponedStatus  string                         `json:"postponedStatus"`
+	BranchLink       string                         `json:"branchLink"`
+	GameSubtype      string                         `json:"gameSubtype"`
+	IsNeutral        bool                           `json:"isNeutral"`
+	Broadcasters     ScheduleLeagueV2Broadcasters   `json:"broadcasters"`
+	HomeTeam         ScheduleLeagueV2Team           `json:"homeTeam"`
+	AwayTeam         ScheduleLeagueV2Team           `json:"awayTeam"`
+	PointsLeaders    []ScheduleLeagueV2PointsLeader `json:"pointsLeaders"`
+}
+
+// Date parses GameDateEst as midnight Eastern.
+func (g ScheduleLeagueV2Game) Date() (time.Time, error) {
+	return timeutil.ParseGameDate(g.GameDateEst)
+}
+
+// StartTime parses GameDateTimeUTC.
+func (g ScheduleLeagueV2Game) StartTime() (time.Time, error) {
+	return timeutil.ParseGameTime(g.GameDateTimeUTC)
+}
+
+// StartTimeET parses GameDateTimeEst as an Eastern time.
+func (g ScheduleLeagueV2Game) StartTimeET() (time.Time, error) {
+	return timeutil.ParseGameET(g.GameDateTimeEst)
+}
+
+func (g ScheduleLeagueV2Game) Arena() ScheduleLeagueV2Arena {
+	return ScheduleLeagueV2Arena{Name: g.ArenaName, City: g.ArenaCity, State: g.ArenaState, Neutral: g.IsNeutral}
+}
+
+// ScheduleLeagueV2GameDate holds the games of one day. GameDate looks like
+// "10/22/2024 00:00:00".
+type ScheduleLeagueV2GameDate struct {
+	GameDate string                 `json:"gameDate"`
+	Games    []ScheduleLeagueV2Game `json:"games"`
+}
+
+// Date parses GameDate as midnight Eastern.
+func (d ScheduleLeagueV2GameDate) Date() (time.Time, error) {
+	return timeutil.ParseGameDate(d.GameDate)
+}
+
+// ScheduleLeagueV2Week is a week of the regular season. Preseason and
+// postseason games have WeekNumber 0.
+type ScheduleLeagueV2Week struct {
+	WeekNumber int    `json:"weekNumber"`
+	WeekName   string `json:"weekName"`
+	StartDate  string `json:"startDate"`
+	EndDate    string `json:"endDate"`
+}
+
+// Dates parses StartDate and EndDate as midnight Eastern. EndDate is the last
+// day of the week.
+func (w ScheduleLeagueV2Week) Dates() (start, end time.Time, err error) {
+	if start, err = timeutil.ParseGameDate(w.StartDate); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if end, err = timeutil.ParseGameDate(w.EndDate); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return start, end, nil
+}
+
+// ScheduleLeagueV2Response is the leagueSchedule object of the season
+// schedule, shared by the scheduleleaguev2 endpoint and the CDN
+// scheduleLeagueV2.json file.
+type ScheduleLeagueV2Response struct {
+	SeasonYear string                     `json:"seasonYear"`
+	LeagueID   string                     `json:"leagueId"`
+	GameDates  []ScheduleLeagueV2GameDate `json:"gameDates"`
+	Weeks      []ScheduleLeagueV2Week     `json:"weeks"`
+}
+
+// Games returns every game of the season in schedule order.
+func (s *ScheduleLeagueV2Response) Games() []ScheduleLeagueV2Game {
+	var games []ScheduleLeagueV2Game
+	for _, date := range s.GameDates {
+		games = append(games, date.Games...)
+	}
+	return games
+}
+
+// TeamGames returns the games of one team in schedule order.
+func (s *ScheduleLeagueV2Response) TeamGames(teamID int) []ScheduleLeagueV2Game {
+	var games []ScheduleLeagueV2Game
+	for _, date := range s.GameDates {
+		for _, game := range date.Games {
+			if game.HomeTeam.TeamID == teamID || game.AwayTeam.TeamID == teamID {
+				games = append(games, game)
+			}
+		}
+	}
+	return games
+}
+
+type scheduleLeagueV2Document struct {
+	Meta           json.RawMessage           `json:"meta"`
+	LeagueSchedule *ScheduleLeagueV2Response `json:"leagueSchedule"`
+}
+
+// GetScheduleLeagueV2 retrieves the full season schedule from the
+// scheduleleaguev2 endpoint.
+func GetScheduleLeagueV2(ctx context.Context, client *stats.Client, req ScheduleLeagueV2Request) (*models.Response[*ScheduleLeagueV2Response], error) {
+	season := parameters.CurrentSeason()
+	if req.Season != nil {
+		season = *req.Season
+	}
+	leagueID := parameters.LeagueIDNBA
+	if req.LeagueID != nil {
+		leagueID = *req.LeagueID
+	}
+
+	params := url.Values{}
+	params.Set("Season", string(season))
+	params.Set("LeagueID", string(leagueID))
+
+	var doc scheduleLeagueV2Document
+	raw, drift, err := getDocument(ctx, client, "scheduleleaguev2", params, &doc)
+	if err != nil {
+		return nil, err
+	}
+
+	response := doc.LeagueSchedule
+	if response == nil {
+		response = &ScheduleLeagueV2Response{}
+	}
+
+	return newDriftResponse(client, response, raw, drift)
+}
@@ -0,0 +1,62 @@
+package endpoints
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/stats/parameters"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
+)
+
+func TestScheduleLeagueV2_Replay(t *testing.T) {
+	season := parameters.Season("2024-25")
+	resp, err := GetScheduleLeagueV2(context.Background(), replayClient(t, "scheduleleaguev2"), ScheduleLeagueV2Request{Season: &season})
+	if err != nil {
+		t.Fatalf("GetScheduleLeagueV2() error = %v", err)
+	}
+	if len(resp.Warnings) != 0 {
+		t.Errorf("Warnings = %v, want none", resp.Warnings)
+	}
+
+	schedule := resp.Data
+	if schedule.SeasonYear != "2024-25" || len(schedule.GameDates) != 2 || len(schedule.Weeks) != 2 {
+		t.Fatalf("schedule = %+v", schedule)
+	}
+	if day, err := schedule.GameDates[1].Date(); err != nil || !day.Equal(time.Date(2024, time.October, 22, 0, 0, 0, 0, timeutil.Eastern)) {
+		t.Errorf("GameDate.Date() = %v, %v", day, err)
+	}
+
+	games := schedule.Games()
+	if len(games) != 3 {
+		t.Fatalf("Games() = %d games, want 3", len(games))
+	}
+	preseason := games[0]
+	if arena := preseason.Arena(); arena.Name != "Etihad Arena" || !arena.Neutral {
+		t.Errorf("Arena() = %+v", arena)
+	}
+	if len(preseason.PointsLeaders) != 1 || preseason.AwayTeam.Score != 107 {
+		t.Errorf("preseason game = %+v", preseason)
+	}
+
+	opener := games[1]
+	if opener.WeekNumber != 1 || opener.Broadcasters.NationalBroadcasters[0].BroadcasterDisplay != "TNT" {
+		t.Errorf("opener = %+v", opener)
+	}
+	start, err := opener.StartTime()
+	if err != nil {
+		t.Fatalf("StartTime() error = %v", err)
+	}
+	if et, err := opener.StartTimeET(); err != nil || !et.Equal(start) {
+		t.Errorf("StartTimeET() = %v, %v, want %v", et, err, start)
+	}
+
+	if lakers := schedule.TeamGames(1610612747); len(lakers) != 1 || lakers[0].GameID != "0022400062" {
+		t.Errorf("TeamGames() = %+v", lakers)
+	}
+
+	start, end, err := schedule.Weeks[0].Dates()
+	if err != nil || end.Sub(start) != 6*24*time.Hour {
+		t.Errorf("Week.Dates() = %v, %v, %v", start, end, err)
+	}
+}
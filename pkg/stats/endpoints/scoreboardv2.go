@@ -159,169 +159,142 @@ func GetScoreboardV2(ctx context.Context, client *stats.Client, req ScoreboardV2
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "scoreboardv2", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "scoreboardv2", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &ScoreboardV2Response{}
-	if len(rawResp.ResultSets) > 0 {
-		response.GameHeader = make([]ScoreboardV2GameHeader, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 14 {
-				item := ScoreboardV2GameHeader{
-					GAME_DATE_EST:                    toString(row[0]),
-					GAME_SEQUENCE:                    toInt(row[1]),
-					GAME_ID:                          toString(row[2]),
-					GAME_STATUS_ID:                   toString(row[3]),
-					GAME_STATUS_TEXT:                 toString(row[4]),
-					GAMECODE:                         toString(row[5]),
-					HOME_TEAM_ID:                     toInt(row[6]),
-					VISITOR_TEAM_ID:                  toInt(row[7]),
-					SEASON:                           toString(row[8]),
-					LIVE_PERIOD:                      toInt(row[9]),
-					LIVE_PC_TIME:                     toString(row[10]),
-					NATL_TV_BROADCASTER_ABBREVIATION: toString(row[11]),
-					LIVE_PERIOD_TIME_BCAST:           toFloat(row[12]),
-					WH_STATUS:                        toString(row[13]),
-				}
-				response.GameHeader = append(response.GameHeader, item)
+	if rs := rawResp.resultSet("GameHeader"); rs != nil {
+		response.GameHeader = decodeRows(rs, func(row *rowReader) ScoreboardV2GameHeader {
+			return ScoreboardV2GameHeader{
+				GAME_DATE_EST:                    row.String("GAME_DATE_EST"),
+				GAME_SEQUENCE:                    row.Int("GAME_SEQUENCE"),
+				GAME_ID:                          row.String("GAME_ID"),
+				GAME_STATUS_ID:                   row.String("GAME_STATUS_ID"),
+				GAME_STATUS_TEXT:                 row.String("GAME_STATUS_TEXT"),
+				GAMECODE:                         row.String("GAMECODE"),
+				HOME_TEAM_ID:                     row.Int("HOME_TEAM_ID"),
+				VISITOR_TEAM_ID:                  row.Int("VISITOR_TEAM_ID"),
+				SEASON:                           row.String("SEASON"),
+				LIVE_PERIOD:                      row.Int("LIVE_PERIOD"),
+				LIVE_PC_TIME:                     row.String("LIVE_PC_TIME"),
+				NATL_TV_BROADCASTER_ABBREVIATION: row.String("NATL_TV_BROADCASTER_ABBREVIATION"),
+				LIVE_PERIOD_TIME_BCAST:           row.Float("LIVE_PERIOD_TIME_BCAST"),
+				WH_STATUS:                        row.String("WH_STATUS"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.LineScore = make([]ScoreboardV2LineScore, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 28 {
-				item := ScoreboardV2LineScore{
-					GAME_DATE_EST:     toString(row[0]),
-					GAME_SEQUENCE:     toInt(row[1]),
-					GAME_ID:           toString(row[2]),
-					TEAM_ID:           toInt(row[3]),
-					TEAM_ABBREVIATION: toString(row[4]),
-					TEAM_CITY_NAME:    toString(row[5]),
-					TEAM_WINS_LOSSES:  toString(row[6]),
-					PTS_QTR1:          toFloat(row[7]),
-					PTS_QTR2:          toFloat(row[8]),
-					PTS_QTR3:          toFloat(row[9]),
-					PTS_QTR4:          toFloat(row[10]),
-					PTS_OT1:           toFloat(row[11]),
-					PTS_OT2:           toFloat(row[12]),
-					PTS_OT3:           toFloat(row[13]),
-					PTS_OT4:           toFloat(row[14]),
-					PTS_OT5:           toFloat(row[15]),
-					PTS_OT6:           toFloat(row[16]),
-					PTS_OT7:           toFloat(row[17]),
-					PTS_OT8:           toFloat(row[18]),
-					PTS_OT9:           toFloat(row[19]),
-					PTS_OT10:          toFloat(row[20]),
-					PTS:               toFloat(row[21]),
-					FG_PCT:            toFloat(row[22]),
-					FT_PCT:            toFloat(row[23]),
-					FG3_PCT:           toFloat(row[24]),
-					AST:               toFloat(row[25]),
-					REB:               toFloat(row[26]),
-					TOV:               toFloat(row[27]),
-				}
-				response.LineScore = append(response.LineScore, item)
+	if rs := rawResp.resultSet("LineScore"); rs != nil {
+		response.LineScore = decodeRows(rs, func(row *rowReader) ScoreboardV2LineScore {
+			return ScoreboardV2LineScore{
+				GAME_DATE_EST:     row.String("GAME_DATE_EST"),
+				GAME_SEQUENCE:     row.Int("GAME_SEQUENCE"),
+				GAME_ID:           row.String("GAME_ID"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				TEAM_CITY_NAME:    row.String("TEAM_CITY_NAME"),
+				TEAM_WINS_LOSSES:  row.String("TEAM_WINS_LOSSES"),
+				PTS_QTR1:          row.Float("PTS_QTR1"),
+				PTS_QTR2:          row.Float("PTS_QTR2"),
+				PTS_QTR3:          row.Float("PTS_QTR3"),
+				PTS_QTR4:          row.Float("PTS_QTR4"),
+				PTS_OT1:           row.Float("PTS_OT1"),
+				PTS_OT2:           row.Float("PTS_OT2"),
+				PTS_OT3:           row.Float("PTS_OT3"),
+				PTS_OT4:           row.Float("PTS_OT4"),
+				PTS_OT5:           row.Float("PTS_OT5"),
+				PTS_OT6:           row.Float("PTS_OT6"),
+				PTS_OT7:           row.Float("PTS_OT7"),
+				PTS_OT8:           row.Float("PTS_OT8"),
+				PTS_OT9:           row.Float("PTS_OT9"),
+				PTS_OT10:          row.Float("PTS_OT10"),
+				PTS:               row.Float("PTS"),
+				FG_PCT:            row.Float("FG_PCT"),
+				FT_PCT:            row.Float("FT_PCT"),
+				FG3_PCT:           row.Float("FG3_PCT"),
+				AST:               row.Float("AST"),
+				REB:               row.Float("REB"),
+				TOV:               row.Float("TOV"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 2 {
-		response.SeriesStandings = make([]ScoreboardV2SeriesStandings, 0, len(rawResp.ResultSets[2].RowSet))
-		for _, row := range rawResp.ResultSets[2].RowSet {
-			if len(row) >= 7 {
-				item := ScoreboardV2SeriesStandings{
-					GAME_ID:          toString(row[0]),
-					HOME_TEAM_ID:     toInt(row[1]),
-					VISITOR_TEAM_ID:  toInt(row[2]),
-					GAME_DATE_EST:    toString(row[3]),
-					HOME_TEAM_WINS:   toString(row[4]),
-					HOME_TEAM_LOSSES: toString(row[5]),
-					SERIES_LEADER:    toString(row[6]),
-				}
-				response.SeriesStandings = append(response.SeriesStandings, item)
+	if rs := rawResp.resultSet("SeriesStandings"); rs != nil {
+		response.SeriesStandings = decodeRows(rs, func(row *rowReader) ScoreboardV2SeriesStandings {
+			return ScoreboardV2SeriesStandings{
+				GAME_ID:          row.String("GAME_ID"),
+				HOME_TEAM_ID:     row.Int("HOME_TEAM_ID"),
+				VISITOR_TEAM_ID:  row.Int("VISITOR_TEAM_ID"),
+				GAME_DATE_EST:    row.String("GAME_DATE_EST"),
+				HOME_TEAM_WINS:   row.String("HOME_TEAM_WINS"),
+				HOME_TEAM_LOSSES: row.String("HOME_TEAM_LOSSES"),
+				SERIES_LEADER:    row.String("SERIES_LEADER"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 3 {
-		response.LastMeeting = make([]ScoreboardV2LastMeeting, 0, len(rawResp.ResultSets[3].RowSet))
-		for _, row := range rawResp.ResultSets[3].RowSet {
-			if len(row) >= 13 {
-				item := ScoreboardV2LastMeeting{
-					GAME_ID:                             toString(row[0]),
-					LAST_GAME_ID:                        toString(row[1]),
-					LAST_GAME_DATE_EST:                  toString(row[2]),
-					LAST_GAME_HOME_TEAM_ID:              toInt(row[3]),
-					LAST_GAME_HOME_TEAM_CITY:            toString(row[4]),
-					LAST_GAME_HOME_TEAM_NAME:            toString(row[5]),
-					LAST_GAME_HOME_TEAM_ABBREVIATION:    toString(row[6]),
-					LAST_GAME_HOME_TEAM_POINTS:          toFloat(row[7]),
-					LAST_GAME_VISITOR_TEAM_ID:           toInt(row[8]),
-					LAST_GAME_VISITOR_TEAM_CITY:         toString(row[9]),
-					LAST_GAME_VISITOR_TEAM_NAME:         toString(row[10]),
-					LAST_GAME_VISITOR_TEAM_ABBREVIATION: toString(row[11]),
-					LAST_GAME_VISITOR_TEAM_POINTS:       toFloat(row[12]),
-				}
-				response.LastMeeting = append(response.LastMeeting, item)
+	if rs := rawResp.resultSet("LastMeeting"); rs != nil {
+		response.LastMeeting = decodeRows(rs, func(row *rowReader) ScoreboardV2LastMeeting {
+			return ScoreboardV2LastMeeting{
+				GAME_ID:                             row.String("GAME_ID"),
+				LAST_GAME_ID:                        row.String("LAST_GAME_ID"),
+				LAST_GAME_DATE_EST:                  row.String("LAST_GAME_DATE_EST"),
+				LAST_GAME_HOME_TEAM_ID:              row.Int("LAST_GAME_HOME_TEAM_ID"),
+				LAST_GAME_HOME_TEAM_CITY:            row.String("LAST_GAME_HOME_TEAM_CITY"),
+				LAST_GAME_HOME_TEAM_NAME:            row.String("LAST_GAME_HOME_TEAM_NAME"),
+				LAST_GAME_HOME_TEAM_ABBREVIATION:    row.String("LAST_GAME_HOME_TEAM_ABBREVIATION"),
+				LAST_GAME_HOME_TEAM_POINTS:          row.Float("LAST_GAME_HOME_TEAM_POINTS"),
+				LAST_GAME_VISITOR_TEAM_ID:           row.Int("LAST_GAME_VISITOR_TEAM_ID"),
+				LAST_GAME_VISITOR_TEAM_CITY:         row.String("LAST_GAME_VISITOR_TEAM_CITY"),
+				LAST_GAME_VISITOR_TEAM_NAME:         row.String("LAST_GAME_VISITOR_TEAM_NAME"),
+				LAST_GAME_VISITOR_TEAM_ABBREVIATION: row.String("LAST_GAME_VISITOR_TEAM_ABBREVIATION"),
+				LAST_GAME_VISITOR_TEAM_POINTS:       row.Float("LAST_GAME_VISITOR_TEAM_POINTS"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 4 {
-		response.EastConfStandingsByDay = make([]ScoreboardV2EastConfStandingsByDay, 0, len(rawResp.ResultSets[4].RowSet))
-		for _, row := range rawResp.ResultSets[4].RowSet {
-			if len(row) >= 12 {
-				item := ScoreboardV2EastConfStandingsByDay{
-					TEAM_ID:       toInt(row[0]),
-					LEAGUE_ID:     toString(row[1]),
-					SEASON_ID:     toString(row[2]),
-					STANDINGSDATE: toString(row[3]),
-					CONFERENCE:    toString(row[4]),
-					TEAM:          toString(row[5]),
-					G:             toString(row[6]),
-					W:             toString(row[7]),
-					L:             toString(row[8]),
-					W_PCT:         toFloat(row[9]),
-					HOME_RECORD:   toString(row[10]),
-					ROAD_RECORD:   toString(row[11]),
-				}
-				response.EastConfStandingsByDay = append(response.EastConfStandingsByDay, item)
+	if rs := rawResp.resultSet("EastConfStandingsByDay"); rs != nil {
+		response.EastConfStandingsByDay = decodeRows(rs, func(row *rowReader) ScoreboardV2EastConfStandingsByDay {
+			return ScoreboardV2EastConfStandingsByDay{
+				TEAM_ID:       row.Int("TEAM_ID"),
+				LEAGUE_ID:     row.String("LEAGUE_ID"),
+				SEASON_ID:     row.String("SEASON_ID"),
+				STANDINGSDATE: row.String("STANDINGSDATE"),
+				CONFERENCE:    row.String("CONFERENCE"),
+				TEAM:          row.String("TEAM"),
+				G:             row.String("G"),
+				W:             row.String("W"),
+				L:             row.String("L"),
+				W_PCT:         row.Float("W_PCT"),
+				HOME_RECORD:   row.String("HOME_RECORD"),
+				ROAD_RECORD:   row.String("ROAD_RECORD"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 5 {
-		response.WestConfStandingsByDay = make([]ScoreboardV2WestConfStandingsByDay, 0, len(rawResp.ResultSets[5].RowSet))
-		for _, row := range rawResp.ResultSets[5].RowSet {
-			if len(row) >= 12 {
-				item := ScoreboardV2WestConfStandingsByDay{
-					TEAM_ID:       toInt(row[0]),
-					LEAGUE_ID:     toString(row[1]),
-					SEASON_ID:     toString(row[2]),
-					STANDINGSDATE: toString(row[3]),
-					CONFERENCE:    toString(row[4]),
-					TEAM:          toString(row[5]),
-					G:             toString(row[6]),
-					W:             toString(row[7]),
-					L:             toString(row[8]),
-					W_PCT:         toFloat(row[9]),
-					HOME_RECORD:   toString(row[10]),
-					ROAD_RECORD:   toString(row[11]),
-				}
-				response.WestConfStandingsByDay = append(response.WestConfStandingsByDay, item)
+	if rs := rawResp.resultSet("WestConfStandingsByDay"); rs != nil {
+		response.WestConfStandingsByDay = decodeRows(rs, func(row *rowReader) ScoreboardV2WestConfStandingsByDay {
+			return ScoreboardV2WestConfStandingsByDay{
+				TEAM_ID:       row.Int("TEAM_ID"),
+				LEAGUE_ID:     row.String("LEAGUE_ID"),
+				SEASON_ID:     row.String("SEASON_ID"),
+				STANDINGSDATE: row.String("STANDINGSDATE"),
+				CONFERENCE:    row.String("CONFERENCE"),
+				TEAM:          row.String("TEAM"),
+				G:             row.String("G"),
+				W:             row.String("W"),
+				L:             row.String("L"),
+				W_PCT:         row.Float("W_PCT"),
+				HOME_RECORD:   row.String("HOME_RECORD"),
+				ROAD_RECORD:   row.String("ROAD_RECORD"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 6 {
-		response.Available = make([]ScoreboardV2Available, 0, len(rawResp.ResultSets[6].RowSet))
-		for _, row := range rawResp.ResultSets[6].RowSet {
-			if len(row) >= 2 {
-				item := ScoreboardV2Available{
-					GAME_ID:      toString(row[0]),
-					PT_AVAILABLE: toString(row[1]),
-				}
-				response.Available = append(response.Available, item)
+	if rs := rawResp.resultSet("Available"); rs != nil {
+		response.Available = decodeRows(rs, func(row *rowReader) ScoreboardV2Available {
+			return ScoreboardV2Available{
+				GAME_ID:      row.String("GAME_ID"),
+				PT_AVAILABLE: row.String("PT_AVAILABLE"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
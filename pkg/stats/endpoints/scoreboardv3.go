@@ -2,12 +2,15 @@ package endpoints
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/n-ae/nba-api-go/pkg/models"
 	"github.com/n-ae/nba-api-go/pkg/stats"
 	"github.com/n-ae/nba-api-go/pkg/stats/parameters"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
 )
 
 // ScoreboardV3Request contains parameters for the ScoreboardV3 endpoint
@@ -16,34 +19,132 @@ type ScoreboardV3Request struct {
 	LeagueID *parameters.LeagueID
 }
 
-// ScoreboardV3GameHeader represents the GameHeader result set for ScoreboardV3
-type ScoreboardV3GameHeader struct {
-	gameId             string `json:"gameId"`
-	gameCode           string `json:"gameCode"`
-	gameStatus         string `json:"gameStatus"`
-	gameStatusText     string `json:"gameStatusText"`
-	period             int    `json:"period"`
-	gameClock          string `json:"gameClock"`
-	gameTimeUTC        string `json:"gameTimeUTC"`
-	gameEt             string `json:"gameEt"`
-	regulationPeriods  int    `json:"regulationPeriods"`
-	seriesGameNumber   string `json:"seriesGameNumber"`
-	seriesText         string `json:"seriesText"`
-	homeTeamId         string `json:"homeTeamId"`
-	homeTeamName       string `json:"homeTeamName"`
-	homeTeamCity       string `json:"homeTeamCity"`
-	homeTeamTricode    string `json:"homeTeamTricode"`
-	homeTeamScore      string `json:"homeTeamScore"`
-	visitorTeamId      string `json:"visitorTeamId"`
-	visitorTeamName    string `json:"visitorTeamName"`
-	visitorTeamCity    string `json:"visitorTeamCity"`
-	visitorTeamTricode string `json:"visitorTeamTricode"`
-	visitorTeamScore   string `json:"visitorTeamScore"`
+type ScoreboardV3Period struct {
+	Period     int    `json:"period"`
+	PeriodType string `json:"periodType"`
+	Score      int    `json:"score"`
 }
 
-// ScoreboardV3Response contains the response data from the ScoreboardV3 endpoint
+type ScoreboardV3Team struct {
+	TeamID            int                  `json:"teamId"`
+	TeamName          string               `json:"teamName"`
+	TeamCity          string               `json:"teamCity"`
+	TeamTricode       string               `json:"teamTricode"`
+	TeamSlug          string               `json:"teamSlug"`
+	Wins              int                  `json:"wins"`
+	Losses            int                  `json:"losses"`
+	Score             int                  `json:"score"`
+	Seed              models.Opt[int]      `json:"seed"`
+	InBonus           models.Opt[string]   `json:"inBonus"`
+	TimeoutsRemaining int                  `json:"timeoutsRemaining"`
+	Periods           []ScoreboardV3Period `json:"periods"`
+}
+
+// ScoreboardV3Leader is a player's line in the game.
+type ScoreboardV3Leader struct {
+	PersonID    int    `json:"personId"`
+	Name        string `json:"name"`
+	PlayerSlug  string `json:"playerSlug"`
+	JerseyNum   string `json:"jerseyNum"`
+	Position    string `json:"position"`
+	TeamTricode string `json:"teamTricode"`
+	Points      int    `json:"points"`
+	Rebounds    int    `json:"rebounds"`
+	Assists     int    `json:"assists"`
+}
+
+// ScoreboardV3TeamLeader is a player's season averages.
+type ScoreboardV3TeamLeader struct {
+	PersonID    int     `json:"personId"`
+	Name        string  `json:"name"`
+	PlayerSlug  string  `json:"playerSlug"`
+	JerseyNum   string  `json:"jerseyNum"`
+	Position    string  `json:"position"`
+	TeamTricode string  `json:"teamTricode"`
+	Points      float64 `json:"points"`
+	Rebounds    float64 `json:"rebounds"`
+	Assists     float64 `json:"assists"`
+}
+
+type ScoreboardV3Broadcaster struct {
+	BroadcasterID          int    `json:"broadcasterId"`
+	BroadcastDisplay       string `json:"broadcastDisplay"`
+	BroadcasterTeamID      int    `json:"broadcasterTeamId"`
+	BroadcasterDescription string `json:"broadcasterDescription"`
+}
+
+type ScoreboardV3Broadcasters struct {
+	NationalBroadcasters      []ScoreboardV3Broadcaster `json:"nationalBroadcasters"`
+	NationalRadioBroadcasters []ScoreboardV3Broadcaster `json:"nationalRadioBroadcasters"`
+	NationalOttBroadcasters   []ScoreboardV3Broadcaster `json:"nationalOttBroadcasters"`
+	HomeTvBroadcasters        []ScoreboardV3Broadcaster `json:"homeTvBroadcasters"`
+	HomeRadioBroadcasters     []ScoreboardV3Broadcaster `json:"homeRadioBroadcasters"`
+	HomeOttBroadcasters       []ScoreboardV3Broadcaster `json:"homeOttBroadcasters"`
+	AwayTvBroadcasters        []ScoreboardV3Broadcaster `json:"awayTvBroadcasters"`
+	AwayRadioBroadcasters     []ScoreboardV3Broadcaster `json:"awayRadioBroadcasters"`
+	AwayOttBroadcasters       []ScoreboardV3Broadcaster `json:"awayOttBroadcasters"`
+}
+
+type ScoreboardV3Game struct {
+	GameID            string           `json:"gameId"`
+	GameCode          string           `json:"gameCode"`
+	GameStatus        int              `json:"gameStatus"`
+	GameStatusText    string           `json:"gameStatusText"`
+	Period            int              `json:"period"`
+	GameClock         string           `json:"gameClock"`
+	GameTimeUTC       string           `json:"gameTimeUTC"`
+	GameEt            string           `json:"gameEt"`
+	RegulationPeriods int              `json:"regulationPeriods"`
+	SeriesGameNumber  string           `json:"seriesGameNumber"`
+	GameLabel         string           `json:"gameLabel"`
+	GameSubLabel      string           `json:"gameSubLabel"`
+	SeriesText        string           `json:"seriesText"`
+	IfNecessary       bool             `json:"ifNecessary"`
+	SeriesConference  string           `json:"seriesConference"`
+	PoRoundDesc       string           `json:"poRoundDesc"`
+	GameSubtype       string           `json:"gameSubtype"`
+	IsNeutral         bool             `json:"isNeutral"`
+	HomeTeam          ScoreboardV3Team `json:"homeTeam"`
+	AwayTeam          ScoreboardV3Team `json:"awayTeam"`
+	GameLeaders       struct {
+		HomeLeaders ScoreboardV3Leader `json:"homeLeaders"`
+		AwayLeaders ScoreboardV3Leader `json:"awayLeaders"`
+	} `json:"gameLeaders"`
+	TeamLeaders struct {
+		HomeLeaders       ScoreboardV3TeamLeader `json:"homeLeaders"`
+		AwayLeaders       ScoreboardV3TeamLeader `json:"awayLeaders"`
+		SeasonLeadersFlag int                    `json:"seasonLeadersFlag"`
+	} `json:"teamLeaders"`
+	Broadcasters ScoreboardV3Broadcasters `json:"broadcasters"`
+}
+
+// Clock parses GameClock, the time left in the period. It is zero when the
+// game is not in progress.
+func (g ScoreboardV3Game) Clock() (time.Duration, error) {
+	return timeutil.ParseClock(g.GameClock)
+}
+
+// StartTime parses GameTimeUTC.
+func (g ScoreboardV3Game) StartTime() (time.Time, error) {
+	return timeutil.ParseGameTime(g.GameTimeUTC)
+}
+
+// StartTimeET parses GameEt as an Eastern time.
+func (g ScoreboardV3Game) StartTimeET() (time.Time, error) {
+	return timeutil.ParseGameET(g.GameEt)
+}
+
+// ScoreboardV3Response is the scoreboard object of the scoreboardv3 document.
 type ScoreboardV3Response struct {
-	GameHeader []ScoreboardV3GameHeader
+	GameDate   string             `json:"gameDate"`
+	LeagueID   string             `json:"leagueId"`
+	LeagueName string             `json:"leagueName"`
+	Games      []ScoreboardV3Game `json:"games"`
+}
+
+type scoreboardV3Document struct {
+	Meta       json.RawMessage       `json:"meta"`
+	Scoreboard *ScoreboardV3Response `json:"scoreboard"`
 }
 
 // GetScoreboardV3 retrieves data from the scoreboardv3 endpoint
@@ -57,43 +158,16 @@ func GetScoreboardV3(ctx context.Context, client *stats.Client, req ScoreboardV3
 		params.Set("LeagueID", string(*req.LeagueID))
 	}
 
-	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "scoreboardv3", params, &rawResp); err != nil {
+	var doc scoreboardV3Document
+	raw, drift, err := getDocument(ctx, client, "scoreboardv3", params, &doc)
+	if err != nil {
 		return nil, err
 	}
 
-	response := &ScoreboardV3Response{}
-	if len(rawResp.ResultSets) > 0 {
-		response.GameHeader = make([]ScoreboardV3GameHeader, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 21 {
-				item := ScoreboardV3GameHeader{
-					gameId:             toString(row[0]),
-					gameCode:           toString(row[1]),
-					gameStatus:         toString(row[2]),
-					gameStatusText:     toString(row[3]),
-					period:             toInt(row[4]),
-					gameClock:          toString(row[5]),
-					gameTimeUTC:        toString(row[6]),
-					gameEt:             toString(row[7]),
-					regulationPeriods:  toInt(row[8]),
-					seriesGameNumber:   toString(row[9]),
-					seriesText:         toString(row[10]),
-					homeTeamId:         toString(row[11]),
-					homeTeamName:       toString(row[12]),
-					homeTeamCity:       toString(row[13]),
-					homeTeamTricode:    toString(row[14]),
-					homeTeamScore:      toString(row[15]),
-					visitorTeamId:      toString(row[16]),
-					visitorTeamName:    toString(row[17]),
-					visitorTeamCity:    toString(row[18]),
-					visitorTeamTricode: toString(row[19]),
-					visitorTeamScore:   toString(row[20]),
-				}
-				response.GameHeader = append(response.GameHeader, item)
-			}
-		}
+	response := doc.Scoreboard
+	if response == nil {
+		response = &ScoreboardV3Response{}
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newDriftResponse(client, response, raw, drift)
 }
@@ -56,34 +56,31 @@ func GetShootingEfficiency(ctx context.Context, client *stats.Client, req Shooti
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "shootingefficiency", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "shootingefficiency", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &ShootingEfficiencyResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.ShootingEfficiency = make([]ShootingEfficiencyShootingEfficiency, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 13 {
-				item := ShootingEfficiencyShootingEfficiency{
-					PLAYER_ID:         toInt(row[0]),
-					PLAYER_NAME:       toString(row[1]),
-					TEAM_ID:           toInt(row[2]),
-					TEAM_ABBREVIATION: toString(row[3]),
-					GP:                toInt(row[4]),
-					MIN:               toFloat(row[5]),
-					PTS:               toFloat(row[6]),
-					FG_PCT:            toFloat(row[7]),
-					FG2_PCT:           toFloat(row[8]),
-					FG3_PCT:           toFloat(row[9]),
-					EFG_PCT:           toFloat(row[10]),
-					FT_PCT:            toFloat(row[11]),
-					TS_PCT:            toFloat(row[12]),
-				}
-				response.ShootingEfficiency = append(response.ShootingEfficiency, item)
+	if rs := rawResp.resultSet("ShootingEfficiency"); rs != nil {
+		response.ShootingEfficiency = decodeRows(rs, func(row *rowReader) ShootingEfficiencyShootingEfficiency {
+			return ShootingEfficiencyShootingEfficiency{
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				GP:                row.Int("GP"),
+				MIN:               row.Float("MIN"),
+				PTS:               row.Float("PTS"),
+				FG_PCT:            row.Float("FG_PCT"),
+				FG2_PCT:           row.Float("FG2_PCT"),
+				FG3_PCT:           row.Float("FG3_PCT"),
+				EFG_PCT:           row.Float("EFG_PCT"),
+				FT_PCT:            row.Float("FT_PCT"),
+				TS_PCT:            row.Float("TS_PCT"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
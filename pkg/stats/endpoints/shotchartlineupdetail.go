@@ -87,62 +87,55 @@ func GetShotChartLineupDetail(ctx context.Context, client *stats.Client, req Sho
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "shotchartlineupdetail", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "shotchartlineupdetail", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &ShotChartLineupDetailResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.Shot_Chart_Detail = make([]ShotChartLineupDetailShot_Chart_Detail, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 24 {
-				item := ShotChartLineupDetailShot_Chart_Detail{
-					GRID_TYPE:           toString(row[0]),
-					GAME_ID:             toString(row[1]),
-					GAME_EVENT_ID:       toString(row[2]),
-					PLAYER_ID:           toInt(row[3]),
-					PLAYER_NAME:         toString(row[4]),
-					TEAM_ID:             toInt(row[5]),
-					TEAM_NAME:           toString(row[6]),
-					PERIOD:              toInt(row[7]),
-					MINUTES_REMAINING:   toFloat(row[8]),
-					SECONDS_REMAINING:   toString(row[9]),
-					EVENT_TYPE:          toString(row[10]),
-					ACTION_TYPE:         toString(row[11]),
-					SHOT_TYPE:           toString(row[12]),
-					SHOT_ZONE_BASIC:     toString(row[13]),
-					SHOT_ZONE_AREA:      toString(row[14]),
-					SHOT_ZONE_RANGE:     toInt(row[15]),
-					SHOT_DISTANCE:       toString(row[16]),
-					LOC_X:               toString(row[17]),
-					LOC_Y:               toString(row[18]),
-					SHOT_ATTEMPTED_FLAG: toString(row[19]),
-					SHOT_MADE_FLAG:      toString(row[20]),
-					GAME_DATE:           toString(row[21]),
-					HTM:                 toString(row[22]),
-					VTM:                 toString(row[23]),
-				}
-				response.Shot_Chart_Detail = append(response.Shot_Chart_Detail, item)
+	if rs := rawResp.resultSet("Shot_Chart_Detail"); rs != nil {
+		response.Shot_Chart_Detail = decodeRows(rs, func(row *rowReader) ShotChartLineupDetailShot_Chart_Detail {
+			return ShotChartLineupDetailShot_Chart_Detail{
+				GRID_TYPE:           row.String("GRID_TYPE"),
+				GAME_ID:             row.String("GAME_ID"),
+				GAME_EVENT_ID:       row.String("GAME_EVENT_ID"),
+				PLAYER_ID:           row.Int("PLAYER_ID"),
+				PLAYER_NAME:         row.String("PLAYER_NAME"),
+				TEAM_ID:             row.Int("TEAM_ID"),
+				TEAM_NAME:           row.String("TEAM_NAME"),
+				PERIOD:              row.Int("PERIOD"),
+				MINUTES_REMAINING:   row.Float("MINUTES_REMAINING"),
+				SECONDS_REMAINING:   row.String("SECONDS_REMAINING"),
+				EVENT_TYPE:          row.String("EVENT_TYPE"),
+				ACTION_TYPE:         row.String("ACTION_TYPE"),
+				SHOT_TYPE:           row.String("SHOT_TYPE"),
+				SHOT_ZONE_BASIC:     row.String("SHOT_ZONE_BASIC"),
+				SHOT_ZONE_AREA:      row.String("SHOT_ZONE_AREA"),
+				SHOT_ZONE_RANGE:     row.Int("SHOT_ZONE_RANGE"),
+				SHOT_DISTANCE:       row.String("SHOT_DISTANCE"),
+				LOC_X:               row.String("LOC_X"),
+				LOC_Y:               row.String("LOC_Y"),
+				SHOT_ATTEMPTED_FLAG: row.String("SHOT_ATTEMPTED_FLAG"),
+				SHOT_MADE_FLAG:      row.String("SHOT_MADE_FLAG"),
+				GAME_DATE:           row.String("GAME_DATE"),
+				HTM:                 row.String("HTM"),
+				VTM:                 row.String("VTM"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.LeagueAverages = make([]ShotChartLineupDetailLeagueAverages, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 7 {
-				item := ShotChartLineupDetailLeagueAverages{
-					GRID_TYPE:       toString(row[0]),
-					SHOT_ZONE_BASIC: toString(row[1]),
-					SHOT_ZONE_AREA:  toString(row[2]),
-					SHOT_ZONE_RANGE: toInt(row[3]),
-					FGA:             toInt(row[4]),
-					FGM:             toInt(row[5]),
-					FG_PCT:          toFloat(row[6]),
-				}
-				response.LeagueAverages = append(response.LeagueAverages, item)
+	if rs := rawResp.resultSet("LeagueAverages"); rs != nil {
+		response.LeagueAverages = decodeRows(rs, func(row *rowReader) ShotChartLineupDetailLeagueAverages {
+			return ShotChartLineupDetailLeagueAverages{
+				GRID_TYPE:       row.String("GRID_TYPE"),
+				SHOT_ZONE_BASIC: row.String("SHOT_ZONE_BASIC"),
+				SHOT_ZONE_AREA:  row.String("SHOT_ZONE_AREA"),
+				SHOT_ZONE_RANGE: row.Int("SHOT_ZONE_RANGE"),
+				FGA:             row.Int("FGA"),
+				FGM:             row.Int("FGM"),
+				FG_PCT:          row.Float("FG_PCT"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -74,44 +74,41 @@ func GetSynergyPlayTypes(ctx context.Context, client *stats.Client, req SynergyP
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "synergyplaytypes", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "synergyplaytypes", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &SynergyPlayTypesResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.SynergyPlayType = make([]SynergyPlayTypesSynergyPlayType, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 23 {
-				item := SynergyPlayTypesSynergyPlayType{
-					PLAYER_ID:         toInt(row[0]),
-					PLAYER_NAME:       toString(row[1]),
-					TEAM_ID:           toInt(row[2]),
-					TEAM_ABBREVIATION: toString(row[3]),
-					PLAY_TYPE:         toString(row[4]),
-					TYPE_GROUPING:     toString(row[5]),
-					PERCENTILE:        toString(row[6]),
-					GP:                toInt(row[7]),
-					POSS:              toString(row[8]),
-					TIME:              toString(row[9]),
-					PTS:               toFloat(row[10]),
-					FGM:               toInt(row[11]),
-					FGA:               toInt(row[12]),
-					FG_PCT:            toFloat(row[13]),
-					AEFG_PCT:          toFloat(row[14]),
-					FT_PCT:            toFloat(row[15]),
-					TOV:               toFloat(row[16]),
-					SF:                toString(row[17]),
-					PLUSONE:           toString(row[18]),
-					SCORE:             toString(row[19]),
-					EFG_PCT:           toFloat(row[20]),
-					PPP:               toString(row[21]),
-					POSS_PCT:          toFloat(row[22]),
-				}
-				response.SynergyPlayType = append(response.SynergyPlayType, item)
+	if rs := rawResp.resultSet("SynergyPlayType"); rs != nil {
+		response.SynergyPlayType = decodeRows(rs, func(row *rowReader) SynergyPlayTypesSynergyPlayType {
+			return SynergyPlayTypesSynergyPlayType{
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				PLAY_TYPE:         row.String("PLAY_TYPE"),
+				TYPE_GROUPING:     row.String("TYPE_GROUPING"),
+				PERCENTILE:        row.String("PERCENTILE"),
+				GP:                row.Int("GP"),
+				POSS:              row.String("POSS"),
+				TIME:              row.String("TIME"),
+				PTS:               row.Float("PTS"),
+				FGM:               row.Int("FGM"),
+				FGA:               row.Int("FGA"),
+				FG_PCT:            row.Float("FG_PCT"),
+				AEFG_PCT:          row.Float("AEFG_PCT"),
+				FT_PCT:            row.Float("FT_PCT"),
+				TOV:               row.Float("TOV"),
+				SF:                row.String("SF"),
+				PLUSONE:           row.String("PLUSONE"),
+				SCORE:             row.String("SCORE"),
+				EFG_PCT:           row.Float("EFG_PCT"),
+				PPP:               row.String("PPP"),
+				POSS_PCT:          row.Float("POSS_PCT"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -117,89 +117,82 @@ func GetTeamAndPlayersVsPlayers(ctx context.Context, client *stats.Client, req T
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "teamandplayersvsplayers", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "teamandplayersvsplayers", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &TeamAndPlayersVsPlayersResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.Overall = make([]TeamAndPlayersVsPlayersOverall, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 29 {
-				item := TeamAndPlayersVsPlayersOverall{
-					TEAM_ID:    toInt(row[0]),
-					TEAM_NAME:  toString(row[1]),
-					SORT_ORDER: toString(row[2]),
-					GP:         toInt(row[3]),
-					W:          toString(row[4]),
-					L:          toString(row[5]),
-					W_PCT:      toFloat(row[6]),
-					MIN:        toFloat(row[7]),
-					FGM:        toInt(row[8]),
-					FGA:        toInt(row[9]),
-					FG_PCT:     toFloat(row[10]),
-					FG3M:       toInt(row[11]),
-					FG3A:       toInt(row[12]),
-					FG3_PCT:    toFloat(row[13]),
-					FTM:        toInt(row[14]),
-					FTA:        toInt(row[15]),
-					FT_PCT:     toFloat(row[16]),
-					OREB:       toFloat(row[17]),
-					DREB:       toFloat(row[18]),
-					REB:        toFloat(row[19]),
-					AST:        toFloat(row[20]),
-					TOV:        toFloat(row[21]),
-					STL:        toFloat(row[22]),
-					BLK:        toFloat(row[23]),
-					BLKA:       toInt(row[24]),
-					PF:         toFloat(row[25]),
-					PFD:        toFloat(row[26]),
-					PTS:        toFloat(row[27]),
-					PLUS_MINUS: toFloat(row[28]),
-				}
-				response.Overall = append(response.Overall, item)
+	if rs := rawResp.resultSet("Overall"); rs != nil {
+		response.Overall = decodeRows(rs, func(row *rowReader) TeamAndPlayersVsPlayersOverall {
+			return TeamAndPlayersVsPlayersOverall{
+				TEAM_ID:    row.Int("TEAM_ID"),
+				TEAM_NAME:  row.String("TEAM_NAME"),
+				SORT_ORDER: row.String("SORT_ORDER"),
+				GP:         row.Int("GP"),
+				W:          row.String("W"),
+				L:          row.String("L"),
+				W_PCT:      row.Float("W_PCT"),
+				MIN:        row.Float("MIN"),
+				FGM:        row.Int("FGM"),
+				FGA:        row.Int("FGA"),
+				FG_PCT:     row.Float("FG_PCT"),
+				FG3M:       row.Int("FG3M"),
+				FG3A:       row.Int("FG3A"),
+				FG3_PCT:    row.Float("FG3_PCT"),
+				FTM:        row.Int("FTM"),
+				FTA:        row.Int("FTA"),
+				FT_PCT:     row.Float("FT_PCT"),
+				OREB:       row.Float("OREB"),
+				DREB:       row.Float("DREB"),
+				REB:        row.Float("REB"),
+				AST:        row.Float("AST"),
+				TOV:        row.Float("TOV"),
+				STL:        row.Float("STL"),
+				BLK:        row.Float("BLK"),
+				BLKA:       row.Int("BLKA"),
+				PF:         row.Float("PF"),
+				PFD:        row.Float("PFD"),
+				PTS:        row.Float("PTS"),
+				PLUS_MINUS: row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.PlayersVsPlayer = make([]TeamAndPlayersVsPlayersPlayersVsPlayer, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 29 {
-				item := TeamAndPlayersVsPlayersPlayersVsPlayer{
-					PLAYER_ID:   toInt(row[0]),
-					PLAYER_NAME: toString(row[1]),
-					SORT_ORDER:  toString(row[2]),
-					GP:          toInt(row[3]),
-					W:           toString(row[4]),
-					L:           toString(row[5]),
-					W_PCT:       toFloat(row[6]),
-					MIN:         toFloat(row[7]),
-					FGM:         toInt(row[8]),
-					FGA:         toInt(row[9]),
-					FG_PCT:      toFloat(row[10]),
-					FG3M:        toInt(row[11]),
-					FG3A:        toInt(row[12]),
-					FG3_PCT:     toFloat(row[13]),
-					FTM:         toInt(row[14]),
-					FTA:         toInt(row[15]),
-					FT_PCT:      toFloat(row[16]),
-					OREB:        toFloat(row[17]),
-					DREB:        toFloat(row[18]),
-					REB:         toFloat(row[19]),
-					AST:         toFloat(row[20]),
-					TOV:         toFloat(row[21]),
-					STL:         toFloat(row[22]),
-					BLK:         toFloat(row[23]),
-					BLKA:        toInt(row[24]),
-					PF:          toFloat(row[25]),
-					PFD:         toFloat(row[26]),
-					PTS:         toFloat(row[27]),
-					PLUS_MINUS:  toFloat(row[28]),
-				}
-				response.PlayersVsPlayer = append(response.PlayersVsPlayer, item)
+	if rs := rawResp.resultSet("PlayersVsPlayer"); rs != nil {
+		response.PlayersVsPlayer = decodeRows(rs, func(row *rowReader) TeamAndPlayersVsPlayersPlayersVsPlayer {
+			return TeamAndPlayersVsPlayersPlayersVsPlayer{
+				PLAYER_ID:   row.Int("PLAYER_ID"),
+				PLAYER_NAME: row.String("PLAYER_NAME"),
+				SORT_ORDER:  row.String("SORT_ORDER"),
+				GP:          row.Int("GP"),
+				W:           row.String("W"),
+				L:           row.String("L"),
+				W_PCT:       row.Float("W_PCT"),
+				MIN:         row.Float("MIN"),
+				FGM:         row.Int("FGM"),
+				FGA:         row.Int("FGA"),
+				FG_PCT:      row.Float("FG_PCT"),
+				FG3M:        row.Int("FG3M"),
+				FG3A:        row.Int("FG3A"),
+				FG3_PCT:     row.Float("FG3_PCT"),
+				FTM:         row.Int("FTM"),
+				FTA:         row.Int("FTA"),
+				FT_PCT:      row.Float("FT_PCT"),
+				OREB:        row.Float("OREB"),
+				DREB:        row.Float("DREB"),
+				REB:         row.Float("REB"),
+				AST:         row.Float("AST"),
+				TOV:         row.Float("TOV"),
+				STL:         row.Float("STL"),
+				BLK:         row.Float("BLK"),
+				BLKA:        row.Int("BLKA"),
+				PF:          row.Float("PF"),
+				PFD:         row.Float("PFD"),
+				PTS:         row.Float("PTS"),
+				PLUS_MINUS:  row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -127,88 +127,81 @@ func GetTeamDashboardByClutch(ctx context.Context, client *stats.Client, req Tea
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "teamdashboardbyclutch", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "teamdashboardbyclutch", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &TeamDashboardByClutchResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.OverallTeamDashboard = make([]TeamDashboardByClutchOverallTeamDashboard, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 28 {
-				item := TeamDashboardByClutchOverallTeamDashboard{
-					TEAM_ID:    toInt(row[0]),
-					TEAM_NAME:  toString(row[1]),
-					GP:         toInt(row[2]),
-					W:          toString(row[3]),
-					L:          toString(row[4]),
-					W_PCT:      toFloat(row[5]),
-					MIN:        toFloat(row[6]),
-					FGM:        toInt(row[7]),
-					FGA:        toInt(row[8]),
-					FG_PCT:     toFloat(row[9]),
-					FG3M:       toInt(row[10]),
-					FG3A:       toInt(row[11]),
-					FG3_PCT:    toFloat(row[12]),
-					FTM:        toInt(row[13]),
-					FTA:        toInt(row[14]),
-					FT_PCT:     toFloat(row[15]),
-					OREB:       toFloat(row[16]),
-					DREB:       toFloat(row[17]),
-					REB:        toFloat(row[18]),
-					AST:        toFloat(row[19]),
-					TOV:        toFloat(row[20]),
-					STL:        toFloat(row[21]),
-					BLK:        toFloat(row[22]),
-					BLKA:       toInt(row[23]),
-					PF:         toFloat(row[24]),
-					PFD:        toFloat(row[25]),
-					PTS:        toFloat(row[26]),
-					PLUS_MINUS: toFloat(row[27]),
-				}
-				response.OverallTeamDashboard = append(response.OverallTeamDashboard, item)
+	if rs := rawResp.resultSet("OverallTeamDashboard"); rs != nil {
+		response.OverallTeamDashboard = decodeRows(rs, func(row *rowReader) TeamDashboardByClutchOverallTeamDashboard {
+			return TeamDashboardByClutchOverallTeamDashboard{
+				TEAM_ID:    row.Int("TEAM_ID"),
+				TEAM_NAME:  row.String("TEAM_NAME"),
+				GP:         row.Int("GP"),
+				W:          row.String("W"),
+				L:          row.String("L"),
+				W_PCT:      row.Float("W_PCT"),
+				MIN:        row.Float("MIN"),
+				FGM:        row.Int("FGM"),
+				FGA:        row.Int("FGA"),
+				FG_PCT:     row.Float("FG_PCT"),
+				FG3M:       row.Int("FG3M"),
+				FG3A:       row.Int("FG3A"),
+				FG3_PCT:    row.Float("FG3_PCT"),
+				FTM:        row.Int("FTM"),
+				FTA:        row.Int("FTA"),
+				FT_PCT:     row.Float("FT_PCT"),
+				OREB:       row.Float("OREB"),
+				DREB:       row.Float("DREB"),
+				REB:        row.Float("REB"),
+				AST:        row.Float("AST"),
+				TOV:        row.Float("TOV"),
+				STL:        row.Float("STL"),
+				BLK:        row.Float("BLK"),
+				BLKA:       row.Int("BLKA"),
+				PF:         row.Float("PF"),
+				PFD:        row.Float("PFD"),
+				PTS:        row.Float("PTS"),
+				PLUS_MINUS: row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.Last5MinCloseGame5PointTeamDashboard = make([]TeamDashboardByClutchLast5MinCloseGame5PointTeamDashboard, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 29 {
-				item := TeamDashboardByClutchLast5MinCloseGame5PointTeamDashboard{
-					TEAM_ID:     toInt(row[0]),
-					TEAM_NAME:   toString(row[1]),
-					CLUTCH_TIME: toString(row[2]),
-					GP:          toInt(row[3]),
-					W:           toString(row[4]),
-					L:           toString(row[5]),
-					W_PCT:       toFloat(row[6]),
-					MIN:         toFloat(row[7]),
-					FGM:         toInt(row[8]),
-					FGA:         toInt(row[9]),
-					FG_PCT:      toFloat(row[10]),
-					FG3M:        toInt(row[11]),
-					FG3A:        toInt(row[12]),
-					FG3_PCT:     toFloat(row[13]),
-					FTM:         toInt(row[14]),
-					FTA:         toInt(row[15]),
-					FT_PCT:      toFloat(row[16]),
-					OREB:        toFloat(row[17]),
-					DREB:        toFloat(row[18]),
-					REB:         toFloat(row[19]),
-					AST:         toFloat(row[20]),
-					TOV:         toFloat(row[21]),
-					STL:         toFloat(row[22]),
-					BLK:         toFloat(row[23]),
-					BLKA:        toInt(row[24]),
-					PF:          toFloat(row[25]),
-					PFD:         toFloat(row[26]),
-					PTS:         toFloat(row[27]),
-					PLUS_MINUS:  toFloat(row[28]),
-				}
-				response.Last5MinCloseGame5PointTeamDashboard = append(response.Last5MinCloseGame5PointTeamDashboard, item)
+	if rs := rawResp.resultSet("Last5MinCloseGame5PointTeamDashboard"); rs != nil {
+		response.Last5MinCloseGame5PointTeamDashboard = decodeRows(rs, func(row *rowReader) TeamDashboardByClutchLast5MinCloseGame5PointTeamDashboard {
+			return TeamDashboardByClutchLast5MinCloseGame5PointTeamDashboard{
+				TEAM_ID:     row.Int("TEAM_ID"),
+				TEAM_NAME:   row.String("TEAM_NAME"),
+				CLUTCH_TIME: row.String("CLUTCH_TIME"),
+				GP:          row.Int("GP"),
+				W:           row.String("W"),
+				L:           row.String("L"),
+				W_PCT:       row.Float("W_PCT"),
+				MIN:         row.Float("MIN"),
+				FGM:         row.Int("FGM"),
+				FGA:         row.Int("FGA"),
+				FG_PCT:      row.Float("FG_PCT"),
+				FG3M:        row.Int("FG3M"),
+				FG3A:        row.Int("FG3A"),
+				FG3_PCT:     row.Float("FG3_PCT"),
+				FTM:         row.Int("FTM"),
+				FTA:         row.Int("FTA"),
+				FT_PCT:      row.Float("FT_PCT"),
+				OREB:        row.Float("OREB"),
+				DREB:        row.Float("DREB"),
+				REB:         row.Float("REB"),
+				AST:         row.Float("AST"),
+				TOV:         row.Float("TOV"),
+				STL:         row.Float("STL"),
+				BLK:         row.Float("BLK"),
+				BLKA:        row.Int("BLKA"),
+				PF:          row.Float("PF"),
+				PFD:         row.Float("PFD"),
+				PTS:         row.Float("PTS"),
+				PLUS_MINUS:  row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -251,244 +251,221 @@ func GetTeamDashboardByGameSplits(ctx context.Context, client *stats.Client, req
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "teamdashboardbygamesplits", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "teamdashboardbygamesplits", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &TeamDashboardByGameSplitsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.OverallTeamDashboard = make([]TeamDashboardByGameSplitsOverallTeamDashboard, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 28 {
-				item := TeamDashboardByGameSplitsOverallTeamDashboard{
-					TEAM_ID:    toInt(row[0]),
-					TEAM_NAME:  toString(row[1]),
-					GP:         toInt(row[2]),
-					W:          toString(row[3]),
-					L:          toString(row[4]),
-					W_PCT:      toFloat(row[5]),
-					MIN:        toFloat(row[6]),
-					FGM:        toInt(row[7]),
-					FGA:        toInt(row[8]),
-					FG_PCT:     toFloat(row[9]),
-					FG3M:       toInt(row[10]),
-					FG3A:       toInt(row[11]),
-					FG3_PCT:    toFloat(row[12]),
-					FTM:        toInt(row[13]),
-					FTA:        toInt(row[14]),
-					FT_PCT:     toFloat(row[15]),
-					OREB:       toFloat(row[16]),
-					DREB:       toFloat(row[17]),
-					REB:        toFloat(row[18]),
-					AST:        toFloat(row[19]),
-					TOV:        toFloat(row[20]),
-					STL:        toFloat(row[21]),
-					BLK:        toFloat(row[22]),
-					BLKA:       toInt(row[23]),
-					PF:         toFloat(row[24]),
-					PFD:        toFloat(row[25]),
-					PTS:        toFloat(row[26]),
-					PLUS_MINUS: toFloat(row[27]),
-				}
-				response.OverallTeamDashboard = append(response.OverallTeamDashboard, item)
+	if rs := rawResp.resultSet("OverallTeamDashboard"); rs != nil {
+		response.OverallTeamDashboard = decodeRows(rs, func(row *rowReader) TeamDashboardByGameSplitsOverallTeamDashboard {
+			return TeamDashboardByGameSplitsOverallTeamDashboard{
+				TEAM_ID:    row.Int("TEAM_ID"),
+				TEAM_NAME:  row.String("TEAM_NAME"),
+				GP:         row.Int("GP"),
+				W:          row.String("W"),
+				L:          row.String("L"),
+				W_PCT:      row.Float("W_PCT"),
+				MIN:        row.Float("MIN"),
+				FGM:        row.Int("FGM"),
+				FGA:        row.Int("FGA"),
+				FG_PCT:     row.Float("FG_PCT"),
+				FG3M:       row.Int("FG3M"),
+				FG3A:       row.Int("FG3A"),
+				FG3_PCT:    row.Float("FG3_PCT"),
+				FTM:        row.Int("FTM"),
+				FTA:        row.Int("FTA"),
+				FT_PCT:     row.Float("FT_PCT"),
+				OREB:       row.Float("OREB"),
+				DREB:       row.Float("DREB"),
+				REB:        row.Float("REB"),
+				AST:        row.Float("AST"),
+				TOV:        row.Float("TOV"),
+				STL:        row.Float("STL"),
+				BLK:        row.Float("BLK"),
+				BLKA:       row.Int("BLKA"),
+				PF:         row.Float("PF"),
+				PFD:        row.Float("PFD"),
+				PTS:        row.Float("PTS"),
+				PLUS_MINUS: row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.LocationTeamDashboard = make([]TeamDashboardByGameSplitsLocationTeamDashboard, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 29 {
-				item := TeamDashboardByGameSplitsLocationTeamDashboard{
-					TEAM_ID:    toInt(row[0]),
-					TEAM_NAME:  toString(row[1]),
-					LOCATION:   toString(row[2]),
-					GP:         toInt(row[3]),
-					W:          toString(row[4]),
-					L:          toString(row[5]),
-					W_PCT:      toFloat(row[6]),
-					MIN:        toFloat(row[7]),
-					FGM:        toInt(row[8]),
-					FGA:        toInt(row[9]),
-					FG_PCT:     toFloat(row[10]),
-					FG3M:       toInt(row[11]),
-					FG3A:       toInt(row[12]),
-					FG3_PCT:    toFloat(row[13]),
-					FTM:        toInt(row[14]),
-					FTA:        toInt(row[15]),
-					FT_PCT:     toFloat(row[16]),
-					OREB:       toFloat(row[17]),
-					DREB:       toFloat(row[18]),
-					REB:        toFloat(row[19]),
-					AST:        toFloat(row[20]),
-					TOV:        toFloat(row[21]),
-					STL:        toFloat(row[22]),
-					BLK:        toFloat(row[23]),
-					BLKA:       toInt(row[24]),
-					PF:         toFloat(row[25]),
-					PFD:        toFloat(row[26]),
-					PTS:        toFloat(row[27]),
-					PLUS_MINUS: toFloat(row[28]),
-				}
-				response.LocationTeamDashboard = append(response.LocationTeamDashboard, item)
+	if rs := rawResp.resultSet("LocationTeamDashboard"); rs != nil {
+		response.LocationTeamDashboard = decodeRows(rs, func(row *rowReader) TeamDashboardByGameSplitsLocationTeamDashboard {
+			return TeamDashboardByGameSplitsLocationTeamDashboard{
+				TEAM_ID:    row.Int("TEAM_ID"),
+				TEAM_NAME:  row.String("TEAM_NAME"),
+				LOCATION:   row.String("LOCATION"),
+				GP:         row.Int("GP"),
+				W:          row.String("W"),
+				L:          row.String("L"),
+				W_PCT:      row.Float("W_PCT"),
+				MIN:        row.Float("MIN"),
+				FGM:        row.Int("FGM"),
+				FGA:        row.Int("FGA"),
+				FG_PCT:     row.Float("FG_PCT"),
+				FG3M:       row.Int("FG3M"),
+				FG3A:       row.Int("FG3A"),
+				FG3_PCT:    row.Float("FG3_PCT"),
+				FTM:        row.Int("FTM"),
+				FTA:        row.Int("FTA"),
+				FT_PCT:     row.Float("FT_PCT"),
+				OREB:       row.Float("OREB"),
+				DREB:       row.Float("DREB"),
+				REB:        row.Float("REB"),
+				AST:        row.Float("AST"),
+				TOV:        row.Float("TOV"),
+				STL:        row.Float("STL"),
+				BLK:        row.Float("BLK"),
+				BLKA:       row.Int("BLKA"),
+				PF:         row.Float("PF"),
+				PFD:        row.Float("PFD"),
+				PTS:        row.Float("PTS"),
+				PLUS_MINUS: row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 2 {
-		response.WinsLossesTeamDashboard = make([]TeamDashboardByGameSplitsWinsLossesTeamDashboard, 0, len(rawResp.ResultSets[2].RowSet))
-		for _, row := range rawResp.ResultSets[2].RowSet {
-			if len(row) >= 29 {
-				item := TeamDashboardByGameSplitsWinsLossesTeamDashboard{
-					TEAM_ID:    toInt(row[0]),
-					TEAM_NAME:  toString(row[1]),
-					W_L:        toString(row[2]),
-					GP:         toInt(row[3]),
-					W:          toString(row[4]),
-					L:          toString(row[5]),
-					W_PCT:      toFloat(row[6]),
-					MIN:        toFloat(row[7]),
-					FGM:        toInt(row[8]),
-					FGA:        toInt(row[9]),
-					FG_PCT:     toFloat(row[10]),
-					FG3M:       toInt(row[11]),
-					FG3A:       toInt(row[12]),
-					FG3_PCT:    toFloat(row[13]),
-					FTM:        toInt(row[14]),
-					FTA:        toInt(row[15]),
-					FT_PCT:     toFloat(row[16]),
-					OREB:       toFloat(row[17]),
-					DREB:       toFloat(row[18]),
-					REB:        toFloat(row[19]),
-					AST:        toFloat(row[20]),
-					TOV:        toFloat(row[21]),
-					STL:        toFloat(row[22]),
-					BLK:        toFloat(row[23]),
-					BLKA:       toInt(row[24]),
-					PF:         toFloat(row[25]),
-					PFD:        toFloat(row[26]),
-					PTS:        toFloat(row[27]),
-					PLUS_MINUS: toFloat(row[28]),
-				}
-				response.WinsLossesTeamDashboard = append(response.WinsLossesTeamDashboard, item)
+	if rs := rawResp.resultSet("WinsLossesTeamDashboard"); rs != nil {
+		response.WinsLossesTeamDashboard = decodeRows(rs, func(row *rowReader) TeamDashboardByGameSplitsWinsLossesTeamDashboard {
+			return TeamDashboardByGameSplitsWinsLossesTeamDashboard{
+				TEAM_ID:    row.Int("TEAM_ID"),
+				TEAM_NAME:  row.String("TEAM_NAME"),
+				W_L:        row.String("W_L"),
+				GP:         row.Int("GP"),
+				W:          row.String("W"),
+				L:          row.String("L"),
+				W_PCT:      row.Float("W_PCT"),
+				MIN:        row.Float("MIN"),
+				FGM:        row.Int("FGM"),
+				FGA:        row.Int("FGA"),
+				FG_PCT:     row.Float("FG_PCT"),
+				FG3M:       row.Int("FG3M"),
+				FG3A:       row.Int("FG3A"),
+				FG3_PCT:    row.Float("FG3_PCT"),
+				FTM:        row.Int("FTM"),
+				FTA:        row.Int("FTA"),
+				FT_PCT:     row.Float("FT_PCT"),
+				OREB:       row.Float("OREB"),
+				DREB:       row.Float("DREB"),
+				REB:        row.Float("REB"),
+				AST:        row.Float("AST"),
+				TOV:        row.Float("TOV"),
+				STL:        row.Float("STL"),
+				BLK:        row.Float("BLK"),
+				BLKA:       row.Int("BLKA"),
+				PF:         row.Float("PF"),
+				PFD:        row.Float("PFD"),
+				PTS:        row.Float("PTS"),
+				PLUS_MINUS: row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 3 {
-		response.MonthTeamDashboard = make([]TeamDashboardByGameSplitsMonthTeamDashboard, 0, len(rawResp.ResultSets[3].RowSet))
-		for _, row := range rawResp.ResultSets[3].RowSet {
-			if len(row) >= 29 {
-				item := TeamDashboardByGameSplitsMonthTeamDashboard{
-					TEAM_ID:    toInt(row[0]),
-					TEAM_NAME:  toString(row[1]),
-					MONTH:      toString(row[2]),
-					GP:         toInt(row[3]),
-					W:          toString(row[4]),
-					L:          toString(row[5]),
-					W_PCT:      toFloat(row[6]),
-					MIN:        toFloat(row[7]),
-					FGM:        toInt(row[8]),
-					FGA:        toInt(row[9]),
-					FG_PCT:     toFloat(row[10]),
-					FG3M:       toInt(row[11]),
-					FG3A:       toInt(row[12]),
-					FG3_PCT:    toFloat(row[13]),
-					FTM:        toInt(row[14]),
-					FTA:        toInt(row[15]),
-					FT_PCT:     toFloat(row[16]),
-					OREB:       toFloat(row[17]),
-					DREB:       toFloat(row[18]),
-					REB:        toFloat(row[19]),
-					AST:        toFloat(row[20]),
-					TOV:        toFloat(row[21]),
-					STL:        toFloat(row[22]),
-					BLK:        toFloat(row[23]),
-					BLKA:       toInt(row[24]),
-					PF:         toFloat(row[25]),
-					PFD:        toFloat(row[26]),
-					PTS:        toFloat(row[27]),
-					PLUS_MINUS: toFloat(row[28]),
-				}
-				response.MonthTeamDashboard = append(response.MonthTeamDashboard, item)
+	if rs := rawResp.resultSet("MonthTeamDashboard"); rs != nil {
+		response.MonthTeamDashboard = decodeRows(rs, func(row *rowReader) TeamDashboardByGameSplitsMonthTeamDashboard {
+			return TeamDashboardByGameSplitsMonthTeamDashboard{
+				TEAM_ID:    row.Int("TEAM_ID"),
+				TEAM_NAME:  row.String("TEAM_NAME"),
+				MONTH:      row.String("MONTH"),
+				GP:         row.Int("GP"),
+				W:          row.String("W"),
+				L:          row.String("L"),
+				W_PCT:      row.Float("W_PCT"),
+				MIN:        row.Float("MIN"),
+				FGM:        row.Int("FGM"),
+				FGA:        row.Int("FGA"),
+				FG_PCT:     row.Float("FG_PCT"),
+				FG3M:       row.Int("FG3M"),
+				FG3A:       row.Int("FG3A"),
+				FG3_PCT:    row.Float("FG3_PCT"),
+				FTM:        row.Int("FTM"),
+				FTA:        row.Int("FTA"),
+				FT_PCT:     row.Float("FT_PCT"),
+				OREB:       row.Float("OREB"),
+				DREB:       row.Float("DREB"),
+				REB:        row.Float("REB"),
+				AST:        row.Float("AST"),
+				TOV:        row.Float("TOV"),
+				STL:        row.Float("STL"),
+				BLK:        row.Float("BLK"),
+				BLKA:       row.Int("BLKA"),
+				PF:         row.Float("PF"),
+				PFD:        row.Float("PFD"),
+				PTS:        row.Float("PTS"),
+				PLUS_MINUS: row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 4 {
-		response.PrePostAllStarTeamDashboard = make([]TeamDashboardByGameSplitsPrePostAllStarTeamDashboard, 0, len(rawResp.ResultSets[4].RowSet))
-		for _, row := range rawResp.ResultSets[4].RowSet {
-			if len(row) >= 29 {
-				item := TeamDashboardByGameSplitsPrePostAllStarTeamDashboard{
-					TEAM_ID:           toInt(row[0]),
-					TEAM_NAME:         toString(row[1]),
-					PRE_POST_ALL_STAR: toString(row[2]),
-					GP:                toInt(row[3]),
-					W:                 toString(row[4]),
-					L:                 toString(row[5]),
-					W_PCT:             toFloat(row[6]),
-					MIN:               toFloat(row[7]),
-					FGM:               toInt(row[8]),
-					FGA:               toInt(row[9]),
-					FG_PCT:            toFloat(row[10]),
-					FG3M:              toInt(row[11]),
-					FG3A:              toInt(row[12]),
-					FG3_PCT:           toFloat(row[13]),
-					FTM:               toInt(row[14]),
-					FTA:               toInt(row[15]),
-					FT_PCT:            toFloat(row[16]),
-					OREB:              toFloat(row[17]),
-					DREB:              toFloat(row[18]),
-					REB:               toFloat(row[19]),
-					AST:               toFloat(row[20]),
-					TOV:               toFloat(row[21]),
-					STL:               toFloat(row[22]),
-					BLK:               toFloat(row[23]),
-					BLKA:              toInt(row[24]),
-					PF:                toFloat(row[25]),
-					PFD:               toFloat(row[26]),
-					PTS:               toFloat(row[27]),
-					PLUS_MINUS:        toFloat(row[28]),
-				}
-				response.PrePostAllStarTeamDashboard = append(response.PrePostAllStarTeamDashboard, item)
+	if rs := rawResp.resultSet("PrePostAllStarTeamDashboard"); rs != nil {
+		response.PrePostAllStarTeamDashboard = decodeRows(rs, func(row *rowReader) TeamDashboardByGameSplitsPrePostAllStarTeamDashboard {
+			return TeamDashboardByGameSplitsPrePostAllStarTeamDashboard{
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_NAME:         row.String("TEAM_NAME"),
+				PRE_POST_ALL_STAR: row.String("PRE_POST_ALL_STAR"),
+				GP:                row.Int("GP"),
+				W:                 row.String("W"),
+				L:                 row.String("L"),
+				W_PCT:             row.Float("W_PCT"),
+				MIN:               row.Float("MIN"),
+				FGM:               row.Int("FGM"),
+				FGA:               row.Int("FGA"),
+				FG_PCT:            row.Float("FG_PCT"),
+				FG3M:              row.Int("FG3M"),
+				FG3A:              row.Int("FG3A"),
+				FG3_PCT:           row.Float("FG3_PCT"),
+				FTM:               row.Int("FTM"),
+				FTA:               row.Int("FTA"),
+				FT_PCT:            row.Float("FT_PCT"),
+				OREB:              row.Float("OREB"),
+				DREB:              row.Float("DREB"),
+				REB:               row.Float("REB"),
+				AST:               row.Float("AST"),
+				TOV:               row.Float("TOV"),
+				STL:               row.Float("STL"),
+				BLK:               row.Float("BLK"),
+				BLKA:              row.Int("BLKA"),
+				PF:                row.Float("PF"),
+				PFD:               row.Float("PFD"),
+				PTS:               row.Float("PTS"),
+				PLUS_MINUS:        row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 5 {
-		response.DaysRestTeamDashboard = make([]TeamDashboardByGameSplitsDaysRestTeamDashboard, 0, len(rawResp.ResultSets[5].RowSet))
-		for _, row := range rawResp.ResultSets[5].RowSet {
-			if len(row) >= 29 {
-				item := TeamDashboardByGameSplitsDaysRestTeamDashboard{
-					TEAM_ID:    toInt(row[0]),
-					TEAM_NAME:  toString(row[1]),
-					DAYS_REST:  toString(row[2]),
-					GP:         toInt(row[3]),
-					W:          toString(row[4]),
-					L:          toString(row[5]),
-					W_PCT:      toFloat(row[6]),
-					MIN:        toFloat(row[7]),
-					FGM:        toInt(row[8]),
-					FGA:        toInt(row[9]),
-					FG_PCT:     toFloat(row[10]),
-					FG3M:       toInt(row[11]),
-					FG3A:       toInt(row[12]),
-					FG3_PCT:    toFloat(row[13]),
-					FTM:        toInt(row[14]),
-					FTA:        toInt(row[15]),
-					FT_PCT:     toFloat(row[16]),
-					OREB:       toFloat(row[17]),
-					DREB:       toFloat(row[18]),
-					REB:        toFloat(row[19]),
-					AST:        toFloat(row[20]),
-					TOV:        toFloat(row[21]),
-					STL:        toFloat(row[22]),
-					BLK:        toFloat(row[23]),
-					BLKA:       toInt(row[24]),
-					PF:         toFloat(row[25]),
-					PFD:        toFloat(row[26]),
-					PTS:        toFloat(row[27]),
-					PLUS_MINUS: toFloat(row[28]),
-				}
-				response.DaysRestTeamDashboard = append(response.DaysRestTeamDashboard, item)
+	if rs := rawResp.resultSet("DaysRestTeamDashboard"); rs != nil {
+		response.DaysRestTeamDashboard = decodeRows(rs, func(row *rowReader) TeamDashboardByGameSplitsDaysRestTeamDashboard {
+			return TeamDashboardByGameSplitsDaysRestTeamDashboard{
+				TEAM_ID:    row.Int("TEAM_ID"),
+				TEAM_NAME:  row.String("TEAM_NAME"),
+				DAYS_REST:  row.String("DAYS_REST"),
+				GP:         row.Int("GP"),
+				W:          row.String("W"),
+				L:          row.String("L"),
+				W_PCT:      row.Float("W_PCT"),
+				MIN:        row.Float("MIN"),
+				FGM:        row.Int("FGM"),
+				FGA:        row.Int("FGA"),
+				FG_PCT:     row.Float("FG_PCT"),
+				FG3M:       row.Int("FG3M"),
+				FG3A:       row.Int("FG3A"),
+				FG3_PCT:    row.Float("FG3_PCT"),
+				FTM:        row.Int("FTM"),
+				FTA:        row.Int("FTA"),
+				FT_PCT:     row.Float("FT_PCT"),
+				OREB:       row.Float("OREB"),
+				DREB:       row.Float("DREB"),
+				REB:        row.Float("REB"),
+				AST:        row.Float("AST"),
+				TOV:        row.Float("TOV"),
+				STL:        row.Float("STL"),
+				BLK:        row.Float("BLK"),
+				BLKA:       row.Int("BLKA"),
+				PF:         row.Float("PF"),
+				PFD:        row.Float("PFD"),
+				PTS:        row.Float("PTS"),
+				PLUS_MINUS: row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -103,127 +103,96 @@ func GetTeamDetails(ctx context.Context, client *stats.Client, req TeamDetailsRe
 	params.Set("TeamID", string(req.TeamID))
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "teamdetails", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "teamdetails", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &TeamDetailsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.TeamBackground = make([]TeamDetailsTeamBackground, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 11 {
-				item := TeamDetailsTeamBackground{
-					TEAM_ID:            toInt(row[0]),
-					ABBREVIATION:       toString(row[1]),
-					NICKNAME:           toString(row[2]),
-					YEARFOUNDED:        toString(row[3]),
-					CITY:               toString(row[4]),
-					ARENA:              toString(row[5]),
-					ARENACAPACITY:      toString(row[6]),
-					OWNER:              toString(row[7]),
-					GENERALMANAGER:     toInt(row[8]),
-					HEADCOACH:          toString(row[9]),
-					DLEAGUEAFFILIATION: toString(row[10]),
-				}
-				response.TeamBackground = append(response.TeamBackground, item)
+	if rs := rawResp.resultSet("TeamBackground"); rs != nil {
+		response.TeamBackground = decodeRows(rs, func(row *rowReader) TeamDetailsTeamBackground {
+			return TeamDetailsTeamBackground{
+				TEAM_ID:            row.Int("TEAM_ID"),
+				ABBREVIATION:       row.String("ABBREVIATION"),
+				NICKNAME:           row.String("NICKNAME"),
+				YEARFOUNDED:        row.String("YEARFOUNDED"),
+				CITY:               row.String("CITY"),
+				ARENA:              row.String("ARENA"),
+				ARENACAPACITY:      row.String("ARENACAPACITY"),
+				OWNER:              row.String("OWNER"),
+				GENERALMANAGER:     row.Int("GENERALMANAGER"),
+				HEADCOACH:          row.String("HEADCOACH"),
+				DLEAGUEAFFILIATION: row.String("DLEAGUEAFFILIATION"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.TeamHistory = make([]TeamDetailsTeamHistory, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 5 {
-				item := TeamDetailsTeamHistory{
-					TEAM_ID:        toInt(row[0]),
-					CITY:           toString(row[1]),
-					NICKNAME:       toString(row[2]),
-					YEARFOUNDED:    toString(row[3]),
-					YEARACTIVETILL: toString(row[4]),
-				}
-				response.TeamHistory = append(response.TeamHistory, item)
+	if rs := rawResp.resultSet("TeamHistory"); rs != nil {
+		response.TeamHistory = decodeRows(rs, func(row *rowReader) TeamDetailsTeamHistory {
+			return TeamDetailsTeamHistory{
+				TEAM_ID:        row.Int("TEAM_ID"),
+				CITY:           row.String("CITY"),
+				NICKNAME:       row.String("NICKNAME"),
+				YEARFOUNDED:    row.String("YEARFOUNDED"),
+				YEARACTIVETILL: row.String("YEARACTIVETILL"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 2 {
-		response.TeamSocialSites = make([]TeamDetailsTeamSocialSites, 0, len(rawResp.ResultSets[2].RowSet))
-		for _, row := range rawResp.ResultSets[2].RowSet {
-			if len(row) >= 2 {
-				item := TeamDetailsTeamSocialSites{
-					ACCOUNTTYPE:  toString(row[0]),
-					WEBSITE_LINK: toString(row[1]),
-				}
-				response.TeamSocialSites = append(response.TeamSocialSites, item)
+	if rs := rawResp.resultSet("TeamSocialSites"); rs != nil {
+		response.TeamSocialSites = decodeRows(rs, func(row *rowReader) TeamDetailsTeamSocialSites {
+			return TeamDetailsTeamSocialSites{
+				ACCOUNTTYPE:  row.String("ACCOUNTTYPE"),
+				WEBSITE_LINK: row.String("WEBSITE_LINK"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 3 {
-		response.TeamAwardsChampionships = make([]TeamDetailsTeamAwardsChampionships, 0, len(rawResp.ResultSets[3].RowSet))
-		for _, row := range rawResp.ResultSets[3].RowSet {
-			if len(row) >= 2 {
-				item := TeamDetailsTeamAwardsChampionships{
-					YEARAWARDED:  toString(row[0]),
-					OPPOSITETEAM: toString(row[1]),
-				}
-				response.TeamAwardsChampionships = append(response.TeamAwardsChampionships, item)
+	if rs := rawResp.resultSet("TeamAwardsChampionships"); rs != nil {
+		response.TeamAwardsChampionships = decodeRows(rs, func(row *rowReader) TeamDetailsTeamAwardsChampionships {
+			return TeamDetailsTeamAwardsChampionships{
+				YEARAWARDED:  row.String("YEARAWARDED"),
+				OPPOSITETEAM: row.String("OPPOSITETEAM"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 4 {
-		response.TeamAwardsConf = make([]TeamDetailsTeamAwardsConf, 0, len(rawResp.ResultSets[4].RowSet))
-		for _, row := range rawResp.ResultSets[4].RowSet {
-			if len(row) >= 2 {
-				item := TeamDetailsTeamAwardsConf{
-					YEARAWARDED:  toString(row[0]),
-					OPPOSITETEAM: toString(row[1]),
-				}
-				response.TeamAwardsConf = append(response.TeamAwardsConf, item)
+	if rs := rawResp.resultSet("TeamAwardsConf"); rs != nil {
+		response.TeamAwardsConf = decodeRows(rs, func(row *rowReader) TeamDetailsTeamAwardsConf {
+			return TeamDetailsTeamAwardsConf{
+				YEARAWARDED:  row.String("YEARAWARDED"),
+				OPPOSITETEAM: row.String("OPPOSITETEAM"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 5 {
-		response.TeamAwardsDiv = make([]TeamDetailsTeamAwardsDiv, 0, len(rawResp.ResultSets[5].RowSet))
-		for _, row := range rawResp.ResultSets[5].RowSet {
-			if len(row) >= 2 {
-				item := TeamDetailsTeamAwardsDiv{
-					YEARAWARDED:  toString(row[0]),
-					OPPOSITETEAM: toString(row[1]),
-				}
-				response.TeamAwardsDiv = append(response.TeamAwardsDiv, item)
+	if rs := rawResp.resultSet("TeamAwardsDiv"); rs != nil {
+		response.TeamAwardsDiv = decodeRows(rs, func(row *rowReader) TeamDetailsTeamAwardsDiv {
+			return TeamDetailsTeamAwardsDiv{
+				YEARAWARDED:  row.String("YEARAWARDED"),
+				OPPOSITETEAM: row.String("OPPOSITETEAM"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 6 {
-		response.TeamHof = make([]TeamDetailsTeamHof, 0, len(rawResp.ResultSets[6].RowSet))
-		for _, row := range rawResp.ResultSets[6].RowSet {
-			if len(row) >= 6 {
-				item := TeamDetailsTeamHof{
-					PLAYERID:        toString(row[0]),
-					PLAYER:          toString(row[1]),
-					POSITION:        toString(row[2]),
-					JERSEY:          toString(row[3]),
-					SEASONSWITHTEAM: toString(row[4]),
-					YEAR:            toString(row[5]),
-				}
-				response.TeamHof = append(response.TeamHof, item)
+	if rs := rawResp.resultSet("TeamHof"); rs != nil {
+		response.TeamHof = decodeRows(rs, func(row *rowReader) TeamDetailsTeamHof {
+			return TeamDetailsTeamHof{
+				PLAYERID:        row.String("PLAYERID"),
+				PLAYER:          row.String("PLAYER"),
+				POSITION:        row.String("POSITION"),
+				JERSEY:          row.String("JERSEY"),
+				SEASONSWITHTEAM: row.String("SEASONSWITHTEAM"),
+				YEAR:            row.String("YEAR"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 7 {
-		response.TeamRetired = make([]TeamDetailsTeamRetired, 0, len(rawResp.ResultSets[7].RowSet))
-		for _, row := range rawResp.ResultSets[7].RowSet {
-			if len(row) >= 6 {
-				item := TeamDetailsTeamRetired{
-					PLAYERID:        toString(row[0]),
-					PLAYER:          toString(row[1]),
-					POSITION:        toString(row[2]),
-					JERSEY:          toString(row[3]),
-					SEASONSWITHTEAM: toString(row[4]),
-					YEAR:            toString(row[5]),
-				}
-				response.TeamRetired = append(response.TeamRetired, item)
+	if rs := rawResp.resultSet("TeamRetired"); rs != nil {
+		response.TeamRetired = decodeRows(rs, func(row *rowReader) TeamDetailsTeamRetired {
+			return TeamDetailsTeamRetired{
+				PLAYERID:        row.String("PLAYERID"),
+				PLAYER:          row.String("PLAYER"),
+				POSITION:        row.String("POSITION"),
+				JERSEY:          row.String("JERSEY"),
+				SEASONSWITHTEAM: row.String("SEASONSWITHTEAM"),
+				YEAR:            row.String("YEAR"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -69,51 +69,48 @@ func GetTeamEstimatedMetrics(ctx context.Context, client *stats.Client, req Team
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "teamestimatedmetrics", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "teamestimatedmetrics", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &TeamEstimatedMetricsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.TeamEstimatedMetrics = make([]TeamEstimatedMetricsTeamEstimatedMetrics, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 30 {
-				item := TeamEstimatedMetricsTeamEstimatedMetrics{
-					TEAM_ID:           toInt(row[0]),
-					TEAM_NAME:         toString(row[1]),
-					GP:                toInt(row[2]),
-					W:                 toString(row[3]),
-					L:                 toString(row[4]),
-					W_PCT:             toFloat(row[5]),
-					MIN:               toFloat(row[6]),
-					E_OFF_RATING:      toString(row[7]),
-					E_DEF_RATING:      toString(row[8]),
-					E_NET_RATING:      toString(row[9]),
-					E_PACE:            toString(row[10]),
-					E_AST_RATIO:       toFloat(row[11]),
-					E_OREB_PCT:        toFloat(row[12]),
-					E_DREB_PCT:        toFloat(row[13]),
-					E_REB_PCT:         toFloat(row[14]),
-					E_TOV_PCT:         toFloat(row[15]),
-					GP_RANK:           toFloat(row[16]),
-					W_RANK:            toFloat(row[17]),
-					L_RANK:            toFloat(row[18]),
-					W_PCT_RANK:        toFloat(row[19]),
-					MIN_RANK:          toFloat(row[20]),
-					E_OFF_RATING_RANK: toFloat(row[21]),
-					E_DEF_RATING_RANK: toFloat(row[22]),
-					E_NET_RATING_RANK: toFloat(row[23]),
-					E_AST_RATIO_RANK:  toFloat(row[24]),
-					E_OREB_PCT_RANK:   toFloat(row[25]),
-					E_DREB_PCT_RANK:   toFloat(row[26]),
-					E_REB_PCT_RANK:    toFloat(row[27]),
-					E_TOV_PCT_RANK:    toFloat(row[28]),
-					E_PACE_RANK:       toFloat(row[29]),
-				}
-				response.TeamEstimatedMetrics = append(response.TeamEstimatedMetrics, item)
+	if rs := rawResp.resultSet("TeamEstimatedMetrics"); rs != nil {
+		response.TeamEstimatedMetrics = decodeRows(rs, func(row *rowReader) TeamEstimatedMetricsTeamEstimatedMetrics {
+			return TeamEstimatedMetricsTeamEstimatedMetrics{
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_NAME:         row.String("TEAM_NAME"),
+				GP:                row.Int("GP"),
+				W:                 row.String("W"),
+				L:                 row.String("L"),
+				W_PCT:             row.Float("W_PCT"),
+				MIN:               row.Float("MIN"),
+				E_OFF_RATING:      row.String("E_OFF_RATING"),
+				E_DEF_RATING:      row.String("E_DEF_RATING"),
+				E_NET_RATING:      row.String("E_NET_RATING"),
+				E_PACE:            row.String("E_PACE"),
+				E_AST_RATIO:       row.Float("E_AST_RATIO"),
+				E_OREB_PCT:        row.Float("E_OREB_PCT"),
+				E_DREB_PCT:        row.Float("E_DREB_PCT"),
+				E_REB_PCT:         row.Float("E_REB_PCT"),
+				E_TOV_PCT:         row.Float("E_TOV_PCT"),
+				GP_RANK:           row.Float("GP_RANK"),
+				W_RANK:            row.Float("W_RANK"),
+				L_RANK:            row.Float("L_RANK"),
+				W_PCT_RANK:        row.Float("W_PCT_RANK"),
+				MIN_RANK:          row.Float("MIN_RANK"),
+				E_OFF_RATING_RANK: row.Float("E_OFF_RATING_RANK"),
+				E_DEF_RATING_RANK: row.Float("E_DEF_RATING_RANK"),
+				E_NET_RATING_RANK: row.Float("E_NET_RATING_RANK"),
+				E_AST_RATIO_RANK:  row.Float("E_AST_RATIO_RANK"),
+				E_OREB_PCT_RANK:   row.Float("E_OREB_PCT_RANK"),
+				E_DREB_PCT_RANK:   row.Float("E_DREB_PCT_RANK"),
+				E_REB_PCT_RANK:    row.Float("E_REB_PCT_RANK"),
+				E_TOV_PCT_RANK:    row.Float("E_TOV_PCT_RANK"),
+				E_PACE_RANK:       row.Float("E_PACE_RANK"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
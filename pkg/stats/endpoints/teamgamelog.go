@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/n-ae/nba-api-go/pkg/models"
 	"github.com/n-ae/nba-api-go/pkg/stats"
 	"github.com/n-ae/nba-api-go/pkg/stats/parameters"
+	"github.com/n-ae/nba-api-go/pkg/timeutil"
 )
 
 type TeamGameLog struct {
@@ -19,7 +21,7 @@ type TeamGameLog struct {
 	W        int     `json:"W"`
 	L        int     `json:"L"`
 	WPct     float64 `json:"W_PCT"`
-	MIN      int     `json:"MIN"`
+	MIN      float64 `json:"MIN"`
 	FGM      int     `json:"FGM"`
 	FGA      int     `json:"FGA"`
 	FGPct    float64 `json:"FG_PCT"`
@@ -40,6 +42,15 @@ type TeamGameLog struct {
 	PTS      int     `json:"PTS"`
 }
 
+// Date parses GameDate ("APR 14, 2024") as midnight Eastern.
+func (g TeamGameLog) Date() (time.Time, error) {
+	return timeutil.ParseGameDate(g.GameDate)
+}
+
+func (g TeamGameLog) Minutes() time.Duration {
+	return timeutil.Minutes(g.MIN)
+}
+
 type TeamGameLogResponse struct {
 	TeamGameLog []TeamGameLog `json:"TeamGameLog"`
 }
@@ -87,56 +98,49 @@ func GetTeamGameLog(ctx context.Context, client *stats.Client, req TeamGameLogRe
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "teamgamelog", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "teamgamelog", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &TeamGameLogResponse{}
-	for _, resultSet := range rawResp.ResultSets {
-		if resultSet.Name == "TeamGameLog" {
-			response.TeamGameLog = parseTeamGameLogs(resultSet.RowSet)
-		}
+	if rs := rawResp.resultSet("TeamGameLog"); rs != nil {
+		response.TeamGameLog = parseTeamGameLogs(rs)
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
 
-func parseTeamGameLogs(rows [][]interface{}) []TeamGameLog {
-	logs := make([]TeamGameLog, 0, len(rows))
-	for _, row := range rows {
-		if len(row) < 27 {
-			continue
-		}
-		log := TeamGameLog{
-			TeamID:   toInt(row[0]),
-			GameID:   toString(row[1]),
-			GameDate: toString(row[2]),
-			Matchup:  toString(row[3]),
-			WL:       toString(row[4]),
-			W:        toInt(row[5]),
-			L:        toInt(row[6]),
-			WPct:     toFloat(row[7]),
-			MIN:      toInt(row[8]),
-			FGM:      toInt(row[9]),
-			FGA:      toInt(row[10]),
-			FGPct:    toFloat(row[11]),
-			FG3M:     toInt(row[12]),
-			FG3A:     toInt(row[13]),
-			FG3Pct:   toFloat(row[14]),
-			FTM:      toInt(row[15]),
-			FTA:      toInt(row[16]),
-			FTPct:    toFloat(row[17]),
-			OREB:     toInt(row[18]),
-			DREB:     toInt(row[19]),
-			REB:      toInt(row[20]),
-			AST:      toInt(row[21]),
-			STL:      toInt(row[22]),
-			BLK:      toInt(row[23]),
-			TOV:      toInt(row[24]),
-			PF:       toInt(row[25]),
-			PTS:      toInt(row[26]),
+func parseTeamGameLogs(rs *ResultSet) []TeamGameLog {
+	return decodeRows(rs, func(row *rowReader) TeamGameLog {
+		return TeamGameLog{
+			TeamID:   row.Int("Team_ID"),
+			GameID:   row.String("Game_ID"),
+			GameDate: row.String("GAME_DATE"),
+			Matchup:  row.String("MATCHUP"),
+			WL:       row.String("WL"),
+			W:        row.Int("W"),
+			L:        row.Int("L"),
+			WPct:     row.Float("W_PCT"),
+			MIN:      row.Float("MIN"),
+			FGM:      row.Int("FGM"),
+			FGA:      row.Int("FGA"),
+			FGPct:    row.Float("FG_PCT"),
+			FG3M:     row.Int("FG3M"),
+			FG3A:     row.Int("FG3A"),
+			FG3Pct:   row.Float("FG3_PCT"),
+			FTM:      row.Int("FTM"),
+			FTA:      row.Int("FTA"),
+			FTPct:    row.Float("FT_PCT"),
+			OREB:     row.Int("OREB"),
+			DREB:     row.Int("DREB"),
+			REB:      row.Int("REB"),
+			AST:      row.Int("AST"),
+			STL:      row.Int("STL"),
+			BLK:      row.Int("BLK"),
+			TOV:      row.Int("TOV"),
+			PF:       row.Int("PF"),
+			PTS:      row.Int("PTS"),
 		}
-		logs = append(logs, log)
-	}
-	return logs
+	})
 }
@@ -87,54 +87,51 @@ func GetTeamGameLogs(ctx context.Context, client *stats.Client, req TeamGameLogs
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "teamgamelogs", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "teamgamelogs", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &TeamGameLogsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.TeamGameLogs = make([]TeamGameLogsTeamGameLogs, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 33 {
-				item := TeamGameLogsTeamGameLogs{
-					SEASON_YEAR:       toString(row[0]),
-					TEAM_ID:           toInt(row[1]),
-					TEAM_ABBREVIATION: toString(row[2]),
-					TEAM_NAME:         toString(row[3]),
-					GAME_ID:           toString(row[4]),
-					GAME_DATE:         toString(row[5]),
-					MATCHUP:           toString(row[6]),
-					WL:                toString(row[7]),
-					MIN:               toFloat(row[8]),
-					FGM:               toInt(row[9]),
-					FGA:               toInt(row[10]),
-					FG_PCT:            toFloat(row[11]),
-					FG3M:              toInt(row[12]),
-					FG3A:              toInt(row[13]),
-					FG3_PCT:           toFloat(row[14]),
-					FTM:               toInt(row[15]),
-					FTA:               toInt(row[16]),
-					FT_PCT:            toFloat(row[17]),
-					OREB:              toInt(row[18]),
-					DREB:              toInt(row[19]),
-					REB:               toInt(row[20]),
-					AST:               toInt(row[21]),
-					TOV:               toInt(row[22]),
-					STL:               toInt(row[23]),
-					BLK:               toInt(row[24]),
-					BLKA:              toInt(row[25]),
-					PF:                toInt(row[26]),
-					PFD:               toInt(row[27]),
-					PTS:               toInt(row[28]),
-					PLUS_MINUS:        toFloat(row[29]),
-					NBA_FANTASY_PTS:   toFloat(row[30]),
-					DD2:               toInt(row[31]),
-					TD3:               toInt(row[32]),
-				}
-				response.TeamGameLogs = append(response.TeamGameLogs, item)
+	if rs := rawResp.resultSet("TeamGameLogs"); rs != nil {
+		response.TeamGameLogs = decodeRows(rs, func(row *rowReader) TeamGameLogsTeamGameLogs {
+			return TeamGameLogsTeamGameLogs{
+				SEASON_YEAR:       row.String("SEASON_YEAR"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				TEAM_NAME:         row.String("TEAM_NAME"),
+				GAME_ID:           row.String("GAME_ID"),
+				GAME_DATE:         row.String("GAME_DATE"),
+				MATCHUP:           row.String("MATCHUP"),
+				WL:                row.String("WL"),
+				MIN:               row.Float("MIN"),
+				FGM:               row.Int("FGM"),
+				FGA:               row.Int("FGA"),
+				FG_PCT:            row.Float("FG_PCT"),
+				FG3M:              row.Int("FG3M"),
+				FG3A:              row.Int("FG3A"),
+				FG3_PCT:           row.Float("FG3_PCT"),
+				FTM:               row.Int("FTM"),
+				FTA:               row.Int("FTA"),
+				FT_PCT:            row.Float("FT_PCT"),
+				OREB:              row.Int("OREB"),
+				DREB:              row.Int("DREB"),
+				REB:               row.Int("REB"),
+				AST:               row.Int("AST"),
+				TOV:               row.Int("TOV"),
+				STL:               row.Int("STL"),
+				BLK:               row.Int("BLK"),
+				BLKA:              row.Int("BLKA"),
+				PF:                row.Int("PF"),
+				PFD:               row.Int("PFD"),
+				PTS:               row.Int("PTS"),
+				PLUS_MINUS:        row.Float("PLUS_MINUS"),
+				NBA_FANTASY_PTS:   row.Float("NBA_FANTASY_PTS"),
+				DD2:               row.Int("DD2"),
+				TD3:               row.Int("TD3"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -66,48 +66,45 @@ func GetTeamGameStreakFinder(ctx context.Context, client *stats.Client, req Team
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "teamgamestreakfinder", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "teamgamestreakfinder", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &TeamGameStreakFinderResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.TeamGameStreakFinder = make([]TeamGameStreakFinderTeamGameStreakFinder, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 27 {
-				item := TeamGameStreakFinderTeamGameStreakFinder{
-					TEAM_ID:           toInt(row[0]),
-					TEAM_NAME:         toString(row[1]),
-					TEAM_ABBREVIATION: toString(row[2]),
-					GAME_ID:           toString(row[3]),
-					GAME_DATE:         toString(row[4]),
-					MATCHUP:           toString(row[5]),
-					WL:                toString(row[6]),
-					MIN:               toFloat(row[7]),
-					PTS:               toFloat(row[8]),
-					FGM:               toInt(row[9]),
-					FGA:               toInt(row[10]),
-					FG_PCT:            toFloat(row[11]),
-					FG3M:              toInt(row[12]),
-					FG3A:              toInt(row[13]),
-					FG3_PCT:           toFloat(row[14]),
-					FTM:               toInt(row[15]),
-					FTA:               toInt(row[16]),
-					FT_PCT:            toFloat(row[17]),
-					OREB:              toFloat(row[18]),
-					DREB:              toFloat(row[19]),
-					REB:               toFloat(row[20]),
-					AST:               toFloat(row[21]),
-					TOV:               toFloat(row[22]),
-					STL:               toFloat(row[23]),
-					BLK:               toFloat(row[24]),
-					PF:                toFloat(row[25]),
-					PLUS_MINUS:        toFloat(row[26]),
-				}
-				response.TeamGameStreakFinder = append(response.TeamGameStreakFinder, item)
+	if rs := rawResp.resultSet("TeamGameStreakFinder"); rs != nil {
+		response.TeamGameStreakFinder = decodeRows(rs, func(row *rowReader) TeamGameStreakFinderTeamGameStreakFinder {
+			return TeamGameStreakFinderTeamGameStreakFinder{
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_NAME:         row.String("TEAM_NAME"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				GAME_ID:           row.String("GAME_ID"),
+				GAME_DATE:         row.String("GAME_DATE"),
+				MATCHUP:           row.String("MATCHUP"),
+				WL:                row.String("WL"),
+				MIN:               row.Float("MIN"),
+				PTS:               row.Float("PTS"),
+				FGM:               row.Int("FGM"),
+				FGA:               row.Int("FGA"),
+				FG_PCT:            row.Float("FG_PCT"),
+				FG3M:              row.Int("FG3M"),
+				FG3A:              row.Int("FG3A"),
+				FG3_PCT:           row.Float("FG3_PCT"),
+				FTM:               row.Int("FTM"),
+				FTA:               row.Int("FTA"),
+				FT_PCT:            row.Float("FT_PCT"),
+				OREB:              row.Float("OREB"),
+				DREB:              row.Float("DREB"),
+				REB:               row.Float("REB"),
+				AST:               row.Float("AST"),
+				TOV:               row.Float("TOV"),
+				STL:               row.Float("STL"),
+				BLK:               row.Float("BLK"),
+				PF:                row.Float("PF"),
+				PLUS_MINUS:        row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -82,86 +82,67 @@ func GetTeamHistoricalLeaders(ctx context.Context, client *stats.Client, req Tea
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "teamhistoricalleaders", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "teamhistoricalleaders", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &TeamHistoricalLeadersResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.CareerLeadersPTS = make([]TeamHistoricalLeadersCareerLeadersPTS, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 5 {
-				item := TeamHistoricalLeadersCareerLeadersPTS{
-					TEAM_ID:   toInt(row[0]),
-					PLAYER_ID: toInt(row[1]),
-					PLAYER:    toString(row[2]),
-					PTS:       toFloat(row[3]),
-					PTS_RANK:  toFloat(row[4]),
-				}
-				response.CareerLeadersPTS = append(response.CareerLeadersPTS, item)
+	if rs := rawResp.resultSet("CareerLeadersPTS"); rs != nil {
+		response.CareerLeadersPTS = decodeRows(rs, func(row *rowReader) TeamHistoricalLeadersCareerLeadersPTS {
+			return TeamHistoricalLeadersCareerLeadersPTS{
+				TEAM_ID:   row.Int("TEAM_ID"),
+				PLAYER_ID: row.Int("PLAYER_ID"),
+				PLAYER:    row.String("PLAYER"),
+				PTS:       row.Float("PTS"),
+				PTS_RANK:  row.Float("PTS_RANK"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.CareerLeadersAST = make([]TeamHistoricalLeadersCareerLeadersAST, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 5 {
-				item := TeamHistoricalLeadersCareerLeadersAST{
-					TEAM_ID:   toInt(row[0]),
-					PLAYER_ID: toInt(row[1]),
-					PLAYER:    toString(row[2]),
-					AST:       toFloat(row[3]),
-					AST_RANK:  toFloat(row[4]),
-				}
-				response.CareerLeadersAST = append(response.CareerLeadersAST, item)
+	if rs := rawResp.resultSet("CareerLeadersAST"); rs != nil {
+		response.CareerLeadersAST = decodeRows(rs, func(row *rowReader) TeamHistoricalLeadersCareerLeadersAST {
+			return TeamHistoricalLeadersCareerLeadersAST{
+				TEAM_ID:   row.Int("TEAM_ID"),
+				PLAYER_ID: row.Int("PLAYER_ID"),
+				PLAYER:    row.String("PLAYER"),
+				AST:       row.Float("AST"),
+				AST_RANK:  row.Float("AST_RANK"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 2 {
-		response.CareerLeadersREB = make([]TeamHistoricalLeadersCareerLeadersREB, 0, len(rawResp.ResultSets[2].RowSet))
-		for _, row := range rawResp.ResultSets[2].RowSet {
-			if len(row) >= 5 {
-				item := TeamHistoricalLeadersCareerLeadersREB{
-					TEAM_ID:   toInt(row[0]),
-					PLAYER_ID: toInt(row[1]),
-					PLAYER:    toString(row[2]),
-					REB:       toFloat(row[3]),
-					REB_RANK:  toFloat(row[4]),
-				}
-				response.CareerLeadersREB = append(response.CareerLeadersREB, item)
+	if rs := rawResp.resultSet("CareerLeadersREB"); rs != nil {
+		response.CareerLeadersREB = decodeRows(rs, func(row *rowReader) TeamHistoricalLeadersCareerLeadersREB {
+			return TeamHistoricalLeadersCareerLeadersREB{
+				TEAM_ID:   row.Int("TEAM_ID"),
+				PLAYER_ID: row.Int("PLAYER_ID"),
+				PLAYER:    row.String("PLAYER"),
+				REB:       row.Float("REB"),
+				REB_RANK:  row.Float("REB_RANK"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 3 {
-		response.CareerLeadersBLK = make([]TeamHistoricalLeadersCareerLeadersBLK, 0, len(rawResp.ResultSets[3].RowSet))
-		for _, row := range rawResp.ResultSets[3].RowSet {
-			if len(row) >= 5 {
-				item := TeamHistoricalLeadersCareerLeadersBLK{
-					TEAM_ID:   toInt(row[0]),
-					PLAYER_ID: toInt(row[1]),
-					PLAYER:    toString(row[2]),
-					BLK:       toFloat(row[3]),
-					BLK_RANK:  toFloat(row[4]),
-				}
-				response.CareerLeadersBLK = append(response.CareerLeadersBLK, item)
+	if rs := rawResp.resultSet("CareerLeadersBLK"); rs != nil {
+		response.CareerLeadersBLK = decodeRows(rs, func(row *rowReader) TeamHistoricalLeadersCareerLeadersBLK {
+			return TeamHistoricalLeadersCareerLeadersBLK{
+				TEAM_ID:   row.Int("TEAM_ID"),
+				PLAYER_ID: row.Int("PLAYER_ID"),
+				PLAYER:    row.String("PLAYER"),
+				BLK:       row.Float("BLK"),
+				BLK_RANK:  row.Float("BLK_RANK"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 4 {
-		response.CareerLeadersSTL = make([]TeamHistoricalLeadersCareerLeadersSTL, 0, len(rawResp.ResultSets[4].RowSet))
-		for _, row := range rawResp.ResultSets[4].RowSet {
-			if len(row) >= 5 {
-				item := TeamHistoricalLeadersCareerLeadersSTL{
-					TEAM_ID:   toInt(row[0]),
-					PLAYER_ID: toInt(row[1]),
-					PLAYER:    toString(row[2]),
-					STL:       toFloat(row[3]),
-					STL_RANK:  toFloat(row[4]),
-				}
-				response.CareerLeadersSTL = append(response.CareerLeadersSTL, item)
+	if rs := rawResp.resultSet("CareerLeadersSTL"); rs != nil {
+		response.CareerLeadersSTL = decodeRows(rs, func(row *rowReader) TeamHistoricalLeadersCareerLeadersSTL {
+			return TeamHistoricalLeadersCareerLeadersSTL{
+				TEAM_ID:   row.Int("TEAM_ID"),
+				PLAYER_ID: row.Int("PLAYER_ID"),
+				PLAYER:    row.String("PLAYER"),
+				STL:       row.Float("STL"),
+				STL_RANK:  row.Float("STL_RANK"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -69,54 +69,47 @@ func GetTeamInfoCommon(ctx context.Context, client *stats.Client, req TeamInfoCo
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "teaminfocommon", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "teaminfocommon", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &TeamInfoCommonResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.TeamInfoCommon = make([]TeamInfoCommonTeamInfoCommon, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 12 {
-				item := TeamInfoCommonTeamInfoCommon{
-					TEAM_ID:           toInt(row[0]),
-					SEASON_YEAR:       toString(row[1]),
-					TEAM_CITY:         toString(row[2]),
-					TEAM_NAME:         toString(row[3]),
-					TEAM_ABBREVIATION: toString(row[4]),
-					TEAM_CONFERENCE:   toString(row[5]),
-					TEAM_DIVISION:     toString(row[6]),
-					W:                 toInt(row[7]),
-					L:                 toInt(row[8]),
-					PCT:               toFloat(row[9]),
-					MIN_YEAR:          toString(row[10]),
-					MAX_YEAR:          toString(row[11]),
-				}
-				response.TeamInfoCommon = append(response.TeamInfoCommon, item)
+	if rs := rawResp.resultSet("TeamInfoCommon"); rs != nil {
+		response.TeamInfoCommon = decodeRows(rs, func(row *rowReader) TeamInfoCommonTeamInfoCommon {
+			return TeamInfoCommonTeamInfoCommon{
+				TEAM_ID:           row.Int("TEAM_ID"),
+				SEASON_YEAR:       row.String("SEASON_YEAR"),
+				TEAM_CITY:         row.String("TEAM_CITY"),
+				TEAM_NAME:         row.String("TEAM_NAME"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				TEAM_CONFERENCE:   row.String("TEAM_CONFERENCE"),
+				TEAM_DIVISION:     row.String("TEAM_DIVISION"),
+				W:                 row.Int("W"),
+				L:                 row.Int("L"),
+				PCT:               row.Float("PCT"),
+				MIN_YEAR:          row.String("MIN_YEAR"),
+				MAX_YEAR:          row.String("MAX_YEAR"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.TeamSeasonRanks = make([]TeamInfoCommonTeamSeasonRanks, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 11 {
-				item := TeamInfoCommonTeamSeasonRanks{
-					LEAGUE_ID:    toString(row[0]),
-					SEASON_ID:    toString(row[1]),
-					TEAM_ID:      toInt(row[2]),
-					PTS_RANK:     toInt(row[3]),
-					PTS_PG:       toFloat(row[4]),
-					REB_RANK:     toInt(row[5]),
-					REB_PG:       toFloat(row[6]),
-					AST_RANK:     toInt(row[7]),
-					AST_PG:       toFloat(row[8]),
-					OPP_PTS_RANK: toInt(row[9]),
-					OPP_PTS_PG:   toFloat(row[10]),
-				}
-				response.TeamSeasonRanks = append(response.TeamSeasonRanks, item)
+	if rs := rawResp.resultSet("TeamSeasonRanks"); rs != nil {
+		response.TeamSeasonRanks = decodeRows(rs, func(row *rowReader) TeamInfoCommonTeamSeasonRanks {
+			return TeamInfoCommonTeamSeasonRanks{
+				LEAGUE_ID:    row.String("LEAGUE_ID"),
+				SEASON_ID:    row.String("SEASON_ID"),
+				TEAM_ID:      row.Int("TEAM_ID"),
+				PTS_RANK:     row.Int("PTS_RANK"),
+				PTS_PG:       row.Float("PTS_PG"),
+				REB_RANK:     row.Int("REB_RANK"),
+				REB_PG:       row.Float("REB_PG"),
+				AST_RANK:     row.Int("AST_RANK"),
+				AST_PG:       row.Float("AST_PG"),
+				OPP_PTS_RANK: row.Int("OPP_PTS_RANK"),
+				OPP_PTS_PG:   row.Float("OPP_PTS_PG"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -76,57 +76,50 @@ func GetTeamInfoCommonV2(ctx context.Context, client *stats.Client, req TeamInfo
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "teaminfocommonv2", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "teaminfocommonv2", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &TeamInfoCommonV2Response{}
-	if len(rawResp.ResultSets) > 0 {
-		response.TeamInfoCommon = make([]TeamInfoCommonV2TeamInfoCommon, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 15 {
-				item := TeamInfoCommonV2TeamInfoCommon{
-					TEAM_ID:           toInt(row[0]),
-					SEASON_YEAR:       toString(row[1]),
-					TEAM_CITY:         toString(row[2]),
-					TEAM_NAME:         toString(row[3]),
-					TEAM_ABBREVIATION: toString(row[4]),
-					TEAM_CONFERENCE:   toString(row[5]),
-					TEAM_DIVISION:     toString(row[6]),
-					TEAM_CODE:         toString(row[7]),
-					W:                 toString(row[8]),
-					L:                 toString(row[9]),
-					PCT:               toString(row[10]),
-					CONF_RANK:         toFloat(row[11]),
-					DIV_RANK:          toFloat(row[12]),
-					MIN_YEAR:          toFloat(row[13]),
-					MAX_YEAR:          toString(row[14]),
-				}
-				response.TeamInfoCommon = append(response.TeamInfoCommon, item)
+	if rs := rawResp.resultSet("TeamInfoCommon"); rs != nil {
+		response.TeamInfoCommon = decodeRows(rs, func(row *rowReader) TeamInfoCommonV2TeamInfoCommon {
+			return TeamInfoCommonV2TeamInfoCommon{
+				TEAM_ID:           row.Int("TEAM_ID"),
+				SEASON_YEAR:       row.String("SEASON_YEAR"),
+				TEAM_CITY:         row.String("TEAM_CITY"),
+				TEAM_NAME:         row.String("TEAM_NAME"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				TEAM_CONFERENCE:   row.String("TEAM_CONFERENCE"),
+				TEAM_DIVISION:     row.String("TEAM_DIVISION"),
+				TEAM_CODE:         row.String("TEAM_CODE"),
+				W:                 row.String("W"),
+				L:                 row.String("L"),
+				PCT:               row.String("PCT"),
+				CONF_RANK:         row.Float("CONF_RANK"),
+				DIV_RANK:          row.Float("DIV_RANK"),
+				MIN_YEAR:          row.Float("MIN_YEAR"),
+				MAX_YEAR:          row.String("MAX_YEAR"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.TeamSeasonRanks = make([]TeamInfoCommonV2TeamSeasonRanks, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 11 {
-				item := TeamInfoCommonV2TeamSeasonRanks{
-					LEAGUE_ID:    toString(row[0]),
-					SEASON_ID:    toString(row[1]),
-					TEAM_ID:      toInt(row[2]),
-					PTS_RANK:     toFloat(row[3]),
-					PTS_PG:       toFloat(row[4]),
-					REB_RANK:     toFloat(row[5]),
-					REB_PG:       toFloat(row[6]),
-					AST_RANK:     toFloat(row[7]),
-					AST_PG:       toFloat(row[8]),
-					OPP_PTS_RANK: toFloat(row[9]),
-					OPP_PTS_PG:   toFloat(row[10]),
-				}
-				response.TeamSeasonRanks = append(response.TeamSeasonRanks, item)
+	if rs := rawResp.resultSet("TeamSeasonRanks"); rs != nil {
+		response.TeamSeasonRanks = decodeRows(rs, func(row *rowReader) TeamInfoCommonV2TeamSeasonRanks {
+			return TeamInfoCommonV2TeamSeasonRanks{
+				LEAGUE_ID:    row.String("LEAGUE_ID"),
+				SEASON_ID:    row.String("SEASON_ID"),
+				TEAM_ID:      row.Int("TEAM_ID"),
+				PTS_RANK:     row.Float("PTS_RANK"),
+				PTS_PG:       row.Float("PTS_PG"),
+				REB_RANK:     row.Float("REB_RANK"),
+				REB_PG:       row.Float("REB_PG"),
+				AST_RANK:     row.Float("AST_RANK"),
+				AST_PG:       row.Float("AST_PG"),
+				OPP_PTS_RANK: row.Float("OPP_PTS_RANK"),
+				OPP_PTS_PG:   row.Float("OPP_PTS_PG"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -87,51 +87,48 @@ func GetTeamLineups(ctx context.Context, client *stats.Client, req TeamLineupsRe
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "teamlineups", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "teamlineups", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &TeamLineupsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.Lineups = make([]TeamLineupsLineups, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 30 {
-				item := TeamLineupsLineups{
-					GROUP_ID:          toString(row[0]),
-					GROUP_NAME:        toString(row[1]),
-					TEAM_ID:           toInt(row[2]),
-					TEAM_ABBREVIATION: toString(row[3]),
-					GP:                toInt(row[4]),
-					W:                 toString(row[5]),
-					L:                 toString(row[6]),
-					W_PCT:             toFloat(row[7]),
-					MIN:               toFloat(row[8]),
-					FGM:               toInt(row[9]),
-					FGA:               toInt(row[10]),
-					FG_PCT:            toFloat(row[11]),
-					FG3M:              toInt(row[12]),
-					FG3A:              toInt(row[13]),
-					FG3_PCT:           toFloat(row[14]),
-					FTM:               toInt(row[15]),
-					FTA:               toInt(row[16]),
-					FT_PCT:            toFloat(row[17]),
-					OREB:              toFloat(row[18]),
-					DREB:              toFloat(row[19]),
-					REB:               toFloat(row[20]),
-					AST:               toFloat(row[21]),
-					TOV:               toFloat(row[22]),
-					STL:               toFloat(row[23]),
-					BLK:               toFloat(row[24]),
-					BLKA:              toInt(row[25]),
-					PF:                toFloat(row[26]),
-					PFD:               toFloat(row[27]),
-					PTS:               toFloat(row[28]),
-					PLUS_MINUS:        toFloat(row[29]),
-				}
-				response.Lineups = append(response.Lineups, item)
+	if rs := rawResp.resultSet("Lineups"); rs != nil {
+		response.Lineups = decodeRows(rs, func(row *rowReader) TeamLineupsLineups {
+			return TeamLineupsLineups{
+				GROUP_ID:          row.String("GROUP_ID"),
+				GROUP_NAME:        row.String("GROUP_NAME"),
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				GP:                row.Int("GP"),
+				W:                 row.String("W"),
+				L:                 row.String("L"),
+				W_PCT:             row.Float("W_PCT"),
+				MIN:               row.Float("MIN"),
+				FGM:               row.Int("FGM"),
+				FGA:               row.Int("FGA"),
+				FG_PCT:            row.Float("FG_PCT"),
+				FG3M:              row.Int("FG3M"),
+				FG3A:              row.Int("FG3A"),
+				FG3_PCT:           row.Float("FG3_PCT"),
+				FTM:               row.Int("FTM"),
+				FTA:               row.Int("FTA"),
+				FT_PCT:            row.Float("FT_PCT"),
+				OREB:              row.Float("OREB"),
+				DREB:              row.Float("DREB"),
+				REB:               row.Float("REB"),
+				AST:               row.Float("AST"),
+				TOV:               row.Float("TOV"),
+				STL:               row.Float("STL"),
+				BLK:               row.Float("BLK"),
+				BLKA:              row.Int("BLKA"),
+				PF:                row.Float("PF"),
+				PFD:               row.Float("PFD"),
+				PTS:               row.Float("PTS"),
+				PLUS_MINUS:        row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -86,54 +86,51 @@ func GetTeamPlayerDashboard(ctx context.Context, client *stats.Client, req TeamP
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "teamplayerdashboard", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "teamplayerdashboard", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &TeamPlayerDashboardResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.PlayersSeasonTotals = make([]TeamPlayerDashboardPlayersSeasonTotals, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 33 {
-				item := TeamPlayerDashboardPlayersSeasonTotals{
-					TEAM_ID:           toInt(row[0]),
-					TEAM_ABBREVIATION: toString(row[1]),
-					PLAYER_ID:         toInt(row[2]),
-					PLAYER_NAME:       toString(row[3]),
-					NICKNAME:          toString(row[4]),
-					PLAYER_POSITION:   toString(row[5]),
-					AGE:               toInt(row[6]),
-					GP:                toInt(row[7]),
-					W:                 toString(row[8]),
-					L:                 toString(row[9]),
-					W_PCT:             toFloat(row[10]),
-					MIN:               toFloat(row[11]),
-					FGM:               toInt(row[12]),
-					FGA:               toInt(row[13]),
-					FG_PCT:            toFloat(row[14]),
-					FG3M:              toInt(row[15]),
-					FG3A:              toInt(row[16]),
-					FG3_PCT:           toFloat(row[17]),
-					FTM:               toInt(row[18]),
-					FTA:               toInt(row[19]),
-					FT_PCT:            toFloat(row[20]),
-					OREB:              toFloat(row[21]),
-					DREB:              toFloat(row[22]),
-					REB:               toFloat(row[23]),
-					AST:               toFloat(row[24]),
-					TOV:               toFloat(row[25]),
-					STL:               toFloat(row[26]),
-					BLK:               toFloat(row[27]),
-					BLKA:              toInt(row[28]),
-					PF:                toFloat(row[29]),
-					PFD:               toFloat(row[30]),
-					PTS:               toFloat(row[31]),
-					PLUS_MINUS:        toFloat(row[32]),
-				}
-				response.PlayersSeasonTotals = append(response.PlayersSeasonTotals, item)
+	if rs := rawResp.resultSet("PlayersSeasonTotals"); rs != nil {
+		response.PlayersSeasonTotals = decodeRows(rs, func(row *rowReader) TeamPlayerDashboardPlayersSeasonTotals {
+			return TeamPlayerDashboardPlayersSeasonTotals{
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				NICKNAME:          row.String("NICKNAME"),
+				PLAYER_POSITION:   row.String("PLAYER_POSITION"),
+				AGE:               row.Int("AGE"),
+				GP:                row.Int("GP"),
+				W:                 row.String("W"),
+				L:                 row.String("L"),
+				W_PCT:             row.Float("W_PCT"),
+				MIN:               row.Float("MIN"),
+				FGM:               row.Int("FGM"),
+				FGA:               row.Int("FGA"),
+				FG_PCT:            row.Float("FG_PCT"),
+				FG3M:              row.Int("FG3M"),
+				FG3A:              row.Int("FG3A"),
+				FG3_PCT:           row.Float("FG3_PCT"),
+				FTM:               row.Int("FTM"),
+				FTA:               row.Int("FTA"),
+				FT_PCT:            row.Float("FT_PCT"),
+				OREB:              row.Float("OREB"),
+				DREB:              row.Float("DREB"),
+				REB:               row.Float("REB"),
+				AST:               row.Float("AST"),
+				TOV:               row.Float("TOV"),
+				STL:               row.Float("STL"),
+				BLK:               row.Float("BLK"),
+				BLKA:              row.Int("BLKA"),
+				PF:                row.Float("PF"),
+				PFD:               row.Float("PFD"),
+				PTS:               row.Float("PTS"),
+				PLUS_MINUS:        row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
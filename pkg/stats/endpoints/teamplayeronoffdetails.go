@@ -77,50 +77,43 @@ func GetTeamPlayerOnOffDetails(ctx context.Context, client *stats.Client, req Te
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "teamplayeronoffdetails", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "teamplayeronoffdetails", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &TeamPlayerOnOffDetailsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.OverallOnOffSummary = make([]TeamPlayerOnOffDetailsOverallOnOffSummary, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 8 {
-				item := TeamPlayerOnOffDetailsOverallOnOffSummary{
-					TEAM_ID:        toInt(row[0]),
-					TEAM_NAME:      toString(row[1]),
-					VS_PLAYER_ID:   toInt(row[2]),
-					VS_PLAYER_NAME: toString(row[3]),
-					COURT_STATUS:   toString(row[4]),
-					GP:             toInt(row[5]),
-					MIN:            toFloat(row[6]),
-					PLUS_MINUS:     toFloat(row[7]),
-				}
-				response.OverallOnOffSummary = append(response.OverallOnOffSummary, item)
+	if rs := rawResp.resultSet("OverallOnOffSummary"); rs != nil {
+		response.OverallOnOffSummary = decodeRows(rs, func(row *rowReader) TeamPlayerOnOffDetailsOverallOnOffSummary {
+			return TeamPlayerOnOffDetailsOverallOnOffSummary{
+				TEAM_ID:        row.Int("TEAM_ID"),
+				TEAM_NAME:      row.String("TEAM_NAME"),
+				VS_PLAYER_ID:   row.Int("VS_PLAYER_ID"),
+				VS_PLAYER_NAME: row.String("VS_PLAYER_NAME"),
+				COURT_STATUS:   row.String("COURT_STATUS"),
+				GP:             row.Int("GP"),
+				MIN:            row.Float("MIN"),
+				PLUS_MINUS:     row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.PlayersOnCourtTeamPlayerOnOffDetails = make([]TeamPlayerOnOffDetailsPlayersOnCourtTeamPlayerOnOffDetails, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 11 {
-				item := TeamPlayerOnOffDetailsPlayersOnCourtTeamPlayerOnOffDetails{
-					TEAM_ID:           toInt(row[0]),
-					TEAM_NAME:         toString(row[1]),
-					TEAM_ABBREVIATION: toString(row[2]),
-					VS_PLAYER_ID:      toInt(row[3]),
-					VS_PLAYER_NAME:    toString(row[4]),
-					COURT_STATUS:      toString(row[5]),
-					PLAYER_ID:         toInt(row[6]),
-					PLAYER_NAME:       toString(row[7]),
-					GP:                toInt(row[8]),
-					MIN:               toFloat(row[9]),
-					PLUS_MINUS:        toFloat(row[10]),
-				}
-				response.PlayersOnCourtTeamPlayerOnOffDetails = append(response.PlayersOnCourtTeamPlayerOnOffDetails, item)
+	if rs := rawResp.resultSet("PlayersOnCourtTeamPlayerOnOffDetails"); rs != nil {
+		response.PlayersOnCourtTeamPlayerOnOffDetails = decodeRows(rs, func(row *rowReader) TeamPlayerOnOffDetailsPlayersOnCourtTeamPlayerOnOffDetails {
+			return TeamPlayerOnOffDetailsPlayersOnCourtTeamPlayerOnOffDetails{
+				TEAM_ID:           row.Int("TEAM_ID"),
+				TEAM_NAME:         row.String("TEAM_NAME"),
+				TEAM_ABBREVIATION: row.String("TEAM_ABBREVIATION"),
+				VS_PLAYER_ID:      row.Int("VS_PLAYER_ID"),
+				VS_PLAYER_NAME:    row.String("VS_PLAYER_NAME"),
+				COURT_STATUS:      row.String("COURT_STATUS"),
+				PLAYER_ID:         row.Int("PLAYER_ID"),
+				PLAYER_NAME:       row.String("PLAYER_NAME"),
+				GP:                row.Int("GP"),
+				MIN:               row.Float("MIN"),
+				PLUS_MINUS:        row.Float("PLUS_MINUS"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -79,55 +79,52 @@ func GetTeamYearByYearStats(ctx context.Context, client *stats.Client, req TeamY
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "teamyearbyyearstats", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "teamyearbyyearstats", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &TeamYearByYearStatsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.TeamStats = make([]TeamYearByYearStatsTeamStats, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 34 {
-				item := TeamYearByYearStatsTeamStats{
-					TEAM_ID:               toInt(row[0]),
-					TEAM_CITY:             toString(row[1]),
-					TEAM_NAME:             toString(row[2]),
-					YEAR:                  toString(row[3]),
-					GP:                    toInt(row[4]),
-					WINS:                  toInt(row[5]),
-					LOSSES:                toInt(row[6]),
-					WIN_PCT:               toFloat(row[7]),
-					CONF_RANK:             toInt(row[8]),
-					DIV_RANK:              toInt(row[9]),
-					PO_WINS:               toInt(row[10]),
-					PO_LOSSES:             toInt(row[11]),
-					CONF_COUNT:            toInt(row[12]),
-					DIV_COUNT:             toInt(row[13]),
-					NBA_FINALS_APPEARANCE: toString(row[14]),
-					FGM:                   toInt(row[15]),
-					FGA:                   toInt(row[16]),
-					FG_PCT:                toFloat(row[17]),
-					FG3M:                  toInt(row[18]),
-					FG3A:                  toInt(row[19]),
-					FG3_PCT:               toFloat(row[20]),
-					FTM:                   toInt(row[21]),
-					FTA:                   toInt(row[22]),
-					FT_PCT:                toFloat(row[23]),
-					OREB:                  toInt(row[24]),
-					DREB:                  toInt(row[25]),
-					REB:                   toInt(row[26]),
-					AST:                   toInt(row[27]),
-					PF:                    toInt(row[28]),
-					STL:                   toInt(row[29]),
-					TOV:                   toInt(row[30]),
-					BLK:                   toInt(row[31]),
-					PTS:                   toInt(row[32]),
-					PTS_RANK:              toInt(row[33]),
-				}
-				response.TeamStats = append(response.TeamStats, item)
+	if rs := rawResp.resultSet("TeamStats"); rs != nil {
+		response.TeamStats = decodeRows(rs, func(row *rowReader) TeamYearByYearStatsTeamStats {
+			return TeamYearByYearStatsTeamStats{
+				TEAM_ID:               row.Int("TEAM_ID"),
+				TEAM_CITY:             row.String("TEAM_CITY"),
+				TEAM_NAME:             row.String("TEAM_NAME"),
+				YEAR:                  row.String("YEAR"),
+				GP:                    row.Int("GP"),
+				WINS:                  row.Int("WINS"),
+				LOSSES:                row.Int("LOSSES"),
+				WIN_PCT:               row.Float("WIN_PCT"),
+				CONF_RANK:             row.Int("CONF_RANK"),
+				DIV_RANK:              row.Int("DIV_RANK"),
+				PO_WINS:               row.Int("PO_WINS"),
+				PO_LOSSES:             row.Int("PO_LOSSES"),
+				CONF_COUNT:            row.Int("CONF_COUNT"),
+				DIV_COUNT:             row.Int("DIV_COUNT"),
+				NBA_FINALS_APPEARANCE: row.String("NBA_FINALS_APPEARANCE"),
+				FGM:                   row.Int("FGM"),
+				FGA:                   row.Int("FGA"),
+				FG_PCT:                row.Float("FG_PCT"),
+				FG3M:                  row.Int("FG3M"),
+				FG3A:                  row.Int("FG3A"),
+				FG3_PCT:               row.Float("FG3_PCT"),
+				FTM:                   row.Int("FTM"),
+				FTA:                   row.Int("FTA"),
+				FT_PCT:                row.Float("FT_PCT"),
+				OREB:                  row.Int("OREB"),
+				DREB:                  row.Int("DREB"),
+				REB:                   row.Int("REB"),
+				AST:                   row.Int("AST"),
+				PF:                    row.Int("PF"),
+				STL:                   row.Int("STL"),
+				TOV:                   row.Int("TOV"),
+				BLK:                   row.Int("BLK"),
+				PTS:                   row.Int("PTS"),
+				PTS_RANK:              row.Int("PTS_RANK"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
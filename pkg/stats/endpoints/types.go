@@ -1,51 +1,100 @@
 package endpoints
 
-import "fmt"
+import (
+	"net/url"
+	"path"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/stats"
+)
 
 type rawStatsResponse struct {
-	ResultSets []struct {
-		Name    string          `json:"name"`
-		Headers []string        `json:"headers"`
-		RowSet  [][]interface{} `json:"rowSet"`
-	} `json:"resultSets"`
+	ResultSets []ResultSet `json:"resultSets"`
+
+	expected []string
+	renamed  map[string]string
 }
 
-// Type conversion helpers for parsing NBA API responses
-func toInt(v interface{}) int {
-	switch val := v.(type) {
-	case float64:
-		return int(val)
-	case int:
-		return val
-	case string:
-		return 0
-	default:
-		return 0
+// resultSet returns the result set called name, or nil when the response
+// does not include it. A result set whose name differs only in case or
+// underscores is returned and reported as renamed.
+func (r *rawStatsResponse) resultSet(name string) *ResultSet {
+	r.expected = append(r.expected, name)
+
+	if rs, err := FindResultSet(r.ResultSets, name); err == nil {
+		return rs
+	}
+	for i := range r.ResultSets {
+		if normalizeName(r.ResultSets[i].Name) == normalizeName(name) {
+			if r.renamed == nil {
+				r.renamed = make(map[string]string)
+			}
+			r.renamed[name] = r.ResultSets[i].Name
+			return &r.ResultSets[i]
+		}
 	}
+	return nil
 }
 
-func toFloat(v interface{}) float64 {
-	switch val := v.(type) {
-	case float64:
-		return val
-	case int:
-		return float64(val)
-	case string:
-		return 0.0
-	default:
-		return 0.0
+// drift reports result sets that were expected but absent, present but never
+// decoded or renamed, followed by the column drift of each result set.
+func (r *rawStatsResponse) drift() []models.SchemaDrift {
+	var drift []models.SchemaDrift
+	used := make(map[string]bool)
+
+	for _, name := range r.expected {
+		if actual, ok := r.renamed[name]; ok {
+			used[actual] = true
+			drift = append(drift, models.SchemaDrift{
+				Kind:      models.DriftRenamedResultSet,
+				ResultSet: name,
+				Detail:    "now " + actual,
+			})
+			continue
+		}
+		if _, err := FindResultSet(r.ResultSets, name); err != nil {
+			drift = append(drift, models.SchemaDrift{Kind: models.DriftMissingResultSet, ResultSet: name})
+			continue
+		}
+		used[name] = true
+	}
+
+	for i := range r.ResultSets {
+		rs := &r.ResultSets[i]
+		if !used[rs.Name] {
+			drift = append(drift, models.SchemaDrift{Kind: models.DriftUnexpectedResultSet, ResultSet: rs.Name})
+		}
+		drift = append(drift, rs.drift...)
+	}
+
+	return drift
+}
+
+// newStatsResponse wraps data with the metadata of raw and applies the
+// client's schema mode to the drift found while decoding rawResp.
+func newStatsResponse[T any](client *stats.Client, data T, raw *models.RawResponse, rawResp *rawStatsResponse) (*models.Response[T], error) {
+	return newDriftResponse(client, data, raw, rawResp.drift())
+}
+
+// newDriftResponse wraps data with the metadata of raw and returns drift as
+// a *models.SchemaDriftError in strict mode or as warnings otherwise.
+func newDriftResponse[T any](client *stats.Client, data T, raw *models.RawResponse, drift []models.SchemaDrift) (*models.Response[T], error) {
+	resp := models.NewResponseFromRaw(data, raw)
+	if len(drift) == 0 {
+		return resp, nil
+	}
+
+	if client.SchemaMode() == stats.SchemaStrict {
+		return nil, &models.SchemaDriftError{Endpoint: endpointName(raw.URL), Drift: drift}
 	}
+	resp.Warnings = drift
+	return resp, nil
 }
 
-func toString(v interface{}) string {
-	switch val := v.(type) {
-	case string:
-		return val
-	case float64:
-		return fmt.Sprintf("%.0f", val)
-	case int:
-		return fmt.Sprintf("%d", val)
-	default:
-		return ""
+func endpointName(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
 	}
+	return path.Base(u.Path)
 }
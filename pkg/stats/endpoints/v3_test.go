@@ -0,0 +1,141 @@
+package endpoints
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+	"github.com/n-ae/nba-api-go/pkg/stats/parameters"
+)
+
+func TestPlayByPlayV3_Replay(t *testing.T) {
+	resp, err := GetPlayByPlayV3(context.Background(), replayClient(t, "playbyplayv3"), PlayByPlayV3Request{GameID: "0022400061"})
+	if err != nil {
+		t.Fatalf("GetPlayByPlayV3() error = %v", err)
+	}
+	if len(resp.Warnings) != 0 {
+		t.Errorf("Warnings = %v, want none", resp.Warnings)
+	}
+
+	game := resp.Data
+	if game.GameID != "0022400061" || len(game.Actions) != 3 {
+		t.Fatalf("game = %+v", game)
+	}
+	shot := game.Actions[1]
+	if shot.ActionNumber != 4 || shot.PersonID != 2544 || shot.TeamTricode != "LAL" || shot.ShotValue != 3 || shot.ScoreHome != "3" {
+		t.Errorf("shot = %+v", shot)
+	}
+	if left, err := shot.Remaining(); err != nil || left != 11*time.Minute+42*time.Second {
+		t.Errorf("Remaining() = %v, %v", left, err)
+	}
+}
+
+func TestScoreboardV3_Replay(t *testing.T) {
+	league := parameters.LeagueIDNBA
+	resp, err := GetScoreboardV3(context.Background(), replayClient(t, "scoreboardv3"), ScoreboardV3Request{GameDate: "2024-10-22", LeagueID: &league})
+	if err != nil {
+		t.Fatalf("GetScoreboardV3() error = %v", err)
+	}
+	if len(resp.Warnings) != 0 {
+		t.Errorf("Warnings = %v, want none", resp.Warnings)
+	}
+
+	if resp.Data.GameDate != "2024-10-22" || len(resp.Data.Games) != 1 {
+		t.Fatalf("scoreboard = %+v", resp.Data)
+	}
+	game := resp.Data.Games[0]
+	if game.HomeTeam.TeamTricode != "LAL" || game.HomeTeam.Score != 110 || len(game.HomeTeam.Periods) != 4 || game.HomeTeam.Seed.Valid {
+		t.Errorf("home team = %+v", game.HomeTeam)
+	}
+	if game.GameLeaders.HomeLeaders.Points != 36 || game.TeamLeaders.AwayLeaders.Points != 25.9 {
+		t.Errorf("leaders = %+v %+v", game.GameLeaders, game.TeamLeaders)
+	}
+	if game.Broadcasters.NationalBroadcasters[0].BroadcastDisplay != "TNT" {
+		t.Errorf("broadcasters = %+v", game.Broadcasters)
+	}
+	start, err := game.StartTime()
+	if err != nil {
+		t.Fatalf("StartTime() error = %v", err)
+	}
+	if et, err := game.StartTimeET(); err != nil || !et.Equal(start) {
+		t.Errorf("StartTimeET() = %v, %v, want %v", et, err, start)
+	}
+}
+
+func TestBoxScoreMatchupsV3_Replay(t *testing.T) {
+	resp, err := GetBoxScoreMatchupsV3(context.Background(), replayClient(t, "boxscorematchupsv3"), BoxScoreMatchupsV3Request{GameID: "0022400061"})
+	if err != nil {
+		t.Fatalf("GetBoxScoreMatchupsV3() error = %v", err)
+	}
+	if len(resp.Warnings) != 0 {
+		t.Errorf("Warnings = %v, want none", resp.Warnings)
+	}
+
+	box := resp.Data
+	if box.HomeTeamID != 1610612747 || box.HomeTeam.TeamTricode != "LAL" || len(box.HomeTeam.Players) != 1 {
+		t.Fatalf("box score = %+v", box)
+	}
+	matchup := box.HomeTeam.Players[0].Matchups[0]
+	if matchup.PersonID != 1630162 || matchup.Statistics.PlayerPoints != 7 || matchup.Statistics.PartialPossessions != 33.6 {
+		t.Errorf("matchup = %+v", matchup)
+	}
+	if minutes, err := matchup.Statistics.Minutes(); err != nil || minutes != 6*time.Minute+43*time.Second {
+		t.Errorf("Minutes() = %v, %v", minutes, err)
+	}
+}
+
+func TestDocumentDrift(t *testing.T) {
+	body := []byte(`{"meta":{},"game":{"gameId":"1","videoAvailable":1,"actions":[
+		{"actionNumber":1,"clock":"PT12M00.00S","Period":1,"newField":true}
+	]}}`)
+
+	drift := documentDrift("playbyplayv3", body, reflect.TypeOf(playByPlayV3Document{}))
+
+	want := map[string]models.DriftKind{
+		"playbyplayv3.game.actions.period":   models.DriftRenamedColumn,
+		"playbyplayv3.game.actions.newField": models.DriftUnexpectedColumn,
+		"playbyplayv3.game.actions.teamId":   models.DriftMissingColumn,
+	}
+	got := make(map[string]models.DriftKind)
+	for _, d := range drift {
+		got[d.ResultSet+"."+d.Column] = d.Kind
+	}
+	for key, kind := range want {
+		if got[key] != kind {
+			t.Errorf("drift[%s] = %q, want %q (all: %v)", key, got[key], kind, drift)
+		}
+	}
+	if _, ok := got["playbyplayv3.game.gameId"]; ok {
+		t.Errorf("gameId reported as drift: %v", drift)
+	}
+
+	missing := documentDrift("playbyplayv3", []byte(`{"meta":{}}`), reflect.TypeOf(playByPlayV3Document{}))
+	if len(missing) != 1 || missing[0].Kind != models.DriftMissingColumn || missing[0].Column != "game" {
+		t.Errorf("drift without game = %v", missing)
+	}
+}
+
+func TestTypeDrift(t *testing.T) {
+	body := []byte(`{"meta":{},"game":{"gameId":"1","videoAvailable":"yes","actions":[
+		{"actionNumber":1,"period":"1","teamId":1610612747},
+		{"actionNumber":2,"period":"1","teamId":"LAL"}
+	]}}`)
+
+	drift := typeDrift("playbyplayv3", body, reflect.TypeOf(&playByPlayV3Document{}))
+
+	want := []string{
+		"playbyplayv3.game.videoAvailable",
+		"playbyplayv3.game.actions.period",
+		"playbyplayv3.game.actions.teamId",
+	}
+	if len(drift) != len(want) {
+		t.Fatalf("drift = %v, want %v", drift, want)
+	}
+	for i, d := range drift {
+		if got := d.ResultSet + "." + d.Column; got != want[i] || d.Kind != models.DriftInvalidValue {
+			t.Errorf("drift[%d] = %v, want invalid value of %s", i, d, want[i])
+		}
+	}
+}
@@ -17,14 +17,14 @@ type VideoEventsRequest struct {
 
 // VideoEventsVideo represents the Video result set for VideoEvents
 type VideoEventsVideo struct {
-	uuid string `json:"uuid"`
-	vl   string `json:"vl"`
-	vt   string `json:"vt"`
-	gc   string `json:"gc"`
-	surl string `json:"surl"`
-	durl string `json:"durl"`
-	vurl string `json:"vurl"`
-	purl string `json:"purl"`
+	Uuid string `json:"uuid"`
+	Vl   string `json:"vl"`
+	Vt   string `json:"vt"`
+	Gc   string `json:"gc"`
+	Surl string `json:"surl"`
+	Durl string `json:"durl"`
+	Vurl string `json:"vurl"`
+	Purl string `json:"purl"`
 }
 
 // VideoEventsResponse contains the response data from the VideoEvents endpoint
@@ -45,29 +45,26 @@ func GetVideoEvents(ctx context.Context, client *stats.Client, req VideoEventsRe
 	params.Set("GameEventID", string(req.GameEventID))
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "videoevents", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "videoevents", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &VideoEventsResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.Video = make([]VideoEventsVideo, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 8 {
-				item := VideoEventsVideo{
-					uuid: toString(row[0]),
-					vl:   toString(row[1]),
-					vt:   toString(row[2]),
-					gc:   toString(row[3]),
-					surl: toString(row[4]),
-					durl: toString(row[5]),
-					vurl: toString(row[6]),
-					purl: toString(row[7]),
-				}
-				response.Video = append(response.Video, item)
+	if rs := rawResp.resultSet("Video"); rs != nil {
+		response.Video = decodeRows(rs, func(row *rowReader) VideoEventsVideo {
+			return VideoEventsVideo{
+				Uuid: row.String("uuid"),
+				Vl:   row.String("vl"),
+				Vt:   row.String("vt"),
+				Gc:   row.String("gc"),
+				Surl: row.String("surl"),
+				Durl: row.String("durl"),
+				Vurl: row.String("vurl"),
+				Purl: row.String("purl"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
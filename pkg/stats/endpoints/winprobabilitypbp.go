@@ -61,51 +61,44 @@ func GetWinProbabilityPBP(ctx context.Context, client *stats.Client, req WinProb
 	}
 
 	var rawResp rawStatsResponse
-	if err := client.GetJSON(ctx, "winprobabilitypbp", params, &rawResp); err != nil {
+	raw, err := client.GetJSONRaw(ctx, "winprobabilitypbp", params, &rawResp)
+	if err != nil {
 		return nil, err
 	}
 
 	response := &WinProbabilityPBPResponse{}
-	if len(rawResp.ResultSets) > 0 {
-		response.WinProbPBP = make([]WinProbabilityPBPWinProbPBP, 0, len(rawResp.ResultSets[0].RowSet))
-		for _, row := range rawResp.ResultSets[0].RowSet {
-			if len(row) >= 13 {
-				item := WinProbabilityPBPWinProbPBP{
-					GAME_ID:           toString(row[0]),
-					EVENT_NUM:         toString(row[1]),
-					HOME_PCT:          toFloat(row[2]),
-					VISITOR_PCT:       toFloat(row[3]),
-					HOME_SCORE:        toString(row[4]),
-					VISITOR_SCORE:     toString(row[5]),
-					SCORE_MARGIN:      toString(row[6]),
-					HOME_PTS_EST:      toFloat(row[7]),
-					VISITOR_PTS_EST:   toFloat(row[8]),
-					HOME_PTS_RANGE:    toFloat(row[9]),
-					VISITOR_PTS_RANGE: toFloat(row[10]),
-					PERIOD:            toInt(row[11]),
-					SECONDS_REMAINING: toString(row[12]),
-				}
-				response.WinProbPBP = append(response.WinProbPBP, item)
+	if rs := rawResp.resultSet("WinProbPBP"); rs != nil {
+		response.WinProbPBP = decodeRows(rs, func(row *rowReader) WinProbabilityPBPWinProbPBP {
+			return WinProbabilityPBPWinProbPBP{
+				GAME_ID:           row.String("GAME_ID"),
+				EVENT_NUM:         row.String("EVENT_NUM"),
+				HOME_PCT:          row.Float("HOME_PCT"),
+				VISITOR_PCT:       row.Float("VISITOR_PCT"),
+				HOME_SCORE:        row.String("HOME_SCORE"),
+				VISITOR_SCORE:     row.String("VISITOR_SCORE"),
+				SCORE_MARGIN:      row.String("SCORE_MARGIN"),
+				HOME_PTS_EST:      row.Float("HOME_PTS_EST"),
+				VISITOR_PTS_EST:   row.Float("VISITOR_PTS_EST"),
+				HOME_PTS_RANGE:    row.Float("HOME_PTS_RANGE"),
+				VISITOR_PTS_RANGE: row.Float("VISITOR_PTS_RANGE"),
+				PERIOD:            row.Int("PERIOD"),
+				SECONDS_REMAINING: row.String("SECONDS_REMAINING"),
 			}
-		}
+		})
 	}
-	if len(rawResp.ResultSets) > 1 {
-		response.GameInfo = make([]WinProbabilityPBPGameInfo, 0, len(rawResp.ResultSets[1].RowSet))
-		for _, row := range rawResp.ResultSets[1].RowSet {
-			if len(row) >= 7 {
-				item := WinProbabilityPBPGameInfo{
-					GAME_ID:             toString(row[0]),
-					HOME_TEAM_ID:        toInt(row[1]),
-					VISITOR_TEAM_ID:     toInt(row[2]),
-					HOME_TEAM_ABR:       toString(row[3]),
-					VISITOR_TEAM_ABR:    toString(row[4]),
-					HOME_FINAL_SCORE:    toString(row[5]),
-					VISITOR_FINAL_SCORE: toString(row[6]),
-				}
-				response.GameInfo = append(response.GameInfo, item)
+	if rs := rawResp.resultSet("GameInfo"); rs != nil {
+		response.GameInfo = decodeRows(rs, func(row *rowReader) WinProbabilityPBPGameInfo {
+			return WinProbabilityPBPGameInfo{
+				GAME_ID:             row.String("GAME_ID"),
+				HOME_TEAM_ID:        row.Int("HOME_TEAM_ID"),
+				VISITOR_TEAM_ID:     row.Int("VISITOR_TEAM_ID"),
+				HOME_TEAM_ABR:       row.String("HOME_TEAM_ABR"),
+				VISITOR_TEAM_ABR:    row.String("VISITOR_TEAM_ABR"),
+				HOME_FINAL_SCORE:    row.String("HOME_FINAL_SCORE"),
+				VISITOR_FINAL_SCORE: row.String("VISITOR_FINAL_SCORE"),
 			}
-		}
+		})
 	}
 
-	return models.NewResponse(response, 200, "", nil), nil
+	return newStatsResponse(client, response, raw, &rawResp)
 }
@@ -1,6 +1,10 @@
 package parameters
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
 
 type PerMode string
 
@@ -61,10 +65,45 @@ func NewSeason(year int) Season {
 	return Season(fmt.Sprintf("%d-%02d", year, (year+1)%100))
 }
 
+// SeasonAt returns the season in progress at t. A new season starts in October.
+func SeasonAt(t time.Time) Season {
+	year := t.Year()
+	if t.Month() < time.October {
+		year--
+	}
+	return NewSeason(year)
+}
+
+func CurrentSeason() Season {
+	return SeasonAt(time.Now())
+}
+
+// StartYear returns the first year of a "2023-24" or "2023" style season.
+func (s Season) StartYear() (int, error) {
+	str := string(s)
+	if len(str) >= 4 {
+		str = str[:4]
+	}
+	year, err := strconv.Atoi(str)
+	if err != nil {
+		return 0, fmt.Errorf("invalid Season: %s", s)
+	}
+	return year, nil
+}
+
+// Validate accepts the all-time season "", a start year such as "2023" and
+// a season such as "2023-24".
 func (s Season) Validate() error {
 	if s == "" {
 		return nil
 	}
+	year, err := s.StartYear()
+	if err != nil {
+		return err
+	}
+	if str := string(s); str != strconv.Itoa(year) && str != string(NewSeason(year)) {
+		return fmt.Errorf("invalid Season: %s", s)
+	}
 	return nil
 }
 
@@ -126,17 +165,21 @@ func (s StatCategory) String() string {
 type MeasureType string
 
 const (
-	MeasureTypeBase     MeasureType = "Base"
-	MeasureTypeAdvanced MeasureType = "Advanced"
-	MeasureTypeMisc     MeasureType = "Misc"
-	MeasureTypeScoring  MeasureType = "Scoring"
-	MeasureTypeUsage    MeasureType = "Usage"
+	MeasureTypeBase        MeasureType = "Base"
+	MeasureTypeAdvanced    MeasureType = "Advanced"
+	MeasureTypeMisc        MeasureType = "Misc"
+	MeasureTypeScoring     MeasureType = "Scoring"
+	MeasureTypeUsage       MeasureType = "Usage"
+	MeasureTypeFourFactors MeasureType = "Four Factors"
+	MeasureTypeOpponent    MeasureType = "Opponent"
+	MeasureTypeDefense     MeasureType = "Defense"
 )
 
 func (m MeasureType) Validate() error {
 	switch m {
 	case MeasureTypeBase, MeasureTypeAdvanced, MeasureTypeMisc,
-		MeasureTypeScoring, MeasureTypeUsage, "":
+		MeasureTypeScoring, MeasureTypeUsage, MeasureTypeFourFactors,
+		MeasureTypeOpponent, MeasureTypeDefense, "":
 		return nil
 	default:
 		return fmt.Errorf("invalid MeasureType: %s", m)
@@ -1,6 +1,9 @@
 package parameters
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestPerMode_Validate(t *testing.T) {
 	tests := []struct {
@@ -105,3 +108,72 @@ func TestNewSeason(t *testing.T) {
 		})
 	}
 }
+
+func TestSeasonAt(t *testing.T) {
+	tests := []struct {
+		name string
+		t    time.Time
+		want Season
+	}{
+		{"before october", time.Date(2024, time.June, 15, 0, 0, 0, 0, time.UTC), "2023-24"},
+		{"october", time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC), "2024-25"},
+		{"century rollover", time.Date(1999, time.November, 1, 0, 0, 0, 0, time.UTC), "1999-00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SeasonAt(tt.t); got != tt.want {
+				t.Errorf("SeasonAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSeason_StartYear(t *testing.T) {
+	tests := []struct {
+		season  Season
+		want    int
+		wantErr bool
+	}{
+		{"2023-24", 2023, false},
+		{"2025", 2025, false},
+		{"", 0, true},
+		{"ALL", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.season), func(t *testing.T) {
+			got, err := tt.season.StartYear()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("StartYear() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("StartYear() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSeason_Validate(t *testing.T) {
+	tests := []struct {
+		season  Season
+		wantErr bool
+	}{
+		{"2023-24", false},
+		{"1999-00", false},
+		{"2023", false},
+		{"", false},
+		{"2023-25", true},
+		{"2023-2024", true},
+		{"latest", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.season), func(t *testing.T) {
+			err := tt.season.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Season.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -1,46 +1,103 @@
 package stats
 
 import (
-	"github.com/n-ae/nba-api-go/internal/middleware"
+	"crypto/tls"
+	"net/http"
+	"time"
+
 	"github.com/n-ae/nba-api-go/pkg/client"
+	"github.com/n-ae/nba-api-go/pkg/transport"
 )
 
 const (
 	StatsBaseURL = "https://stats.nba.com/stats"
 )
 
+type SchemaMode int
+
+const (
+	// SchemaLenient decodes what it can and reports schema drift as
+	// Response.Warnings.
+	SchemaLenient SchemaMode = iota
+	// SchemaStrict fails with *models.SchemaDriftError on any schema drift.
+	SchemaStrict
+)
+
 type Client struct {
-	client *client.Client
+	client     *client.Client
+	schemaMode SchemaMode
 }
 
 type Config struct {
-	Headers     map[string]string
-	Timeout     int
-	Middlewares []middleware.Middleware
+	Headers map[string]string
+
+	// Timeout is the per-request timeout in seconds. Zero uses
+	// client.DefaultTimeout.
+	Timeout int
+
+	HTTPClient client.HTTPClient
+	ProxyURL   string
+	TLSConfig  *tls.Config
+
+	// Middlewares replaces the default chain when non-empty.
+	Middlewares []transport.Middleware
+
+	// ExtraMiddlewares are appended to the end of the chain (default or
+	// Middlewares), so they run closest to the network.
+	ExtraMiddlewares []transport.Middleware
+
+	// SchemaMode controls how endpoints handle responses whose result sets or
+	// columns differ from the Go types.
+	SchemaMode SchemaMode
 }
 
-func NewClient(config Config) *Client {
+// DefaultMiddlewares returns the middleware chain used when Config.Middlewares
+// is empty. Each call returns fresh middlewares with their own state.
+func DefaultMiddlewares() []transport.Middleware {
+	return []transport.Middleware{
+		transport.WithRetry(transport.DefaultRetryConfig()),
+		transport.WithUserAgent("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36"),
+		transport.WithReferer("https://www.nba.com/"),
+		transport.WithAccept("application/json"),
+		transport.WithPerHostRateLimit(3, 5),
+	}
+}
+
+// NewClient builds a client from config. Options are applied last and
+// override the corresponding Config fields.
+func NewClient(config Config, opts ...client.Option) *Client {
 	clientConfig := client.Config{
-		BaseURL: StatsBaseURL,
+		BaseURL:    StatsBaseURL,
+		HTTPClient: config.HTTPClient,
+		Timeout:    time.Duration(config.Timeout) * time.Second,
+		ProxyURL:   config.ProxyURL,
+		TLSConfig:  config.TLSConfig,
+	}
+
+	if len(config.Headers) > 0 {
+		clientConfig.Headers = make(http.Header, len(config.Headers))
+		for key, value := range config.Headers {
+			clientConfig.Headers.Set(key, value)
+		}
 	}
 
 	if len(config.Middlewares) > 0 {
-		clientConfig.Middlewares = config.Middlewares
+		clientConfig.Middlewares = append(clientConfig.Middlewares, config.Middlewares...)
 	} else {
-		clientConfig.Middlewares = []middleware.Middleware{
-			middleware.WithRetry(middleware.DefaultRetryConfig()),
-			middleware.WithUserAgent("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36"),
-			middleware.WithReferer("https://www.nba.com/"),
-			middleware.WithAccept("application/json"),
-			middleware.WithPerHostRateLimit(3, 5),
-		}
+		clientConfig.Middlewares = DefaultMiddlewares()
 	}
+	clientConfig.Middlewares = append(clientConfig.Middlewares, config.ExtraMiddlewares...)
 
 	return &Client{
-		client: client.NewClient(clientConfig),
+		client:     client.NewClient(clientConfig, opts...),
+		schemaMode: config.SchemaMode,
 	}
 }
 
+func (c *Client) SchemaMode() SchemaMode {
+	return c.schemaMode
+}
+
 func NewDefaultClient() *Client {
 	return NewClient(Config{})
 }
@@ -0,0 +1,61 @@
+package stats
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/n-ae/nba-api-go/pkg/transport"
+)
+
+func TestNewClient_ExtraMiddlewares(t *testing.T) {
+	var seen http.Header
+	stop := func(next transport.RoundTripper) transport.RoundTripper {
+		return transport.RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			seen = req.Header.Clone()
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+				Body:       http.NoBody,
+			}, nil
+		})
+	}
+
+	client := NewClient(Config{
+		ExtraMiddlewares: []transport.Middleware{stop},
+	})
+
+	_, _ = client.Get(context.Background(), "playergamelog", nil)
+
+	if seen == nil {
+		t.Fatal("extra middleware was not called")
+	}
+	if seen.Get("Referer") != "https://www.nba.com/" {
+		t.Errorf("default chain not applied before extra middleware, Referer = %q", seen.Get("Referer"))
+	}
+}
+
+func TestNewClient_HonorsHeaders(t *testing.T) {
+	var seen http.Header
+	capture := func(next transport.RoundTripper) transport.RoundTripper {
+		return transport.RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			seen = req.Header.Clone()
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+				Body:       http.NoBody,
+			}, nil
+		})
+	}
+
+	client := NewClient(Config{
+		Headers:     map[string]string{"X-Team": "analytics"},
+		Middlewares: []transport.Middleware{capture},
+	})
+
+	_, _ = client.Get(context.Background(), "playergamelog", nil)
+
+	if seen.Get("X-Team") != "analytics" {
+		t.Errorf("X-Team header = %q, want %q", seen.Get("X-Team"), "analytics")
+	}
+}
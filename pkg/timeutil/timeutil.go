@@ -0,0 +1,148 @@
+// Package timeutil parses the time formats used by the NBA APIs: minutes
+// played, game dates, game clocks and tip-off times.
+package timeutil
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // Eastern must load on hosts without a zoneinfo database.
+)
+
+var ErrInvalidFormat = errors.New("invalid time format")
+
+// Eastern is the league's home time zone. Game dates and ET tip-off times
+// are wall-clock times in this zone.
+var Eastern = mustLoadLocation("America/New_York")
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+// ParseClock parses a game clock or elapsed time. It accepts ISO 8601
+// durations ("PT11M42.00S") and colon forms ("11:42", "0:42.3", "1:02:03").
+// An empty string is zero.
+func ParseClock(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	switch {
+	case s == "":
+		return 0, nil
+	case strings.HasPrefix(s, "PT"):
+		return parseISODuration(s)
+	case strings.Contains(s, ":"):
+		return parseColonDuration(s)
+	}
+	return 0, fmt.Errorf("%w: clock %q", ErrInvalidFormat, s)
+}
+
+// ParseMinutes parses minutes played. Besides the formats of ParseClock it
+// accepts decimal minutes ("34.5", "34").
+func ParseMinutes(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.HasPrefix(s, "PT") || strings.Contains(s, ":") {
+		return ParseClock(s)
+	}
+	m, err := strconv.ParseFloat(s, 64)
+	if err != nil || m < 0 {
+		return 0, fmt.Errorf("%w: minutes %q", ErrInvalidFormat, s)
+	}
+	return Minutes(m), nil
+}
+
+// Minutes converts fractional minutes to a duration rounded to the
+// millisecond.
+func Minutes(m float64) time.Duration {
+	return time.Duration(math.Round(m*60*1000)) * time.Millisecond
+}
+
+var dateLayouts = []string{
+	"Jan 02, 2006",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04:05Z",
+	"2006-01-02",
+	"01/02/2006 15:04:05",
+	"01/02/2006",
+	"Monday, January 2, 2006",
+}
+
+// ParseGameDate parses a game date such as "OCT 24, 2023", "2023-10-24",
+// "2023-10-24T00:00:00" or "10/24/2023 00:00:00" as midnight Eastern. A
+// trailing "Z" is ignored as for ParseGameET.
+func ParseGameDate(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range dateLayouts {
+		if t, err := time.ParseInLocation(layout, s, Eastern); err == nil {
+			y, m, d := t.Date()
+			return time.Date(y, m, d, 0, 0, 0, 0, Eastern), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: date %q", ErrInvalidFormat, s)
+}
+
+// ParseGameTime parses a UTC timestamp such as gameTimeUTC
+// ("2024-01-15T00:30:00Z").
+func ParseGameTime(s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, strings.TrimSpace(s))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: time %q", ErrInvalidFormat, s)
+	}
+	return t, nil
+}
+
+// ParseGameET parses an Eastern tip-off time such as gameEt. The live API
+// marks these with a "Z" suffix even though they are Eastern wall-clock
+// times, so a trailing "Z" is ignored; any other offset is honored.
+func ParseGameET(s string) (time.Time, error) {
+	s = strings.TrimSuffix(strings.TrimSpace(s), "Z")
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.In(Eastern), nil
+	}
+	t, err := time.ParseInLocation("2006-01-02T15:04:05", s, Eastern)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: time %q", ErrInvalidFormat, s)
+	}
+	return t, nil
+}
+
+func parseISODuration(s string) (time.Duration, error) {
+	rest := strings.TrimPrefix(s, "PT")
+	if rest == "" || strings.Trim(rest, "0123456789.HMS") != "" {
+		return 0, fmt.Errorf("%w: duration %q", ErrInvalidFormat, s)
+	}
+	d, err := time.ParseDuration(strings.ToLower(rest))
+	if err != nil {
+		return 0, fmt.Errorf("%w: duration %q", ErrInvalidFormat, s)
+	}
+	return d, nil
+}
+
+func parseColonDuration(s string) (time.Duration, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) > 3 {
+		return 0, fmt.Errorf("%w: clock %q", ErrInvalidFormat, s)
+	}
+
+	seconds, err := strconv.ParseFloat(parts[len(parts)-1], 64)
+	if err != nil || seconds < 0 || seconds >= 60 {
+		return 0, fmt.Errorf("%w: clock %q", ErrInvalidFormat, s)
+	}
+	d := time.Duration(math.Round(seconds*1000)) * time.Millisecond
+
+	unit := time.Minute
+	for i := len(parts) - 2; i >= 0; i-- {
+		n, err := strconv.Atoi(parts[i])
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("%w: clock %q", ErrInvalidFormat, s)
+		}
+		d += time.Duration(n) * unit
+		unit = time.Hour
+	}
+	return d, nil
+}
@@ -0,0 +1,90 @@
+package timeutil
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseClock(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"PT11M42.00S", 11*time.Minute + 42*time.Second},
+		{"PT00M04.30S", 4300 * time.Millisecond},
+		{"PT45S", 45 * time.Second},
+		{"11:42", 11*time.Minute + 42*time.Second},
+		{"0:42.3", 42300 * time.Millisecond},
+		{"240:00", 240 * time.Minute},
+		{"1:02:03", time.Hour + 2*time.Minute + 3*time.Second},
+		{"", 0},
+	}
+	for _, tt := range tests {
+		got, err := ParseClock(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseClock(%q) = %v, %v, want %v", tt.in, got, err, tt.want)
+		}
+	}
+
+	for _, in := range []string{"PT", "PT-5M", "11:75", "abc", "1:2:3:4"} {
+		if _, err := ParseClock(in); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("ParseClock(%q) error = %v, want ErrInvalidFormat", in, err)
+		}
+	}
+}
+
+func TestParseMinutes(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"34", 34 * time.Minute},
+		{"34.5", 34*time.Minute + 30*time.Second},
+		{"36:21", 36*time.Minute + 21*time.Second},
+		{"PT24M13.00S", 24*time.Minute + 13*time.Second},
+	}
+	for _, tt := range tests {
+		got, err := ParseMinutes(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseMinutes(%q) = %v, %v, want %v", tt.in, got, err, tt.want)
+		}
+	}
+	if _, err := ParseMinutes("DNP"); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("ParseMinutes(DNP) error = %v, want ErrInvalidFormat", err)
+	}
+}
+
+func TestParseGameDate(t *testing.T) {
+	want := time.Date(2023, time.October, 24, 0, 0, 0, 0, Eastern)
+	for _, in := range []string{"OCT 24, 2023", "Oct 24, 2023", "2023-10-24", "2023-10-24T00:00:00", "2023-10-24T00:00:00Z", "10/24/2023", "10/24/2023 00:00:00"} {
+		got, err := ParseGameDate(in)
+		if err != nil || !got.Equal(want) {
+			t.Errorf("ParseGameDate(%q) = %v, %v, want %v", in, got, err, want)
+		}
+	}
+	if _, err := ParseGameDate("yesterday"); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("ParseGameDate(yesterday) error = %v, want ErrInvalidFormat", err)
+	}
+}
+
+func TestParseGameTimes(t *testing.T) {
+	utc, err := ParseGameTime("2024-01-15T00:30:00Z")
+	if err != nil {
+		t.Fatalf("ParseGameTime() error = %v", err)
+	}
+
+	// gameEt carries a bogus Z; it is the same instant as the UTC time.
+	et, err := ParseGameET("2024-01-14T19:30:00Z")
+	if err != nil {
+		t.Fatalf("ParseGameET() error = %v", err)
+	}
+	if !et.Equal(utc) || et.Location() != Eastern {
+		t.Errorf("ParseGameET() = %v, want %v in Eastern", et, utc)
+	}
+
+	withOffset, err := ParseGameET("2024-01-14T19:30:00-05:00")
+	if err != nil || !withOffset.Equal(utc) {
+		t.Errorf("ParseGameET(offset) = %v, %v, want %v", withOffset, err, utc)
+	}
+}
@@ -0,0 +1,212 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+type AdaptiveRateLimitConfig struct {
+	InitialRate float64
+	MinRate     float64
+	MaxRate     float64
+	Burst       int
+
+	// IncreaseStep is added to the rate after every successful request.
+	IncreaseStep float64
+
+	// DecreaseFactor multiplies the rate after a throttled request.
+	DecreaseFactor float64
+
+	// DecreaseCooldown ignores further throttling signals for this long after
+	// a decrease, so a burst of in-flight 429s only lowers the rate once.
+	DecreaseCooldown time.Duration
+
+	// IsThrottled classifies a result as a signal to slow down. Defaults to
+	// 429 and 503 responses and timeouts.
+	IsThrottled func(resp *http.Response, err error) bool
+
+	// OnRateChange is called after the rate for host changes.
+	OnRateChange func(host string, requestsPerSecond float64)
+}
+
+func DefaultAdaptiveRateLimitConfig() AdaptiveRateLimitConfig {
+	return AdaptiveRateLimitConfig{
+		InitialRate:      3,
+		MinRate:          0.2,
+		MaxRate:          10,
+		Burst:            5,
+		IncreaseStep:     0.05,
+		DecreaseFactor:   0.5,
+		DecreaseCooldown: time.Second,
+		IsThrottled:      DefaultIsThrottled,
+	}
+}
+
+func DefaultIsThrottled(resp *http.Response, err error) bool {
+	if err != nil {
+		var netErr net.Error
+		return errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout())
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable
+}
+
+// AdaptiveRateLimiter keeps a per-host token bucket whose rate is lowered
+// multiplicatively when upstream throttles and raised additively on success.
+type AdaptiveRateLimiter struct {
+	config AdaptiveRateLimitConfig
+	mu     sync.Mutex
+	hosts  map[string]*adaptiveHost
+	now    func() time.Time
+}
+
+type adaptiveHost struct {
+	limiter      *rate.Limiter
+	rate         float64
+	lastDecrease time.Time
+}
+
+func NewAdaptiveRateLimiter(config AdaptiveRateLimitConfig) *AdaptiveRateLimiter {
+	defaults := DefaultAdaptiveRateLimitConfig()
+	if config.MaxRate <= 0 {
+		config.MaxRate = defaults.MaxRate
+	}
+	if config.MinRate <= 0 || config.MinRate > config.MaxRate {
+		config.MinRate = min(defaults.MinRate, config.MaxRate)
+	}
+	if config.InitialRate <= 0 {
+		config.InitialRate = defaults.InitialRate
+	}
+	config.InitialRate = clampRate(config.InitialRate, config.MinRate, config.MaxRate)
+	if config.Burst <= 0 {
+		config.Burst = defaults.Burst
+	}
+	if config.IncreaseStep <= 0 {
+		config.IncreaseStep = defaults.IncreaseStep
+	}
+	if config.DecreaseFactor <= 0 || config.DecreaseFactor >= 1 {
+		config.DecreaseFactor = defaults.DecreaseFactor
+	}
+	if config.DecreaseCooldown <= 0 {
+		config.DecreaseCooldown = defaults.DecreaseCooldown
+	}
+	if config.IsThrottled == nil {
+		config.IsThrottled = defaults.IsThrottled
+	}
+
+	return &AdaptiveRateLimiter{
+		config: config,
+		hosts:  make(map[string]*adaptiveHost),
+		now:    time.Now,
+	}
+}
+
+// WithAdaptiveRateLimit returns the middleware of a new AdaptiveRateLimiter.
+// Use NewAdaptiveRateLimiter directly to read the current rate.
+func WithAdaptiveRateLimit(config AdaptiveRateLimitConfig) Middleware {
+	return NewAdaptiveRateLimiter(config).Middleware()
+}
+
+func (a *AdaptiveRateLimiter) Middleware() Middleware {
+	return func(next RoundTripper) RoundTripper {
+		return RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			host := req.URL.Host
+			if err := a.host(host).limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+
+			resp, err := next.RoundTrip(ctx, req)
+			if err != nil && errors.Is(err, context.Canceled) {
+				return resp, err
+			}
+
+			if a.config.IsThrottled(resp, err) {
+				a.decrease(host)
+			} else if err == nil && resp.StatusCode < 400 {
+				a.increase(host)
+			}
+			return resp, err
+		})
+	}
+}
+
+// Rate returns the current requests per second for host; unknown hosts report
+// the initial rate.
+func (a *AdaptiveRateLimiter) Rate(host string) float64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if h, ok := a.hosts[host]; ok {
+		return h.rate
+	}
+	return a.config.InitialRate
+}
+
+// Rates returns the current requests per second of every tracked host.
+func (a *AdaptiveRateLimiter) Rates() map[string]float64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	rates := make(map[string]float64, len(a.hosts))
+	for host, h := range a.hosts {
+		rates[host] = h.rate
+	}
+	return rates
+}
+
+func (a *AdaptiveRateLimiter) host(host string) *adaptiveHost {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	h, ok := a.hosts[host]
+	if !ok {
+		h = &adaptiveHost{
+			limiter: rate.NewLimiter(rate.Limit(a.config.InitialRate), a.config.Burst),
+			rate:    a.config.InitialRate,
+		}
+		a.hosts[host] = h
+	}
+	return h
+}
+
+func (a *AdaptiveRateLimiter) increase(host string) {
+	a.adjust(host, func(h *adaptiveHost, now time.Time) float64 {
+		return h.rate + a.config.IncreaseStep
+	})
+}
+
+func (a *AdaptiveRateLimiter) decrease(host string) {
+	a.adjust(host, func(h *adaptiveHost, now time.Time) float64 {
+		if !h.lastDecrease.IsZero() && now.Sub(h.lastDecrease) < a.config.DecreaseCooldown {
+			return h.rate
+		}
+		h.lastDecrease = now
+		return h.rate * a.config.DecreaseFactor
+	})
+}
+
+func (a *AdaptiveRateLimiter) adjust(host string, next func(h *adaptiveHost, now time.Time) float64) {
+	h := a.host(host)
+
+	a.mu.Lock()
+	updated := clampRate(next(h, a.now()), a.config.MinRate, a.config.MaxRate)
+	changed := updated != h.rate
+	if changed {
+		h.rate = updated
+		h.limiter.SetLimit(rate.Limit(updated))
+	}
+	a.mu.Unlock()
+
+	if changed && a.config.OnRateChange != nil {
+		a.config.OnRateChange(host, updated)
+	}
+}
+
+func clampRate(r, lower, upper float64) float64 {
+	return max(lower, min(r, upper))
+}
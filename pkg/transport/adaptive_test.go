@@ -0,0 +1,119 @@
+package transport
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAdaptiveRateLimiter_AIMD(t *testing.T) {
+	now := time.Unix(0, 0)
+	var changes []float64
+
+	limiter := NewAdaptiveRateLimiter(AdaptiveRateLimitConfig{
+		InitialRate:      8,
+		MinRate:          1,
+		MaxRate:          10,
+		Burst:            100,
+		IncreaseStep:     1,
+		DecreaseFactor:   0.5,
+		DecreaseCooldown: time.Second,
+		OnRateChange: func(host string, rps float64) {
+			changes = append(changes, rps)
+		},
+	})
+	limiter.now = func() time.Time { return now }
+
+	status, calls := http.StatusOK, 0
+	rt := limiter.Middleware()(statusTransport(&status, &calls))
+	do := func() {
+		req := httptest.NewRequest(http.MethodGet, "https://stats.nba.com/stats/x", nil)
+		if _, err := rt.RoundTrip(context.Background(), req); err != nil {
+			t.Fatalf("RoundTrip() error = %v", err)
+		}
+	}
+
+	do()
+	do()
+	do()
+	if got := limiter.Rate("stats.nba.com"); got != 10 {
+		t.Fatalf("Rate() after successes = %v, want ceiling 10", got)
+	}
+
+	status = http.StatusTooManyRequests
+	do()
+	do()
+	if got := limiter.Rate("stats.nba.com"); got != 5 {
+		t.Fatalf("Rate() within cooldown = %v, want 5", got)
+	}
+
+	for range 5 {
+		now = now.Add(2 * time.Second)
+		do()
+	}
+	if got := limiter.Rate("stats.nba.com"); got != 1 {
+		t.Fatalf("Rate() after repeated throttling = %v, want floor 1", got)
+	}
+
+	want := []float64{9, 10, 5, 2.5, 1.25, 1}
+	if len(changes) != len(want) {
+		t.Fatalf("OnRateChange calls = %v, want %v", changes, want)
+	}
+	for i := range want {
+		if math.Abs(changes[i]-want[i]) > 1e-9 {
+			t.Errorf("change[%d] = %v, want %v", i, changes[i], want[i])
+		}
+	}
+}
+
+func TestAdaptiveRateLimiter_TimeoutsAndUnknownHosts(t *testing.T) {
+	limiter := NewAdaptiveRateLimiter(AdaptiveRateLimitConfig{InitialRate: 4, Burst: 10})
+
+	if got := limiter.Rate("cdn.nba.com"); got != 4 {
+		t.Errorf("Rate() for unknown host = %v, want initial 4", got)
+	}
+	if len(limiter.Rates()) != 0 {
+		t.Errorf("Rate() should not start tracking a host")
+	}
+
+	rt := limiter.Middleware()(RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		return nil, context.DeadlineExceeded
+	}))
+	req := httptest.NewRequest(http.MethodGet, "https://stats.nba.com/stats/x", nil)
+	rt.RoundTrip(context.Background(), req)
+
+	if got := limiter.Rates()["stats.nba.com"]; got != 2 {
+		t.Errorf("Rate() after timeout = %v, want 2", got)
+	}
+}
+
+func TestAdaptiveRateLimiter_Defaults(t *testing.T) {
+	now := time.Unix(0, 0)
+	limiter := NewAdaptiveRateLimiter(AdaptiveRateLimitConfig{InitialRate: 4})
+	limiter.now = func() time.Time { return now }
+
+	status, calls := http.StatusOK, 0
+	rt := limiter.Middleware()(statusTransport(&status, &calls))
+	do := func() {
+		req := httptest.NewRequest(http.MethodGet, "https://stats.nba.com/stats/x", nil)
+		if _, err := rt.RoundTrip(context.Background(), req); err != nil {
+			t.Fatalf("RoundTrip() error = %v", err)
+		}
+	}
+
+	do()
+	if got := limiter.Rate("stats.nba.com"); math.Abs(got-4.05) > 1e-9 {
+		t.Errorf("Rate() after a success = %v, want 4.05", got)
+	}
+
+	// Throttled requests within the default cooldown lower the rate once.
+	status = http.StatusTooManyRequests
+	do()
+	do()
+	if got := limiter.Rate("stats.nba.com"); math.Abs(got-2.025) > 1e-9 {
+		t.Errorf("Rate() after throttling = %v, want 2.025", got)
+	}
+}
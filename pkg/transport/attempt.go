@@ -0,0 +1,51 @@
+package transport
+
+import "context"
+
+type attemptKey struct{}
+
+type attemptCounterKey struct{}
+
+// AttemptFromContext returns the 1-based attempt number that WithRetry is
+// currently making, or 1 outside of a retry loop.
+func AttemptFromContext(ctx context.Context) int {
+	if attempt, ok := ctx.Value(attemptKey{}).(int); ok {
+		return attempt
+	}
+	return 1
+}
+
+func withAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, attemptKey{}, attempt)
+}
+
+// TrackAttempts returns a context in which WithRetry records how many
+// attempts it made, and a function reporting that count (1 if no retry
+// middleware ran).
+func TrackAttempts(ctx context.Context) (context.Context, func() int) {
+	counter := new(int)
+	return context.WithValue(ctx, attemptCounterKey{}, counter), func() int {
+		if *counter == 0 {
+			return 1
+		}
+		return *counter
+	}
+}
+
+func recordAttempt(ctx context.Context, attempt int) {
+	if counter, ok := ctx.Value(attemptCounterKey{}).(*int); ok {
+		*counter = attempt
+	}
+}
+
+// attemptsMade reports the current attempt when called inside WithRetry, or
+// the number of attempts recorded by TrackAttempts when called outside it.
+func attemptsMade(ctx context.Context) int {
+	if attempt, ok := ctx.Value(attemptKey{}).(int); ok {
+		return attempt
+	}
+	if counter, ok := ctx.Value(attemptCounterKey{}).(*int); ok && *counter > 0 {
+		return *counter
+	}
+	return 1
+}
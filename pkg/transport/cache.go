@@ -0,0 +1,432 @@
+package transport
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheStatusHeader is set on every response that passes through WithCache
+// to CacheHit or CacheMiss.
+const (
+	CacheStatusHeader = "X-Cache"
+	CacheHit          = "HIT"
+	CacheMiss         = "MISS"
+)
+
+type CachedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+	StoredAt   time.Time   `json:"stored_at"`
+	ExpiresAt  time.Time   `json:"expires_at"`
+}
+
+func (c *CachedResponse) expired(now time.Time) bool {
+	return !c.ExpiresAt.IsZero() && now.After(c.ExpiresAt)
+}
+
+// Cache stores responses keyed by CacheKey. Implementations must be safe for
+// concurrent use and must not return expired entries.
+type Cache interface {
+	Get(key string) (*CachedResponse, bool)
+	Set(key string, entry *CachedResponse)
+	Delete(key string)
+}
+
+// CacheKey returns the cache key for req: the method and the full URL, whose
+// query is already sorted by client.buildURL.
+func CacheKey(req *http.Request) string {
+	return req.Method + " " + req.URL.String()
+}
+
+type CacheConfig struct {
+	Cache Cache
+
+	// DefaultTTL applies to endpoints with no more specific rule.
+	DefaultTTL time.Duration
+
+	// HistoricalTTL applies to requests for a past season: by their Season
+	// parameter, or by their GameID, which holds the season of the game, so
+	// box scores and play-by-play of games from past seasons are kept. Games
+	// of the current season keep their endpoint TTL, since a request does not
+	// tell whether the game is over.
+	HistoricalTTL time.Duration
+
+	// EndpointTTLs overrides the TTL per endpoint, keyed by the lowercase last
+	// path segment (e.g. "scoreboardv2"). A zero TTL disables caching.
+	EndpointTTLs map[string]time.Duration
+
+	// PrefixTTLs overrides the TTL of endpoints whose lowercase last path
+	// segment starts with the key, for per-game files such as
+	// "boxscore_0022300061.json". EndpointTTLs take precedence and the
+	// longest matching prefix wins.
+	PrefixTTLs map[string]time.Duration
+
+	// TTL, when set, replaces the built-in policy entirely.
+	TTL func(req *http.Request) time.Duration
+}
+
+func DefaultCacheConfig(cache Cache) CacheConfig {
+	return CacheConfig{
+		Cache:         cache,
+		DefaultTTL:    10 * time.Minute,
+		HistoricalTTL: 7 * 24 * time.Hour,
+		EndpointTTLs: map[string]time.Duration{
+			"scoreboardv2":             5 * time.Second,
+			"scoreboardv3":             5 * time.Second,
+			"todaysscoreboard_00.json": 5 * time.Second,
+			"odds_todaysgames.json":    30 * time.Second,
+			"playbyplayv2":             30 * time.Second,
+			"playbyplayv3":             30 * time.Second,
+			"boxscoresummaryv2":        time.Minute,
+			"boxscoretraditionalv2":    time.Minute,
+			"boxscoreadvancedv2":       time.Minute,
+			"boxscoremiscv2":           time.Minute,
+			"boxscorescoringv2":        time.Minute,
+			"boxscoreusagev2":          time.Minute,
+			"boxscorefourfactorsv2":    time.Minute,
+			"boxscoreplayertrackv2":    time.Minute,
+			"boxscoredefensivev2":      time.Minute,
+			"boxscorehustlev2":         time.Minute,
+			"boxscorematchupsv3":       time.Minute,
+			"boxscoresummaryv3":        time.Minute,
+			"boxscoretraditionalv3":    time.Minute,
+			"boxscoreadvancedv3":       time.Minute,
+			"boxscoremiscv3":           time.Minute,
+			"boxscorescoringv3":        time.Minute,
+			"boxscoreusagev3":          time.Minute,
+			"boxscorefourfactorsv3":    time.Minute,
+			"boxscoreplayertrackv3":    time.Minute,
+			"boxscoredefensivev3":      time.Minute,
+			"boxscorehustlev3":         time.Minute,
+		},
+		PrefixTTLs: map[string]time.Duration{
+			"playbyplay_": 30 * time.Second,
+			"boxscore_":   time.Minute,
+		},
+	}
+}
+
+func (c CacheConfig) ttlFor(req *http.Request) time.Duration {
+	if c.TTL != nil {
+		return c.TTL(req)
+	}
+
+	endpoint := strings.ToLower(path.Base(req.URL.Path))
+	ttl, ok := c.EndpointTTLs[endpoint]
+	if !ok {
+		ttl, ok = c.prefixTTL(endpoint)
+	}
+	if ok && ttl == 0 {
+		return 0
+	}
+
+	query := req.URL.Query()
+	if c.HistoricalTTL > 0 && (isHistoricalSeason(query.Get("Season")) || isHistoricalGame(gameID(query, endpoint))) {
+		return c.HistoricalTTL
+	}
+
+	if ok {
+		return ttl
+	}
+	return c.DefaultTTL
+}
+
+func (c CacheConfig) prefixTTL(endpoint string) (time.Duration, bool) {
+	var ttl time.Duration
+	longest := -1
+	for prefix, prefixTTL := range c.PrefixTTLs {
+		if strings.HasPrefix(endpoint, prefix) && len(prefix) > longest {
+			ttl, longest = prefixTTL, len(prefix)
+		}
+	}
+	return ttl, longest >= 0
+}
+
+// gameID returns the GameID parameter, or the ID that ends a per-game file
+// name such as "boxscore_0022300061.json".
+func gameID(query url.Values, endpoint string) string {
+	if id := query.Get("GameID"); id != "" {
+		return id
+	}
+	if name, ok := strings.CutSuffix(endpoint, ".json"); ok {
+		return name[strings.LastIndex(name, "_")+1:]
+	}
+	return ""
+}
+
+// isHistoricalSeason reports whether season, such as "2023-24" or "2023",
+// started before the season in progress.
+func isHistoricalSeason(season string) bool {
+	if len(season) < 4 {
+		return false
+	}
+	year, err := strconv.Atoi(season[:4])
+	if err != nil {
+		return false
+	}
+	return year < seasonStartYear(time.Now())
+}
+
+// isHistoricalGame reports whether gameID, such as "0022300061", is a game of
+// a past season. Its fourth and fifth digits are the season's start year.
+func isHistoricalGame(gameID string) bool {
+	if len(gameID) != 10 {
+		return false
+	}
+	yy, err := strconv.Atoi(gameID[3:5])
+	if err != nil {
+		return false
+	}
+	year := 2000 + yy
+	if yy >= 46 {
+		year = 1900 + yy
+	}
+	return year < seasonStartYear(time.Now())
+}
+
+// seasonStartYear returns the start year of the season in progress at t. A
+// new season starts in October, as in parameters.SeasonAt.
+func seasonStartYear(t time.Time) int {
+	if t.Month() < time.October {
+		return t.Year() - 1
+	}
+	return t.Year()
+}
+
+// WithCache serves successful GET responses from config.Cache. It should be
+// placed before retry and rate limiting so hits cost no upstream budget.
+func WithCache(config CacheConfig) Middleware {
+	return func(next RoundTripper) RoundTripper {
+		return RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			if req.Method != http.MethodGet || config.Cache == nil {
+				return next.RoundTrip(ctx, req)
+			}
+
+			ttl := config.ttlFor(req)
+			if ttl <= 0 {
+				return next.RoundTrip(ctx, req)
+			}
+
+			key := CacheKey(req)
+			if entry, ok := config.Cache.Get(key); ok {
+				return entry.response(req, CacheHit), nil
+			}
+
+			resp, err := next.RoundTrip(ctx, req)
+			if err != nil || resp.StatusCode != http.StatusOK {
+				return resp, err
+			}
+
+			body, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+
+			now := time.Now()
+			entry := &CachedResponse{
+				StatusCode: resp.StatusCode,
+				Header:     resp.Header.Clone(),
+				Body:       body,
+				StoredAt:   now,
+				ExpiresAt:  now.Add(ttl),
+			}
+			config.Cache.Set(key, entry)
+
+			return entry.response(req, CacheMiss), nil
+		})
+	}
+}
+
+func (c *CachedResponse) response(req *http.Request, status string) *http.Response {
+	header := c.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set(CacheStatusHeader, status)
+
+	return bufferedResponse(req, c.StatusCode, header, c.Body)
+}
+
+// bufferedResponse builds a response that reads from an in-memory body.
+func bufferedResponse(req *http.Request, statusCode int, header http.Header, body []byte) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// MemoryCache is an in-memory LRU cache bounded by entry count.
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	ll         *list.List
+	items      map[string]*list.Element
+}
+
+type memoryCacheItem struct {
+	key   string
+	entry *CachedResponse
+}
+
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		items:      make(map[string]*list.Element),
+	}
+}
+
+func (m *MemoryCache) Get(key string) (*CachedResponse, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	elem, ok := m.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	item := elem.Value.(*memoryCacheItem)
+	if item.entry.expired(time.Now()) {
+		m.removeElement(elem)
+		return nil, false
+	}
+
+	m.ll.MoveToFront(elem)
+	return item.entry, true
+}
+
+func (m *MemoryCache) Set(key string, entry *CachedResponse) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if elem, ok := m.items[key]; ok {
+		elem.Value.(*memoryCacheItem).entry = entry
+		m.ll.MoveToFront(elem)
+		return
+	}
+
+	m.items[key] = m.ll.PushFront(&memoryCacheItem{key: key, entry: entry})
+
+	if m.maxEntries > 0 && m.ll.Len() > m.maxEntries {
+		m.removeElement(m.ll.Back())
+	}
+}
+
+func (m *MemoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if elem, ok := m.items[key]; ok {
+		m.removeElement(elem)
+	}
+}
+
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.ll.Len()
+}
+
+func (m *MemoryCache) removeElement(elem *list.Element) {
+	m.ll.Remove(elem)
+	delete(m.items, elem.Value.(*memoryCacheItem).key)
+}
+
+// DiskCache stores one JSON file per entry in a directory, so cached
+// responses survive process restarts.
+type DiskCache struct {
+	dir string
+	mu  sync.RWMutex
+}
+
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+func (d *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (d *DiskCache) Get(key string) (*CachedResponse, bool) {
+	d.mu.RLock()
+	data, err := os.ReadFile(d.path(key))
+	d.mu.RUnlock()
+	if err != nil {
+		return nil, false
+	}
+
+	var entry CachedResponse
+	if err := json.Unmarshal(data, &entry); err != nil {
+		d.Delete(key)
+		return nil, false
+	}
+
+	if entry.expired(time.Now()) {
+		d.Delete(key)
+		return nil, false
+	}
+
+	return &entry, true
+}
+
+func (d *DiskCache) Set(key string, entry *CachedResponse) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	tmp, err := os.CreateTemp(d.dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), d.path(key)); err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+func (d *DiskCache) Delete(key string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	_ = os.Remove(d.path(key))
+}
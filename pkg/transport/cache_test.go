@@ -0,0 +1,157 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func countingTransport(calls *int32, body string) RoundTripper {
+	return RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(calls, 1)
+		rec := httptest.NewRecorder()
+		rec.WriteString(body)
+		return rec.Result(), nil
+	})
+}
+
+func TestWithCache_HitAndMiss(t *testing.T) {
+	var calls int32
+	cache := NewMemoryCache(10)
+	rt := WithCache(DefaultCacheConfig(cache))(countingTransport(&calls, `{"ok":true}`))
+
+	for i, want := range []string{CacheMiss, CacheHit} {
+		req := httptest.NewRequest(http.MethodGet, "https://stats.nba.com/stats/playercareerstats?PlayerID=2544", nil)
+		resp, err := rt.RoundTrip(context.Background(), req)
+		if err != nil {
+			t.Fatalf("RoundTrip() error = %v", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		if string(body) != `{"ok":true}` {
+			t.Errorf("request %d body = %s", i, body)
+		}
+		if got := resp.Header.Get(CacheStatusHeader); got != want {
+			t.Errorf("request %d %s = %q, want %q", i, CacheStatusHeader, got, want)
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("upstream calls = %d, want 1", calls)
+	}
+}
+
+func TestWithCache_ZeroTTLBypasses(t *testing.T) {
+	var calls int32
+	config := DefaultCacheConfig(NewMemoryCache(10))
+	config.EndpointTTLs["scoreboardv2"] = 0
+	rt := WithCache(config)(countingTransport(&calls, `{}`))
+
+	for i := 0; i < 2; i++ {
+		req := httptest.NewRequest(http.MethodGet, "https://stats.nba.com/stats/scoreboardv2?GameDate=2024-01-01", nil)
+		if _, err := rt.RoundTrip(context.Background(), req); err != nil {
+			t.Fatalf("RoundTrip() error = %v", err)
+		}
+	}
+
+	if calls != 2 {
+		t.Errorf("upstream calls = %d, want 2", calls)
+	}
+}
+
+func TestCacheConfig_TTLPolicy(t *testing.T) {
+	config := DefaultCacheConfig(nil)
+	current := seasonStartYear(time.Now())
+
+	tests := []struct {
+		url  string
+		want time.Duration
+	}{
+		{"https://stats.nba.com/stats/scoreboardv2?GameDate=2024-01-01", 5 * time.Second},
+		{"https://stats.nba.com/stats/playergamelog?Season=2015-16", config.HistoricalTTL},
+		{fmt.Sprintf("https://stats.nba.com/stats/playergamelog?Season=%d-%02d", current, (current+1)%100), config.DefaultTTL},
+		{"https://stats.nba.com/stats/commonallplayers", config.DefaultTTL},
+		{"https://stats.nba.com/stats/boxscoretraditionalv3?GameID=0022300061", config.HistoricalTTL},
+		{"https://stats.nba.com/stats/playbyplayv3?GameID=0029600001", config.HistoricalTTL},
+		{"https://cdn.nba.com/static/json/liveData/odds/odds_todaysGames.json", 30 * time.Second},
+		{fmt.Sprintf("https://cdn.nba.com/static/json/liveData/boxscore/boxscore_002%02d00061.json", current%100), time.Minute},
+		{fmt.Sprintf("https://cdn.nba.com/static/json/liveData/playbyplay/playbyplay_002%02d00061.json", current%100), 30 * time.Second},
+		{"https://cdn.nba.com/static/json/liveData/playbyplay/playbyplay_0022300061.json", config.HistoricalTTL},
+		{fmt.Sprintf("https://stats.nba.com/stats/boxscoretraditionalv3?GameID=002%02d00061", current%100), time.Minute},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+		if got := config.ttlFor(req); got != tt.want {
+			t.Errorf("ttlFor(%s) = %v, want %v", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestSeasonStartYear(t *testing.T) {
+	if got := seasonStartYear(time.Date(2024, time.September, 30, 0, 0, 0, 0, time.UTC)); got != 2023 {
+		t.Errorf("seasonStartYear(September 2024) = %d, want 2023", got)
+	}
+	if got := seasonStartYear(time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC)); got != 2024 {
+		t.Errorf("seasonStartYear(October 2024) = %d, want 2024", got)
+	}
+}
+
+func TestMemoryCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewMemoryCache(2)
+	cache.Set("a", &CachedResponse{Body: []byte("a")})
+	cache.Set("b", &CachedResponse{Body: []byte("b")})
+	cache.Get("a")
+	cache.Set("c", &CachedResponse{Body: []byte("c")})
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("expected b to be evicted")
+	}
+	if _, ok := cache.Get("a"); !ok {
+		t.Error("expected a to remain")
+	}
+	if cache.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", cache.Len())
+	}
+}
+
+func TestMemoryCache_Expiry(t *testing.T) {
+	cache := NewMemoryCache(0)
+	cache.Set("k", &CachedResponse{ExpiresAt: time.Now().Add(-time.Second)})
+
+	if _, ok := cache.Get("k"); ok {
+		t.Error("expected expired entry to be dropped")
+	}
+}
+
+func TestDiskCache_RoundTrip(t *testing.T) {
+	cache, err := NewDiskCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewDiskCache() error = %v", err)
+	}
+
+	entry := &CachedResponse{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       []byte(`{"resultSets":[]}`),
+		ExpiresAt:  time.Now().Add(time.Hour),
+	}
+	cache.Set("GET https://stats.nba.com/stats/x", entry)
+
+	got, ok := cache.Get("GET https://stats.nba.com/stats/x")
+	if !ok {
+		t.Fatal("expected disk cache hit")
+	}
+	if string(got.Body) != string(entry.Body) || got.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("Get() = %+v", got)
+	}
+
+	cache.Delete("GET https://stats.nba.com/stats/x")
+	if _, ok := cache.Get("GET https://stats.nba.com/stats/x"); ok {
+		t.Error("expected entry to be deleted")
+	}
+}
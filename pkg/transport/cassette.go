@@ -0,0 +1,218 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+var ErrCassetteMiss = errors.New("no recorded interaction")
+
+type CassetteMode int
+
+const (
+	// ModeReplay serves only recorded interactions and fails on a miss.
+	ModeReplay CassetteMode = iota
+	// ModeRecord always calls upstream and overwrites matching interactions.
+	ModeRecord
+	// ModeRecordMissing replays when possible and records on a miss.
+	ModeRecordMissing
+)
+
+func (m CassetteMode) String() string {
+	switch m {
+	case ModeReplay:
+		return "replay"
+	case ModeRecord:
+		return "record"
+	case ModeRecordMissing:
+		return "record-missing"
+	default:
+		return fmt.Sprintf("CassetteMode(%d)", int(m))
+	}
+}
+
+// ParseCassetteMode parses "replay", "record" or "record-missing".
+func ParseCassetteMode(s string) (CassetteMode, error) {
+	switch s {
+	case "replay", "":
+		return ModeReplay, nil
+	case "record":
+		return ModeRecord, nil
+	case "record-missing":
+		return ModeRecordMissing, nil
+	default:
+		return ModeReplay, fmt.Errorf("invalid cassette mode: %s", s)
+	}
+}
+
+type RecordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+}
+
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+type Interaction struct {
+	Request    RecordedRequest  `json:"request"`
+	Response   RecordedResponse `json:"response"`
+	RecordedAt time.Time        `json:"recorded_at"`
+}
+
+// Cassette is a file of recorded HTTP interactions. Requests are matched on
+// method, path and sorted query; the host is ignored.
+type Cassette struct {
+	path         string
+	mu           sync.Mutex
+	Interactions []Interaction `json:"interactions"`
+}
+
+// LoadCassette reads the cassette at path. A missing file yields an empty
+// cassette that is created on the first recording.
+func LoadCassette(path string) (*Cassette, error) {
+	cassette := &Cassette{path: path}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cassette, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cassette: %w", err)
+	}
+
+	if err := json.Unmarshal(data, cassette); err != nil {
+		return nil, fmt.Errorf("failed to parse cassette %s: %w", path, err)
+	}
+
+	return cassette, nil
+}
+
+func (c *Cassette) Path() string {
+	return c.path
+}
+
+func (c *Cassette) Save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.saveLocked()
+}
+
+func (c *Cassette) saveLocked() error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return fmt.Errorf("failed to create cassette directory: %w", err)
+	}
+
+	return os.WriteFile(c.path, data, 0644)
+}
+
+func (c *Cassette) find(key string) (Interaction, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, interaction := range c.Interactions {
+		if matchKey(interaction.Request.Method, interaction.Request.URL) == key {
+			return interaction, true
+		}
+	}
+	return Interaction{}, false
+}
+
+func (c *Cassette) record(interaction Interaction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := matchKey(interaction.Request.Method, interaction.Request.URL)
+	for i, existing := range c.Interactions {
+		if matchKey(existing.Request.Method, existing.Request.URL) == key {
+			c.Interactions[i] = interaction
+			return c.saveLocked()
+		}
+	}
+
+	c.Interactions = append(c.Interactions, interaction)
+	return c.saveLocked()
+}
+
+func matchKey(method, rawURL string) string {
+	req, err := http.NewRequest(method, rawURL, nil)
+	if err != nil {
+		return method + " " + rawURL
+	}
+	return requestMatchKey(req)
+}
+
+func requestMatchKey(req *http.Request) string {
+	return req.Method + " " + req.URL.Path + "?" + req.URL.Query().Encode()
+}
+
+// WithCassette records upstream exchanges to cassette and replays them
+// according to mode.
+func WithCassette(cassette *Cassette, mode CassetteMode) Middleware {
+	return func(next RoundTripper) RoundTripper {
+		return RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			key := requestMatchKey(req)
+
+			if mode != ModeRecord {
+				if interaction, ok := cassette.find(key); ok {
+					return interaction.Response.toHTTP(req), nil
+				}
+				if mode == ModeReplay {
+					return nil, fmt.Errorf("%w for %s in %s", ErrCassetteMiss, key, cassette.path)
+				}
+			}
+
+			resp, err := next.RoundTrip(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+
+			body, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+
+			interaction := Interaction{
+				Request: RecordedRequest{
+					Method: req.Method,
+					URL:    req.URL.String(),
+				},
+				Response: RecordedResponse{
+					StatusCode: resp.StatusCode,
+					Header:     resp.Header.Clone(),
+					Body:       string(body),
+				},
+				RecordedAt: time.Now().UTC(),
+			}
+			if err := cassette.record(interaction); err != nil {
+				return nil, fmt.Errorf("failed to save cassette: %w", err)
+			}
+
+			return interaction.Response.toHTTP(req), nil
+		})
+	}
+}
+
+func (r RecordedResponse) toHTTP(req *http.Request) *http.Response {
+	header := r.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	return bufferedResponse(req, r.StatusCode, header, []byte(r.Body))
+}
@@ -0,0 +1,91 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+)
+
+func TestWithCassette_RecordThenReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	var calls int32
+	upstream := countingTransport(&calls, `{"resultSets":[]}`)
+
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		t.Fatalf("LoadCassette() error = %v", err)
+	}
+	recorder := WithCassette(cassette, ModeRecord)(upstream)
+	req := httptest.NewRequest(http.MethodGet, "https://stats.nba.com/stats/playergamelog?Season=2023-24&PlayerID=2544", nil)
+	if _, err := recorder.RoundTrip(context.Background(), req); err != nil {
+		t.Fatalf("record RoundTrip() error = %v", err)
+	}
+
+	reloaded, err := LoadCassette(path)
+	if err != nil {
+		t.Fatalf("LoadCassette() error = %v", err)
+	}
+	replayer := WithCassette(reloaded, ModeReplay)(upstream)
+
+	// Same query in a different order and on a different host still matches.
+	req = httptest.NewRequest(http.MethodGet, "http://localhost/stats/playergamelog?PlayerID=2544&Season=2023-24", nil)
+	resp, err := replayer.RoundTrip(context.Background(), req)
+	if err != nil {
+		t.Fatalf("replay RoundTrip() error = %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != `{"resultSets":[]}` {
+		t.Errorf("replayed body = %s", body)
+	}
+	if calls != 1 {
+		t.Errorf("upstream calls = %d, want 1", calls)
+	}
+}
+
+func TestWithCassette_ReplayMiss(t *testing.T) {
+	cassette, err := LoadCassette(filepath.Join(t.TempDir(), "missing.json"))
+	if err != nil {
+		t.Fatalf("LoadCassette() error = %v", err)
+	}
+
+	var calls int32
+	rt := WithCassette(cassette, ModeReplay)(countingTransport(&calls, `{}`))
+	req := httptest.NewRequest(http.MethodGet, "https://stats.nba.com/stats/x", nil)
+
+	if _, err := rt.RoundTrip(context.Background(), req); !errors.Is(err, ErrCassetteMiss) {
+		t.Errorf("RoundTrip() error = %v, want ErrCassetteMiss", err)
+	}
+	if calls != 0 {
+		t.Errorf("upstream calls = %d, want 0", calls)
+	}
+}
+
+func TestWithCassette_RecordMissing(t *testing.T) {
+	cassette, err := LoadCassette(filepath.Join(t.TempDir(), "cassette.json"))
+	if err != nil {
+		t.Fatalf("LoadCassette() error = %v", err)
+	}
+
+	var calls int32
+	rt := WithCassette(cassette, ModeRecordMissing)(countingTransport(&calls, `{}`))
+
+	for i := 0; i < 3; i++ {
+		req := httptest.NewRequest(http.MethodGet, "https://stats.nba.com/stats/x?a=1", nil)
+		if _, err := rt.RoundTrip(context.Background(), req); err != nil {
+			t.Fatalf("RoundTrip() error = %v", err)
+		}
+	}
+
+	if atomic.LoadInt32(&calls) != 1 {
+		t.Errorf("upstream calls = %d, want 1", calls)
+	}
+	if len(cassette.Interactions) != 1 {
+		t.Errorf("len(Interactions) = %d, want 1", len(cassette.Interactions))
+	}
+}
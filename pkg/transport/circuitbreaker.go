@@ -0,0 +1,246 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+)
+
+type CircuitState int
+
+const (
+	CircuitClosed CircuitState = iota
+	CircuitOpen
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return fmt.Sprintf("CircuitState(%d)", int(s))
+	}
+}
+
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures that opens the
+	// circuit for a host.
+	FailureThreshold int
+
+	// Cooldown is how long an open circuit rejects requests before letting
+	// trial requests through in the half-open state.
+	Cooldown time.Duration
+
+	// HalfOpenMaxRequests caps concurrent trial requests while half-open.
+	HalfOpenMaxRequests int
+
+	// IsFailure classifies an upstream result. Defaults to transport errors
+	// and 5xx responses. Caller cancellation is never classified: it neither
+	// counts as a failure nor closes a half-open circuit.
+	IsFailure func(resp *http.Response, err error) bool
+
+	// OnStateChange is called synchronously on every transition and must not
+	// call back into the breaker.
+	OnStateChange func(host string, from, to CircuitState)
+}
+
+func DefaultCircuitBreakerConfig() CircuitBreakerConfig {
+	return CircuitBreakerConfig{
+		FailureThreshold:    5,
+		Cooldown:            30 * time.Second,
+		HalfOpenMaxRequests: 1,
+		IsFailure:           DefaultIsFailure,
+	}
+}
+
+func DefaultIsFailure(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, context.Canceled)
+	}
+	return resp.StatusCode >= 500
+}
+
+// CircuitBreaker tracks an independent circuit per request host.
+type CircuitBreaker struct {
+	config   CircuitBreakerConfig
+	mu       sync.Mutex
+	circuits map[string]*circuit
+	now      func() time.Time
+}
+
+type circuit struct {
+	state            CircuitState
+	failures         int
+	openedAt         time.Time
+	halfOpenInFlight int
+}
+
+func NewCircuitBreaker(config CircuitBreakerConfig) *CircuitBreaker {
+	defaults := DefaultCircuitBreakerConfig()
+	if config.FailureThreshold <= 0 {
+		config.FailureThreshold = defaults.FailureThreshold
+	}
+	if config.Cooldown <= 0 {
+		config.Cooldown = defaults.Cooldown
+	}
+	if config.HalfOpenMaxRequests <= 0 {
+		config.HalfOpenMaxRequests = defaults.HalfOpenMaxRequests
+	}
+	if config.IsFailure == nil {
+		config.IsFailure = defaults.IsFailure
+	}
+
+	return &CircuitBreaker{
+		config:   config,
+		circuits: make(map[string]*circuit),
+		now:      time.Now,
+	}
+}
+
+// WithCircuitBreaker returns the middleware of a new CircuitBreaker. Use
+// NewCircuitBreaker directly when the state needs to be inspected.
+func WithCircuitBreaker(config CircuitBreakerConfig) Middleware {
+	return NewCircuitBreaker(config).Middleware()
+}
+
+func (cb *CircuitBreaker) Middleware() Middleware {
+	return func(next RoundTripper) RoundTripper {
+		return RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			host := req.URL.Host
+
+			trial, err := cb.allow(host)
+			if err != nil {
+				return nil, err
+			}
+
+			resp, err := next.RoundTrip(ctx, req)
+			if errors.Is(err, context.Canceled) {
+				cb.release(host, trial)
+				return resp, err
+			}
+			cb.record(host, trial, cb.config.IsFailure(resp, err))
+			return resp, err
+		})
+	}
+}
+
+// State returns the current state for host; unknown hosts are closed.
+func (cb *CircuitBreaker) State(host string) CircuitState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	c, ok := cb.circuits[host]
+	if !ok {
+		return CircuitClosed
+	}
+	cb.refreshLocked(host, c)
+	return c.state
+}
+
+// States returns a snapshot of every tracked host.
+func (cb *CircuitBreaker) States() map[string]CircuitState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	states := make(map[string]CircuitState, len(cb.circuits))
+	for host, c := range cb.circuits {
+		cb.refreshLocked(host, c)
+		states[host] = c.state
+	}
+	return states
+}
+
+func (cb *CircuitBreaker) allow(host string) (trial bool, err error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	c, ok := cb.circuits[host]
+	if !ok {
+		c = &circuit{}
+		cb.circuits[host] = c
+	}
+	cb.refreshLocked(host, c)
+
+	switch c.state {
+	case CircuitOpen:
+		return false, &models.CircuitOpenError{
+			Host:       host,
+			RetryAfter: c.openedAt.Add(cb.config.Cooldown).Sub(cb.now()),
+		}
+	case CircuitHalfOpen:
+		if c.halfOpenInFlight >= cb.config.HalfOpenMaxRequests {
+			return false, &models.CircuitOpenError{Host: host}
+		}
+		c.halfOpenInFlight++
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+func (cb *CircuitBreaker) record(host string, trial, failed bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	c := cb.circuits[host]
+	if trial {
+		c.halfOpenInFlight--
+	}
+
+	if !failed {
+		c.failures = 0
+		if c.state == CircuitHalfOpen {
+			cb.transitionLocked(host, c, CircuitClosed)
+		}
+		return
+	}
+
+	c.failures++
+	if c.state == CircuitHalfOpen || (c.state == CircuitClosed && c.failures >= cb.config.FailureThreshold) {
+		c.openedAt = cb.now()
+		cb.transitionLocked(host, c, CircuitOpen)
+	}
+}
+
+// release frees the slot of a trial request whose caller gave up, leaving the
+// circuit as it was.
+func (cb *CircuitBreaker) release(host string, trial bool) {
+	if !trial {
+		return
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.circuits[host].halfOpenInFlight--
+}
+
+// refreshLocked moves an open circuit to half-open once its cooldown expires.
+func (cb *CircuitBreaker) refreshLocked(host string, c *circuit) {
+	if c.state == CircuitOpen && cb.now().Sub(c.openedAt) >= cb.config.Cooldown {
+		c.halfOpenInFlight = 0
+		cb.transitionLocked(host, c, CircuitHalfOpen)
+	}
+}
+
+func (cb *CircuitBreaker) transitionLocked(host string, c *circuit, to CircuitState) {
+	from := c.state
+	if from == to {
+		return
+	}
+	c.state = to
+	if to == CircuitClosed {
+		c.failures = 0
+	}
+	if cb.config.OnStateChange != nil {
+		cb.config.OnStateChange(host, from, to)
+	}
+}
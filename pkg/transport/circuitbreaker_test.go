@@ -0,0 +1,170 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+)
+
+func statusTransport(status *int, calls *int) RoundTripper {
+	return RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		*calls++
+		rec := httptest.NewRecorder()
+		rec.WriteHeader(*status)
+		return rec.Result(), nil
+	})
+}
+
+func TestCircuitBreaker_Transitions(t *testing.T) {
+	now := time.Unix(0, 0)
+	var transitions []string
+
+	cb := NewCircuitBreaker(CircuitBreakerConfig{
+		FailureThreshold: 2,
+		Cooldown:         time.Minute,
+		OnStateChange: func(host string, from, to CircuitState) {
+			transitions = append(transitions, from.String()+"->"+to.String())
+		},
+	})
+	cb.now = func() time.Time { return now }
+
+	status, calls := http.StatusServiceUnavailable, 0
+	rt := cb.Middleware()(statusTransport(&status, &calls))
+	do := func() error {
+		req := httptest.NewRequest(http.MethodGet, "https://stats.nba.com/stats/x", nil)
+		_, err := rt.RoundTrip(context.Background(), req)
+		return err
+	}
+
+	do()
+	do()
+	if got := cb.State("stats.nba.com"); got != CircuitOpen {
+		t.Fatalf("State() = %v, want open", got)
+	}
+
+	err := do()
+	if !errors.Is(err, models.ErrCircuitOpen) {
+		t.Fatalf("RoundTrip() error = %v, want ErrCircuitOpen", err)
+	}
+	var openErr *models.CircuitOpenError
+	if !errors.As(err, &openErr) || openErr.Host != "stats.nba.com" {
+		t.Errorf("expected CircuitOpenError for stats.nba.com, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("upstream calls = %d, want 2", calls)
+	}
+
+	now = now.Add(time.Minute)
+	if got := cb.State("stats.nba.com"); got != CircuitHalfOpen {
+		t.Fatalf("State() = %v, want half-open", got)
+	}
+
+	status = http.StatusOK
+	if err := do(); err != nil {
+		t.Fatalf("trial RoundTrip() error = %v", err)
+	}
+	if got := cb.State("stats.nba.com"); got != CircuitClosed {
+		t.Errorf("State() = %v, want closed", got)
+	}
+
+	want := []string{"closed->open", "open->half-open", "half-open->closed"}
+	if len(transitions) != len(want) {
+		t.Fatalf("transitions = %v, want %v", transitions, want)
+	}
+	for i := range want {
+		if transitions[i] != want[i] {
+			t.Errorf("transitions = %v, want %v", transitions, want)
+			break
+		}
+	}
+}
+
+func TestCircuitBreaker_HalfOpenFailureReopens(t *testing.T) {
+	now := time.Unix(0, 0)
+	cb := NewCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 1, Cooldown: time.Second})
+	cb.now = func() time.Time { return now }
+
+	status, calls := http.StatusBadGateway, 0
+	rt := cb.Middleware()(statusTransport(&status, &calls))
+	req := httptest.NewRequest(http.MethodGet, "https://stats.nba.com/stats/x", nil)
+
+	rt.RoundTrip(context.Background(), req)
+	now = now.Add(time.Second)
+	rt.RoundTrip(context.Background(), req)
+
+	if got := cb.State("stats.nba.com"); got != CircuitOpen {
+		t.Errorf("State() = %v, want open", got)
+	}
+}
+
+func TestCircuitBreaker_PerHost(t *testing.T) {
+	cb := NewCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 1})
+
+	status, calls := http.StatusInternalServerError, 0
+	rt := cb.Middleware()(statusTransport(&status, &calls))
+	rt.RoundTrip(context.Background(), httptest.NewRequest(http.MethodGet, "https://stats.nba.com/stats/x", nil))
+
+	states := cb.States()
+	if states["stats.nba.com"] != CircuitOpen {
+		t.Errorf("stats.nba.com = %v, want open", states["stats.nba.com"])
+	}
+	if cb.State("cdn.nba.com") != CircuitClosed {
+		t.Errorf("cdn.nba.com = %v, want closed", cb.State("cdn.nba.com"))
+	}
+}
+
+func TestCircuitBreaker_InsideRetry(t *testing.T) {
+	cb := NewCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 2, Cooldown: time.Minute})
+	retry := RetryConfig{
+		MaxRetries:      5,
+		InitialBackoff:  time.Millisecond,
+		MaxBackoff:      time.Millisecond,
+		BackoffMultiple: 1,
+		RetryableStatus: []int{http.StatusServiceUnavailable},
+	}
+
+	status, calls := http.StatusServiceUnavailable, 0
+	rt := Chain(WithRetry(retry), cb.Middleware())(statusTransport(&status, &calls))
+	_, err := rt.RoundTrip(context.Background(), httptest.NewRequest(http.MethodGet, "https://stats.nba.com/stats/x", nil))
+
+	if !errors.Is(err, models.ErrCircuitOpen) {
+		t.Errorf("RoundTrip() error = %v, want ErrCircuitOpen", err)
+	}
+	if calls != 2 {
+		t.Errorf("upstream calls = %d, want 2", calls)
+	}
+}
+
+func TestCircuitBreaker_HalfOpenCancelIsNeutral(t *testing.T) {
+	now := time.Unix(0, 0)
+	cb := NewCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 1, Cooldown: time.Second})
+	cb.now = func() time.Time { return now }
+
+	status, calls := http.StatusBadGateway, 0
+	req := httptest.NewRequest(http.MethodGet, "https://stats.nba.com/stats/x", nil)
+	cb.Middleware()(statusTransport(&status, &calls)).RoundTrip(context.Background(), req)
+	now = now.Add(time.Second)
+
+	canceled := cb.Middleware()(RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		return nil, context.Canceled
+	}))
+	if _, err := canceled.RoundTrip(context.Background(), req); !errors.Is(err, context.Canceled) {
+		t.Fatalf("RoundTrip() error = %v, want context.Canceled", err)
+	}
+	if got := cb.State("stats.nba.com"); got != CircuitHalfOpen {
+		t.Fatalf("State() after canceled trial = %v, want half-open", got)
+	}
+
+	status = http.StatusOK
+	if _, err := cb.Middleware()(statusTransport(&status, &calls)).RoundTrip(context.Background(), req); err != nil {
+		t.Fatalf("second trial error = %v, want the slot freed", err)
+	}
+	if got := cb.State("stats.nba.com"); got != CircuitClosed {
+		t.Errorf("State() = %v, want closed", got)
+	}
+}
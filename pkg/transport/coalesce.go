@@ -0,0 +1,118 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// Coalescer shares one upstream round trip between concurrent identical GET
+// requests, keyed by CacheKey.
+type Coalescer struct {
+	mu    sync.Mutex
+	calls map[string]*coalescedCall
+}
+
+type coalescedCall struct {
+	done    chan struct{}
+	cancel  context.CancelFunc
+	waiters int
+
+	statusCode int
+	header     http.Header
+	body       []byte
+	err        error
+}
+
+func NewCoalescer() *Coalescer {
+	return &Coalescer{calls: make(map[string]*coalescedCall)}
+}
+
+// WithCoalescing returns the middleware of a new Coalescer. Place it before
+// rate limiting so a coalesced group spends a single token.
+func WithCoalescing() Middleware {
+	return NewCoalescer().Middleware()
+}
+
+// InFlight returns the number of distinct upstream requests in progress.
+func (c *Coalescer) InFlight() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.calls)
+}
+
+func (c *Coalescer) Middleware() Middleware {
+	return func(next RoundTripper) RoundTripper {
+		return RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			if req.Method != http.MethodGet {
+				return next.RoundTrip(ctx, req)
+			}
+
+			key := CacheKey(req)
+
+			c.mu.Lock()
+			call, ok := c.calls[key]
+			if ok {
+				call.waiters++
+				c.mu.Unlock()
+			} else {
+				// The shared request outlives any single caller: it is only
+				// cancelled once every waiter has gone away.
+				sharedCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+				call = &coalescedCall{done: make(chan struct{}), cancel: cancel, waiters: 1}
+				c.calls[key] = call
+				c.mu.Unlock()
+
+				go c.run(sharedCtx, key, call, next, req.Clone(sharedCtx))
+			}
+
+			select {
+			case <-call.done:
+				if call.err != nil {
+					return nil, call.err
+				}
+				return bufferedResponse(req, call.statusCode, call.header.Clone(), call.body), nil
+			case <-ctx.Done():
+				c.leave(key, call)
+				return nil, ctx.Err()
+			}
+		})
+	}
+}
+
+func (c *Coalescer) run(ctx context.Context, key string, call *coalescedCall, next RoundTripper, req *http.Request) {
+	defer call.cancel()
+
+	resp, err := next.RoundTrip(ctx, req)
+	if err == nil {
+		call.statusCode = resp.StatusCode
+		call.header = resp.Header.Clone()
+		call.body, err = io.ReadAll(resp.Body)
+		resp.Body.Close()
+	}
+	call.err = err
+
+	c.mu.Lock()
+	if c.calls[key] == call {
+		delete(c.calls, key)
+	}
+	c.mu.Unlock()
+
+	close(call.done)
+}
+
+// leave drops a waiter whose context ended. The last waiter cancels the shared
+// request and detaches it so later callers start a fresh one.
+func (c *Coalescer) leave(key string, call *coalescedCall) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	call.waiters--
+	if call.waiters == 0 {
+		call.cancel()
+		if c.calls[key] == call {
+			delete(c.calls, key)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCoalescer_SharesInFlightRequest(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	base := RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		rec := httptest.NewRecorder()
+		rec.WriteString(`{"rows":1}`)
+		return rec.Result(), nil
+	})
+
+	coalescer := NewCoalescer()
+	rt := coalescer.Middleware()(base)
+
+	const callers = 10
+	var wg sync.WaitGroup
+	bodies := make([]string, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			req := httptest.NewRequest(http.MethodGet, "https://stats.nba.com/stats/leaguedashplayerstats?Season=2023-24", nil)
+			resp, err := rt.RoundTrip(context.Background(), req)
+			if err != nil {
+				t.Errorf("RoundTrip() error = %v", err)
+				return
+			}
+			data, _ := io.ReadAll(resp.Body)
+			bodies[i] = string(data)
+		}(i)
+	}
+
+	waitFor(t, func() bool { return atomic.LoadInt32(&calls) == 1 })
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("upstream calls = %d, want 1", calls)
+	}
+	for i, body := range bodies {
+		if body != `{"rows":1}` {
+			t.Errorf("caller %d body = %q", i, body)
+		}
+	}
+	if coalescer.InFlight() != 0 {
+		t.Errorf("InFlight() = %d, want 0", coalescer.InFlight())
+	}
+}
+
+func TestCoalescer_CallerCancelDoesNotCancelOthers(t *testing.T) {
+	release := make(chan struct{})
+	var upstreamErr atomic.Value
+	base := RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		select {
+		case <-release:
+		case <-ctx.Done():
+			upstreamErr.Store(ctx.Err())
+			return nil, ctx.Err()
+		}
+		rec := httptest.NewRecorder()
+		rec.WriteString("ok")
+		return rec.Result(), nil
+	})
+
+	coalescer := NewCoalescer()
+	rt := coalescer.Middleware()(base)
+	newReq := func() *http.Request {
+		return httptest.NewRequest(http.MethodGet, "https://stats.nba.com/stats/x", nil)
+	}
+
+	cancelCtx, cancel := context.WithCancel(context.Background())
+	cancelled := make(chan error, 1)
+	go func() {
+		_, err := rt.RoundTrip(cancelCtx, newReq())
+		cancelled <- err
+	}()
+	waitFor(t, func() bool { return coalescer.InFlight() == 1 })
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := rt.RoundTrip(context.Background(), newReq())
+		done <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	cancel()
+	if err := <-cancelled; err != context.Canceled {
+		t.Errorf("cancelled caller error = %v, want context.Canceled", err)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Errorf("remaining caller error = %v", err)
+	}
+	if err := upstreamErr.Load(); err != nil {
+		t.Errorf("shared request was cancelled: %v", err)
+	}
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met within 1s")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,247 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+)
+
+// RetryPolicy decides whether a result should be retried. A zero wait lets
+// WithRetry use its exponential backoff.
+type RetryPolicy func(resp *http.Response, err error) (retry bool, wait time.Duration)
+
+type RetryConfig struct {
+	MaxRetries     int
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the exponential backoff. A Retry-After longer than it
+	// is not waited for: the response is returned without retrying.
+	MaxBackoff      time.Duration
+	BackoffMultiple float64
+	RetryableStatus []int
+
+	// Policy overrides the default classification built from RetryableStatus.
+	Policy RetryPolicy
+
+	// Budget, when set, caps the rate of retries across all requests sharing
+	// the middleware. Exhausting it returns the last result immediately.
+	Budget *RetryBudget
+}
+
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxRetries:      3,
+		InitialBackoff:  1 * time.Second,
+		MaxBackoff:      30 * time.Second,
+		BackoffMultiple: 2.0,
+		RetryableStatus: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// RetryBudget is a token bucket shared by every request that passes through
+// one WithRetry middleware.
+type RetryBudget struct {
+	limiter *rate.Limiter
+}
+
+func NewRetryBudget(retriesPerSecond float64, burst int) *RetryBudget {
+	return &RetryBudget{
+		limiter: rate.NewLimiter(rate.Limit(retriesPerSecond), burst),
+	}
+}
+
+func (b *RetryBudget) Allow() bool {
+	return b.limiter.Allow()
+}
+
+// DefaultRetryPolicy retries the given statuses, honoring Retry-After, and
+// transport errors that may succeed on another attempt.
+func DefaultRetryPolicy(retryableStatus []int) RetryPolicy {
+	return func(resp *http.Response, err error) (bool, time.Duration) {
+		if err != nil {
+			return IsRetryableError(err), 0
+		}
+		if !isRetryableStatus(resp.StatusCode, retryableStatus) {
+			return false, 0
+		}
+		return true, ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+	}
+}
+
+// IsRetryableError reports whether a transport error is worth retrying.
+// Cancellation, open circuits, unknown hosts and certificate failures are
+// permanent.
+func IsRetryableError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, models.ErrCircuitOpen) {
+		return false
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+		return false
+	}
+
+	var certErr *tls.CertificateVerificationError
+	var unknownAuthority x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	if errors.As(err, &certErr) || errors.As(err, &unknownAuthority) || errors.As(err, &hostnameErr) {
+		return false
+	}
+
+	return true
+}
+
+// ParseRetryAfter parses a Retry-After value in seconds or as an HTTP date.
+// It returns 0 when the header is absent or invalid.
+func ParseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if when, err := http.ParseTime(value); err == nil {
+		if wait := when.Sub(now); wait > 0 {
+			return wait
+		}
+	}
+	return 0
+}
+
+func WithRetry(config RetryConfig) Middleware {
+	policy := config.Policy
+	if policy == nil {
+		policy = DefaultRetryPolicy(config.RetryableStatus)
+	}
+
+	return func(next RoundTripper) RoundTripper {
+		return RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			if err := makeRewindable(req); err != nil {
+				return nil, err
+			}
+
+			var resp *http.Response
+			var err error
+			var wait time.Duration
+
+			for attempt := 0; attempt <= config.MaxRetries; attempt++ {
+				if attempt > 0 {
+					if wait <= 0 {
+						wait = calculateBackoff(attempt, config)
+					}
+					select {
+					case <-time.After(wait):
+					case <-ctx.Done():
+						return nil, ctx.Err()
+					}
+				}
+
+				attemptReq, rewindErr := rewind(req, attempt)
+				if rewindErr != nil {
+					return nil, rewindErr
+				}
+
+				recordAttempt(ctx, attempt+1)
+				resp, err = next.RoundTrip(withAttempt(ctx, attempt+1), attemptReq)
+
+				if ctx.Err() != nil {
+					return resp, err
+				}
+
+				var retry bool
+				retry, wait = policy(resp, err)
+				if !retry || attempt == config.MaxRetries {
+					return resp, err
+				}
+				if config.MaxBackoff > 0 && wait > config.MaxBackoff {
+					return resp, err
+				}
+				if config.Budget != nil && !config.Budget.Allow() {
+					return resp, err
+				}
+
+				if resp != nil {
+					resp.Body.Close()
+				}
+			}
+
+			return resp, err
+		})
+	}
+}
+
+// makeRewindable buffers a request body that has no GetBody so it can be
+// replayed on every attempt.
+func makeRewindable(req *http.Request) error {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody != nil {
+		return nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return err
+	}
+
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	req.Body, _ = req.GetBody()
+	return nil
+}
+
+func rewind(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.GetBody == nil {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	clone := req.Clone(req.Context())
+	clone.Body = body
+	return clone, nil
+}
+
+func calculateBackoff(attempt int, config RetryConfig) time.Duration {
+	backoff := float64(config.InitialBackoff) * math.Pow(config.BackoffMultiple, float64(attempt-1))
+	backoff = backoff + (backoff * 0.1 * (rand.Float64()*2 - 1))
+
+	if backoff > float64(config.MaxBackoff) {
+		backoff = float64(config.MaxBackoff)
+	}
+
+	return time.Duration(backoff)
+}
+
+func isRetryableStatus(statusCode int, retryable []int) bool {
+	for _, code := range retryable {
+		if statusCode == code {
+			return true
+		}
+	}
+	return false
+}
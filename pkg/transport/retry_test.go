@@ -0,0 +1,191 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/n-ae/nba-api-go/pkg/models"
+)
+
+func fastRetryConfig() RetryConfig {
+	config := DefaultRetryConfig()
+	config.InitialBackoff = time.Millisecond
+	config.MaxBackoff = 5 * time.Millisecond
+	return config
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"", 0},
+		{"3", 3 * time.Second},
+		{"-1", 0},
+		{now.Add(10 * time.Second).Format(http.TimeFormat), 10 * time.Second},
+		{now.Add(-time.Minute).Format(http.TimeFormat), 0},
+		{"soon", 0},
+	}
+
+	for _, tt := range tests {
+		if got := ParseRetryAfter(tt.value, now); got != tt.want {
+			t.Errorf("ParseRetryAfter(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestDefaultRetryPolicy(t *testing.T) {
+	policy := DefaultRetryPolicy(DefaultRetryConfig().RetryableStatus)
+
+	rec := httptest.NewRecorder()
+	rec.Header().Set("Retry-After", "2")
+	rec.WriteHeader(http.StatusTooManyRequests)
+	if retry, wait := policy(rec.Result(), nil); !retry || wait != 2*time.Second {
+		t.Errorf("429 with Retry-After: retry=%v wait=%v", retry, wait)
+	}
+
+	rec = httptest.NewRecorder()
+	rec.WriteHeader(http.StatusNotFound)
+	if retry, _ := policy(rec.Result(), nil); retry {
+		t.Error("404 should not be retried")
+	}
+}
+
+func TestIsRetryableError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"canceled", fmt.Errorf("wrapped: %w", context.Canceled), false},
+		{"circuit open", &models.CircuitOpenError{Host: "stats.nba.com"}, false},
+		{"dns not found", &net.DNSError{Err: "no such host", Name: "x.invalid", IsNotFound: true}, false},
+		{"dns timeout", &net.DNSError{Err: "timeout", Name: "stats.nba.com", IsTimeout: true}, true},
+		{"connection reset", errors.New("connection reset by peer"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryableError(tt.err); got != tt.want {
+				t.Errorf("IsRetryableError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithRetry_RewindsBody(t *testing.T) {
+	var bodies []string
+	base := RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		data, _ := io.ReadAll(req.Body)
+		bodies = append(bodies, string(data))
+		rec := httptest.NewRecorder()
+		if len(bodies) < 3 {
+			rec.WriteHeader(http.StatusServiceUnavailable)
+		}
+		return rec.Result(), nil
+	})
+
+	rt := WithRetry(fastRetryConfig())(base)
+	req, _ := http.NewRequest(http.MethodPost, "https://stats.nba.com/x", io.NopCloser(strings.NewReader("payload")))
+	resp, err := rt.RoundTrip(context.Background(), req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want 200", resp.StatusCode)
+	}
+
+	for i, body := range bodies {
+		if body != "payload" {
+			t.Errorf("attempt %d body = %q, want payload", i+1, body)
+		}
+	}
+}
+
+func TestWithRetry_DoesNotRetryPermanentErrors(t *testing.T) {
+	calls := 0
+	base := RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		calls++
+		return nil, &net.DNSError{Err: "no such host", Name: "x.invalid", IsNotFound: true}
+	})
+
+	rt := WithRetry(fastRetryConfig())(base)
+	if _, err := rt.RoundTrip(context.Background(), httptest.NewRequest(http.MethodGet, "https://x.invalid/", nil)); err == nil {
+		t.Fatal("expected error")
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+}
+
+func TestWithRetry_RetryAfter(t *testing.T) {
+	tests := []struct {
+		retryAfter string
+		wantCalls  int
+	}{
+		{"", 4},
+		{"0", 4},
+		{"60", 1},
+	}
+
+	for _, tt := range tests {
+		calls := 0
+		base := RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			calls++
+			rec := httptest.NewRecorder()
+			if tt.retryAfter != "" {
+				rec.Header().Set("Retry-After", tt.retryAfter)
+			}
+			rec.WriteHeader(http.StatusTooManyRequests)
+			return rec.Result(), nil
+		})
+
+		rt := WithRetry(fastRetryConfig())(base)
+		resp, err := rt.RoundTrip(context.Background(), httptest.NewRequest(http.MethodGet, "https://stats.nba.com/x", nil))
+		if err != nil || resp.StatusCode != http.StatusTooManyRequests {
+			t.Fatalf("Retry-After %q: RoundTrip() = %v, %v", tt.retryAfter, resp, err)
+		}
+		if calls != tt.wantCalls {
+			t.Errorf("Retry-After %q: calls = %d, want %d", tt.retryAfter, calls, tt.wantCalls)
+		}
+	}
+}
+
+func TestWithRetry_BudgetAndAttempts(t *testing.T) {
+	calls := 0
+	var seenAttempts []int
+	base := RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		calls++
+		seenAttempts = append(seenAttempts, AttemptFromContext(ctx))
+		rec := httptest.NewRecorder()
+		rec.WriteHeader(http.StatusBadGateway)
+		return rec.Result(), nil
+	})
+
+	config := fastRetryConfig()
+	config.Budget = NewRetryBudget(0.001, 1)
+	rt := WithRetry(config)(base)
+
+	ctx, attempts := TrackAttempts(context.Background())
+	rt.RoundTrip(ctx, httptest.NewRequest(http.MethodGet, "https://stats.nba.com/x", nil))
+
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2 (one retry allowed by budget)", calls)
+	}
+	if attempts() != 2 {
+		t.Errorf("attempts() = %d, want 2", attempts())
+	}
+	if len(seenAttempts) != 2 || seenAttempts[0] != 1 || seenAttempts[1] != 2 {
+		t.Errorf("AttemptFromContext = %v, want [1 2]", seenAttempts)
+	}
+}
@@ -0,0 +1,160 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const redacted = "[REDACTED]"
+
+type SlogConfig struct {
+	// Handler receives the records. Defaults to slog.Default().Handler().
+	Handler slog.Handler
+
+	// Level is used for successful requests. 4xx responses are logged at
+	// Warn and transport errors and 5xx responses at Error.
+	Level slog.Level
+
+	// IncludeHeaders adds the request headers as a "headers" group.
+	IncludeHeaders bool
+
+	// RedactHeaders and RedactParams name headers and query parameters whose
+	// values are replaced with "[REDACTED]". Matching is case-insensitive.
+	RedactHeaders []string
+	RedactParams  []string
+}
+
+func DefaultSlogConfig(handler slog.Handler) SlogConfig {
+	return SlogConfig{
+		Handler:       handler,
+		Level:         slog.LevelInfo,
+		RedactHeaders: []string{"Authorization", "Cookie", "Proxy-Authorization", "X-Api-Key"},
+	}
+}
+
+// WithSlog emits one structured record per request once the response body is
+// closed, so the byte count covers the whole body. Attributes are method,
+// endpoint, host, params (sorted), status, duration, attempt, bytes and
+// cache_hit, plus error on failure.
+func WithSlog(config SlogConfig) Middleware {
+	handler := config.Handler
+	if handler == nil {
+		handler = slog.Default().Handler()
+	}
+	logger := slog.New(handler)
+
+	redactHeaders := lowerAll(config.RedactHeaders)
+	redactParams := lowerAll(config.RedactParams)
+
+	return func(next RoundTripper) RoundTripper {
+		return RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			attrs := []slog.Attr{
+				slog.String("method", req.Method),
+				slog.String("endpoint", strings.ToLower(path.Base(req.URL.Path))),
+				slog.String("host", req.URL.Host),
+				redactedGroup("params", req.URL.Query(), redactParams),
+			}
+			if config.IncludeHeaders {
+				attrs = append(attrs, redactedGroup("headers", req.Header, redactHeaders))
+			}
+
+			resp, err := next.RoundTrip(ctx, req)
+			attempt := attemptsMade(ctx)
+
+			if err != nil {
+				attrs = append(attrs,
+					slog.Duration("duration", time.Since(start)),
+					slog.Int("attempt", attempt),
+					slog.String("error", err.Error()),
+				)
+				logger.LogAttrs(ctx, slog.LevelError, "nba api request failed", attrs...)
+				return resp, err
+			}
+
+			level := config.Level
+			switch {
+			case resp.StatusCode >= 500:
+				level = slog.LevelError
+			case resp.StatusCode >= 400:
+				level = slog.LevelWarn
+			}
+			attrs = append(attrs,
+				slog.Int("status", resp.StatusCode),
+				slog.Int("attempt", attempt),
+				slog.Bool("cache_hit", resp.Header.Get(CacheStatusHeader) == CacheHit),
+			)
+
+			resp.Body = &loggedBody{
+				ReadCloser: resp.Body,
+				emit: func(n int64) {
+					attrs = append(attrs,
+						slog.Duration("duration", time.Since(start)),
+						slog.Int64("bytes", n),
+					)
+					logger.LogAttrs(ctx, level, "nba api request", attrs...)
+				},
+			}
+			return resp, nil
+		})
+	}
+}
+
+// loggedBody counts bytes read and emits the record on EOF or Close,
+// whichever comes first.
+type loggedBody struct {
+	io.ReadCloser
+	n    int64
+	once sync.Once
+	emit func(n int64)
+}
+
+func (b *loggedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.n += int64(n)
+	if err == io.EOF {
+		b.once.Do(func() { b.emit(b.n) })
+	}
+	return n, err
+}
+
+func (b *loggedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(func() { b.emit(b.n) })
+	return err
+}
+
+// redactedGroup renders values as a group with sorted keys, replacing the
+// values of keys listed in redact.
+func redactedGroup(name string, values map[string][]string, redact []string) slog.Attr {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	attrs := make([]any, 0, len(keys))
+	for _, key := range keys {
+		value := strings.Join(values[key], ",")
+		if slices.Contains(redact, strings.ToLower(key)) {
+			value = redacted
+		}
+		attrs = append(attrs, slog.String(key, value))
+	}
+	return slog.Group(name, attrs...)
+}
+
+func lowerAll(values []string) []string {
+	lowered := make([]string, len(values))
+	for i, v := range values {
+		lowered[i] = strings.ToLower(v)
+	}
+	return lowered
+}
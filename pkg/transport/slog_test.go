@@ -0,0 +1,113 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithSlog_Attributes(t *testing.T) {
+	var buf bytes.Buffer
+	config := DefaultSlogConfig(slog.NewJSONHandler(&buf, nil))
+	config.IncludeHeaders = true
+	config.RedactParams = []string{"PlayerID"}
+
+	rt := WithSlog(config)(RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		rec := httptest.NewRecorder()
+		rec.Header().Set(CacheStatusHeader, CacheHit)
+		rec.WriteString(`{"ok":true}`)
+		return rec.Result(), nil
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "https://stats.nba.com/stats/PlayerGameLog?Season=2023-24&PlayerID=2544", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	resp, err := rt.RoundTrip(context.Background(), req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Fatalf("record emitted before body was consumed: %s", buf.String())
+	}
+	io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	var record struct {
+		Level    string            `json:"level"`
+		Endpoint string            `json:"endpoint"`
+		Params   map[string]string `json:"params"`
+		Headers  map[string]string `json:"headers"`
+		Status   int               `json:"status"`
+		Attempt  int               `json:"attempt"`
+		Bytes    int               `json:"bytes"`
+		CacheHit bool              `json:"cache_hit"`
+		Duration *int64            `json:"duration"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("expected exactly one JSON record, got %q: %v", buf.String(), err)
+	}
+
+	if record.Level != "INFO" || record.Endpoint != "playergamelog" || record.Status != 200 {
+		t.Errorf("unexpected record: %+v", record)
+	}
+	if record.Params["Season"] != "2023-24" || record.Params["PlayerID"] != redacted {
+		t.Errorf("params = %v", record.Params)
+	}
+	if record.Headers["Authorization"] != redacted {
+		t.Errorf("Authorization header not redacted: %v", record.Headers)
+	}
+	if record.Attempt != 1 || record.Bytes != len(`{"ok":true}`) || !record.CacheHit || record.Duration == nil {
+		t.Errorf("unexpected record: %+v", record)
+	}
+	if !bytes.Contains(buf.Bytes(), []byte(`"params":{"PlayerID":"[REDACTED]","Season":"2023-24"}`)) {
+		t.Errorf("params not sorted: %s", buf.String())
+	}
+}
+
+func TestWithSlog_Error(t *testing.T) {
+	var buf bytes.Buffer
+	rt := WithSlog(DefaultSlogConfig(slog.NewJSONHandler(&buf, nil)))(RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		return nil, errors.New("connection reset")
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "https://stats.nba.com/stats/x", nil)
+	rt.RoundTrip(withAttempt(context.Background(), 3), req)
+
+	var record map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("invalid record %q: %v", buf.String(), err)
+	}
+	if record["level"] != "ERROR" || record["error"] != "connection reset" || record["attempt"] != float64(3) {
+		t.Errorf("unexpected record: %v", record)
+	}
+}
+
+func TestWithSlog_OutsideRetryReportsAttempts(t *testing.T) {
+	var buf bytes.Buffer
+	status, calls := http.StatusServiceUnavailable, 0
+	rt := Chain(
+		WithSlog(DefaultSlogConfig(slog.NewJSONHandler(&buf, nil))),
+		WithRetry(fastRetryConfig()),
+	)(statusTransport(&status, &calls))
+
+	ctx, _ := TrackAttempts(context.Background())
+	req := httptest.NewRequest(http.MethodGet, "https://stats.nba.com/stats/x", nil)
+	resp, err := rt.RoundTrip(ctx, req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	resp.Body.Close()
+
+	var record map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("invalid record %q: %v", buf.String(), err)
+	}
+	if record["attempt"] != float64(calls) || record["level"] != "ERROR" {
+		t.Errorf("record = %v, want attempt %d at ERROR", record, calls)
+	}
+}
@@ -1,4 +1,6 @@
-package middleware
+// Package transport provides the composable RoundTripper middleware used by
+// the stats and live clients.
+package transport
 
 import (
 	"context"
@@ -0,0 +1,74 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func recordingMiddleware(name string, calls *[]string) Middleware {
+	return func(next RoundTripper) RoundTripper {
+		return RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			*calls = append(*calls, name)
+			return next.RoundTrip(ctx, req)
+		})
+	}
+}
+
+func TestChain_Order(t *testing.T) {
+	var calls []string
+	base := RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		calls = append(calls, "base")
+		return httptest.NewRecorder().Result(), nil
+	})
+
+	rt := Chain(
+		recordingMiddleware("first", &calls),
+		recordingMiddleware("second", &calls),
+	)(base)
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	if _, err := rt.RoundTrip(context.Background(), req); err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+
+	want := []string{"first", "second", "base"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("call order = %v, want %v", calls, want)
+	}
+}
+
+func TestHeaderMiddlewares(t *testing.T) {
+	var got http.Header
+	base := RoundTripperFunc(func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		got = req.Header.Clone()
+		return httptest.NewRecorder().Result(), nil
+	})
+
+	rt := Chain(
+		WithUserAgent("test-agent"),
+		WithReferer("https://example.com/"),
+		WithAccept("application/json"),
+		WithHeaders(http.Header{"X-Custom": []string{"value"}}),
+	)(base)
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	req.Header.Set("Accept", "text/plain")
+	if _, err := rt.RoundTrip(context.Background(), req); err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+
+	tests := map[string]string{
+		"User-Agent": "test-agent",
+		"Referer":    "https://example.com/",
+		"Accept":     "text/plain",
+		"X-Custom":   "value",
+	}
+	for key, want := range tests {
+		if v := got.Get(key); v != want {
+			t.Errorf("header %s = %q, want %q", key, v, want)
+		}
+	}
+}
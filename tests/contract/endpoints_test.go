@@ -605,7 +605,7 @@ func TestInternationalBroadcasterSchedule_Contract(t *testing.T) {
 		resp, err := endpoints.GetInternationalBroadcasterSchedule(ctx, client, req)
 		assertNoError(t, err, "Failed to fetch InternationalBroadcasterSchedule")
 
-		data, err := json.MarshalIndent(resp, "", "  ")
+		data, err := json.MarshalIndent(resp.Data, "", "  ")
 		assertNoError(t, err, "Failed to marshal response")
 
 		saveFixture(t, fixtureName, data)
@@ -639,9 +639,8 @@ func validateBasicSchema(t *testing.T, fixture []byte, expectedField string) {
 	t.Helper()
 
 	var resp struct {
-		StatusCode int                    `json:"StatusCode"`
-		URL        string                 `json:"URL"`
-		Data       map[string]interface{} `json:"Data"`
+		StatusCode int                    `json:"status_code"`
+		Data       map[string]interface{} `json:"data"`
 	}
 
 	err := json.Unmarshal(fixture, &resp)
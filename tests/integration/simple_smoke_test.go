@@ -125,7 +125,7 @@ func TestSimpleSmokeTests(t *testing.T) {
 			t.Fatal("Expected response, got nil")
 		}
 
-		t.Logf("✓ InternationalBroadcasterSchedule OK: %d games", len(resp.Games))
+		t.Logf("✓ InternationalBroadcasterSchedule OK: %d games", len(resp.Data.Games))
 	})
 
 	t.Run("InternationalBroadcasterSchedule_PreviousSeason", func(t *testing.T) {
@@ -145,6 +145,6 @@ func TestSimpleSmokeTests(t *testing.T) {
 			t.Fatal("Expected response, got nil")
 		}
 
-		t.Logf("✓ InternationalBroadcasterSchedule 2024 OK: %d games", len(resp.Games))
+		t.Logf("✓ InternationalBroadcasterSchedule 2024 OK: %d games", len(resp.Data.Games))
 	})
 }
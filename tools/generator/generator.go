@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"text/template"
 )
@@ -38,15 +39,19 @@ type ParameterMetadata struct {
 }
 
 type ResultSetMetadata struct {
-	Name       string          `json:"name"`
-	Fields     []string        `json:"fields"`
+	Name   string   `json:"name"`
+	Fields []string `json:"fields"`
+	// Nullable lists numeric fields the API sends as null, such as box score
+	// stats of players who did not play. They are generated as models.Opt.
+	Nullable   []string        `json:"nullable"`
 	FieldTypes []FieldTypeInfo `json:"-"`
 }
 
 type FieldTypeInfo struct {
-	Name    string
-	GoType  string
-	JSONTag string
+	Name     string
+	GoType   string
+	JSONTag  string
+	Nullable bool
 }
 
 func (g *Generator) GenerateFromMetadata(metadataFile string, dryRun bool) error {
@@ -166,25 +171,36 @@ func (g *Generator) processMetadata(metadata EndpointMetadata) EndpointMetadata
 
 	// Process result sets to infer field types
 	for i := range metadata.ResultSets {
-		metadata.ResultSets[i].FieldTypes = inferFieldTypes(metadata.ResultSets[i].Fields)
+		metadata.ResultSets[i].FieldTypes = inferFieldTypes(metadata.ResultSets[i].Fields, metadata.ResultSets[i].Nullable)
 	}
 
 	return metadata
 }
 
 // inferFieldTypes infers Go types from NBA API field names
-func inferFieldTypes(fields []string) []FieldTypeInfo {
+func inferFieldTypes(fields, nullable []string) []FieldTypeInfo {
 	fieldTypes := make([]FieldTypeInfo, len(fields))
 	for i, field := range fields {
+		goType := inferGoType(field)
 		fieldTypes[i] = FieldTypeInfo{
-			Name:    field,
-			GoType:  inferGoType(field),
-			JSONTag: field,
+			Name:     exportedName(field),
+			GoType:   goType,
+			JSONTag:  field,
+			Nullable: goType != "string" && slices.Contains(nullable, field),
 		}
 	}
 	return fieldTypes
 }
 
+// exportedName capitalizes camelCase headers such as "vsEast" so the field is
+// exported.
+func exportedName(field string) string {
+	if field == "" {
+		return field
+	}
+	return strings.ToUpper(field[:1]) + field[1:]
+}
+
 // inferGoType infers the Go type from a field name using NBA API conventions
 func inferGoType(fieldName string) string {
 	lower := strings.ToLower(fieldName)
@@ -228,36 +244,42 @@ func inferGoType(fieldName string) string {
 		return "string"
 	}
 
-	// Statistical fields - most are numbers
-	// Common stat abbreviations
+	// Statistical fields - most are numbers. Abbreviations must be whole
+	// "_"-separated tokens: "vsEast" or "Score100PTS" are "W-L" records, not
+	// assists or points.
+	tokens := strings.Split(lower, "_")
 	statAbbreviations := []string{
 		"pts", "reb", "ast", "stl", "blk", "tov", "pf", "fgm", "fga", "ftm", "fta",
-		"fg3m", "fg3a", "oreb", "dreb", "min", "gp", "gs", "plus_minus", "pfd",
-		"blka", "dd2", "td3", "fantasy", "_count", "_games", "_rank",
+		"fg3m", "fg3a", "oreb", "dreb", "min", "gp", "gs", "pfd",
+		"blka", "dd2", "td3", "fantasy",
 	}
-
+	isStat := strings.Contains("_"+lower+"_", "_plus_minus_") ||
+		slices.Contains(tokens[1:], "count") || slices.Contains(tokens[1:], "games") ||
+		slices.Contains(tokens[1:], "rank")
 	for _, abbrev := range statAbbreviations {
-		if strings.Contains(lower, abbrev) {
-			// MIN (minutes) is typically float64
-			if strings.Contains(lower, "min") && !strings.Contains(lower, "game") {
-				return "float64"
-			}
-			// Made/Attempted stats can be int or float depending on context
-			// For box scores, they're typically int
-			if strings.HasSuffix(lower, "m") || strings.HasSuffix(lower, "a") {
-				return "int"
-			}
-			// Most other stats are float64 (especially averages)
-			if strings.Contains(lower, "avg") || strings.Contains(lower, "per") {
-				return "float64"
-			}
-			// Game counts are int
-			if lower == "gp" || lower == "gs" {
-				return "int"
-			}
-			// Default for stats is float64
+		isStat = isStat || slices.Contains(tokens, abbrev)
+	}
+
+	if isStat {
+		// MIN (minutes) is typically float64
+		if slices.Contains(tokens, "min") && !strings.Contains(lower, "game") {
 			return "float64"
 		}
+		// Made/Attempted stats can be int or float depending on context
+		// For box scores, they're typically int
+		if strings.HasSuffix(lower, "m") || strings.HasSuffix(lower, "a") {
+			return "int"
+		}
+		// Most other stats are float64 (especially averages)
+		if strings.Contains(lower, "avg") || strings.Contains(lower, "per") {
+			return "float64"
+		}
+		// Game counts are int
+		if lower == "gp" || lower == "gs" {
+			return "int"
+		}
+		// Default for stats is float64
+		return "float64"
 	}
 
 	// Age is int
@@ -0,0 +1,35 @@
+package main
+
+import "testing"
+
+func TestInferGoType(t *testing.T) {
+	tests := []struct {
+		field string
+		want  string
+	}{
+		{"PTS", "float64"},
+		{"OPP_PTS", "float64"},
+		{"FGM", "int"},
+		{"FG3A", "int"},
+		{"MIN", "float64"},
+		{"FG_PCT", "float64"},
+		{"PLUS_MINUS", "float64"},
+		{"PLAYER_ID", "int"},
+		{"GAME_ID", "string"},
+		// Standings records such as "30-22" only contain stat abbreviations.
+		{"vsEast", "string"},
+		{"vsSoutheast", "string"},
+		{"Score100PTS", "string"},
+		{"OppScore100PTS", "string"},
+		{"LeadInFGPCT", "string"},
+		{"LeadInReb", "string"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			if got := inferGoType(tt.field); got != tt.want {
+				t.Errorf("inferGoType(%q) = %s, want %s", tt.field, got, tt.want)
+			}
+		})
+	}
+}